@@ -19,9 +19,55 @@ package v1alpha1
 import (
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// TypeDomainValidated indicates whether ACM has validated the ownership of
+// the domains of a DNS validated Certificate.
+const TypeDomainValidated xpv1.ConditionType = "DomainValidated"
+
+// Reasons a Certificate is or is not validated.
+const (
+	ReasonValidationPending   xpv1.ConditionReason = "PendingValidation"
+	ReasonValidationSucceeded xpv1.ConditionReason = "ValidationSucceeded"
+	ReasonValidationFailed    xpv1.ConditionReason = "ValidationFailed"
+)
+
+// ValidationPending returns a condition that indicates ACM has not yet
+// validated the domains of a Certificate.
+func ValidationPending() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDomainValidated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValidationPending,
+	}
+}
+
+// ValidationSucceeded returns a condition that indicates ACM has validated
+// the domains of a Certificate.
+func ValidationSucceeded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDomainValidated,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValidationSucceeded,
+	}
+}
+
+// ValidationFailed returns a condition that indicates ACM could not validate
+// the domains of a Certificate.
+func ValidationFailed(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDomainValidated,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValidationFailed,
+		Message:            msg,
+	}
+}
+
 // Tag represents user-provided metadata that can be associated
 type Tag struct {
 
@@ -43,6 +89,33 @@ type DomainValidationOption struct {
 	ValidationDomain string `json:"validationDomain"`
 }
 
+// ValidationResourceRecord is a CNAME record that ACM uses to validate the
+// ownership of a domain.
+type ValidationResourceRecord struct {
+	// Name of the DNS record to create in the hosted zone of the domain.
+	Name string `json:"name"`
+
+	// Type of the DNS record. Currently this can be CNAME.
+	Type string `json:"type"`
+
+	// Value of the DNS record.
+	Value string `json:"value"`
+}
+
+// DomainValidation is the validation state of one of the domains of a
+// Certificate.
+type DomainValidation struct {
+	// Fully qualified domain name (FQDN) of the certificate being validated.
+	DomainName string `json:"domainName"`
+
+	// Status of the validation of the domain.
+	ValidationStatus string `json:"validationStatus,omitempty"`
+
+	// ResourceRecord is the DNS record that validates the domain when DNS
+	// validation is used.
+	ResourceRecord *ValidationResourceRecord `json:"resourceRecord,omitempty"`
+}
+
 // CertificateSpec defines the desired state of Certificate
 type CertificateSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -65,6 +138,13 @@ type CertificateExternalStatus struct {
 	// Type of the certificate
 	// +kubebuilder:validation:Enum=IMPORTED;AMAZON_ISSUED;PRIVATE
 	Type acm.CertificateType `json:"type,omitempty"`
+
+	// Serial is the serial number of the certificate.
+	Serial string `json:"serial,omitempty"`
+
+	// DomainValidations contains the validation state of the domains of the
+	// certificate.
+	DomainValidations []DomainValidation `json:"domainValidations,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
@@ -92,8 +172,21 @@ type CertificateParameters struct {
 	CertificateAuthorityARNSelector *xpv1.Selector `json:"certificateAuthorityARNSelector,omitempty"`
 
 	// Fully qualified domain name (FQDN),that to secure with an ACM certificate.
+	// It is required unless the certificate is imported from a Secret.
+	// +optional
 	// +immutable
-	DomainName string `json:"domainName"`
+	DomainName string `json:"domainName,omitempty"`
+
+	// CertificateSecretRef references a Kubernetes TLS Secret, such as one
+	// written by cert-manager, whose certificate is imported into ACM instead
+	// of requesting a certificate. The certificate is read from the tls.crt
+	// key, the private key from tls.key and the chain from the certificates
+	// that follow the first one in tls.crt, or from ca.crt if there are
+	// none. Whenever the certificate in the Secret changes, it is re-imported
+	// under the same ARN.
+	// +optional
+	// +immutable
+	CertificateSecretRef *xpv1.SecretReference `json:"certificateSecretRef,omitempty"`
 
 	// The domain name that you want ACM to use to send you emails so that you can
 	// validate domain ownership.
@@ -101,7 +194,8 @@ type CertificateParameters struct {
 	// +immutable
 	DomainValidationOptions []*DomainValidationOption `json:"domainValidationOptions,omitempty"`
 
-	// Parameter add the certificate to a certificate transparency log.
+	// Parameter add the certificate to a certificate transparency log. It is
+	// ignored for imported certificates.
 	// +optional
 	// +kubebuilder:validation:Enum=ENABLED;DISABLED
 	CertificateTransparencyLoggingPreference *acm.CertificateTransparencyLoggingPreference `json:"certificateTransparencyLoggingPreference,omitempty"`
@@ -122,6 +216,24 @@ type CertificateParameters struct {
 	// Flag to renew the certificate
 	// +optional
 	RenewCertificate *bool `json:"renewCertificate,omitempty"`
+
+	// ValidationZoneID is the ID of the Route53 hosted zone in which the
+	// validation records of a DNS validated certificate are created. The
+	// records are kept for as long as the certificate exists so that ACM can
+	// renew it, and are removed when the certificate is deleted unless another
+	// certificate in the account uses them. Validation records are left to the
+	// user when this is not set.
+	// +optional
+	ValidationZoneID *string `json:"validationZoneId,omitempty"`
+
+	// ValidationZoneRef references a Route53 HostedZone to retrieve its ID.
+	// +optional
+	ValidationZoneRef *xpv1.Reference `json:"validationZoneRef,omitempty"`
+
+	// ValidationZoneSelector selects a reference to a Route53 HostedZone to
+	// retrieve its ID.
+	// +optional
+	ValidationZoneSelector *xpv1.Selector `json:"validationZoneSelector,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -25,6 +25,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 )
 
 // ResolveReferences of this Certificate
@@ -45,5 +46,19 @@ func (mg *Certificate) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.CertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CertificateAuthorityARNRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.validationZoneId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ValidationZoneID),
+		Reference:    mg.Spec.ForProvider.ValidationZoneRef,
+		Selector:     mg.Spec.ForProvider.ValidationZoneSelector,
+		To:           reference.To{Managed: &route53v1alpha1.HostedZone{}, List: &route53v1alpha1.HostedZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.validationZoneId")
+	}
+	mg.Spec.ForProvider.ValidationZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ValidationZoneRef = rsp.ResolvedReference
+
 	return nil
 }
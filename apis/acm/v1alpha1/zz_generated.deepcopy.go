@@ -56,6 +56,13 @@ func (in *Certificate) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus) {
 	*out = *in
+	if in.DomainValidations != nil {
+		in, out := &in.DomainValidations, &out.DomainValidations
+		*out = make([]DomainValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
@@ -118,6 +125,11 @@ func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CertificateSecretRef != nil {
+		in, out := &in.CertificateSecretRef, &out.CertificateSecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
 	if in.DomainValidationOptions != nil {
 		in, out := &in.DomainValidationOptions, &out.DomainValidationOptions
 		*out = make([]*DomainValidationOption, len(*in))
@@ -160,6 +172,21 @@ func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ValidationZoneID != nil {
+		in, out := &in.ValidationZoneID, &out.ValidationZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValidationZoneRef != nil {
+		in, out := &in.ValidationZoneRef, &out.ValidationZoneRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ValidationZoneSelector != nil {
+		in, out := &in.ValidationZoneSelector, &out.ValidationZoneSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateParameters.
@@ -193,7 +220,7 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
@@ -206,6 +233,26 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainValidation) DeepCopyInto(out *DomainValidation) {
+	*out = *in
+	if in.ResourceRecord != nil {
+		in, out := &in.ResourceRecord, &out.ResourceRecord
+		*out = new(ValidationResourceRecord)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainValidation.
+func (in *DomainValidation) DeepCopy() *DomainValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainValidationOption) DeepCopyInto(out *DomainValidationOption) {
 	*out = *in
@@ -235,3 +282,18 @@ func (in *Tag) DeepCopy() *Tag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationResourceRecord) DeepCopyInto(out *ValidationResourceRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationResourceRecord.
+func (in *ValidationResourceRecord) DeepCopy() *ValidationResourceRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationResourceRecord)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CertificateParameters defines the desired state of a certificate issued by
+// an AWS CertificateAuthority.
+type CertificateParameters struct {
+	// Region is the region of the CertificateAuthority that issues the
+	// Certificate.
+	Region string `json:"region"`
+
+	// CertificateAuthorityARN is the ARN of the certificate authority that
+	// issues the Certificate.
+	// +optional
+	// +immutable
+	CertificateAuthorityARN *string `json:"certificateAuthorityARN,omitempty"`
+
+	// CertificateAuthorityARNRef references a CertificateAuthority to
+	// retrieve its Arn.
+	// +optional
+	// +immutable
+	CertificateAuthorityARNRef *xpv1.Reference `json:"certificateAuthorityARNRef,omitempty"`
+
+	// CertificateAuthorityARNSelector selects a reference to a
+	// CertificateAuthority to retrieve its Arn.
+	// +optional
+	CertificateAuthorityARNSelector *xpv1.Selector `json:"certificateAuthorityARNSelector,omitempty"`
+
+	// CertificateSigningRequestSecretRef references the key of a Secret that
+	// holds a PEM encoded certificate signing request. If it is not given, a
+	// private key and certificate signing request are generated from the
+	// Subject and DNSNames, and the private key is published to the
+	// connection secret.
+	// +optional
+	// +immutable
+	CertificateSigningRequestSecretRef *xpv1.SecretKeySelector `json:"certificateSigningRequestSecretRef,omitempty"`
+
+	// Subject of the generated certificate signing request.
+	// +optional
+	// +immutable
+	Subject *CertificateSubject `json:"subject,omitempty"`
+
+	// DNSNames are the subject alternative names of the generated
+	// certificate signing request.
+	// +optional
+	// +immutable
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// KeyAlgorithm of the generated private key. Defaults to RSA_2048.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=RSA_2048;EC_secp384r1;EC_prime256v1;RSA_4096
+	KeyAlgorithm acmpca.KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+
+	// SigningAlgorithm that the certificate authority uses to sign the
+	// certificate. It has to match the key algorithm of the certificate
+	// authority.
+	// +immutable
+	// +kubebuilder:validation:Enum=SHA512WITHECDSA;SHA256WITHECDSA;SHA384WITHECDSA;SHA512WITHRSA;SHA256WITHRSA;SHA384WITHRSA
+	SigningAlgorithm acmpca.SigningAlgorithm `json:"signingAlgorithm"`
+
+	// TemplateARN is the ARN of the template used to issue the certificate.
+	// Defaults to the EndEntityCertificate/V1 template.
+	// +optional
+	// +immutable
+	TemplateARN *string `json:"templateArn,omitempty"`
+
+	// Validity of the certificate.
+	// +immutable
+	Validity CertificateValidity `json:"validity"`
+
+	// RenewBeforeInDays is the number of days before the certificate expires
+	// that a new certificate is issued. Defaults to 30 days. If it is not
+	// smaller than the validity of the certificate, the certificate is
+	// renewed once two thirds of its validity have passed instead.
+	// +optional
+	RenewBeforeInDays *int64 `json:"renewBeforeInDays,omitempty"`
+}
+
+// CertificateSubject is the subject of a generated certificate signing
+// request.
+type CertificateSubject struct {
+	// CommonName of the certificate.
+	CommonName string `json:"commonName"`
+
+	// Country is the two digit code of the country the subject resides in.
+	// +optional
+	Country *string `json:"country,omitempty"`
+
+	// Locality of the subject.
+	// +optional
+	Locality *string `json:"locality,omitempty"`
+
+	// Organization of the subject.
+	// +optional
+	Organization *string `json:"organization,omitempty"`
+
+	// OrganizationalUnit of the subject.
+	// +optional
+	OrganizationalUnit *string `json:"organizationalUnit,omitempty"`
+
+	// State or province of the subject.
+	// +optional
+	State *string `json:"state,omitempty"`
+}
+
+// CertificateValidity is the period a certificate is valid for.
+type CertificateValidity struct {
+	// Type of the validity period.
+	// +kubebuilder:validation:Enum=END_DATE;ABSOLUTE;DAYS;MONTHS;YEARS
+	Type acmpca.ValidityPeriodType `json:"type"`
+
+	// Value of the validity period in the unit of its Type. END_DATE is in
+	// YYYYMMDDHHMMSS format and ABSOLUTE is in seconds since the Unix epoch.
+	Value int64 `json:"value"`
+}
+
+// CertificateObservation keeps the state for the external resource
+type CertificateObservation struct {
+	// CertificateARN is the ARN of the issued certificate.
+	CertificateARN string `json:"certificateArn,omitempty"`
+
+	// Serial is the serial number of the issued certificate.
+	Serial string `json:"serial,omitempty"`
+
+	// NotBefore is the date and time before which the certificate is not
+	// valid.
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the date and time after which the certificate is not
+	// valid.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalGeneration is the number of times the certificate has been
+	// renewed.
+	RenewalGeneration int64 `json:"renewalGeneration,omitempty"`
+
+	// PendingCertificateARN is the ARN of a renewed certificate that
+	// replaces the current one once it has been issued.
+	PendingCertificateARN string `json:"pendingCertificateArn,omitempty"`
+
+	// SupersededSerial is the serial number of a renewed certificate that is
+	// revoked once its replacement has been published.
+	SupersededSerial string `json:"supersededSerial,omitempty"`
+}
+
+// A CertificateSpec defines the desired state of a Certificate.
+type CertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CertificateParameters `json:"forProvider"`
+}
+
+// A CertificateStatus represents the observed state of a Certificate.
+type CertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Certificate is a managed resource that represents a certificate issued by
+// an AWS CertificateAuthority.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NOT-AFTER",type="string",JSONPath=".status.atProvider.notAfter"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateList contains a list of Certificate
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Certificate `json:"items"`
+}
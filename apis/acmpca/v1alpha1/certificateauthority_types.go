@@ -28,9 +28,35 @@ type CertificateAuthorityParameters struct {
 	Region string `json:"region"`
 
 	// Type of the certificate authority
-	// +kubebuilder:validation:Enum=ROOT;SUBORINATE
+	// +kubebuilder:validation:Enum=ROOT;SUBORDINATE
 	Type acmpca.CertificateAuthorityType `json:"type"`
 
+	// ParentCertificateAuthorityARN is the ARN of the certificate authority
+	// that signs the certificate of a SUBORDINATE certificate authority. The
+	// parent must be ACTIVE. It is ignored for ROOT certificate authorities,
+	// which sign their own certificate.
+	// +optional
+	// +immutable
+	ParentCertificateAuthorityARN *string `json:"parentCertificateAuthorityArn,omitempty"`
+
+	// ParentCertificateAuthorityARNRef references a CertificateAuthority to
+	// retrieve its Arn.
+	// +optional
+	// +immutable
+	ParentCertificateAuthorityARNRef *xpv1.Reference `json:"parentCertificateAuthorityArnRef,omitempty"`
+
+	// ParentCertificateAuthorityARNSelector selects a reference to a
+	// CertificateAuthority to retrieve its Arn.
+	// +optional
+	ParentCertificateAuthorityARNSelector *xpv1.Selector `json:"parentCertificateAuthorityArnSelector,omitempty"`
+
+	// ValidityInDays is the number of days the certificate of the certificate
+	// authority is valid for. Defaults to 3650 days for ROOT and 1825 days
+	// for SUBORDINATE certificate authorities.
+	// +optional
+	// +immutable
+	ValidityInDays *int64 `json:"validityInDays,omitempty"`
+
 	// RevocationConfiguration to associate with the certificateAuthority.
 	// +optional
 	RevocationConfiguration *RevocationConfiguration `json:"revocationConfiguration,omitempty"`
@@ -175,6 +201,10 @@ type CertificateAuthorityExternalStatus struct {
 
 	// Status is the current status of the CertificateAuthority.
 	Status string `json:"status,omitempty"`
+
+	// NotAfter is the date and time after which the certificate of the
+	// CertificateAuthority is no longer valid.
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
 }
 
 // CertificateAuthoritySpec defines the desired state of CertificateAuthority
@@ -49,6 +49,14 @@ var (
 	CertificateAuthorityGroupVersionKind = SchemeGroupVersion.WithKind(CertificateAuthorityKind)
 )
 
+// Certificate type metadata.
+var (
+	CertificateKind             = reflect.TypeOf(Certificate{}).Name()
+	CertificateGroupKind        = schema.GroupKind{Group: Group, Kind: CertificateKind}.String()
+	CertificateKindAPIVersion   = CertificateKind + "." + SchemeGroupVersion.String()
+	CertificateGroupVersionKind = SchemeGroupVersion.WithKind(CertificateKind)
+)
+
 // CertificateAuthorityPermission type metadata.
 var (
 	CertificateAuthorityPermissionKind             = reflect.TypeOf(CertificateAuthorityPermission{}).Name()
@@ -59,5 +67,6 @@ var (
 
 func init() {
 	SchemeBuilder.Register(&CertificateAuthority{}, &CertificateAuthorityList{})
+	SchemeBuilder.Register(&Certificate{}, &CertificateList{})
 	SchemeBuilder.Register(&CertificateAuthorityPermission{}, &CertificateAuthorityPermissionList{})
 }
@@ -0,0 +1,372 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DB cluster states.
+const (
+	// The DB cluster is healthy and available.
+	DBClusterStateAvailable = "available"
+	// The DB cluster is being created.
+	DBClusterStateCreating = "creating"
+	// The DB cluster is being deleted.
+	DBClusterStateDeleting = "deleting"
+	// The DB cluster is being modified.
+	DBClusterStateModifying = "modifying"
+	// The DB cluster is being backed up.
+	DBClusterStateBackingUp = "backing-up"
+)
+
+// DBClusterParameters define the desired state of an AWS Aurora DB cluster.
+type DBClusterParameters struct {
+	// Region is the region you'd like your DBCluster to be created in.
+	Region string `json:"region"`
+
+	// AvailabilityZones is a list of Availability Zones (AZs) where instances
+	// in the DB cluster can be created.
+	// +immutable
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// BackupRetentionPeriod is the number of days for which automated backups
+	// are retained. Must be a value from 1 to 35.
+	// Default: 1
+	// +optional
+	BackupRetentionPeriod *int `json:"backupRetentionPeriod,omitempty"`
+
+	// CharacterSetName indicates that the DB cluster should be associated with
+	// the specified CharacterSet.
+	// +immutable
+	// +optional
+	CharacterSetName *string `json:"characterSetName,omitempty"`
+
+	// CopyTagsToSnapshot should be true to copy all tags from the DB cluster
+	// to snapshots of the DB cluster, and otherwise false. The default is false.
+	// +optional
+	CopyTagsToSnapshot *bool `json:"copyTagsToSnapshot,omitempty"`
+
+	// DatabaseName is the name for your database of up to 64 alphanumeric
+	// characters. If you do not provide a name, Amazon RDS doesn't create a
+	// database in the DB cluster you are creating.
+	// +immutable
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// DBClusterParameterGroupName is the name of the DB cluster parameter
+	// group to associate with this DB cluster. If omitted, the default DB
+	// cluster parameter group for the specified engine is used.
+	// +optional
+	DBClusterParameterGroupName *string `json:"dbClusterParameterGroupName,omitempty"`
+
+	// DBClusterParameterGroupNameRef is a reference to a
+	// DBClusterParameterGroup used to set DBClusterParameterGroupName.
+	// +optional
+	DBClusterParameterGroupNameRef *xpv1.Reference `json:"dbClusterParameterGroupNameRef,omitempty"`
+
+	// DBClusterParameterGroupNameSelector selects a reference to a
+	// DBClusterParameterGroup used to set DBClusterParameterGroupName.
+	// +optional
+	DBClusterParameterGroupNameSelector *xpv1.Selector `json:"dbClusterParameterGroupNameSelector,omitempty"`
+
+	// DBSubnetGroupName is a DB subnet group to associate with this DB
+	// cluster.
+	// +immutable
+	// +optional
+	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+
+	// DBSubnetGroupNameRef is a reference to a DBSubnetGroup used to set
+	// DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameRef *xpv1.Reference `json:"dbSubnetGroupNameRef,omitempty"`
+
+	// DBSubnetGroupNameSelector selects a reference to a DBSubnetGroup used to
+	// set DBSubnetGroupName.
+	// +immutable
+	// +optional
+	DBSubnetGroupNameSelector *xpv1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
+
+	// DeletionProtection indicates if the DB cluster should have deletion
+	// protection enabled. The database can't be deleted when this value is set
+	// to true. The default is false.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// EnableCloudwatchLogsExports is the list of log types that need to be
+	// enabled for exporting to CloudWatch Logs. The values in the list depend
+	// on the DB engine being used.
+	// +optional
+	EnableCloudwatchLogsExports []string `json:"enableCloudwatchLogsExports,omitempty"`
+
+	// EnableHTTPEndpoint enables the HTTP endpoint for an Aurora Serverless DB
+	// cluster. By default, the HTTP endpoint is disabled.
+	// +optional
+	EnableHTTPEndpoint *bool `json:"enableHttpEndpoint,omitempty"`
+
+	// EnableIAMDatabaseAuthentication should be true to enable mapping of AWS
+	// Identity and Access Management (IAM) accounts to database accounts, and
+	// otherwise false.
+	// Default: false
+	// +optional
+	EnableIAMDatabaseAuthentication *bool `json:"enableIAMDatabaseAuthentication,omitempty"`
+
+	// Engine is the name of the database engine to be used for this DB
+	// cluster.
+	// +immutable
+	// +kubebuilder:validation:Enum=aurora;aurora-mysql;aurora-postgresql
+	Engine string `json:"engine"`
+
+	// EngineMode is the DB engine mode of the DB cluster, either provisioned,
+	// serverless, parallelquery, global, or multimaster.
+	// +immutable
+	// +optional
+	EngineMode *string `json:"engineMode,omitempty"`
+
+	// EngineVersion is the version number of the database engine to use.
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// KMSKeyID is the AWS KMS key identifier for an encrypted DB cluster.
+	// If an encryption key is not specified and StorageEncrypted is true,
+	// Amazon RDS will use your default encryption key.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a KMS Key used to set KMSKeyID.
+	// +immutable
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set KMSKeyID.
+	// +immutable
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// MasterUsername is the name of the master user for the DB cluster.
+	// Constraints:
+	//    * Must be 1 to 16 letters or numbers.
+	//    * First character must be a letter.
+	//    * Can't be a reserved word for the chosen database engine.
+	// +immutable
+	// +optional
+	MasterUsername *string `json:"masterUsername,omitempty"`
+
+	// MasterPasswordSecretRef references the secret that contains the password
+	// used in the creation of this DB cluster. If no reference is given, a
+	// password will be auto-generated.
+	// +optional
+	MasterPasswordSecretRef *xpv1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
+
+	// Port is the port number on which the instances in the DB cluster accept
+	// connections.
+	// Default: 3306 if engine is set as aurora or aurora-mysql, 5432 if set to
+	// aurora-postgresql.
+	// +optional
+	Port *int `json:"port,omitempty"`
+
+	// PreferredBackupWindow is the daily time range during which automated
+	// backups are created if automated backups are enabled, in the format
+	// hh24:mi-hh24:mi.
+	// +optional
+	PreferredBackupWindow *string `json:"preferredBackupWindow,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range during which system
+	// maintenance can occur, in Universal Coordinated Time (UTC), in the format
+	// ddd:hh24:mi-ddd:hh24:mi.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// ScalingConfiguration is the scaling properties of the DB cluster. It is
+	// only applicable to DB clusters in serverless DB engine mode.
+	// +optional
+	ScalingConfiguration *ScalingConfiguration `json:"scalingConfiguration,omitempty"`
+
+	// StorageEncrypted specifies whether the DB cluster is encrypted.
+	// +immutable
+	// +optional
+	StorageEncrypted *bool `json:"storageEncrypted,omitempty"`
+
+	// VPCSecurityGroupIDs is a list of EC2 VPC security groups to associate
+	// with this DB cluster.
+	// +optional
+	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIds,omitempty"`
+
+	// VPCSecurityGroupIDRefs are references to VPCSecurityGroups used to set
+	// the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDRefs []xpv1.Reference `json:"vpcSecurityGroupIDRefs,omitempty"`
+
+	// VPCSecurityGroupIDSelector selects references to VPCSecurityGroups used
+	// to set the VPCSecurityGroupIDs.
+	// +optional
+	VPCSecurityGroupIDSelector *xpv1.Selector `json:"vpcSecurityGroupIDSelector,omitempty"`
+
+	// Tags assigned to the DB cluster.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// AllowMajorVersionUpgrade indicates that major version upgrades are
+	// allowed. Changing this parameter doesn't result in an outage and the
+	// change is asynchronously applied as soon as possible.
+	// +optional
+	AllowMajorVersionUpgrade *bool `json:"allowMajorVersionUpgrade,omitempty"`
+
+	// ApplyModificationsImmediately specifies whether the modifications in this
+	// request and any pending modifications are asynchronously applied as soon
+	// as possible, regardless of the PreferredMaintenanceWindow setting for the
+	// DB cluster.
+	// Default: false
+	// +optional
+	ApplyModificationsImmediately *bool `json:"applyModificationsImmediately,omitempty"`
+
+	// SkipFinalSnapshotBeforeDeletion determines whether a final DB cluster
+	// snapshot is created before the DB cluster is deleted. If true is
+	// specified, no DB cluster snapshot is created. If false is specified, a DB
+	// cluster snapshot is created before the DB cluster is deleted.
+	// The FinalDBSnapshotIdentifier parameter must be specified if
+	// SkipFinalSnapshotBeforeDeletion is false.
+	// Default: false
+	// +optional
+	SkipFinalSnapshotBeforeDeletion *bool `json:"skipFinalSnapshotBeforeDeletion,omitempty"`
+
+	// FinalDBSnapshotIdentifier is the DB cluster snapshot identifier of the
+	// new DB cluster snapshot created when SkipFinalSnapshotBeforeDeletion is
+	// set to false.
+	// Constraints:
+	//    * Must be 1 to 255 letters, numbers, or hyphens.
+	//    * First character must be a letter
+	//    * Can't end with a hyphen or contain two consecutive hyphens
+	// +optional
+	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+}
+
+// A DBClusterSpec defines the desired state of a DBCluster.
+type DBClusterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBClusterParameters `json:"forProvider"`
+}
+
+// DBClusterMember contains information about an instance that is part of a
+// DB cluster.
+type DBClusterMember struct {
+	// DBInstanceIdentifier specifies the instance identifier for this member
+	// of the DB cluster.
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier,omitempty"`
+
+	// DBClusterParameterGroupStatus specifies the status of the DB cluster
+	// parameter group for this member of the DB cluster.
+	DBClusterParameterGroupStatus string `json:"dbClusterParameterGroupStatus,omitempty"`
+
+	// IsClusterWriter is true if the cluster member is the primary instance
+	// for the DB cluster and false otherwise.
+	IsClusterWriter bool `json:"isClusterWriter,omitempty"`
+
+	// PromotionTier specifies the order in which an Aurora Replica is promoted
+	// to the primary instance after a failure of the existing primary instance.
+	PromotionTier int `json:"promotionTier,omitempty"`
+}
+
+// DBClusterObservation is the representation of the current state that is
+// observed.
+type DBClusterObservation struct {
+	// Status specifies the current state of this DB cluster.
+	Status string `json:"status,omitempty"`
+
+	// DBClusterARN is the Amazon Resource Name (ARN) for the DB cluster.
+	DBClusterARN string `json:"dbClusterArn,omitempty"`
+
+	// DBClusterResourceID is the AWS Region-unique, immutable identifier for
+	// the DB cluster.
+	DBClusterResourceID string `json:"dbClusterResourceId,omitempty"`
+
+	// DBClusterMembers provides the list of instances that make up the DB
+	// cluster.
+	DBClusterMembers []DBClusterMember `json:"dbClusterMembers,omitempty"`
+
+	// Endpoint specifies the connection endpoint for the primary instance of
+	// the DB cluster.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ReaderEndpoint specifies the endpoint that load-balances connections
+	// across the Aurora Replicas that are available in the DB cluster.
+	ReaderEndpoint string `json:"readerEndpoint,omitempty"`
+
+	// Port specifies the port that the database engine is listening on.
+	Port int `json:"port,omitempty"`
+
+	// HostedZoneID specifies the ID that Amazon Route 53 assigns when you
+	// create a hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+
+	// ClusterCreateTime specifies the time when the DB cluster was created.
+	ClusterCreateTime *metav1.Time `json:"clusterCreateTime,omitempty"`
+
+	// EarliestRestorableTime is the earliest time to which a database can be
+	// restored with point-in-time restore.
+	EarliestRestorableTime *metav1.Time `json:"earliestRestorableTime,omitempty"`
+
+	// LatestRestorableTime specifies the latest time to which a database can
+	// be restored with point-in-time restore.
+	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
+
+	// MultiAZ specifies whether the DB cluster has instances in multiple
+	// Availability Zones.
+	MultiAZ bool `json:"multiAZ,omitempty"`
+
+	// Capacity is the current capacity of an Aurora Serverless DB cluster.
+	Capacity int `json:"capacity,omitempty"`
+}
+
+// A DBClusterStatus represents the observed state of a DBCluster.
+type DBClusterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBClusterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBCluster is a managed resource that represents an AWS Aurora DB cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engine"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.engineVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterSpec   `json:"spec"`
+	Status DBClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterList contains a list of DBClusters
+type DBClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBCluster `json:"items"`
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Parameter is a single parameter of a DB parameter group or a DB cluster
+// parameter group.
+type Parameter struct {
+	// ParameterName is the name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// ParameterValue is the value of the parameter.
+	ParameterValue string `json:"parameterValue"`
+
+	// ApplyMethod indicates when to apply parameter updates. Dynamic
+	// parameters can be applied immediately, static ones only after a reboot.
+	// Default: pending-reboot
+	// +kubebuilder:validation:Enum=immediate;pending-reboot
+	// +optional
+	ApplyMethod *string `json:"applyMethod,omitempty"`
+}
+
+// DBParameterGroupParameters define the desired state of an AWS RDS DB
+// parameter group.
+type DBParameterGroupParameters struct {
+	// Region is the region you'd like your DBParameterGroup to be created in.
+	Region string `json:"region"`
+
+	// DBParameterGroupFamily is the DB parameter group family name, such as
+	// mysql5.7 or postgres11. A DB parameter group can be associated with one
+	// and only one DB parameter group family, and can be applied only to a DB
+	// instance running a database engine and engine version compatible with
+	// that DB parameter group family.
+	// +immutable
+	DBParameterGroupFamily string `json:"dbParameterGroupFamily"`
+
+	// Description is the description of the DB parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters is the list of parameters whose values differ from the
+	// defaults of the DB parameter group family. Parameters that are removed
+	// from this list are reset to their default values.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Tags assigned to the DB parameter group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBParameterGroupSpec defines the desired state of a DBParameterGroup.
+type DBParameterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBParameterGroupParameters `json:"forProvider"`
+}
+
+// DBParameterGroupObservation is the representation of the current state
+// that is observed.
+type DBParameterGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) for this DB parameter group.
+	ARN string `json:"arn,omitempty"`
+}
+
+// A DBParameterGroupResourceStatus represents the observed state of a
+// DBParameterGroup.
+type DBParameterGroupResourceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBParameterGroup is a managed resource that represents an AWS RDS DB
+// parameter group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.dbParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBParameterGroupSpec           `json:"spec"`
+	Status DBParameterGroupResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBParameterGroupList contains a list of DBParameterGroups
+type DBParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBParameterGroup `json:"items"`
+}
+
+// DBClusterParameterGroupParameters define the desired state of an AWS RDS
+// DB cluster parameter group.
+type DBClusterParameterGroupParameters struct {
+	// Region is the region you'd like your DBClusterParameterGroup to be
+	// created in.
+	Region string `json:"region"`
+
+	// DBParameterGroupFamily is the DB cluster parameter group family name,
+	// such as aurora-mysql5.7 or aurora-postgresql11. A DB cluster parameter
+	// group can be associated with one and only one DB cluster parameter group
+	// family, and can be applied only to a DB cluster running a database
+	// engine and engine version compatible with that DB cluster parameter
+	// group family.
+	// +immutable
+	DBParameterGroupFamily string `json:"dbParameterGroupFamily"`
+
+	// Description is the description of the DB cluster parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Parameters is the list of parameters whose values differ from the
+	// defaults of the DB parameter group family. Parameters that are removed
+	// from this list are reset to their default values.
+	// +optional
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Tags assigned to the DB cluster parameter group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DBClusterParameterGroupSpec defines the desired state of a
+// DBClusterParameterGroup.
+type DBClusterParameterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBClusterParameterGroupParameters `json:"forProvider"`
+}
+
+// DBClusterParameterGroupObservation is the representation of the current
+// state that is observed.
+type DBClusterParameterGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) for this DB cluster parameter
+	// group.
+	ARN string `json:"arn,omitempty"`
+}
+
+// A DBClusterParameterGroupStatus represents the observed state of a
+// DBClusterParameterGroup.
+type DBClusterParameterGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBClusterParameterGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBClusterParameterGroup is a managed resource that represents an AWS RDS
+// DB cluster parameter group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.dbParameterGroupFamily"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBClusterParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBClusterParameterGroupSpec   `json:"spec"`
+	Status DBClusterParameterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBClusterParameterGroupList contains a list of DBClusterParameterGroups
+type DBClusterParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBClusterParameterGroup `json:"items"`
+}
@@ -106,6 +106,97 @@ type ScalingConfiguration struct {
 	SecondsUntilAutoPause *int `json:"secondsUntilAutoPause,omitempty"`
 }
 
+// Sources from which an RDSInstance can be restored.
+const (
+	RestoreSourceSnapshot    = "Snapshot"
+	RestoreSourcePointInTime = "PointInTime"
+	RestoreSourceS3          = "S3"
+)
+
+// SnapshotRestoreBackupConfiguration defines the details of the DB snapshot to
+// restore from.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/RestoreDBInstanceFromDBSnapshot
+type SnapshotRestoreBackupConfiguration struct {
+	// SnapshotIdentifier is the identifier of the DB snapshot to restore from.
+	// If you are restoring from a shared manual DB snapshot, this must be the
+	// ARN of the snapshot.
+	SnapshotIdentifier string `json:"snapshotIdentifier"`
+}
+
+// PointInTimeRestoreBackupConfiguration defines the details of the source DB
+// instance and the time to restore it to.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/RestoreDBInstanceToPointInTime
+type PointInTimeRestoreBackupConfiguration struct {
+	// SourceDBInstanceIdentifier is the identifier of the source DB instance
+	// from which to restore.
+	// +optional
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceDbiResourceID is the resource ID of the source DB instance from
+	// which to restore.
+	// +optional
+	SourceDbiResourceID *string `json:"sourceDbiResourceId,omitempty"`
+
+	// RestoreTime is the date and time to restore from. It must be before the
+	// latest restorable time for the DB instance and can't be specified if
+	// UseLatestRestorableTime is true.
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// UseLatestRestorableTime specifies whether the DB instance is restored
+	// from the latest backup time.
+	// +optional
+	UseLatestRestorableTime *bool `json:"useLatestRestorableTime,omitempty"`
+}
+
+// S3RestoreBackupConfiguration defines the details of the S3 backup to restore
+// from.
+// Please also see https://docs.aws.amazon.com/goto/WebAPI/rds-2014-10-31/RestoreDBInstanceFromS3
+type S3RestoreBackupConfiguration struct {
+	// BucketName is the name of the S3 bucket that contains the backup files.
+	BucketName string `json:"bucketName"`
+
+	// IngestionRoleARN is the ARN of the IAM role that allows Amazon RDS to
+	// access the S3 bucket.
+	IngestionRoleARN string `json:"ingestionRoleARN"`
+
+	// Prefix is the prefix of the backup files in the S3 bucket. If it is not
+	// specified, all the files in the bucket are used.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// SourceEngine is the name of the engine of the backup. Valid value is
+	// mysql.
+	SourceEngine string `json:"sourceEngine"`
+
+	// SourceEngineVersion is the version of the engine of the backup.
+	SourceEngineVersion string `json:"sourceEngineVersion"`
+}
+
+// RestoreBackupConfiguration defines the backup to restore a new RDS instance
+// from.
+type RestoreBackupConfiguration struct {
+	// Source is the type of the backup to restore from. Snapshot, PointInTime
+	// and S3 are supported.
+	// +kubebuilder:validation:Enum=Snapshot;PointInTime;S3
+	Source string `json:"source"`
+
+	// Snapshot defines the details of the DB snapshot to restore from. It is
+	// required when Source is Snapshot.
+	// +optional
+	Snapshot *SnapshotRestoreBackupConfiguration `json:"snapshot,omitempty"`
+
+	// PointInTime defines the details of the point-in-time restore. It is
+	// required when Source is PointInTime.
+	// +optional
+	PointInTime *PointInTimeRestoreBackupConfiguration `json:"pointInTime,omitempty"`
+
+	// S3 defines the details of the S3 backup to restore from. It is required
+	// when Source is S3.
+	// +optional
+	S3 *S3RestoreBackupConfiguration `json:"s3,omitempty"`
+}
+
 // RDSInstanceParameters define the desired state of an AWS Relational Database
 // Service instance.
 type RDSInstanceParameters struct {
@@ -215,6 +306,18 @@ type RDSInstanceParameters struct {
 	// +optional
 	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
 
+	// DBClusterIdentifierRef is a reference to a DBCluster used to set
+	// DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *xpv1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster used to
+	// set DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *xpv1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
 	// DBInstanceClass is the compute and memory capacity of the DB instance, for example, db.m4.large.
 	// Not all DB instance classes are available in all AWS Regions, or for all
 	// database engines. For the full list of DB instance classes, and availability
@@ -396,6 +499,16 @@ type RDSInstanceParameters struct {
 	// +optional
 	KMSKeyID *string `json:"kmsKeyId,omitempty"`
 
+	// KMSKeyIDRef is a reference to a Key used to set KMSKeyID.
+	// +immutable
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a Key used to set KMSKeyID.
+	// +immutable
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
 	// LicenseModel information for this DB instance.
 	// Valid values: license-included | bring-your-own-license | general-public-license
 	// +optional
@@ -591,6 +704,34 @@ type RDSInstanceParameters struct {
 	// +optional
 	ScalingConfiguration *ScalingConfiguration `json:"scalingConfiguration,omitempty"`
 
+	// SourceDBInstanceIdentifier is the identifier of the DB instance that will
+	// act as the source for the Read Replica. If it is set, the DB instance is
+	// created as a Read Replica and the master credentials are inherited from
+	// the source. If the source DB instance is in a different AWS Region than
+	// the Read Replica, specify the ARN of the source DB instance. Clearing it
+	// promotes the Read Replica to a standalone DB instance, which cannot be
+	// undone.
+	// +optional
+	SourceDBInstanceIdentifier *string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceDBInstanceIdentifierRef is a reference to an RDSInstance used to
+	// set SourceDBInstanceIdentifier. The ARN of the referenced RDSInstance is
+	// used if it is in a different region than this one.
+	// +optional
+	SourceDBInstanceIdentifierRef *xpv1.Reference `json:"sourceDBInstanceIdentifierRef,omitempty"`
+
+	// SourceDBInstanceIdentifierSelector selects a reference to an RDSInstance
+	// used to set SourceDBInstanceIdentifier.
+	// +optional
+	SourceDBInstanceIdentifierSelector *xpv1.Selector `json:"sourceDBInstanceIdentifierSelector,omitempty"`
+
+	// SourceRegion is the region of the source DB instance of a cross-region
+	// encrypted Read Replica. It is used to generate the pre-signed URL that
+	// is required by CreateDBInstanceReadReplica.
+	// +immutable
+	// +optional
+	SourceRegion *string `json:"sourceRegion,omitempty"`
+
 	// StorageEncrypted specifies whether the DB instance is encrypted.
 	// Amazon Aurora
 	// Not applicable. The encryption for DB instances is managed by the DB cluster.
@@ -692,6 +833,16 @@ type RDSInstanceParameters struct {
 	// +optional
 	DBParameterGroupName *string `json:"dbParameterGroupName,omitempty"`
 
+	// DBParameterGroupNameRef is a reference to a DBParameterGroup used to set
+	// DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameRef *xpv1.Reference `json:"dbParameterGroupNameRef,omitempty"`
+
+	// DBParameterGroupNameSelector selects a reference to a DBParameterGroup
+	// used to set DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameSelector *xpv1.Selector `json:"dbParameterGroupNameSelector,omitempty"`
+
 	// Domain specifies the Active Directory Domain to create the instance in.
 	// +optional
 	Domain *string `json:"domain,omitempty"`
@@ -747,6 +898,13 @@ type RDSInstanceParameters struct {
 	//    * Cannot end with a hyphen or contain two consecutive hyphens
 	//    * Cannot be specified when deleting a Read Replica.
 	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+
+	// RestoreFrom specifies the backup to restore when the RDS instance is
+	// created. The remaining parameters are applied to the restored instance
+	// once it is available. It is ignored if the instance already exists.
+	// +immutable
+	// +optional
+	RestoreFrom *RestoreBackupConfiguration `json:"restoreFrom,omitempty"`
 }
 
 // An RDSInstanceSpec defines the desired state of an RDSInstance.
@@ -1078,6 +1236,11 @@ type RDSInstanceObservation struct {
 	// VPCSecurityGroups provides a list of VPC security group elements that the DB instance belongs
 	// to.
 	VPCSecurityGroups []VPCSecurityGroupMembership `json:"vpcSecurityGroups,omitempty"`
+
+	// MasterUserPasswordPending indicates that the DB instance was restored
+	// with the master password of its source and the password published in
+	// the connection secret has not been set yet.
+	MasterUserPasswordPending bool `json:"masterUserPasswordPending,omitempty"`
 }
 
 // An RDSInstanceStatus represents the observed state of an RDSInstance.
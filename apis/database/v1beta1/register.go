@@ -53,7 +53,34 @@ var (
 	DBSubnetGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBSubnetGroupKind)
 )
 
+// DBCluster type metadata.
+var (
+	DBClusterKind             = reflect.TypeOf(DBCluster{}).Name()
+	DBClusterGroupKind        = schema.GroupKind{Group: Group, Kind: DBClusterKind}.String()
+	DBClusterKindAPIVersion   = DBClusterKind + "." + SchemeGroupVersion.String()
+	DBClusterGroupVersionKind = SchemeGroupVersion.WithKind(DBClusterKind)
+)
+
+// DBParameterGroup type metadata.
+var (
+	DBParameterGroupKind             = reflect.TypeOf(DBParameterGroup{}).Name()
+	DBParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBParameterGroupKind}.String()
+	DBParameterGroupKindAPIVersion   = DBParameterGroupKind + "." + SchemeGroupVersion.String()
+	DBParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBParameterGroupKind)
+)
+
+// DBClusterParameterGroup type metadata.
+var (
+	DBClusterParameterGroupKind             = reflect.TypeOf(DBClusterParameterGroup{}).Name()
+	DBClusterParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: DBClusterParameterGroupKind}.String()
+	DBClusterParameterGroupKindAPIVersion   = DBClusterParameterGroupKind + "." + SchemeGroupVersion.String()
+	DBClusterParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(DBClusterParameterGroupKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RDSInstance{}, &RDSInstanceList{})
 	SchemeBuilder.Register(&DBSubnetGroup{}, &DBSubnetGroupList{})
+	SchemeBuilder.Register(&DBCluster{}, &DBClusterList{})
+	SchemeBuilder.Register(&DBParameterGroup{}, &DBParameterGroupList{})
+	SchemeBuilder.Register(&DBClusterParameterGroup{}, &DBClusterParameterGroupList{})
 }
@@ -32,7 +32,44 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// Replicas are the other regions that the table is replicated to using
+	// version 2019.11.21 of global tables. Streams have to be enabled with
+	// NEW_AND_OLD_IMAGES view type before replicas can be added. Replicas
+	// are late-initialized from the table if omitted.
+	// +optional
+	Replicas []*TableReplica `json:"replicas,omitempty"`
+
+	// TimeToLive configures the expiry of the items of the table.
+	// +optional
+	TimeToLive *TimeToLive `json:"timeToLive,omitempty"`
+
+	// PointInTimeRecoveryEnabled indicates whether point in time recovery is
+	// enabled for the table.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+}
+
+// TableReplica is a replica of a Table in another region.
+type TableReplica struct {
+	// RegionName is the region of the replica.
+	RegionName string `json:"regionName"`
+
+	// KMSMasterKeyID is the KMS key that is used to encrypt the replica if it
+	// should differ from the default DynamoDB KMS key.
+	// +optional
+	KMSMasterKeyID *string `json:"kmsMasterKeyID,omitempty"`
+}
+
+// TimeToLive configures the time to live of the items of a Table.
+type TimeToLive struct {
+	// Enabled indicates whether time to live is enabled.
+	Enabled bool `json:"enabled"`
+
+	// AttributeName is the name of the attribute that stores the expiry time
+	// of the items.
+	AttributeName string `json:"attributeName"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
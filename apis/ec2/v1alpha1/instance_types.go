@@ -0,0 +1,319 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Defines the states of Instance
+const (
+	InstanceStatePending      = "pending"
+	InstanceStateRunning      = "running"
+	InstanceStateShuttingDown = "shutting-down"
+	InstanceStateTerminated   = "terminated"
+	InstanceStateStopping     = "stopping"
+	InstanceStateStopped      = "stopped"
+)
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// UserDataSource selects the user data of an instance from a key of a
+// ConfigMap or a Secret. Exactly one of them should be set.
+type UserDataSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// BlockDeviceMapping describes a block device mapping of an instance.
+type BlockDeviceMapping struct {
+	// DeviceName is the device name, for example /dev/sdh or xvdh.
+	DeviceName string `json:"deviceName"`
+
+	// EBS holds the parameters used to automatically set up an EBS volume
+	// when the instance is launched.
+	// +optional
+	EBS *EBSBlockDevice `json:"ebs,omitempty"`
+
+	// NoDevice suppresses the specified device included in the block device
+	// mapping of the AMI.
+	// +optional
+	NoDevice *string `json:"noDevice,omitempty"`
+
+	// VirtualName is the virtual device name of an instance store volume,
+	// for example ephemeral0.
+	// +optional
+	VirtualName *string `json:"virtualName,omitempty"`
+}
+
+// EBSBlockDevice describes an EBS volume attached to an instance at launch.
+type EBSBlockDevice struct {
+	// DeleteOnTermination indicates whether the volume is deleted when the
+	// instance is terminated.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+
+	// Encrypted indicates whether the volume is encrypted.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// IOPS is the number of I/O operations per second that the volume
+	// supports. Required for io1 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+
+	// KMSKeyID is the ARN of the KMS key used to encrypt the volume.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// SnapshotID is the ID of the snapshot the volume is created from.
+	// +optional
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// VolumeSize is the size of the volume, in GiB.
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// VolumeType is the type of the volume.
+	// +optional
+	// +kubebuilder:validation:Enum=standard;io1;io2;gp2;gp3;sc1;st1
+	VolumeType *string `json:"volumeType,omitempty"`
+}
+
+// LaunchTemplateSpecification selects the launch template, and its version,
+// an instance is launched from.
+type LaunchTemplateSpecification struct {
+	// LaunchTemplateName is the name of the launch template.
+	// +optional
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	// LaunchTemplateNameRef references a LaunchTemplate to retrieve its
+	// name.
+	// +optional
+	LaunchTemplateNameRef *xpv1.Reference `json:"launchTemplateNameRef,omitempty"`
+
+	// LaunchTemplateNameSelector selects a reference to a LaunchTemplate to
+	// retrieve its name.
+	// +optional
+	LaunchTemplateNameSelector *xpv1.Selector `json:"launchTemplateNameSelector,omitempty"`
+
+	// Version is the version number of the launch template. The default
+	// version is used if not set.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// InstanceParameters define the desired state of an AWS EC2 Instance.
+// Fields that are not marked as mutable require the instance to be replaced
+// to take effect, which is left to the user.
+type InstanceParameters struct {
+	// Region is the region the Instance will be launched in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ImageID is the ID of the AMI. It is required unless it is set by the
+	// launch template.
+	// +immutable
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// InstanceType is the instance type, for example t3.micro. It is
+	// required unless it is set by the launch template.
+	// +immutable
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// LaunchTemplate is the launch template to launch the instance from.
+	// Parameters set on the Instance override the ones of the template.
+	// +immutable
+	// +optional
+	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
+
+	// KeyName is the name of the key pair used to connect to the instance.
+	// +immutable
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// KeyNameRef references a KeyPair to retrieve its name.
+	// +immutable
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair to retrieve its name.
+	// +immutable
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
+
+	// SubnetID is the ID of the subnet to launch the instance into.
+	// +immutable
+	// +optional
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references a Subnet to retrieve its subnetId.
+	// +immutable
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet to retrieve its
+	// subnetId.
+	// +immutable
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instance.
+	// This field is mutable.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// IAMInstanceProfile is the name or the ARN of the IAM instance profile
+	// of the instance.
+	// +immutable
+	// +optional
+	IAMInstanceProfile *string `json:"iamInstanceProfile,omitempty"`
+
+	// UserData is the user data made available to the instance. It must not
+	// be base64 encoded.
+	// +immutable
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// UserDataFrom selects the user data from a ConfigMap or a Secret key.
+	// It takes precedence over UserData.
+	// +immutable
+	// +optional
+	UserDataFrom *UserDataSource `json:"userDataFrom,omitempty"`
+
+	// BlockDeviceMappings are the block device mappings of the instance,
+	// including the EBS volumes to attach at launch.
+	// +immutable
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// EBSOptimized indicates whether the instance is optimized for EBS I/O.
+	// +immutable
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// Monitoring enables detailed monitoring of the instance.
+	// +immutable
+	// +optional
+	Monitoring *bool `json:"monitoring,omitempty"`
+
+	// PrivateIPAddress is the primary private IPv4 address of the instance.
+	// +immutable
+	// +optional
+	PrivateIPAddress *string `json:"privateIpAddress,omitempty"`
+
+	// DisableAPITermination prevents the instance from being terminated
+	// through the API. This field is mutable.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// InstanceInitiatedShutdownBehavior indicates whether the instance stops
+	// or terminates when it is shut down from the instance. This field is
+	// mutable.
+	// +optional
+	// +kubebuilder:validation:Enum=stop;terminate
+	InstanceInitiatedShutdownBehavior *string `json:"instanceInitiatedShutdownBehavior,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// InstanceSpec defines the desired state of an Instance.
+type InstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InstanceParameters `json:"forProvider"`
+}
+
+// InstanceObservation keeps the state for the external resource.
+type InstanceObservation struct {
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+	InstanceID       string `json:"instanceId,omitempty"`
+	PrivateDNSName   string `json:"privateDnsName,omitempty"`
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+	PublicDNSName    string `json:"publicDnsName,omitempty"`
+	PublicIPAddress  string `json:"publicIpAddress,omitempty"`
+	State            string `json:"state,omitempty"`
+	VPCID            string `json:"vpcId,omitempty"`
+}
+
+// InstanceStatus describes the observed state of an Instance.
+type InstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InstanceObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Instance is a managed resource that represents an AWS EC2 Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.instanceType"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="PRIVATE IP",type="string",JSONPath=".status.atProvider.privateIpAddress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InstanceSpec   `json:"spec"`
+	Status InstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InstanceList contains a list of Instances
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Instance `json:"items"`
+}
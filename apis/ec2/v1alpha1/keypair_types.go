@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// KeyPairPrivateKey is the key of the connection secret that holds the
+// private key of a key pair that is generated by AWS.
+const KeyPairPrivateKey = "privateKey"
+
+// KeyPairParameters define the desired state of an AWS EC2 key pair. The
+// name of the key pair is the external name of the resource.
+type KeyPairParameters struct {
+	// Region is the region of the KeyPair.
+	// +immutable
+	Region string `json:"region"`
+
+	// PublicKey is the public key to import, for example an OpenSSH
+	// public key. If it is not set, AWS generates a key pair and its private
+	// key is written to the connection secret.
+	// +immutable
+	// +optional
+	PublicKey *string `json:"publicKey,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// KeyPairSpec defines the desired state of a KeyPair.
+type KeyPairSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyPairParameters `json:"forProvider"`
+}
+
+// KeyPairObservation keeps the state for the external resource.
+type KeyPairObservation struct {
+	KeyFingerprint string `json:"keyFingerprint,omitempty"`
+	KeyPairID      string `json:"keyPairId,omitempty"`
+}
+
+// KeyPairStatus describes the observed state of a KeyPair.
+type KeyPairStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyPairObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyPair is a managed resource that represents an AWS EC2 key pair.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.keyPairId"
+// +kubebuilder:printcolumn:name="FINGERPRINT",type="string",JSONPath=".status.atProvider.keyFingerprint"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyPair struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyPairSpec   `json:"spec"`
+	Status KeyPairStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyPairList contains a list of KeyPairs
+type KeyPairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyPair `json:"items"`
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LaunchTemplateData holds the instance parameters of a launch template
+// version.
+type LaunchTemplateData struct {
+	// ImageID is the ID of the AMI.
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// InstanceType is the instance type, for example t3.micro.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// KeyName is the name of the key pair used to connect to the instances.
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// KeyNameRef references a KeyPair to retrieve its name.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair to retrieve its name.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the instances.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// IAMInstanceProfile is the name or the ARN of the IAM instance profile
+	// of the instances.
+	// +optional
+	IAMInstanceProfile *string `json:"iamInstanceProfile,omitempty"`
+
+	// UserData is the user data made available to the instances. It must
+	// not be base64 encoded.
+	// +optional
+	UserData *string `json:"userData,omitempty"`
+
+	// BlockDeviceMappings are the block device mappings of the instances.
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// EBSOptimized indicates whether the instances are optimized for EBS
+	// I/O.
+	// +optional
+	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
+
+	// Monitoring enables detailed monitoring of the instances.
+	// +optional
+	Monitoring *bool `json:"monitoring,omitempty"`
+
+	// DisableAPITermination prevents the instances from being terminated
+	// through the API.
+	// +optional
+	DisableAPITermination *bool `json:"disableApiTermination,omitempty"`
+
+	// InstanceInitiatedShutdownBehavior indicates whether the instances stop
+	// or terminate when they are shut down from the instance.
+	// +optional
+	// +kubebuilder:validation:Enum=stop;terminate
+	InstanceInitiatedShutdownBehavior *string `json:"instanceInitiatedShutdownBehavior,omitempty"`
+}
+
+// LaunchTemplateParameters define the desired state of an AWS EC2 launch
+// template. The name of the template is the external name of the resource.
+type LaunchTemplateParameters struct {
+	// Region is the region of the LaunchTemplate.
+	// +immutable
+	Region string `json:"region"`
+
+	// LaunchTemplateData is the instance configuration of the latest version
+	// of the template. Any change creates a new version of the template.
+	LaunchTemplateData LaunchTemplateData `json:"launchTemplateData"`
+
+	// VersionDescription is the description of the versions created for the
+	// template.
+	// +optional
+	VersionDescription *string `json:"versionDescription,omitempty"`
+
+	// SetDefaultVersion makes every new version of the template its default
+	// version. Otherwise the first version stays the default.
+	// +optional
+	SetDefaultVersion *bool `json:"setDefaultVersion,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// LaunchTemplateSpec defines the desired state of a LaunchTemplate.
+type LaunchTemplateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LaunchTemplateParameters `json:"forProvider"`
+}
+
+// LaunchTemplateObservation keeps the state for the external resource.
+type LaunchTemplateObservation struct {
+	DefaultVersionNumber int64  `json:"defaultVersionNumber,omitempty"`
+	LatestVersionNumber  int64  `json:"latestVersionNumber,omitempty"`
+	LaunchTemplateID     string `json:"launchTemplateId,omitempty"`
+}
+
+// LaunchTemplateStatus describes the observed state of a LaunchTemplate.
+type LaunchTemplateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LaunchTemplateObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LaunchTemplate is a managed resource that represents an AWS EC2 launch
+// template.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.launchTemplateId"
+// +kubebuilder:printcolumn:name="DEFAULT",type="integer",JSONPath=".status.atProvider.defaultVersionNumber"
+// +kubebuilder:printcolumn:name="LATEST",type="integer",JSONPath=".status.atProvider.latestVersionNumber"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LaunchTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LaunchTemplateSpec   `json:"spec"`
+	Status LaunchTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LaunchTemplateList contains a list of LaunchTemplates
+type LaunchTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LaunchTemplate `json:"items"`
+}
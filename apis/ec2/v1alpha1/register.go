@@ -56,7 +56,70 @@ var (
 	NATGatewayGroupVersionKind = SchemeGroupVersion.WithKind(NATGatewayKind)
 )
 
+// SecurityGroupRule type metadata.
+var (
+	SecurityGroupRuleKind             = reflect.TypeOf(SecurityGroupRule{}).Name()
+	SecurityGroupRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityGroupRuleKind}.String()
+	SecurityGroupRuleKindAPIVersion   = SecurityGroupRuleKind + "." + SchemeGroupVersion.String()
+	SecurityGroupRuleGroupVersionKind = SchemeGroupVersion.WithKind(SecurityGroupRuleKind)
+)
+
+// VPCPeeringConnection type metadata.
+var (
+	VPCPeeringConnectionKind             = reflect.TypeOf(VPCPeeringConnection{}).Name()
+	VPCPeeringConnectionGroupKind        = schema.GroupKind{Group: Group, Kind: VPCPeeringConnectionKind}.String()
+	VPCPeeringConnectionKindAPIVersion   = VPCPeeringConnectionKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionKind)
+)
+
+// TransitGateway type metadata.
+var (
+	TransitGatewayKind             = reflect.TypeOf(TransitGateway{}).Name()
+	TransitGatewayGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayKind}.String()
+	TransitGatewayKindAPIVersion   = TransitGatewayKind + "." + SchemeGroupVersion.String()
+	TransitGatewayGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayKind)
+)
+
+// TransitGatewayVPCAttachment type metadata.
+var (
+	TransitGatewayVPCAttachmentKind             = reflect.TypeOf(TransitGatewayVPCAttachment{}).Name()
+	TransitGatewayVPCAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayVPCAttachmentKind}.String()
+	TransitGatewayVPCAttachmentKindAPIVersion   = TransitGatewayVPCAttachmentKind + "." + SchemeGroupVersion.String()
+	TransitGatewayVPCAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayVPCAttachmentKind)
+)
+
+// Instance type metadata.
+var (
+	InstanceKind             = reflect.TypeOf(Instance{}).Name()
+	InstanceGroupKind        = schema.GroupKind{Group: Group, Kind: InstanceKind}.String()
+	InstanceKindAPIVersion   = InstanceKind + "." + SchemeGroupVersion.String()
+	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
+)
+
+// LaunchTemplate type metadata.
+var (
+	LaunchTemplateKind             = reflect.TypeOf(LaunchTemplate{}).Name()
+	LaunchTemplateGroupKind        = schema.GroupKind{Group: Group, Kind: LaunchTemplateKind}.String()
+	LaunchTemplateKindAPIVersion   = LaunchTemplateKind + "." + SchemeGroupVersion.String()
+	LaunchTemplateGroupVersionKind = SchemeGroupVersion.WithKind(LaunchTemplateKind)
+)
+
+// KeyPair type metadata.
+var (
+	KeyPairKind             = reflect.TypeOf(KeyPair{}).Name()
+	KeyPairGroupKind        = schema.GroupKind{Group: Group, Kind: KeyPairKind}.String()
+	KeyPairKindAPIVersion   = KeyPairKind + "." + SchemeGroupVersion.String()
+	KeyPairGroupVersionKind = SchemeGroupVersion.WithKind(KeyPairKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ElasticIP{}, &ElasticIPList{})
 	SchemeBuilder.Register(&NATGateway{}, &NATGatewayList{})
+	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
+	SchemeBuilder.Register(&VPCPeeringConnection{}, &VPCPeeringConnectionList{})
+	SchemeBuilder.Register(&TransitGateway{}, &TransitGatewayList{})
+	SchemeBuilder.Register(&TransitGatewayVPCAttachment{}, &TransitGatewayVPCAttachmentList{})
+	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&LaunchTemplate{}, &LaunchTemplateList{})
+	SchemeBuilder.Register(&KeyPair{}, &KeyPairList{})
 }
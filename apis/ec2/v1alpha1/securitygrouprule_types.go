@@ -0,0 +1,157 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Defines the directions of a SecurityGroupRule
+const (
+	SecurityGroupRuleTypeIngress = "ingress"
+	SecurityGroupRuleTypeEgress  = "egress"
+)
+
+// SecurityGroupRuleParameters define the desired state of a single AWS
+// SecurityGroup permission. Exactly one of CIDRBlock, IPv6CIDRBlock,
+// PrefixListID or SourceSecurityGroupID must be set.
+type SecurityGroupRuleParameters struct {
+	// Region is the region of the SecurityGroup this rule belongs to.
+	// +immutable
+	Region string `json:"region"`
+
+	// Type is the direction of the rule, either ingress or egress.
+	// +immutable
+	// +kubebuilder:validation:Enum=ingress;egress
+	Type string `json:"type"`
+
+	// SecurityGroupID is the ID of the SecurityGroup the rule is added to.
+	// +immutable
+	// +optional
+	SecurityGroupID *string `json:"securityGroupId,omitempty"`
+
+	// SecurityGroupIDRef references a SecurityGroup and retrieves its ID.
+	// +immutable
+	// +optional
+	SecurityGroupIDRef *xpv1.Reference `json:"securityGroupIdRef,omitempty"`
+
+	// SecurityGroupIDSelector selects a reference to a SecurityGroup and
+	// retrieves its ID.
+	// +immutable
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// IPProtocol is the IP protocol name (tcp, udp, icmp, icmpv6) or number.
+	// Use -1 to specify all protocols, in which case the ports are ignored.
+	// +immutable
+	IPProtocol string `json:"ipProtocol"`
+
+	// FromPort is the start of the port range for the TCP and UDP protocols,
+	// or an ICMP/ICMPv6 type number.
+	// +immutable
+	// +optional
+	FromPort *int64 `json:"fromPort,omitempty"`
+
+	// ToPort is the end of the port range for the TCP and UDP protocols, or
+	// an ICMP/ICMPv6 code.
+	// +immutable
+	// +optional
+	ToPort *int64 `json:"toPort,omitempty"`
+
+	// CIDRBlock is the IPv4 CIDR range the rule applies to.
+	// +immutable
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// IPv6CIDRBlock is the IPv6 CIDR range the rule applies to.
+	// +immutable
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// PrefixListID is the ID of the prefix list the rule applies to.
+	// +immutable
+	// +optional
+	PrefixListID *string `json:"prefixListId,omitempty"`
+
+	// SourceSecurityGroupID is the ID of the SecurityGroup the rule applies
+	// to. It is the source for ingress rules and the destination for egress
+	// rules.
+	// +immutable
+	// +optional
+	SourceSecurityGroupID *string `json:"sourceSecurityGroupId,omitempty"`
+
+	// SourceSecurityGroupIDRef references a SecurityGroup and retrieves its
+	// ID.
+	// +immutable
+	// +optional
+	SourceSecurityGroupIDRef *xpv1.Reference `json:"sourceSecurityGroupIdRef,omitempty"`
+
+	// SourceSecurityGroupIDSelector selects a reference to a SecurityGroup and
+	// retrieves its ID.
+	// +immutable
+	// +optional
+	SourceSecurityGroupIDSelector *xpv1.Selector `json:"sourceSecurityGroupIdSelector,omitempty"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// A SecurityGroupRuleSpec defines the desired state of a SecurityGroupRule.
+type SecurityGroupRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityGroupRuleParameters `json:"forProvider"`
+}
+
+// A SecurityGroupRuleStatus represents the observed state of a
+// SecurityGroupRule.
+type SecurityGroupRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityGroupRule is a managed resource that represents a single ingress
+// or egress permission of an AWS SecurityGroup. Rules are identified by their
+// content, so they can be managed alongside a SecurityGroup that leaves its
+// own ingress and egress lists empty.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.securityGroupId"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.ipProtocol"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SecurityGroupRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupRuleSpec   `json:"spec"`
+	Status SecurityGroupRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupRuleList contains a list of SecurityGroupRules
+type SecurityGroupRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroupRule `json:"items"`
+}
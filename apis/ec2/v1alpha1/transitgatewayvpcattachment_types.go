@@ -0,0 +1,161 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Defines the states of TransitGatewayVPCAttachment
+const (
+	TransitGatewayAttachmentStateInitiating        = "initiating"
+	TransitGatewayAttachmentStatePendingAcceptance = "pendingAcceptance"
+	TransitGatewayAttachmentStateRollingBack       = "rollingBack"
+	TransitGatewayAttachmentStatePending           = "pending"
+	TransitGatewayAttachmentStateAvailable         = "available"
+	TransitGatewayAttachmentStateModifying         = "modifying"
+	TransitGatewayAttachmentStateDeleting          = "deleting"
+	TransitGatewayAttachmentStateDeleted           = "deleted"
+	TransitGatewayAttachmentStateFailed            = "failed"
+	TransitGatewayAttachmentStateRejected          = "rejected"
+	TransitGatewayAttachmentStateRejecting         = "rejecting"
+	TransitGatewayAttachmentStateFailing           = "failing"
+)
+
+// TransitGatewayVPCAttachmentParameters define the desired state of an AWS
+// Transit Gateway VPC attachment.
+type TransitGatewayVPCAttachmentParameters struct {
+	// Region is the region of the TransitGateway.
+	// +immutable
+	Region string `json:"region"`
+
+	// TransitGatewayID is the ID of the TransitGateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +immutable
+	// +optional
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// VPCID is the ID of the VPC to attach.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets, one per availability zone, the
+	// TransitGateway uses to route traffic to the VPC.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// DNSSupport enables DNS support.
+	// +optional
+	// +kubebuilder:validation:Enum=enable;disable
+	DNSSupport *string `json:"dnsSupport,omitempty"`
+
+	// IPv6Support enables IPv6 support.
+	// +optional
+	// +kubebuilder:validation:Enum=enable;disable
+	IPv6Support *string `json:"ipv6Support,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// TransitGatewayVPCAttachmentSpec defines the desired state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayVPCAttachmentParameters `json:"forProvider"`
+}
+
+// TransitGatewayVPCAttachmentObservation keeps the state for the external
+// resource.
+type TransitGatewayVPCAttachmentObservation struct {
+	State                      string `json:"state,omitempty"`
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
+	VPCOwnerID                 string `json:"vpcOwnerId,omitempty"`
+}
+
+// TransitGatewayVPCAttachmentStatus describes the observed state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayVPCAttachmentObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayVPCAttachment is a managed resource that represents an AWS
+// Transit Gateway VPC attachment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TRANSIT GATEWAY",type="string",JSONPath=".spec.forProvider.transitGatewayId"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayVPCAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentSpec   `json:"spec"`
+	Status TransitGatewayVPCAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentList contains a list of
+// TransitGatewayVPCAttachments
+type TransitGatewayVPCAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachment `json:"items"`
+}
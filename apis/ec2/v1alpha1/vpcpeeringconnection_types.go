@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Defines the states of VPCPeeringConnection
+const (
+	VPCPeeringConnectionStatusInitiatingRequest = "initiating-request"
+	VPCPeeringConnectionStatusPendingAcceptance = "pending-acceptance"
+	VPCPeeringConnectionStatusProvisioning      = "provisioning"
+	VPCPeeringConnectionStatusActive            = "active"
+	VPCPeeringConnectionStatusDeleting          = "deleting"
+	VPCPeeringConnectionStatusDeleted           = "deleted"
+	VPCPeeringConnectionStatusRejected          = "rejected"
+	VPCPeeringConnectionStatusFailed            = "failed"
+	VPCPeeringConnectionStatusExpired           = "expired"
+)
+
+// VPCPeeringConnectionParameters define the desired state of an AWS VPC
+// peering connection.
+type VPCPeeringConnectionParameters struct {
+	// Region is the region of the requester VPC.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the requester VPC.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// PeerVPCID is the ID of the accepter VPC.
+	// +immutable
+	// +optional
+	PeerVPCID *string `json:"peerVpcId,omitempty"`
+
+	// PeerVPCIDRef references a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	PeerVPCIDRef *xpv1.Reference `json:"peerVpcIdRef,omitempty"`
+
+	// PeerVPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	PeerVPCIDSelector *xpv1.Selector `json:"peerVpcIdSelector,omitempty"`
+
+	// PeerOwnerID is the AWS account ID of the owner of the accepter VPC.
+	// Defaults to the account of the requester.
+	// +immutable
+	// +optional
+	PeerOwnerID *string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region of the accepter VPC. Defaults to the region of
+	// the requester.
+	// +immutable
+	// +optional
+	PeerRegion *string `json:"peerRegion,omitempty"`
+
+	// AcceptRequest makes the controller accept the peering request on the
+	// accepter side once it is pending acceptance.
+	// +optional
+	AcceptRequest *bool `json:"acceptRequest,omitempty"`
+
+	// AccepterProviderConfigRef references the ProviderConfig whose
+	// credentials are used to accept the peering request. It is required when
+	// the accepter VPC belongs to another account. Defaults to the
+	// ProviderConfig of this resource.
+	// +optional
+	AccepterProviderConfigRef *xpv1.Reference `json:"accepterProviderConfigRef,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// VPCPeeringConnectionSpec defines the desired state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCPeeringConnectionParameters `json:"forProvider"`
+}
+
+// VPCPeeringConnectionVPCInfo describes a VPC of a peering connection.
+type VPCPeeringConnectionVPCInfo struct {
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+	OwnerID   string `json:"ownerId,omitempty"`
+	Region    string `json:"region,omitempty"`
+	VPCID     string `json:"vpcId,omitempty"`
+}
+
+// VPCPeeringConnectionObservation keeps the state for the external resource.
+type VPCPeeringConnectionObservation struct {
+	AccepterVPCInfo        VPCPeeringConnectionVPCInfo `json:"accepterVpcInfo,omitempty"`
+	ExpirationTime         *metav1.Time                `json:"expirationTime,omitempty"`
+	RequesterVPCInfo       VPCPeeringConnectionVPCInfo `json:"requesterVpcInfo,omitempty"`
+	StatusCode             string                      `json:"statusCode,omitempty"`
+	StatusMessage          string                      `json:"statusMessage,omitempty"`
+	VPCPeeringConnectionID string                      `json:"vpcPeeringConnectionId,omitempty"`
+}
+
+// VPCPeeringConnectionStatus describes the observed state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCPeeringConnectionObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnection is a managed resource that represents an AWS VPC
+// peering connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="PEER VPC",type="string",JSONPath=".spec.forProvider.peerVpcId"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.statusCode"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnections
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
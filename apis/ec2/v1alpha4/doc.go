@@ -15,7 +15,7 @@ limitations under the License.
 */
 
 // Package v1alpha4 contains managed resources for AWS network services such as
-// RouteTable.
+// RouteTable and VPCEndpoint.
 // +kubebuilder:object:generate=true
 // +groupName=ec2.aws.crossplane.io
 // +versionName=v1alpha4
@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -49,7 +50,7 @@ func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) er
 	// Resolve spec.forProvider.routes[].gatewayId
 	for i := range mg.Spec.ForProvider.Routes {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
-			CurrentValue: aws.StringValue(mg.Spec.ForProvider.Routes[i].GatewayID),
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Routes[i].GatewayID),
 			Reference:    mg.Spec.ForProvider.Routes[i].GatewayIDRef,
 			Selector:     mg.Spec.ForProvider.Routes[i].GatewayIDSelector,
 			To:           reference.To{Managed: &ec2v1beta1.InternetGateway{}, List: &ec2v1beta1.InternetGatewayList{}},
@@ -58,10 +59,74 @@ func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) er
 		if err != nil {
 			return errors.Wrapf(err, "spec.forProvider.routes[%d].gatewayId", i)
 		}
-		mg.Spec.ForProvider.Routes[i].GatewayID = aws.String(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].GatewayID = reference.ToPtrValue(rsp.ResolvedValue)
 		mg.Spec.ForProvider.Routes[i].GatewayIDRef = rsp.ResolvedReference
 	}
 
+	// Resolve spec.forProvider.routes[].natGatewayId
+	for i := range mg.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Routes[i].NatGatewayID),
+			Reference:    mg.Spec.ForProvider.Routes[i].NatGatewayIDRef,
+			Selector:     mg.Spec.ForProvider.Routes[i].NatGatewayIDSelector,
+			To:           reference.To{Managed: &ec2v1alpha1.NATGateway{}, List: &ec2v1alpha1.NATGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].natGatewayId", i)
+		}
+		mg.Spec.ForProvider.Routes[i].NatGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].NatGatewayIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.routes[].transitGatewayId
+	for i := range mg.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Routes[i].TransitGatewayID),
+			Reference:    mg.Spec.ForProvider.Routes[i].TransitGatewayIDRef,
+			Selector:     mg.Spec.ForProvider.Routes[i].TransitGatewayIDSelector,
+			To:           reference.To{Managed: &ec2v1alpha1.TransitGateway{}, List: &ec2v1alpha1.TransitGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].transitGatewayId", i)
+		}
+		mg.Spec.ForProvider.Routes[i].TransitGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].TransitGatewayIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.routes[].vpcPeeringConnectionId
+	for i := range mg.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Routes[i].VPCPeeringConnectionID),
+			Reference:    mg.Spec.ForProvider.Routes[i].VPCPeeringConnectionIDRef,
+			Selector:     mg.Spec.ForProvider.Routes[i].VPCPeeringConnectionIDSelector,
+			To:           reference.To{Managed: &ec2v1alpha1.VPCPeeringConnection{}, List: &ec2v1alpha1.VPCPeeringConnectionList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].vpcPeeringConnectionId", i)
+		}
+		mg.Spec.ForProvider.Routes[i].VPCPeeringConnectionID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].VPCPeeringConnectionIDRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.routes[].vpcEndpointId
+	for i := range mg.Spec.ForProvider.Routes {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Routes[i].VPCEndpointID),
+			Reference:    mg.Spec.ForProvider.Routes[i].VPCEndpointIDRef,
+			Selector:     mg.Spec.ForProvider.Routes[i].VPCEndpointIDSelector,
+			To:           reference.To{Managed: &VPCEndpoint{}, List: &VPCEndpointList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].vpcEndpointId", i)
+		}
+		mg.Spec.ForProvider.Routes[i].VPCEndpointID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Routes[i].VPCEndpointIDRef = rsp.ResolvedReference
+	}
+
 	// Resolve spec.associations[].subnetId
 	for i := range mg.Spec.ForProvider.Associations {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
@@ -80,3 +145,66 @@ func (mg *RouteTable) ResolveReferences(ctx context.Context, c client.Reader) er
 
 	return nil
 }
+
+// ResolveReferences of this VPCEndpoint
+func (mg *VPCEndpoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &ec2v1beta1.VPC{}, List: &ec2v1beta1.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcId")
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.routeTableIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.RouteTableIDs,
+		References:    mg.Spec.ForProvider.RouteTableIDRefs,
+		Selector:      mg.Spec.ForProvider.RouteTableIDSelector,
+		To:            reference.To{Managed: &RouteTable{}, List: &RouteTableList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.routeTableIds")
+	}
+	mg.Spec.ForProvider.RouteTableIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.RouteTableIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.SecurityGroup{}, List: &ec2v1beta1.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.securityGroupIds")
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
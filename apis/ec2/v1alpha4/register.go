@@ -48,6 +48,15 @@ var (
 	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
 )
 
+// VPCEndpoint type metadata.
+var (
+	VPCEndpointKind             = reflect.TypeOf(VPCEndpoint{}).Name()
+	VPCEndpointGroupKind        = schema.GroupKind{Group: Group, Kind: VPCEndpointKind}.String()
+	VPCEndpointKindAPIVersion   = VPCEndpointKind + "." + SchemeGroupVersion.String()
+	VPCEndpointGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&VPCEndpoint{}, &VPCEndpointList{})
 }
@@ -24,13 +24,23 @@ import (
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
-// Route describes a route in a route table.
+// Route describes a route in a route table. Exactly one destination and one
+// target should be set.
 type Route struct {
 	// The IPv4 CIDR address block used for the destination match. Routing
 	// decisions are based on the most specific match.
 	// +optional
 	DestinationCIDRBlock *string `json:"destinationCidrBlock,omitempty"`
 
+	// The IPv6 CIDR block used for the destination match. Routing decisions
+	// are based on the most specific match.
+	// +optional
+	DestinationIPv6CIDRBlock *string `json:"destinationIpv6CidrBlock,omitempty"`
+
+	// The ID of a prefix list used for the destination match.
+	// +optional
+	DestinationPrefixListID *string `json:"destinationPrefixListId,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	// +optional
@@ -41,6 +51,60 @@ type Route struct {
 
 	// A selector to select a referencer to retrieve the ID of a gateway
 	GatewayIDSelector *xpv1.Selector `json:"gatewayIdSelector,omitempty"`
+
+	// The ID of a NAT gateway.
+	// +optional
+	NatGatewayID *string `json:"natGatewayId,omitempty"`
+
+	// A referencer to retrieve the ID of a NAT gateway
+	// +optional
+	NatGatewayIDRef *xpv1.Reference `json:"natGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a NAT gateway
+	// +optional
+	NatGatewayIDSelector *xpv1.Selector `json:"natGatewayIdSelector,omitempty"`
+
+	// The ID of a network interface.
+	// +optional
+	NetworkInterfaceID *string `json:"networkInterfaceId,omitempty"`
+
+	// The ID of a transit gateway.
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// A referencer to retrieve the ID of a transit gateway
+	// +optional
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a transit gateway
+	// +optional
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// The ID of a VPC peering connection.
+	// +optional
+	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// A referencer to retrieve the ID of a VPC peering connection
+	// +optional
+	VPCPeeringConnectionIDRef *xpv1.Reference `json:"vpcPeeringConnectionIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a VPC peering
+	// connection
+	// +optional
+	VPCPeeringConnectionIDSelector *xpv1.Selector `json:"vpcPeeringConnectionIdSelector,omitempty"`
+
+	// The ID of a Gateway Load Balancer endpoint.
+	// +optional
+	VPCEndpointID *string `json:"vpcEndpointId,omitempty"`
+
+	// A referencer to retrieve the ID of a Gateway Load Balancer endpoint
+	// +optional
+	VPCEndpointIDRef *xpv1.Reference `json:"vpcEndpointIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a Gateway Load
+	// Balancer endpoint
+	// +optional
+	VPCEndpointIDSelector *xpv1.Selector `json:"vpcEndpointIdSelector,omitempty"`
 }
 
 // RouteState describes a route state in the route table.
@@ -50,13 +114,34 @@ type RouteState struct {
 	// to the VPC, or the specified NAT instance has been terminated).
 	State string `json:"state,omitempty"`
 
+	// Describes how the route was created.
+	Origin string `json:"origin,omitempty"`
+
 	// The IPv4 CIDR address block used for the destination match. Routing
 	// decisions are based on the most specific match.
 	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
 
+	// The IPv6 CIDR block used for the destination match.
+	DestinationIPv6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
+
+	// The prefix of the AWS service used for the destination match.
+	DestinationPrefixListID string `json:"destinationPrefixListId,omitempty"`
+
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
 	GatewayID string `json:"gatewayId,omitempty"`
+
+	// The ID of a NAT gateway.
+	NatGatewayID string `json:"natGatewayId,omitempty"`
+
+	// The ID of the network interface.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// The ID of a transit gateway.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// The ID of a VPC peering connection.
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
 }
 
 // Association describes an association between a route table and a subnet.
@@ -102,6 +187,13 @@ type RouteTableParameters struct {
 	// the routes in the route table
 	Routes []Route `json:"routes"`
 
+	// PruneRoutes deletes the routes of the route table that were created
+	// with CreateRoute but are not specified in Routes, including the ones
+	// that were not created by this resource. Routes that are removed from
+	// Routes are left in place unless it is true.
+	// +optional
+	PruneRoutes *bool `json:"pruneRoutes,omitempty"`
+
 	// Tags represents to current ec2 tags.
 	// +optional
 	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
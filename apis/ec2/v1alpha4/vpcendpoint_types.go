@@ -0,0 +1,191 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// Defines the types of VPCEndpoint
+const (
+	VPCEndpointTypeGateway   = "Gateway"
+	VPCEndpointTypeInterface = "Interface"
+)
+
+// Defines the states of VPCEndpoint
+const (
+	VPCEndpointStatePendingAcceptance = "PendingAcceptance"
+	VPCEndpointStatePending           = "Pending"
+	VPCEndpointStateAvailable         = "Available"
+	VPCEndpointStateDeleting          = "Deleting"
+	VPCEndpointStateDeleted           = "Deleted"
+	VPCEndpointStateRejected          = "Rejected"
+	VPCEndpointStateFailed            = "Failed"
+	VPCEndpointStateExpired           = "Expired"
+)
+
+// VPCEndpointParameters define the desired state of an AWS VPC endpoint.
+type VPCEndpointParameters struct {
+	// Region is the region you'd like your VPCEndpoint to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC the endpoint is created in.
+	// +immutable
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +immutable
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// ServiceName is the service name, e.g. com.amazonaws.us-east-1.s3.
+	// +immutable
+	ServiceName string `json:"serviceName"`
+
+	// VPCEndpointType is the type of the endpoint. Defaults to Gateway.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	VPCEndpointType *string `json:"vpcEndpointType,omitempty"`
+
+	// PolicyDocument is the IAM policy that controls access to the service.
+	// Defaults to full access.
+	// +optional
+	PolicyDocument *string `json:"policyDocument,omitempty"`
+
+	// PrivateDNSEnabled associates a private hosted zone with the VPC. Only
+	// valid for Interface endpoints.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// RouteTableIDs are the IDs of the route tables the endpoint is added
+	// to. Only valid for Gateway endpoints.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs are references to RouteTables used to set the
+	// RouteTableIDs.
+	// +optional
+	RouteTableIDRefs []xpv1.Reference `json:"routeTableIdRefs,omitempty"`
+
+	// RouteTableIDSelector selects references to RouteTables used to set the
+	// RouteTableIDs.
+	// +optional
+	RouteTableIDSelector *xpv1.Selector `json:"routeTableIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the endpoint network interfaces
+	// are created in. Only valid for Interface endpoints.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups associated with
+	// the endpoint network interfaces. Only valid for Interface endpoints.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []ec2v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// VPCEndpointSpec defines the desired state of a VPCEndpoint.
+type VPCEndpointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCEndpointParameters `json:"forProvider"`
+}
+
+// DNSEntry describes a DNS entry of an Interface endpoint.
+type DNSEntry struct {
+	DNSName      string `json:"dnsName,omitempty"`
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+}
+
+// VPCEndpointObservation keeps the state for the external resource.
+type VPCEndpointObservation struct {
+	DNSEntries          []DNSEntry `json:"dnsEntries,omitempty"`
+	LastErrorMessage    string     `json:"lastErrorMessage,omitempty"`
+	NetworkInterfaceIDs []string   `json:"networkInterfaceIds,omitempty"`
+	OwnerID             string     `json:"ownerId,omitempty"`
+	State               string     `json:"state,omitempty"`
+	VPCEndpointID       string     `json:"vpcEndpointId,omitempty"`
+}
+
+// VPCEndpointStatus describes the observed state of a VPCEndpoint.
+type VPCEndpointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCEndpointObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpoint is a managed resource that represents an AWS VPC endpoint of
+// either Gateway or Interface type.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.vpcEndpointType"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointSpec   `json:"spec"`
+	Status VPCEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointList contains a list of VPCEndpoints
+type VPCEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpoint `json:"items"`
+}
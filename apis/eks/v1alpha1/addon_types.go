@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AddonStatusType is a type of Addon status.
+type AddonStatusType string
+
+// Types of Addon status.
+const (
+	AddonStatusCreating     AddonStatusType = "CREATING"
+	AddonStatusActive       AddonStatusType = "ACTIVE"
+	AddonStatusCreateFailed AddonStatusType = "CREATE_FAILED"
+	AddonStatusUpdating     AddonStatusType = "UPDATING"
+	AddonStatusDeleting     AddonStatusType = "DELETING"
+	AddonStatusDeleteFailed AddonStatusType = "DELETE_FAILED"
+	AddonStatusDegraded     AddonStatusType = "DEGRADED"
+)
+
+// AddonParameters define the desired state of an AWS Elastic Kubernetes
+// Service add-on. The name of the add-on, e.g. vpc-cni, coredns or
+// kube-proxy, is the external name of the resource.
+type AddonParameters struct {
+	// Region is the region you'd like the Addon to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// ClusterName is the name of the cluster to install the add-on into.
+	// +immutable
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used to set the
+	// ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// AddonVersion is the version of the add-on. It must match one of the
+	// versions returned by DescribeAddonVersions. The default version of the
+	// add-on for the cluster is used if omitted.
+	// +optional
+	AddonVersion *string `json:"addonVersion,omitempty"`
+
+	// ResolveConflicts determines how to resolve field value conflicts between
+	// the add-on and resources that already exist in the cluster when the
+	// add-on is created or updated. OVERWRITE replaces the existing values
+	// with the add-on defaults; NONE leaves them and fails on conflicts.
+	// +kubebuilder:validation:Enum=OVERWRITE;NONE
+	// +optional
+	ResolveConflicts *string `json:"resolveConflicts,omitempty"`
+
+	// ServiceAccountRoleARN is the ARN of an existing IAM role to bind to the
+	// add-on's service account. The role must be assumable by the service
+	// account through the cluster's OpenID Connect provider.
+	// +optional
+	ServiceAccountRoleARN *string `json:"serviceAccountRoleArn,omitempty"`
+
+	// ServiceAccountRoleARNRef is a reference to an IAMRole used to set the
+	// ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNRef *xpv1.Reference `json:"serviceAccountRoleArnRef,omitempty"`
+
+	// ServiceAccountRoleARNSelector selects references to an IAMRole used to
+	// set the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNSelector *xpv1.Selector `json:"serviceAccountRoleArnSelector,omitempty"`
+
+	// Tags are the metadata applied to the add-on.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AddonObservation is the observed state of an Addon.
+type AddonObservation struct {
+	// AddonARN is the Amazon Resource Name (ARN) of the add-on.
+	AddonARN string `json:"addonArn,omitempty"`
+
+	// AddonVersion is the version of the add-on that is installed.
+	AddonVersion string `json:"addonVersion,omitempty"`
+
+	// CreatedAt is the date and time the add-on was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// ModifiedAt is the date and time the add-on was last modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+
+	// Status is the current status of the add-on.
+	Status AddonStatusType `json:"status,omitempty"`
+}
+
+// An AddonSpec defines the desired state of an EKS Addon.
+type AddonSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AddonParameters `json:"forProvider"`
+}
+
+// An AddonStatus represents the observed state of an EKS Addon.
+type AddonStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AddonObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Addon is a managed resource that represents an AWS Elastic Kubernetes
+// Service add-on.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.addonVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Addon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AddonSpec   `json:"spec"`
+	Status AddonStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AddonList contains a list of Addon items
+type AddonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Addon `json:"items"`
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IAMIdentityMappingParameters define the desired state of an entry in the
+// aws-auth ConfigMap of an EKS cluster that maps an IAM role or user to a
+// Kubernetes user and groups. Exactly one of RoleARN and UserARN must be set.
+type IAMIdentityMappingParameters struct {
+	// Region is the region of the cluster.
+	// +immutable
+	Region string `json:"region"`
+
+	// ClusterName is the name of the cluster whose aws-auth ConfigMap the
+	// mapping is written to.
+	// +immutable
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used to set the
+	// ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// RoleARN is the ARN of the IAM role to map. It is written to the mapRoles
+	// section of the aws-auth ConfigMap.
+	// +immutable
+	// +optional
+	RoleARN string `json:"roleArn,omitempty"`
+
+	// RoleARNRef is a reference to an IAMRole used to set the RoleARN.
+	// +immutable
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAMRole used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// UserARN is the ARN of the IAM user to map. It is written to the mapUsers
+	// section of the aws-auth ConfigMap.
+	// +immutable
+	// +optional
+	UserARN string `json:"userArn,omitempty"`
+
+	// UserARNRef is a reference to an IAMUser used to set the UserARN.
+	// +immutable
+	// +optional
+	UserARNRef *xpv1.Reference `json:"userArnRef,omitempty"`
+
+	// UserARNSelector selects references to an IAMUser used to set the
+	// UserARN.
+	// +optional
+	UserARNSelector *xpv1.Selector `json:"userArnSelector,omitempty"`
+
+	// Username is the Kubernetes user name the IAM identity is mapped to,
+	// e.g. system:node:{{EC2PrivateDNSName}} for node roles.
+	Username string `json:"username"`
+
+	// Groups are the Kubernetes groups the IAM identity is mapped to, e.g.
+	// system:masters.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// An IAMIdentityMappingSpec defines the desired state of an
+// IAMIdentityMapping.
+type IAMIdentityMappingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IAMIdentityMappingParameters `json:"forProvider"`
+}
+
+// An IAMIdentityMappingStatus represents the observed state of an
+// IAMIdentityMapping.
+type IAMIdentityMappingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IAMIdentityMapping is a managed resource that represents an entry in
+// the aws-auth ConfigMap of an AWS Elastic Kubernetes Service cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IAMIdentityMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IAMIdentityMappingSpec   `json:"spec"`
+	Status IAMIdentityMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IAMIdentityMappingList contains a list of IAMIdentityMapping items
+type IAMIdentityMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IAMIdentityMapping `json:"items"`
+}
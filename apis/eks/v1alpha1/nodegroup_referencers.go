@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
@@ -91,5 +92,21 @@ func (mg *NodeGroup) ResolveReferences(ctx context.Context, c client.Reader) err
 		mg.Spec.ForProvider.RemoteAccess.SourceSecurityGroupRefs = mrsp.ResolvedReferences
 	}
 
+	// Resolve spec.forProvider.launchTemplate.name
+	if lt := mg.Spec.ForProvider.LaunchTemplate; lt != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(lt.Name),
+			Reference:    lt.NameRef,
+			Selector:     lt.NameSelector,
+			To:           reference.To{Managed: &ec2v1alpha1.LaunchTemplate{}, List: &ec2v1alpha1.LaunchTemplateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.launchTemplate.name")
+		}
+		lt.Name = reference.ToPtrValue(rsp.ResolvedValue)
+		lt.NameRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
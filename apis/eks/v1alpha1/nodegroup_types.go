@@ -86,6 +86,14 @@ type NodeGroupParameters struct {
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 
+	// An object representing a node group's launch template specification. If
+	// specified, then do not specify instanceTypes, diskSize, or remoteAccess
+	// and make sure that the launch template meets the requirements in
+	// launchTemplateSpecification (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
+	// in the Amazon EKS User Guide.
+	// +optional
+	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
+
 	// The Amazon Resource Name (ARN) of the IAM role to associate with your node
 	// group. The Amazon EKS worker node kubelet daemon makes calls to AWS APIs
 	// on your behalf. Worker nodes receive permissions for these API calls through
@@ -187,6 +195,35 @@ type RemoteAccessConfig struct {
 	SourceSecurityGroupSelector *xpv1.Selector `json:"sourceSecurityGroupSelector,omitempty"`
 }
 
+// LaunchTemplateSpecification is the launch template of a node group. You
+// can specify either the ID or the name of the launch template, but not both.
+type LaunchTemplateSpecification struct {
+	// The ID of the launch template.
+	// +immutable
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// The name of the launch template.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// NameRef is a reference to a LaunchTemplate used to set the Name.
+	// +immutable
+	// +optional
+	NameRef *xpv1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to a LaunchTemplate used to set the
+	// Name.
+	// +optional
+	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
+
+	// The version of the launch template to use. If no version is specified,
+	// then the template's default version is used.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
 // NodeGroupScalingConfig is the configuration for scaling a node group.
 type NodeGroupScalingConfig struct {
 	// The current number of worker nodes that the managed node group should maintain.
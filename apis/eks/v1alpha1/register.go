@@ -48,9 +48,21 @@ var (
 	FargateProfileGroupKind        = schema.GroupKind{Group: Group, Kind: FargateProfileKind}.String()
 	FargateProfileKindAPIVersion   = FargateProfileKind + "." + SchemeGroupVersion.String()
 	FargateProfileGroupVersionKind = SchemeGroupVersion.WithKind(FargateProfileKind)
+
+	AddonKind             = reflect.TypeOf(Addon{}).Name()
+	AddonGroupKind        = schema.GroupKind{Group: Group, Kind: AddonKind}.String()
+	AddonKindAPIVersion   = AddonKind + "." + SchemeGroupVersion.String()
+	AddonGroupVersionKind = SchemeGroupVersion.WithKind(AddonKind)
+
+	IAMIdentityMappingKind             = reflect.TypeOf(IAMIdentityMapping{}).Name()
+	IAMIdentityMappingGroupKind        = schema.GroupKind{Group: Group, Kind: IAMIdentityMappingKind}.String()
+	IAMIdentityMappingKindAPIVersion   = IAMIdentityMappingKind + "." + SchemeGroupVersion.String()
+	IAMIdentityMappingGroupVersionKind = SchemeGroupVersion.WithKind(IAMIdentityMappingKind)
 )
 
 func init() {
 	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
 	SchemeBuilder.Register(&FargateProfile{}, &FargateProfileList{})
+	SchemeBuilder.Register(&Addon{}, &AddonList{})
+	SchemeBuilder.Register(&IAMIdentityMapping{}, &IAMIdentityMappingList{})
 }
@@ -23,11 +23,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 )
 
+// ClusterOIDCIssuer returns the OpenID Connect issuer URL of a Cluster.
+func ClusterOIDCIssuer() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Cluster)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.Identity.OIDC.Issuer
+	}
+}
+
 // ResolveReferences of this Cluster
 func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -74,5 +87,21 @@ func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.ResourcesVpcConfig.SecurityGroupIDs = mrsp.ResolvedValues
 	mg.Spec.ForProvider.ResourcesVpcConfig.SecurityGroupIDRefs = mrsp.ResolvedReferences
 
+	// Resolve spec.forProvider.encryptionConfig[*].provider.keyArn
+	for i, ec := range mg.Spec.ForProvider.EncryptionConfig {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: ec.Provider.KeyArn,
+			Reference:    ec.Provider.KeyArnRef,
+			Selector:     ec.Provider.KeyArnSelector,
+			To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+			Extract:      kmsv1alpha1.KeyARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.encryptionConfig[%d].provider.keyArn", i)
+		}
+		mg.Spec.ForProvider.EncryptionConfig[i].Provider.KeyArn = rsp.ResolvedValue
+		mg.Spec.ForProvider.EncryptionConfig[i].Provider.KeyArnRef = rsp.ResolvedReference
+	}
+
 	return nil
 }
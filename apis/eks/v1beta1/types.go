@@ -137,7 +137,16 @@ type Provider struct {
 	// the CMK. For more information, see Allowing Users in Other Accounts to Use
 	// a CMK (https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-modifying-external-accounts.html)
 	// in the AWS Key Management Service Developer Guide.
-	KeyArn string `json:"keyArn"`
+	KeyArn string `json:"keyArn,omitempty"`
+
+	// KeyArnRef is a reference to a Key used to set the KeyArn.
+	// +immutable
+	// +optional
+	KeyArnRef *xpv1.Reference `json:"keyArnRef,omitempty"`
+
+	// KeyArnSelector selects a reference to a Key used to set the KeyArn.
+	// +optional
+	KeyArnSelector *xpv1.Selector `json:"keyArnSelector,omitempty"`
 }
 
 // Logging in the logging configuration for a cluster.
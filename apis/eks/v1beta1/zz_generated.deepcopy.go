@@ -197,7 +197,7 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptionConfig) DeepCopyInto(out *EncryptionConfig) {
 	*out = *in
-	out.Provider = in.Provider
+	in.Provider.DeepCopyInto(&out.Provider)
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = make([]string, len(*in))
@@ -296,6 +296,16 @@ func (in *OIDC) DeepCopy() *OIDC {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
+	if in.KeyArnRef != nil {
+		in, out := &in.KeyArnRef, &out.KeyArnRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyArnSelector != nil {
+		in, out := &in.KeyArnSelector, &out.KeyArnSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TargetGroupTuple is a target group that a forward action routes requests
+// to, together with its weight.
+type TargetGroupTuple struct {
+	// The Amazon Resource Name (ARN) of the target group.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef references a TargetGroup to retrieve its ARN.
+	// +optional
+	TargetGroupARNRef *xpv1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup to retrieve
+	// its ARN.
+	// +optional
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// The weight of the target group.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// TargetGroupStickinessConfig configures target group stickiness for a
+// forward action.
+type TargetGroupStickinessConfig struct {
+	// Indicates whether target group stickiness is enabled.
+	Enabled bool `json:"enabled"`
+
+	// The time period, in seconds, during which requests from a client
+	// should be routed to the same target group.
+	// +optional
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+}
+
+// ForwardActionConfig routes requests to one or more weighted target groups.
+type ForwardActionConfig struct {
+	// The target groups.
+	TargetGroups []TargetGroupTuple `json:"targetGroups"`
+
+	// The target group stickiness for the rule.
+	// +optional
+	TargetGroupStickinessConfig *TargetGroupStickinessConfig `json:"targetGroupStickinessConfig,omitempty"`
+}
+
+// RedirectActionConfig redirects requests from one URL to another. Each
+// component that is not specified retains its original value.
+type RedirectActionConfig struct {
+	// The HTTP redirect code, either HTTP_301 or HTTP_302.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+
+	// The hostname.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// The absolute path, starting with the leading "/".
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// The port.
+	// +optional
+	Port *string `json:"port,omitempty"`
+
+	// The protocol, either HTTP, HTTPS or #{protocol}.
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The query parameters, URL-encoded when necessary, but not
+	// percent-encoded. Do not include the leading "?".
+	// +optional
+	Query *string `json:"query,omitempty"`
+}
+
+// FixedResponseActionConfig returns a custom HTTP response.
+type FixedResponseActionConfig struct {
+	// The HTTP response code (2XX, 4XX, or 5XX).
+	StatusCode string `json:"statusCode"`
+
+	// The content type.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// The message.
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+}
+
+// An Action is performed by a listener or listener rule on matching
+// requests.
+type Action struct {
+	// The type of action.
+	// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+	Type string `json:"type"`
+
+	// The order for the action. Required when a listener or rule has more
+	// than one action.
+	// +optional
+	Order *int64 `json:"order,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the target group that a forward
+	// action routes requests to. Use forwardConfig to route to multiple
+	// target groups.
+	// +optional
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef references a TargetGroup to retrieve its ARN.
+	// +optional
+	TargetGroupARNRef *xpv1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup to retrieve
+	// its ARN.
+	// +optional
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// Information for a forward action that routes to multiple target
+	// groups.
+	// +optional
+	ForwardConfig *ForwardActionConfig `json:"forwardConfig,omitempty"`
+
+	// Information for a redirect action.
+	// +optional
+	RedirectConfig *RedirectActionConfig `json:"redirectConfig,omitempty"`
+
+	// Information for a fixed-response action.
+	// +optional
+	FixedResponseConfig *FixedResponseActionConfig `json:"fixedResponseConfig,omitempty"`
+}
+
+// ListenerParameters define the desired state of an AWS Elastic Load
+// Balancing v2 listener.
+type ListenerParameters struct {
+	// Region is the region you'd like your Listener to be created in.
+	Region string `json:"region"`
+
+	// The Amazon Resource Name (ARN) of the load balancer.
+	// +optional
+	// +immutable
+	LoadBalancerARN *string `json:"loadBalancerArn,omitempty"`
+
+	// LoadBalancerARNRef references a LoadBalancer to retrieve its ARN.
+	// +optional
+	// +immutable
+	LoadBalancerARNRef *xpv1.Reference `json:"loadBalancerArnRef,omitempty"`
+
+	// LoadBalancerARNSelector selects a reference to a LoadBalancer to
+	// retrieve its ARN.
+	// +optional
+	// +immutable
+	LoadBalancerARNSelector *xpv1.Selector `json:"loadBalancerArnSelector,omitempty"`
+
+	// The port on which the load balancer is listening.
+	Port int64 `json:"port"`
+
+	// The protocol for connections from clients to the load balancer.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// The security policy that defines which protocols and ciphers are
+	// supported. Used only by HTTPS and TLS listeners.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the default server certificate.
+	// Required for HTTPS and TLS listeners.
+	// +optional
+	CertificateARN *string `json:"certificateArn,omitempty"`
+
+	// CertificateARNRef references an ACM Certificate to retrieve its ARN.
+	// +optional
+	CertificateARNRef *xpv1.Reference `json:"certificateArnRef,omitempty"`
+
+	// CertificateARNSelector selects a reference to an ACM Certificate to
+	// retrieve its ARN.
+	// +optional
+	CertificateARNSelector *xpv1.Selector `json:"certificateArnSelector,omitempty"`
+
+	// The name of the Application-Layer Protocol Negotiation (ALPN) policy
+	// of a TLS listener.
+	// +optional
+	ALPNPolicy []string `json:"alpnPolicy,omitempty"`
+
+	// The actions for the default rule.
+	DefaultActions []Action `json:"defaultActions"`
+}
+
+// A ListenerSpec defines the desired state of a Listener.
+type ListenerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ListenerParameters `json:"forProvider"`
+}
+
+// ListenerObservation keeps the state for the external resource
+type ListenerObservation struct {
+	// The Amazon Resource Name (ARN) of the listener.
+	ListenerARN string `json:"listenerArn,omitempty"`
+}
+
+// A ListenerStatus represents the observed state of a Listener.
+type ListenerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ListenerObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Listener is a managed resource that represents a listener of an AWS
+// Application or Network Load Balancer.
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.forProvider.port"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listeners
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// HTTPHeaderConditionConfig matches requests on the value of an HTTP header.
+type HTTPHeaderConditionConfig struct {
+	// The name of the HTTP header field.
+	HTTPHeaderName string `json:"httpHeaderName"`
+
+	// One or more strings to compare against the value of the HTTP header.
+	Values []string `json:"values"`
+}
+
+// QueryStringKeyValuePair is a key/value pair of a query string condition.
+type QueryStringKeyValuePair struct {
+	// The key. You can omit the key.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// The value.
+	Value string `json:"value"`
+}
+
+// A RuleCondition specifies which requests a listener rule applies to. Exactly
+// one of the value lists must be set, matching the given field.
+type RuleCondition struct {
+	// The field in the HTTP request.
+	// +kubebuilder:validation:Enum=host-header;path-pattern;http-header;http-request-method;query-string;source-ip
+	Field string `json:"field"`
+
+	// The host names to match when field is host-header.
+	// +optional
+	HostHeaderValues []string `json:"hostHeaderValues,omitempty"`
+
+	// The path patterns to match when field is path-pattern.
+	// +optional
+	PathPatternValues []string `json:"pathPatternValues,omitempty"`
+
+	// The HTTP header to match when field is http-header.
+	// +optional
+	HTTPHeaderConfig *HTTPHeaderConditionConfig `json:"httpHeaderConfig,omitempty"`
+
+	// The HTTP request methods to match when field is http-request-method.
+	// +optional
+	HTTPRequestMethodValues []string `json:"httpRequestMethodValues,omitempty"`
+
+	// The query string pairs to match when field is query-string.
+	// +optional
+	QueryStringValues []QueryStringKeyValuePair `json:"queryStringValues,omitempty"`
+
+	// The source IP CIDR blocks to match when field is source-ip.
+	// +optional
+	SourceIPValues []string `json:"sourceIpValues,omitempty"`
+}
+
+// ListenerRuleParameters define the desired state of an AWS Elastic Load
+// Balancing v2 listener rule.
+type ListenerRuleParameters struct {
+	// Region is the region you'd like your ListenerRule to be created in.
+	Region string `json:"region"`
+
+	// The Amazon Resource Name (ARN) of the listener.
+	// +optional
+	// +immutable
+	ListenerARN *string `json:"listenerArn,omitempty"`
+
+	// ListenerARNRef references a Listener to retrieve its ARN.
+	// +optional
+	// +immutable
+	ListenerARNRef *xpv1.Reference `json:"listenerArnRef,omitempty"`
+
+	// ListenerARNSelector selects a reference to a Listener to retrieve its
+	// ARN.
+	// +optional
+	// +immutable
+	ListenerARNSelector *xpv1.Selector `json:"listenerArnSelector,omitempty"`
+
+	// The rule priority. A listener can't have multiple rules with the same
+	// priority.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	Priority int64 `json:"priority"`
+
+	// The conditions of the rule.
+	Conditions []RuleCondition `json:"conditions"`
+
+	// The actions of the rule.
+	Actions []Action `json:"actions"`
+}
+
+// A ListenerRuleSpec defines the desired state of a ListenerRule.
+type ListenerRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ListenerRuleParameters `json:"forProvider"`
+}
+
+// ListenerRuleObservation keeps the state for the external resource
+type ListenerRuleObservation struct {
+	// The Amazon Resource Name (ARN) of the rule.
+	RuleARN string `json:"ruleArn,omitempty"`
+}
+
+// A ListenerRuleStatus represents the observed state of a ListenerRule.
+type ListenerRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ListenerRuleObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ListenerRule is a managed resource that represents a rule of an AWS
+// Application Load Balancer listener.
+// +kubebuilder:printcolumn:name="PRIORITY",type="integer",JSONPath=".spec.forProvider.priority"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ListenerRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerRuleSpec   `json:"spec"`
+	Status ListenerRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerRuleList contains a list of ListenerRules
+type ListenerRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ListenerRule `json:"items"`
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Load balancer states.
+const (
+	LoadBalancerStateActive         = "active"
+	LoadBalancerStateProvisioning   = "provisioning"
+	LoadBalancerStateActiveImpaired = "active_impaired"
+	LoadBalancerStateFailed         = "failed"
+)
+
+// Tag defines a key value pair that can be attached to an Elastic Load
+// Balancing v2 resource.
+type Tag struct {
+
+	// The key of the tag.
+	Key string `json:"key"`
+
+	// The value of the tag.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// SubnetMapping specifies a subnet for a load balancer together with the
+// addresses that the load balancer uses in it.
+type SubnetMapping struct {
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId"`
+
+	// The allocation ID of the Elastic IP address for an internet-facing
+	// Network Load Balancer.
+	// +optional
+	AllocationID *string `json:"allocationId,omitempty"`
+
+	// The private IPv4 address for an internal Network Load Balancer.
+	// +optional
+	PrivateIPv4Address *string `json:"privateIPv4Address,omitempty"`
+}
+
+// LoadBalancerParameters define the desired state of an AWS Application or
+// Network Load Balancer.
+type LoadBalancerParameters struct {
+	// Region is the region you'd like your LoadBalancer to be created in.
+	Region string `json:"region"`
+
+	// The type of load balancer. The default is application.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=application;network
+	Type *string `json:"type,omitempty"`
+
+	// The nodes of an Internet-facing load balancer have public IP addresses,
+	// the nodes of an internal load balancer have only private IP addresses.
+	// The default is internet-facing.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=internet-facing;internal
+	Scheme *string `json:"scheme,omitempty"`
+
+	// The type of IP addresses used by the subnets for your load balancer.
+	// Internal load balancers must use ipv4.
+	// +optional
+	// +kubebuilder:validation:Enum=ipv4;dualstack
+	IPAddressType *string `json:"ipAddressType,omitempty"`
+
+	// The IDs of the security groups for the load balancer. Valid only for
+	// Application Load Balancers.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs references to a SecurityGroup and retrieves its SecurityGroupID
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects a set of references that each retrieve the SecurityGroupID from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The IDs of the public subnets. You can specify only one subnet per
+	// Availability Zone. Either subnetIds or subnetMappings must be specified.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs references to a Subnet to and retrieves its SubnetID
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects a set of references that each retrieve the subnetID from the referenced Subnet
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The subnets of a Network Load Balancer together with the Elastic IP or
+	// private IPv4 address to use in each of them.
+	// +optional
+	// +immutable
+	SubnetMappings []SubnetMapping `json:"subnetMappings,omitempty"`
+
+	// A list of tags to assign to the load balancer.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A LoadBalancerSpec defines the desired state of a LoadBalancer.
+type LoadBalancerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoadBalancerParameters `json:"forProvider"`
+}
+
+// LoadBalancerObservation keeps the state for the external resource
+type LoadBalancerObservation struct {
+	// The Amazon Resource Name (ARN) of the load balancer.
+	LoadBalancerARN string `json:"loadBalancerArn,omitempty"`
+
+	// The ID of the Amazon Route 53 hosted zone associated with the load
+	// balancer. Use it together with dnsName in Route 53 alias records.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
+	// The public DNS name of the load balancer.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// The state of the load balancer.
+	State string `json:"state,omitempty"`
+
+	// The ID of the VPC for the load balancer.
+	VPCID string `json:"vpcId,omitempty"`
+}
+
+// A LoadBalancerStatus represents the observed state of a LoadBalancer.
+type LoadBalancerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoadBalancerObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoadBalancer is a managed resource that represents an AWS Application or
+// Network Load Balancer.
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DNSNAME",type="string",JSONPath=".status.atProvider.dnsName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerSpec   `json:"spec"`
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancers
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
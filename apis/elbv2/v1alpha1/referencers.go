@@ -0,0 +1,243 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	acmv1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// LoadBalancerARN returns a function that returns the ARN of the given
+// load balancer.
+func LoadBalancerARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*LoadBalancer)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.LoadBalancerARN
+	}
+}
+
+// TargetGroupARN returns a function that returns the ARN of the given target
+// group.
+func TargetGroupARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*TargetGroup)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.TargetGroupARN
+	}
+}
+
+// ResolveReferences of this LoadBalancer
+func (mg *LoadBalancer) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.securityGroupIds")
+	}
+	mg.Spec.ForProvider.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this TargetGroup
+func (mg *TargetGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vpcId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
+		Reference:    mg.Spec.ForProvider.VPCIDRef,
+		Selector:     mg.Spec.ForProvider.VPCIDSelector,
+		To:           reference.To{Managed: &ec2.VPC{}, List: &ec2.VPCList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcId")
+	}
+	mg.Spec.ForProvider.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VPCIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Listener
+func (mg *Listener) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.loadBalancerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LoadBalancerARN),
+		Reference:    mg.Spec.ForProvider.LoadBalancerARNRef,
+		Selector:     mg.Spec.ForProvider.LoadBalancerARNSelector,
+		To:           reference.To{Managed: &LoadBalancer{}, List: &LoadBalancerList{}},
+		Extract:      LoadBalancerARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.loadBalancerArn")
+	}
+	mg.Spec.ForProvider.LoadBalancerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LoadBalancerARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.certificateArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CertificateARN),
+		Reference:    mg.Spec.ForProvider.CertificateARNRef,
+		Selector:     mg.Spec.ForProvider.CertificateARNSelector,
+		To:           reference.To{Managed: &acmv1alpha1.Certificate{}, List: &acmv1alpha1.CertificateList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.certificateArn")
+	}
+	mg.Spec.ForProvider.CertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CertificateARNRef = rsp.ResolvedReference
+
+	return resolveActions(ctx, r, mg.Spec.ForProvider.DefaultActions, "spec.forProvider.defaultActions")
+}
+
+// ResolveReferences of this ListenerRule
+func (mg *ListenerRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.listenerArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ListenerARN),
+		Reference:    mg.Spec.ForProvider.ListenerARNRef,
+		Selector:     mg.Spec.ForProvider.ListenerARNSelector,
+		To:           reference.To{Managed: &Listener{}, List: &ListenerList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.listenerArn")
+	}
+	mg.Spec.ForProvider.ListenerARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ListenerARNRef = rsp.ResolvedReference
+
+	return resolveActions(ctx, r, mg.Spec.ForProvider.Actions, "spec.forProvider.actions")
+}
+
+// ResolveReferences of this TargetGroupAttachment
+func (mg *TargetGroupAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.targetGroupArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetGroupARN),
+		Reference:    mg.Spec.ForProvider.TargetGroupARNRef,
+		Selector:     mg.Spec.ForProvider.TargetGroupARNSelector,
+		To:           reference.To{Managed: &TargetGroup{}, List: &TargetGroupList{}},
+		Extract:      TargetGroupARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetGroupArn")
+	}
+	mg.Spec.ForProvider.TargetGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetGroupARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.targetId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetID),
+		Reference:    mg.Spec.ForProvider.TargetIDRef,
+		Selector:     mg.Spec.ForProvider.TargetIDSelector,
+		To:           reference.To{Managed: &ec2v1alpha1.Instance{}, List: &ec2v1alpha1.InstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetId")
+	}
+	mg.Spec.ForProvider.TargetID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// resolveActions resolves the target group references of the given actions
+// in place.
+func resolveActions(ctx context.Context, r *reference.APIResolver, actions []Action, path string) error {
+	for i := range actions {
+		// Resolve <path>[*].targetGroupArn
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(actions[i].TargetGroupARN),
+			Reference:    actions[i].TargetGroupARNRef,
+			Selector:     actions[i].TargetGroupARNSelector,
+			To:           reference.To{Managed: &TargetGroup{}, List: &TargetGroupList{}},
+			Extract:      TargetGroupARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s[%d].targetGroupArn", path, i))
+		}
+		actions[i].TargetGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+		actions[i].TargetGroupARNRef = rsp.ResolvedReference
+
+		if actions[i].ForwardConfig == nil {
+			continue
+		}
+		for j, tg := range actions[i].ForwardConfig.TargetGroups {
+			// Resolve <path>[*].forwardConfig.targetGroups[*].targetGroupArn
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(tg.TargetGroupARN),
+				Reference:    tg.TargetGroupARNRef,
+				Selector:     tg.TargetGroupARNSelector,
+				To:           reference.To{Managed: &TargetGroup{}, List: &TargetGroupList{}},
+				Extract:      TargetGroupARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("%s[%d].forwardConfig.targetGroups[%d].targetGroupArn", path, i, j))
+			}
+			actions[i].ForwardConfig.TargetGroups[j].TargetGroupARN = reference.ToPtrValue(rsp.ResolvedValue)
+			actions[i].ForwardConfig.TargetGroups[j].TargetGroupARNRef = rsp.ResolvedReference
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "elbv2.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// LoadBalancer type metadata.
+var (
+	LoadBalancerKind             = reflect.TypeOf(LoadBalancer{}).Name()
+	LoadBalancerGroupKind        = schema.GroupKind{Group: Group, Kind: LoadBalancerKind}.String()
+	LoadBalancerKindAPIVersion   = LoadBalancerKind + "." + SchemeGroupVersion.String()
+	LoadBalancerGroupVersionKind = SchemeGroupVersion.WithKind(LoadBalancerKind)
+)
+
+// TargetGroup type metadata.
+var (
+	TargetGroupKind             = reflect.TypeOf(TargetGroup{}).Name()
+	TargetGroupGroupKind        = schema.GroupKind{Group: Group, Kind: TargetGroupKind}.String()
+	TargetGroupKindAPIVersion   = TargetGroupKind + "." + SchemeGroupVersion.String()
+	TargetGroupGroupVersionKind = SchemeGroupVersion.WithKind(TargetGroupKind)
+)
+
+// Listener type metadata.
+var (
+	ListenerKind             = reflect.TypeOf(Listener{}).Name()
+	ListenerGroupKind        = schema.GroupKind{Group: Group, Kind: ListenerKind}.String()
+	ListenerKindAPIVersion   = ListenerKind + "." + SchemeGroupVersion.String()
+	ListenerGroupVersionKind = SchemeGroupVersion.WithKind(ListenerKind)
+)
+
+// ListenerRule type metadata.
+var (
+	ListenerRuleKind             = reflect.TypeOf(ListenerRule{}).Name()
+	ListenerRuleGroupKind        = schema.GroupKind{Group: Group, Kind: ListenerRuleKind}.String()
+	ListenerRuleKindAPIVersion   = ListenerRuleKind + "." + SchemeGroupVersion.String()
+	ListenerRuleGroupVersionKind = SchemeGroupVersion.WithKind(ListenerRuleKind)
+)
+
+// TargetGroupAttachment type metadata.
+var (
+	TargetGroupAttachmentKind             = reflect.TypeOf(TargetGroupAttachment{}).Name()
+	TargetGroupAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: TargetGroupAttachmentKind}.String()
+	TargetGroupAttachmentKindAPIVersion   = TargetGroupAttachmentKind + "." + SchemeGroupVersion.String()
+	TargetGroupAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TargetGroupAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancer{}, &LoadBalancerList{})
+	SchemeBuilder.Register(&TargetGroup{}, &TargetGroupList{})
+	SchemeBuilder.Register(&Listener{}, &ListenerList{})
+	SchemeBuilder.Register(&ListenerRule{}, &ListenerRuleList{})
+	SchemeBuilder.Register(&TargetGroupAttachment{}, &TargetGroupAttachmentList{})
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TargetGroupParameters define the desired state of an AWS Elastic Load
+// Balancing v2 target group.
+type TargetGroupParameters struct {
+	// Region is the region you'd like your TargetGroup to be created in.
+	Region string `json:"region"`
+
+	// The protocol to use for routing traffic to the targets. Not used when
+	// the target type is lambda.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol *string `json:"protocol,omitempty"`
+
+	// The port on which the targets receive traffic. Not used when the target
+	// type is lambda.
+	// +optional
+	// +immutable
+	Port *int64 `json:"port,omitempty"`
+
+	// The type of target that you must specify when registering targets with
+	// this target group. The default is instance.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=instance;ip;lambda
+	TargetType *string `json:"targetType,omitempty"`
+
+	// The identifier of the virtual private cloud (VPC). Not used when the
+	// target type is lambda.
+	// +optional
+	// +immutable
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to and retrieves its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to and retrieves its vpcId
+	// +optional
+	// +immutable
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// Indicates whether health checks are enabled.
+	// +optional
+	HealthCheckEnabled *bool `json:"healthCheckEnabled,omitempty"`
+
+	// The approximate amount of time, in seconds, between health checks of an
+	// individual target.
+	// +optional
+	HealthCheckIntervalSeconds *int64 `json:"healthCheckIntervalSeconds,omitempty"`
+
+	// The ping path that is the destination on the targets for health checks.
+	// +optional
+	HealthCheckPath *string `json:"healthCheckPath,omitempty"`
+
+	// The port the load balancer uses when performing health checks on
+	// targets. The default is traffic-port.
+	// +optional
+	HealthCheckPort *string `json:"healthCheckPort,omitempty"`
+
+	// The protocol the load balancer uses when performing health checks on
+	// targets.
+	// +optional
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	HealthCheckProtocol *string `json:"healthCheckProtocol,omitempty"`
+
+	// The amount of time, in seconds, during which no response from a target
+	// means a failed health check.
+	// +optional
+	HealthCheckTimeoutSeconds *int64 `json:"healthCheckTimeoutSeconds,omitempty"`
+
+	// The number of consecutive health checks successes required before
+	// considering an unhealthy target healthy.
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// The number of consecutive health check failures required before
+	// considering a target unhealthy.
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+
+	// The HTTP codes to use when checking for a successful response from a
+	// target, e.g. "200" or "200-299".
+	// +optional
+	Matcher *string `json:"matcher,omitempty"`
+
+	// A list of tags to assign to the target group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TargetGroupSpec defines the desired state of a TargetGroup.
+type TargetGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetGroupParameters `json:"forProvider"`
+}
+
+// TargetGroupObservation keeps the state for the external resource
+type TargetGroupObservation struct {
+	// The Amazon Resource Name (ARN) of the target group.
+	TargetGroupARN string `json:"targetGroupArn,omitempty"`
+
+	// The Amazon Resource Names (ARN) of the load balancers that route
+	// traffic to this target group.
+	LoadBalancerARNs []string `json:"loadBalancerArns,omitempty"`
+}
+
+// A TargetGroupStatus represents the observed state of a TargetGroup.
+type TargetGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetGroupObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetGroup is a managed resource that represents an AWS Elastic Load
+// Balancing v2 target group.
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.forProvider.port"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupSpec   `json:"spec"`
+	Status TargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupList contains a list of TargetGroups
+type TargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroup `json:"items"`
+}
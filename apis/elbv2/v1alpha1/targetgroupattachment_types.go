@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TargetGroupAttachmentParameters define the desired state of a target
+// registered with an AWS Elastic Load Balancing v2 target group.
+type TargetGroupAttachmentParameters struct {
+	// Region is the region you'd like your TargetGroupAttachment to be in.
+	Region string `json:"region"`
+
+	// The Amazon Resource Name (ARN) of the target group.
+	// +optional
+	// +immutable
+	TargetGroupARN *string `json:"targetGroupArn,omitempty"`
+
+	// TargetGroupARNRef references a TargetGroup to retrieve its ARN.
+	// +optional
+	// +immutable
+	TargetGroupARNRef *xpv1.Reference `json:"targetGroupArnRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup to retrieve
+	// its ARN.
+	// +optional
+	// +immutable
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// The ID of the target: an instance ID, an IP address or the ARN of a
+	// Lambda function, depending on the target type of the target group.
+	// +optional
+	// +immutable
+	TargetID *string `json:"targetId,omitempty"`
+
+	// TargetIDRef references an Instance to retrieve its ID.
+	// +optional
+	// +immutable
+	TargetIDRef *xpv1.Reference `json:"targetIdRef,omitempty"`
+
+	// TargetIDSelector selects a reference to an Instance to retrieve its ID.
+	// +optional
+	// +immutable
+	TargetIDSelector *xpv1.Selector `json:"targetIdSelector,omitempty"`
+
+	// The port on which the target is listening. Defaults to the port of the
+	// target group.
+	// +optional
+	// +immutable
+	Port *int64 `json:"port,omitempty"`
+
+	// An Availability Zone or "all". Used only for IP targets outside of the
+	// VPC of the target group.
+	// +optional
+	// +immutable
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+}
+
+// A TargetGroupAttachmentSpec defines the desired state of a
+// TargetGroupAttachment.
+type TargetGroupAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetGroupAttachmentParameters `json:"forProvider"`
+}
+
+// TargetGroupAttachmentObservation keeps the state for the external resource
+type TargetGroupAttachmentObservation struct {
+	// The health state of the target.
+	State string `json:"state,omitempty"`
+
+	// The reason code for the health state of the target.
+	Reason string `json:"reason,omitempty"`
+}
+
+// A TargetGroupAttachmentStatus represents the observed state of a
+// TargetGroupAttachment.
+type TargetGroupAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetGroupAttachmentObservation `json:"atProvider"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TargetGroupAttachment is a managed resource that represents a target
+// registered with an AWS Elastic Load Balancing v2 target group.
+// +kubebuilder:printcolumn:name="TARGETID",type="string",JSONPath=".spec.forProvider.targetId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TargetGroupAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupAttachmentSpec   `json:"spec"`
+	Status TargetGroupAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupAttachmentList contains a list of TargetGroupAttachments
+type TargetGroupAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroupAttachment `json:"items"`
+}
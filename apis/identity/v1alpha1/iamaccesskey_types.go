@@ -22,6 +22,11 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 )
 
+// AnnotationKeyRotateBefore is the annotation that triggers the rotation of
+// an IAMAccessKey. Its value is an RFC3339 timestamp and the access key is
+// rotated if it was created before that time.
+const AnnotationKeyRotateBefore = "identity.aws.crossplane.io/rotate-before"
+
 // IAMAccessKeyParameters define the desired state of an AWS IAM Access Key.
 type IAMAccessKeyParameters struct {
 	// IAMUsername contains the name of the IAMUser.
@@ -41,6 +46,27 @@ type IAMAccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// RotationPolicy configures the periodic rotation of the access key.
+	// +optional
+	RotationPolicy *AccessKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+// AccessKeyRotationPolicy configures the rotation of an access key. When the
+// access key is rotated a new key is created and published to the connection
+// secret. The previous key is deactivated and deleted once the grace period
+// has passed.
+type AccessKeyRotationPolicy struct {
+	// MaxAge is the age after which the access key is rotated, e.g. 2160h for
+	// 90 days. The access key is only rotated using the rotate-before
+	// annotation if it is not set.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// GracePeriod is how long the previous access key is kept after it is
+	// replaced by a new one. Defaults to 24h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
 }
 
 // An IAMAccessKeySpec defines the desired state of an IAM Access Key.
@@ -49,9 +75,22 @@ type IAMAccessKeySpec struct {
 	ForProvider       IAMAccessKeyParameters `json:"forProvider"`
 }
 
+// IAMAccessKeyObservation keeps the state for the external resource
+type IAMAccessKeyObservation struct {
+	// CreateDate is the time the current access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// PreviousAccessKeyID is the ID of the access key that was replaced by the
+	// current one during rotation, i.e. the other access key of the user that
+	// was created before the current one. It is deactivated and deleted once
+	// the grace period has passed.
+	PreviousAccessKeyID string `json:"previousAccessKeyId,omitempty"`
+}
+
 // IAMAccessKeyStatus represents the observed state of an IAM Access Key.
 type IAMAccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IAMAccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
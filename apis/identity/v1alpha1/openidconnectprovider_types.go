@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OpenIDConnectProviderParameters define the desired state of an AWS IAM
+// OpenID Connect identity provider.
+type OpenIDConnectProviderParameters struct {
+	// URL of the identity provider. The URL must begin with https:// and
+	// corresponds to the iss claim in the provider's OpenID Connect ID tokens.
+	// +immutable
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ClusterRef references an EKS Cluster whose OpenID Connect issuer URL is
+	// used to set the URL.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to an EKS Cluster whose OpenID
+	// Connect issuer URL is used to set the URL.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// ClientIDList is a list of client IDs, also known as audiences, that
+	// are allowed to authenticate using the identity provider. EKS IAM roles
+	// for service accounts use sts.amazonaws.com.
+	// +optional
+	ClientIDList []string `json:"clientIdList,omitempty"`
+
+	// ThumbprintList is a list of server certificate thumbprints for the
+	// identity provider's server certificates. If omitted, the thumbprint of
+	// the root certificate authority presented by the URL is computed and
+	// filled in.
+	// +optional
+	ThumbprintList []string `json:"thumbprintList,omitempty"`
+}
+
+// An OpenIDConnectProviderSpec defines the desired state of an
+// OpenIDConnectProvider.
+type OpenIDConnectProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OpenIDConnectProviderParameters `json:"forProvider"`
+}
+
+// OpenIDConnectProviderObservation keeps the state for the external resource.
+type OpenIDConnectProviderObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the OpenID Connect provider.
+	ARN string `json:"arn,omitempty"`
+
+	// CreateDate is the date and time when the provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// An OpenIDConnectProviderStatus represents the observed state of an
+// OpenIDConnectProvider.
+type OpenIDConnectProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OpenIDConnectProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OpenIDConnectProvider is a managed resource that represents an AWS IAM
+// OpenID Connect identity provider.
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.forProvider.url"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OpenIDConnectProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenIDConnectProviderSpec   `json:"spec"`
+	Status OpenIDConnectProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenIDConnectProviderList contains a list of OpenIDConnectProviders
+type OpenIDConnectProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenIDConnectProvider `json:"items"`
+}
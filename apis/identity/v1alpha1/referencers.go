@@ -48,6 +48,18 @@ func IAMUserARN() reference.ExtractValueFn {
 	}
 }
 
+// OpenIDConnectProviderARN returns a function that returns the ARN of the
+// given OpenID Connect provider.
+func OpenIDConnectProviderARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*OpenIDConnectProvider)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
 // ResolveReferences of this IAMUserPolicyAttachment
 func (mg *IAMUserPolicyAttachment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
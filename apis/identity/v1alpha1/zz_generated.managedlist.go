@@ -82,3 +82,12 @@ func (l *IAMUserPolicyAttachmentList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this OpenIDConnectProviderList.
+func (l *OpenIDConnectProviderList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
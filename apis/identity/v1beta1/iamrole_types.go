@@ -49,9 +49,19 @@ type Tag struct {
 type IAMRoleParameters struct {
 
 	// AssumeRolePolicyDocument is the the trust relationship policy document
-	// that grants an entity permission to assume the role.
+	// that grants an entity permission to assume the role. It is required
+	// unless ServiceAccountTrust is set.
 	// +immutable
-	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument"`
+	// +optional
+	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument,omitempty"`
+
+	// ServiceAccountTrust generates the AssumeRolePolicyDocument so that the
+	// given Kubernetes service account can assume the role through the OpenID
+	// Connect provider of its cluster, i.e. IAM roles for service accounts.
+	// The generated document is used instead of AssumeRolePolicyDocument, which
+	// is not changed.
+	// +optional
+	ServiceAccountTrust *ServiceAccountTrust `json:"serviceAccountTrust,omitempty"`
 
 	// Description is a description of the role.
 	// +optional
@@ -80,6 +90,59 @@ type IAMRoleParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// InlinePolicies maps the names of the inline policies embedded in the
+	// role to their JSON policy documents. If set, inline policies of the role
+	// that are not in this map are deleted.
+	// +optional
+	InlinePolicies map[string]string `json:"inlinePolicies,omitempty"`
+
+	// ManagedPolicyARNs are the ARNs of the managed policies attached to the
+	// role. If set, managed policies that are attached to the role but not in
+	// this list are detached.
+	// +optional
+	ManagedPolicyARNs []string `json:"managedPolicyArns,omitempty"`
+
+	// ManagedPolicyARNRefs are references to IAMPolicies used to set
+	// the ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNRefs []xpv1.Reference `json:"managedPolicyArnRefs,omitempty"`
+
+	// ManagedPolicyARNSelector selects references to IAMPolicies used
+	// to set the ManagedPolicyARNs.
+	// +optional
+	ManagedPolicyARNSelector *xpv1.Selector `json:"managedPolicyArnSelector,omitempty"`
+
+	// CreateInstanceProfile indicates whether an instance profile with the
+	// same name and path as the role should be created and the role added to
+	// it, so that the role can be used by EC2 instances.
+	// +optional
+	CreateInstanceProfile *bool `json:"createInstanceProfile,omitempty"`
+}
+
+// ServiceAccountTrust is a Kubernetes service account that is allowed to
+// assume an IAMRole.
+type ServiceAccountTrust struct {
+	// OIDCProviderARN is the ARN of the OpenID Connect provider of the cluster
+	// the service account belongs to.
+	// +optional
+	OIDCProviderARN string `json:"oidcProviderArn,omitempty"`
+
+	// OIDCProviderARNRef references an OpenIDConnectProvider to retrieve its
+	// ARN.
+	// +optional
+	OIDCProviderARNRef *xpv1.Reference `json:"oidcProviderArnRef,omitempty"`
+
+	// OIDCProviderARNSelector selects a reference to an OpenIDConnectProvider
+	// to retrieve its ARN.
+	// +optional
+	OIDCProviderARNSelector *xpv1.Selector `json:"oidcProviderArnSelector,omitempty"`
+
+	// Namespace of the service account.
+	Namespace string `json:"namespace"`
+
+	// ServiceAccountName is the name of the service account.
+	ServiceAccountName string `json:"serviceAccountName"`
 }
 
 // An IAMRoleSpec defines the desired state of an IAMRole.
@@ -99,6 +162,10 @@ type IAMRoleExternalStatus struct {
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
 	RoleID string `json:"roleID"`
+
+	// InstanceProfileARN is the Amazon Resource Name (ARN) of the instance
+	// profile of the role, if one was requested.
+	InstanceProfileARN string `json:"instanceProfileArn,omitempty"`
 }
 
 // An IAMRoleStatus represents the observed state of an IAMRole.
@@ -18,7 +18,6 @@ package v1beta1
 
 import (
 	"context"
-
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -74,3 +73,43 @@ func (mg *IAMRolePolicyAttachment) ResolveReferences(ctx context.Context, c clie
 
 	return nil
 }
+
+// ResolveReferences of this IAMRole
+func (mg *IAMRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.managedPolicyArns
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ManagedPolicyARNs,
+		References:    mg.Spec.ForProvider.ManagedPolicyARNRefs,
+		Selector:      mg.Spec.ForProvider.ManagedPolicyARNSelector,
+		To:            reference.To{Managed: &v1alpha1.IAMPolicy{}, List: &v1alpha1.IAMPolicyList{}},
+		Extract:       v1alpha1.IAMPolicyARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.managedPolicyArns")
+	}
+	mg.Spec.ForProvider.ManagedPolicyARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ManagedPolicyARNRefs = mrsp.ResolvedReferences
+
+	t := mg.Spec.ForProvider.ServiceAccountTrust
+	if t == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.serviceAccountTrust.oidcProviderArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: t.OIDCProviderARN,
+		Reference:    t.OIDCProviderARNRef,
+		Selector:     t.OIDCProviderARNSelector,
+		To:           reference.To{Managed: &v1alpha1.OpenIDConnectProvider{}, List: &v1alpha1.OpenIDConnectProviderList{}},
+		Extract:      v1alpha1.OpenIDConnectProviderARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccountTrust.oidcProviderArn")
+	}
+	t.OIDCProviderARN = rsp.ResolvedValue
+	t.OIDCProviderARNRef = rsp.ResolvedReference
+
+	return nil
+}
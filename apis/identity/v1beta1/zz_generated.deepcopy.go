@@ -102,6 +102,11 @@ func (in *IAMRoleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMRoleParameters) DeepCopyInto(out *IAMRoleParameters) {
 	*out = *in
+	if in.ServiceAccountTrust != nil {
+		in, out := &in.ServiceAccountTrust, &out.ServiceAccountTrust
+		*out = new(ServiceAccountTrust)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -127,6 +132,33 @@ func (in *IAMRoleParameters) DeepCopyInto(out *IAMRoleParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ManagedPolicyARNs != nil {
+		in, out := &in.ManagedPolicyARNs, &out.ManagedPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPolicyARNRefs != nil {
+		in, out := &in.ManagedPolicyARNRefs, &out.ManagedPolicyARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPolicyARNSelector != nil {
+		in, out := &in.ManagedPolicyARNSelector, &out.ManagedPolicyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateInstanceProfile != nil {
+		in, out := &in.CreateInstanceProfile, &out.CreateInstanceProfile
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleParameters.
@@ -316,6 +348,31 @@ func (in *IAMRoleStatus) DeepCopy() *IAMRoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTrust) DeepCopyInto(out *ServiceAccountTrust) {
+	*out = *in
+	if in.OIDCProviderARNRef != nil {
+		in, out := &in.OIDCProviderARNRef, &out.OIDCProviderARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OIDCProviderARNSelector != nil {
+		in, out := &in.OIDCProviderARNSelector, &out.OIDCProviderARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTrust.
+func (in *ServiceAccountTrust) DeepCopy() *ServiceAccountTrust {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTrust)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AliasParameters define the desired state of an AWS KMS alias.
+type AliasParameters struct {
+	// Region is the region you'd like your Alias to be created in.
+	Region string `json:"region"`
+
+	// TargetKeyID is the ID of the key the alias points to.
+	// +optional
+	TargetKeyID *string `json:"targetKeyId,omitempty"`
+
+	// TargetKeyIDRef is a reference to a Key used to set TargetKeyID.
+	// +optional
+	TargetKeyIDRef *xpv1.Reference `json:"targetKeyIdRef,omitempty"`
+
+	// TargetKeyIDSelector selects a reference to a Key used to set
+	// TargetKeyID.
+	// +optional
+	TargetKeyIDSelector *xpv1.Selector `json:"targetKeyIdSelector,omitempty"`
+}
+
+// An AliasSpec defines the desired state of an Alias.
+type AliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AliasParameters `json:"forProvider"`
+}
+
+// AliasObservation keeps the state for the external resource.
+type AliasObservation struct {
+	// The Amazon Resource Name (ARN) of the alias.
+	AliasARN string `json:"aliasArn,omitempty"`
+}
+
+// An AliasStatus represents the observed state of an Alias.
+type AliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Alias is a managed resource that represents a friendly name of an AWS
+// KMS customer master key. Its external name is the name of the alias without
+// the alias/ prefix.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.targetKeyId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Alias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AliasSpec   `json:"spec"`
+	Status AliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AliasList contains a list of Aliases
+type AliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Alias `json:"items"`
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyParameters define the desired state of an AWS KMS customer master key.
+type KeyParameters struct {
+	// Region is the region you'd like your Key to be created in.
+	Region string `json:"region"`
+
+	// A description of the key. Use a description that helps you decide whether
+	// the key is appropriate for a task.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Determines the cryptographic operations for which you can use the key.
+	// It cannot be changed after the key is created.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=ENCRYPT_DECRYPT;SIGN_VERIFY
+	KeyUsage *string `json:"keyUsage,omitempty"`
+
+	// Specifies the type of key to create. The default value, SYMMETRIC_DEFAULT,
+	// creates a key with a 256-bit symmetric key for encryption and decryption.
+	// It cannot be changed after the key is created.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=SYMMETRIC_DEFAULT;RSA_2048;RSA_3072;RSA_4096;ECC_NIST_P256;ECC_NIST_P384;ECC_NIST_P521;ECC_SECG_P256K1
+	CustomerMasterKeySpec *string `json:"customerMasterKeySpec,omitempty"`
+
+	// The key policy to attach to the key, as a JSON document. If you do not
+	// provide one, AWS KMS attaches a default key policy that gives the AWS
+	// account full access to the key.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// A flag to indicate whether to bypass the key policy lockout safety check.
+	// Setting this value to true increases the risk that the key becomes
+	// unmanageable. Only use it if you intend to prevent the principal that is
+	// making the request from making subsequent PutKeyPolicy requests.
+	// +optional
+	BypassPolicyLockoutSafetyCheck *bool `json:"bypassPolicyLockoutSafetyCheck,omitempty"`
+
+	// Specifies whether the key is enabled. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Specifies whether automatic rotation of the key material is enabled.
+	// Rotation is only supported for symmetric keys.
+	// +optional
+	EnableKeyRotation *bool `json:"enableKeyRotation,omitempty"`
+
+	// The waiting period, in days, that AWS KMS waits before it deletes the
+	// key once this resource is deleted. Defaults to 30 days.
+	// +optional
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=30
+	PendingWindowInDays *int64 `json:"pendingWindowInDays,omitempty"`
+
+	// Metadata tagging key value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A KeySpec defines the desired state of a Key.
+type KeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyParameters `json:"forProvider"`
+}
+
+// KeyObservation keeps the state for the external resource.
+type KeyObservation struct {
+	// The Amazon Resource Name (ARN) of the key.
+	ARN string `json:"arn,omitempty"`
+
+	// The globally unique identifier of the key.
+	KeyID string `json:"keyId,omitempty"`
+
+	// The current status of the key.
+	KeyState string `json:"keyState,omitempty"`
+
+	// The manager of the key, either AWS or CUSTOMER.
+	KeyManager string `json:"keyManager,omitempty"`
+
+	// The source of the key material.
+	Origin string `json:"origin,omitempty"`
+
+	// The date and time when the key was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+
+	// The date and time after which AWS KMS deletes the key. It is only
+	// present when the key is pending deletion.
+	DeletionDate *metav1.Time `json:"deletionDate,omitempty"`
+}
+
+// A KeyStatus represents the observed state of a Key.
+type KeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Key is a managed resource that represents an AWS KMS customer master key.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.keyState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Key struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeySpec   `json:"spec"`
+	Status KeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyList contains a list of Keys
+type KeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Key `json:"items"`
+}
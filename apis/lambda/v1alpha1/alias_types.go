@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AliasRoutingConfig configures traffic shifting between two versions of a
+// function.
+type AliasRoutingConfig struct {
+	// AdditionalVersionWeights maps a second function version to the
+	// percentage of traffic, between 0.0 and 1.0, routed to it.
+	AdditionalVersionWeights map[string]float64 `json:"additionalVersionWeights"`
+}
+
+// AliasParameters define the desired state of an AWS Lambda Alias.
+type AliasParameters struct {
+	// Region is the region you'd like your Alias to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name of the function the alias belongs to.
+	// +immutable
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef is a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// FunctionVersion is the function version that the alias invokes.
+	FunctionVersion string `json:"functionVersion"`
+
+	// Description of the alias.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// RoutingConfig configures weighted routing to a second function
+	// version.
+	// +optional
+	RoutingConfig *AliasRoutingConfig `json:"routingConfig,omitempty"`
+}
+
+// An AliasSpec defines the desired state of an Alias.
+type AliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AliasParameters `json:"forProvider"`
+}
+
+// AliasObservation keeps the state for the external resource
+type AliasObservation struct {
+	// AliasARN is the ARN of the alias.
+	AliasARN string `json:"aliasArn,omitempty"`
+
+	// RevisionID is a unique identifier that changes when the alias is
+	// updated.
+	RevisionID string `json:"revisionId,omitempty"`
+}
+
+// An AliasStatus represents the observed state of an Alias.
+type AliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Alias is a managed resource that represents an AWS Lambda Alias.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.functionVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Alias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AliasSpec   `json:"spec"`
+	Status AliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AliasList contains a list of Aliases
+type AliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Alias `json:"items"`
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EventSourceMappingParameters define the desired state of an AWS Lambda
+// EventSourceMapping.
+type EventSourceMappingParameters struct {
+	// Region is the region you'd like your EventSourceMapping to be created
+	// in.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name, ARN or qualified ARN of the function that
+	// the events are sent to.
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef is a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// EventSourceARN is the ARN of the event source, i.e. an SQS queue, a
+	// Kinesis or DynamoDB stream or an MSK cluster.
+	// +immutable
+	// +optional
+	EventSourceARN *string `json:"eventSourceArn,omitempty"`
+
+	// EventSourceARNRef is a reference to a Queue used to set the
+	// EventSourceARN.
+	// +optional
+	EventSourceARNRef *xpv1.Reference `json:"eventSourceArnRef,omitempty"`
+
+	// EventSourceARNSelector selects a reference to a Queue used to set the
+	// EventSourceARN.
+	// +optional
+	EventSourceARNSelector *xpv1.Selector `json:"eventSourceArnSelector,omitempty"`
+
+	// BatchSize is the maximum number of items to retrieve in a single
+	// batch.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// Enabled disables the event source mapping to pause polling and
+	// invocation if set to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaximumBatchingWindowInSeconds is the maximum amount of time to gather
+	// records before invoking the function, in seconds.
+	// +optional
+	MaximumBatchingWindowInSeconds *int64 `json:"maximumBatchingWindowInSeconds,omitempty"`
+
+	// StartingPosition is the position in a stream from which to start
+	// reading. Required for Kinesis, DynamoDB and MSK event sources.
+	// +kubebuilder:validation:Enum=TRIM_HORIZON;LATEST;AT_TIMESTAMP
+	// +immutable
+	// +optional
+	StartingPosition *string `json:"startingPosition,omitempty"`
+
+	// StartingPositionTimestamp is the time from which to start reading if
+	// StartingPosition is AT_TIMESTAMP.
+	// +immutable
+	// +optional
+	StartingPositionTimestamp *metav1.Time `json:"startingPositionTimestamp,omitempty"`
+
+	// BisectBatchOnFunctionError splits a batch in two and retries if the
+	// function returns an error. Streams only.
+	// +optional
+	BisectBatchOnFunctionError *bool `json:"bisectBatchOnFunctionError,omitempty"`
+
+	// MaximumRecordAgeInSeconds discards records older than the specified
+	// age. Streams only.
+	// +optional
+	MaximumRecordAgeInSeconds *int64 `json:"maximumRecordAgeInSeconds,omitempty"`
+
+	// MaximumRetryAttempts discards records after the specified number of
+	// retries. Streams only.
+	// +optional
+	MaximumRetryAttempts *int64 `json:"maximumRetryAttempts,omitempty"`
+
+	// ParallelizationFactor is the number of batches to process from each
+	// shard concurrently. Streams only.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	ParallelizationFactor *int64 `json:"parallelizationFactor,omitempty"`
+
+	// OnFailureDestinationARN is the ARN of an SQS queue or SNS topic that
+	// discarded records are sent to. Streams only.
+	// +optional
+	OnFailureDestinationARN *string `json:"onFailureDestinationArn,omitempty"`
+}
+
+// An EventSourceMappingSpec defines the desired state of an
+// EventSourceMapping.
+type EventSourceMappingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventSourceMappingParameters `json:"forProvider"`
+}
+
+// EventSourceMappingObservation keeps the state for the external resource
+type EventSourceMappingObservation struct {
+	// FunctionARN is the ARN of the function the events are sent to.
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// LastModified is the date that the event source mapping was last
+	// updated, or its state changed.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// LastProcessingResult is the result of the last invocation of the
+	// function.
+	LastProcessingResult string `json:"lastProcessingResult,omitempty"`
+
+	// State is the state of the event source mapping, e.g. Enabled or
+	// Creating.
+	State string `json:"state,omitempty"`
+
+	// StateTransitionReason indicates whether the last change to the event
+	// source mapping was made by a user or by the Lambda service.
+	StateTransitionReason string `json:"stateTransitionReason,omitempty"`
+}
+
+// An EventSourceMappingStatus represents the observed state of an
+// EventSourceMapping.
+type EventSourceMappingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EventSourceMappingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventSourceMapping is a managed resource that represents an AWS Lambda
+// event source mapping. Its external name is the UUID assigned by AWS.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="UUID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EventSourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventSourceMappingSpec   `json:"spec"`
+	Status EventSourceMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventSourceMappingList contains a list of EventSourceMappings
+type EventSourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSourceMapping `json:"items"`
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Package types of a Lambda function.
+const (
+	PackageTypeZip   = "Zip"
+	PackageTypeImage = "Image"
+)
+
+// FunctionCode is the code of a Lambda function. Either an S3 object holding
+// a deployment package or a container image in ECR must be given.
+type FunctionCode struct {
+	// S3Bucket is the name of the S3 bucket that holds the deployment package.
+	// The bucket must be in the same region as the function.
+	// +optional
+	S3Bucket *string `json:"s3Bucket,omitempty"`
+
+	// S3BucketRef is a reference to a Bucket used to set the S3Bucket.
+	// +optional
+	S3BucketRef *xpv1.Reference `json:"s3BucketRef,omitempty"`
+
+	// S3BucketSelector selects a reference to a Bucket used to set the
+	// S3Bucket.
+	// +optional
+	S3BucketSelector *xpv1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// S3Key is the key of the deployment package in the S3 bucket.
+	// +optional
+	S3Key *string `json:"s3Key,omitempty"`
+
+	// S3ObjectVersion is the version of the deployment package object to use
+	// if the S3 bucket is versioned.
+	// +optional
+	S3ObjectVersion *string `json:"s3ObjectVersion,omitempty"`
+
+	// ImageRepositoryURI is the URI of the ECR repository that holds the
+	// container image of the function.
+	// +optional
+	ImageRepositoryURI *string `json:"imageRepositoryUri,omitempty"`
+
+	// ImageRepositoryURIRef is a reference to a Repository used to set the
+	// ImageRepositoryURI.
+	// +optional
+	ImageRepositoryURIRef *xpv1.Reference `json:"imageRepositoryUriRef,omitempty"`
+
+	// ImageRepositoryURISelector selects a reference to a Repository used to
+	// set the ImageRepositoryURI.
+	// +optional
+	ImageRepositoryURISelector *xpv1.Selector `json:"imageRepositoryUriSelector,omitempty"`
+
+	// ImageTag is the tag or the digest of the container image to deploy, e.g.
+	// v1.0.0 or sha256:0123456789abcdef. Defaults to latest.
+	// +optional
+	ImageTag *string `json:"imageTag,omitempty"`
+
+	// SHA256 is the base64 encoded SHA-256 hash of the deployment package or
+	// the hex encoded digest of the container image. The code of the function
+	// is updated whenever it differs from the CodeSha256 reported by AWS, so
+	// it should be changed every time a new package or image is published.
+	// Without it, the code is only updated when the S3 location of the
+	// package or the image changes.
+	// +optional
+	SHA256 *string `json:"sha256,omitempty"`
+}
+
+// VPCConfig is the VPC configuration of a Lambda function.
+type VPCConfig struct {
+	// SubnetIDs is the list of VPC subnet IDs the function connects to.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs is the list of VPC security group IDs attached to the
+	// network interfaces of the function.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
+
+// TracingConfig is the X-Ray tracing configuration of a Lambda function.
+type TracingConfig struct {
+	// Mode is the tracing mode of the function.
+	// +kubebuilder:validation:Enum=Active;PassThrough
+	Mode string `json:"mode"`
+}
+
+// DeadLetterConfig is the dead-letter queue configuration of a Lambda
+// function.
+type DeadLetterConfig struct {
+	// TargetARN is the ARN of an SQS queue or SNS topic that receives the
+	// events that failed asynchronous invocation.
+	TargetARN string `json:"targetArn"`
+}
+
+// FunctionParameters define the desired state of an AWS Lambda Function.
+type FunctionParameters struct {
+	// Region is the region you'd like your Function to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// Code is the code of the function.
+	Code FunctionCode `json:"code"`
+
+	// Description of the function.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Handler is the name of the method within the code that Lambda calls to
+	// execute the function. It is required for Zip packages and must be
+	// omitted for container images.
+	// +optional
+	Handler *string `json:"handler,omitempty"`
+
+	// Runtime is the identifier of the runtime of the function, e.g.
+	// python3.8. It is required for Zip packages and must be omitted for
+	// container images.
+	// +optional
+	Runtime *string `json:"runtime,omitempty"`
+
+	// Role is the ARN of the execution role of the function.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// RoleRef is a reference to an IAMRole used to set the Role.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an IAMRole used to set the Role.
+	// +optional
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
+
+	// MemorySize is the amount of memory available to the function at
+	// runtime, in MB.
+	// +kubebuilder:validation:Minimum=128
+	// +optional
+	MemorySize *int64 `json:"memorySize,omitempty"`
+
+	// Timeout is the amount of time in seconds that Lambda allows a function
+	// to run before stopping it.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// Environment is the set of environment variables that are accessible
+	// from the function code during execution.
+	// +optional
+	Environment map[string]string `json:"environment,omitempty"`
+
+	// KMSKeyARN is the ARN of the KMS key used to encrypt the environment
+	// variables of the function.
+	// +optional
+	KMSKeyARN *string `json:"kmsKeyArn,omitempty"`
+
+	// Layers is a list of function layer version ARNs to add to the execution
+	// environment of the function.
+	// +optional
+	Layers []string `json:"layers,omitempty"`
+
+	// DeadLetterConfig configures a dead-letter queue for events that failed
+	// asynchronous invocation.
+	// +optional
+	DeadLetterConfig *DeadLetterConfig `json:"deadLetterConfig,omitempty"`
+
+	// TracingConfig configures X-Ray tracing for the function.
+	// +optional
+	TracingConfig *TracingConfig `json:"tracingConfig,omitempty"`
+
+	// VPCConfig connects the function to a VPC.
+	// +optional
+	VPCConfig *VPCConfig `json:"vpcConfig,omitempty"`
+
+	// Publish creates a new version of the function whenever it is created
+	// or its code is updated.
+	// +optional
+	Publish *bool `json:"publish,omitempty"`
+
+	// Tags is the list of tags to apply to the function.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A FunctionSpec defines the desired state of a Function.
+type FunctionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FunctionParameters `json:"forProvider"`
+}
+
+// FunctionObservation keeps the state for the external resource
+type FunctionObservation struct {
+	// FunctionARN is the ARN of the function.
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// CodeSHA256 is the SHA-256 hash of the deployed code as reported by AWS.
+	CodeSHA256 string `json:"codeSha256,omitempty"`
+
+	// CodeSize is the size of the deployment package in bytes.
+	CodeSize int64 `json:"codeSize,omitempty"`
+
+	// PackageType is the type of the deployment package, Zip or Image.
+	PackageType string `json:"packageType,omitempty"`
+
+	// ImageURI is the URI of the deployed container image.
+	ImageURI string `json:"imageUri,omitempty"`
+
+	// ResolvedImageURI is the URI of the deployed container image including
+	// its digest.
+	ResolvedImageURI string `json:"resolvedImageUri,omitempty"`
+
+	// LastModified is the date and time the function was last updated, in
+	// ISO-8601 format.
+	LastModified string `json:"lastModified,omitempty"`
+
+	// LastUpdateStatus is the status of the last update performed on the
+	// function.
+	LastUpdateStatus string `json:"lastUpdateStatus,omitempty"`
+
+	// State is the current state of the function.
+	State string `json:"state,omitempty"`
+
+	// StateReason is the reason for the current state of the function.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// Version is the version of the function.
+	Version string `json:"version,omitempty"`
+
+	// RevisionID is the latest updated revision of the function.
+	RevisionID string `json:"revisionId,omitempty"`
+
+	// LastAppliedCode is the S3 location of the deployment package that was
+	// last deployed to the function.
+	LastAppliedCode *FunctionCodeLocation `json:"lastAppliedCode,omitempty"`
+}
+
+// FunctionCodeLocation is the S3 location of a deployment package.
+type FunctionCodeLocation struct {
+	// S3Bucket is the name of the S3 bucket that holds the deployment package.
+	S3Bucket string `json:"s3Bucket"`
+
+	// S3Key is the key of the deployment package in the S3 bucket.
+	S3Key string `json:"s3Key,omitempty"`
+
+	// S3ObjectVersion is the version of the deployment package object.
+	S3ObjectVersion string `json:"s3ObjectVersion,omitempty"`
+}
+
+// A FunctionStatus represents the observed state of a Function.
+type FunctionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Function is a managed resource that represents an AWS Lambda Function.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.functionArn",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Function struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FunctionSpec   `json:"spec"`
+	Status FunctionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FunctionList contains a list of Functions
+type FunctionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Function `json:"items"`
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PermissionParameters define the desired state of an AWS Lambda Permission,
+// i.e. a statement of the resource-based policy of a function. The external
+// name of a Permission is used as the statement ID.
+type PermissionParameters struct {
+	// Region is the region you'd like your Permission to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// FunctionName is the name of the function the permission is granted
+	// for.
+	// +immutable
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef is a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// Qualifier is the version or alias of the function the permission is
+	// granted for.
+	// +immutable
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// Action is the action that the principal can use on the function, e.g.
+	// lambda:InvokeFunction.
+	Action string `json:"action"`
+
+	// Principal is the AWS service or account that invokes the function,
+	// e.g. s3.amazonaws.com or an account ID.
+	Principal string `json:"principal"`
+
+	// SourceARN restricts the permission to invocations on behalf of the
+	// given resource, e.g. an S3 bucket or an SNS topic.
+	// +optional
+	SourceARN *string `json:"sourceArn,omitempty"`
+
+	// SourceAccount restricts the permission to invocations on behalf of
+	// resources owned by the given account. Useful together with S3, whose
+	// bucket ARNs do not contain the account ID.
+	// +optional
+	SourceAccount *string `json:"sourceAccount,omitempty"`
+
+	// EventSourceToken restricts the permission to Alexa Smart Home functions
+	// invoked with the given token.
+	// +optional
+	EventSourceToken *string `json:"eventSourceToken,omitempty"`
+}
+
+// A PermissionSpec defines the desired state of a Permission.
+type PermissionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PermissionParameters `json:"forProvider"`
+}
+
+// A PermissionStatus represents the observed state of a Permission.
+type PermissionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Permission is a managed resource that represents a statement in the
+// resource-based policy of an AWS Lambda Function.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principal"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Permission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionSpec   `json:"spec"`
+	Status PermissionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionList contains a list of Permissions
+type PermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Permission `json:"items"`
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	ecr "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	iam "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	s3 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	sqs "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// FunctionARN returns the status.atProvider.functionArn of a Function.
+func FunctionARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Function)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.FunctionARN
+	}
+}
+
+// ResolveReferences of this Function
+func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.role
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Role),
+		Reference:    mg.Spec.ForProvider.RoleRef,
+		Selector:     mg.Spec.ForProvider.RoleSelector,
+		To:           reference.To{Managed: &iam.IAMRole{}, List: &iam.IAMRoleList{}},
+		Extract:      iam.IAMRoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.role")
+	}
+	mg.Spec.ForProvider.Role = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.code.s3Bucket
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Code.S3Bucket),
+		Reference:    mg.Spec.ForProvider.Code.S3BucketRef,
+		Selector:     mg.Spec.ForProvider.Code.S3BucketSelector,
+		To:           reference.To{Managed: &s3.Bucket{}, List: &s3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.code.s3Bucket")
+	}
+	mg.Spec.ForProvider.Code.S3Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Code.S3BucketRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.code.imageRepositoryUri
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Code.ImageRepositoryURI),
+		Reference:    mg.Spec.ForProvider.Code.ImageRepositoryURIRef,
+		Selector:     mg.Spec.ForProvider.Code.ImageRepositoryURISelector,
+		To:           reference.To{Managed: &ecr.Repository{}, List: &ecr.RepositoryList{}},
+		Extract:      ecr.RepositoryURI(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.code.imageRepositoryUri")
+	}
+	mg.Spec.ForProvider.Code.ImageRepositoryURI = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.Code.ImageRepositoryURIRef = rsp.ResolvedReference
+
+	if mg.Spec.ForProvider.VPCConfig == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.vpcConfig.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCConfig.SubnetIDs,
+		References:    mg.Spec.ForProvider.VPCConfig.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCConfig.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.subnetIds")
+	}
+	mg.Spec.ForProvider.VPCConfig.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCConfig.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcConfig.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.VPCConfig.SecurityGroupIDs,
+		References:    mg.Spec.ForProvider.VPCConfig.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.VPCConfig.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.securityGroupIds")
+	}
+	mg.Spec.ForProvider.VPCConfig.SecurityGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.VPCConfig.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Alias
+func (mg *Alias) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Permission
+func (mg *Permission) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this EventSourceMapping
+func (mg *EventSourceMapping) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &Function{}, List: &FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventSourceArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventSourceARN),
+		Reference:    mg.Spec.ForProvider.EventSourceARNRef,
+		Selector:     mg.Spec.ForProvider.EventSourceARNSelector,
+		To:           reference.To{Managed: &sqs.Queue{}, List: &sqs.QueueList{}},
+		Extract:      sqs.QueueARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventSourceArn")
+	}
+	mg.Spec.ForProvider.EventSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EventSourceARNRef = rsp.ResolvedReference
+
+	return nil
+}
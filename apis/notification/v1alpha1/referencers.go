@@ -23,8 +23,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 )
 
+// SNSTopicARN returns a function that returns the ARN of the given SNS Topic.
+func SNSTopicARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*SNSTopic)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
 // ResolveReferences for SNS Subscription managed type
 func (mg *SNSSubscription) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -46,3 +60,24 @@ func (mg *SNSSubscription) ResolveReferences(ctx context.Context, c client.Reade
 
 	return nil
 }
+
+// ResolveReferences for SNS Topic managed type
+func (mg *SNSTopic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.kmsMasterKeyId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSMasterKeyID),
+		Reference:    mg.Spec.ForProvider.KMSMasterKeyIDRef,
+		Selector:     mg.Spec.ForProvider.KMSMasterKeyIDSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      kmsv1alpha1.KeyARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsMasterKeyId")
+	}
+	mg.Spec.ForProvider.KMSMasterKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KMSMasterKeyIDRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -66,6 +66,15 @@ type SNSTopicParameters struct {
 	// +optional
 	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
 
+	// KMSMasterKeyIDRef is a reference to a Key used to set KMSMasterKeyID.
+	// +optional
+	KMSMasterKeyIDRef *xpv1.Reference `json:"kmsMasterKeyIdRef,omitempty"`
+
+	// KMSMasterKeyIDSelector selects a reference to a Key used to set
+	// KMSMasterKeyID.
+	// +optional
+	KMSMasterKeyIDSelector *xpv1.Selector `json:"kmsMasterKeyIdSelector,omitempty"`
+
 	// The policy that defines who can access your topic. By default,
 	// only the topic owner can publish or subscribe to the topic.
 	// +optional
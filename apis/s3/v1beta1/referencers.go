@@ -23,6 +23,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
 )
 
@@ -37,6 +38,17 @@ func SNSTopicARN() reference.ExtractValueFn {
 	}
 }
 
+// BucketARN returns a function that returns the ARN of the given Bucket.
+func BucketARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Bucket)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.ARN
+	}
+}
+
 // ResolveReferences of this Bucket
 func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
 	r := reference.NewAPIResolver(c, mg)
@@ -110,5 +122,23 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 		}
 	}
 
+	// Resolve spec.forProvider.serverSideEncryptionConfiguration.rules[*].applyServerSideEncryptionByDefault.kmsMasterKeyId
+	if mg.Spec.ForProvider.ServerSideEncryptionConfiguration != nil {
+		for i, v := range mg.Spec.ForProvider.ServerSideEncryptionConfiguration.Rules {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(v.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+				Reference:    v.ApplyServerSideEncryptionByDefault.KMSMasterKeyIDRef,
+				Selector:     v.ApplyServerSideEncryptionByDefault.KMSMasterKeyIDSelector,
+				To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+				Extract:      kmsv1alpha1.KeyARN(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.serverSideEncryptionConfiguration.rules[%d].applyServerSideEncryptionByDefault.kmsMasterKeyId", i)
+			}
+			mg.Spec.ForProvider.ServerSideEncryptionConfiguration.Rules[i].ApplyServerSideEncryptionByDefault.KMSMasterKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.ServerSideEncryptionConfiguration.Rules[i].ApplyServerSideEncryptionByDefault.KMSMasterKeyIDRef = rsp.ResolvedReference
+		}
+	}
+
 	return nil
 }
@@ -16,6 +16,10 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
 // ServerSideEncryptionConfiguration specifies the default server-side-encryption configuration.
 type ServerSideEncryptionConfiguration struct {
 	// Container for information about a particular server-side encryption configuration
@@ -56,6 +60,15 @@ type ServerSideEncryptionByDefault struct {
 	// +optional
 	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
 
+	// KMSMasterKeyIDRef is a reference to a Key used to set KMSMasterKeyID.
+	// +optional
+	KMSMasterKeyIDRef *xpv1.Reference `json:"kmsMasterKeyIdRef,omitempty"`
+
+	// KMSMasterKeyIDSelector selects a reference to a Key used to set
+	// KMSMasterKeyID.
+	// +optional
+	KMSMasterKeyIDSelector *xpv1.Selector `json:"kmsMasterKeyIdSelector,omitempty"`
+
 	// NOTE(muvaf): aws:kms is not accepted by kubebuilder enum.
 
 	// Server-side encryption algorithm to use for the default encryption.
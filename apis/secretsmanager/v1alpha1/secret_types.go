@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RotationRules configure the automatic rotation of a secret.
+type RotationRules struct {
+	// The number of days between automatic scheduled rotations of the secret.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	AutomaticallyAfterDays int64 `json:"automaticallyAfterDays"`
+}
+
+// SecretParameters define the desired state of an AWS Secrets Manager secret.
+type SecretParameters struct {
+	// Region is the region you'd like your Secret to be created in.
+	Region string `json:"region"`
+
+	// A description of the secret.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The ARN or alias of the KMS key used to encrypt the secret. If you do
+	// not specify one, Secrets Manager uses the account's default key
+	// aws/secretsmanager.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a Key used to set KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a Key used to set KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// StringSecretRef references a key of a Kubernetes Secret whose value is
+	// stored as the value of the secret. Whenever the value changes a new
+	// version of the secret is created. Only one of StringSecretRef and
+	// SecretRef may be given.
+	// +optional
+	StringSecretRef *xpv1.SecretKeySelector `json:"stringSecretRef,omitempty"`
+
+	// SecretRef references a Kubernetes Secret whose data is stored as a JSON
+	// object of key value pairs as the value of the secret, e.g. the
+	// connection secret of an RDSInstance. Whenever the data changes a new
+	// version of the secret is created. Only one of StringSecretRef and
+	// SecretRef may be given.
+	// +optional
+	SecretRef *xpv1.SecretReference `json:"secretRef,omitempty"`
+
+	// The ARN of a Lambda function that rotates the secret. Once rotation is
+	// configured the value of the secret is owned by the rotation function and
+	// the referenced Kubernetes Secret only provides its initial value.
+	// +optional
+	RotationLambdaARN *string `json:"rotationLambdaArn,omitempty"`
+
+	// RotationLambdaARNRef is a reference to a Function used to set
+	// RotationLambdaARN.
+	// +optional
+	RotationLambdaARNRef *xpv1.Reference `json:"rotationLambdaArnRef,omitempty"`
+
+	// RotationLambdaARNSelector selects a reference to a Function used to set
+	// RotationLambdaARN.
+	// +optional
+	RotationLambdaARNSelector *xpv1.Selector `json:"rotationLambdaArnSelector,omitempty"`
+
+	// The rotation schedule of the secret. It is only used if a rotation
+	// function is given.
+	// +optional
+	RotationRules *RotationRules `json:"rotationRules,omitempty"`
+
+	// The number of days that Secrets Manager waits before it deletes the
+	// secret once this resource is deleted. Defaults to 30 days.
+	// +optional
+	// +kubebuilder:validation:Minimum=7
+	// +kubebuilder:validation:Maximum=30
+	RecoveryWindowInDays *int64 `json:"recoveryWindowInDays,omitempty"`
+
+	// Specifies whether the secret is deleted without any recovery window
+	// once this resource is deleted.
+	// +optional
+	ForceDeleteWithoutRecovery *bool `json:"forceDeleteWithoutRecovery,omitempty"`
+
+	// Metadata tagging key value pairs.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A SecretSpec defines the desired state of a Secret.
+type SecretSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecretParameters `json:"forProvider"`
+}
+
+// SecretObservation keeps the state for the external resource.
+type SecretObservation struct {
+	// The Amazon Resource Name (ARN) of the secret.
+	ARN string `json:"arn,omitempty"`
+
+	// The ID of the version of the secret that is labeled AWSCURRENT.
+	VersionID string `json:"versionId,omitempty"`
+
+	// Specifies whether automatic rotation is enabled for the secret.
+	RotationEnabled bool `json:"rotationEnabled,omitempty"`
+
+	// The last date and time that the secret was modified.
+	LastChangedDate *metav1.Time `json:"lastChangedDate,omitempty"`
+
+	// The last date and time that the secret was rotated.
+	LastRotatedDate *metav1.Time `json:"lastRotatedDate,omitempty"`
+
+	// The date and time after which the secret is deleted. It is only present
+	// when the secret is scheduled for deletion.
+	DeletedDate *metav1.Time `json:"deletedDate,omitempty"`
+}
+
+// A SecretStatus represents the observed state of a Secret.
+type SecretStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecretObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Secret is a managed resource that represents an AWS Secrets Manager
+// secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.versionId"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Secret struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretSpec   `json:"spec"`
+	Status SecretStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretList contains a list of Secrets
+type SecretList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Secret `json:"items"`
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// QueuePolicyParameters define the desired state of an AWS QueuePolicy.
+type QueuePolicyParameters struct {
+	// Region is where the Queue referenced by this QueuePolicy resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// This is the current IAM policy version
+	Version string `json:"version"`
+
+	// This is the policy's optional identifier
+	// +immutable
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// This is the list of statement this policy applies
+	Statements []QueuePolicyStatement `json:"statements"`
+
+	// QueueURL is the URL of the queue this policy is attached to.
+	// +optional
+	// +immutable
+	QueueURL *string `json:"queueUrl,omitempty"`
+
+	// QueueURLRef references a Queue to retrieve its URL
+	// +optional
+	QueueURLRef *xpv1.Reference `json:"queueUrlRef,omitempty"`
+
+	// QueueURLSelector selects a reference to a Queue to retrieve its URL
+	// +optional
+	QueueURLSelector *xpv1.Selector `json:"queueUrlSelector,omitempty"`
+}
+
+// QueuePolicyStatement defines an individual statement within the
+// QueuePolicy
+type QueuePolicyStatement struct {
+	// Optional identifier for this statement, must be unique within the
+	// policy if provided.
+	// +optional
+	SID *string `json:"sid,omitempty"`
+
+	// The effect is required and specifies whether the statement results
+	// in an allow or an explicit deny. Valid values for Effect are Allow and Deny.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Used with the SQS policy to specify the principal that is allowed
+	// or denied access to the queue.
+	// +optional
+	Principal *QueuePrincipal `json:"principal,omitempty"`
+
+	// Used with the SQS policy to specify the users which are not included
+	// in this policy
+	// +optional
+	NotPrincipal *QueuePrincipal `json:"notPrincipal,omitempty"`
+
+	// Each element of the PolicyAction array describes the specific
+	// action or actions that will be allowed or denied with this PolicyStatement.
+	// +optional
+	Action []string `json:"action,omitempty"`
+
+	// Each element of the NotPolicyAction array will allow the property to match
+	// all but the listed actions.
+	// +optional
+	NotAction []string `json:"notAction,omitempty"`
+
+	// The ARNs of the resources this statement applies to. If neither
+	// Resource nor NotResource is given, the ARN of the queue this policy
+	// is attached to is used.
+	// +optional
+	Resource []string `json:"resource,omitempty"`
+
+	// This will explicitly match all resources except the ones
+	// specified in this array
+	// +optional
+	NotResource []string `json:"notResource,omitempty"`
+
+	// Condition specifies where conditions for policy are in effect.
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-api-permissions-reference.html
+	// +optional
+	Condition map[string]Condition `json:"condition,omitempty"`
+}
+
+// QueuePrincipal defines the principal users affected by
+// the QueuePolicyStatement
+// Please see the AWS IAM docs for more information
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html
+type QueuePrincipal struct {
+	// This flag indicates if the policy should be made available
+	// to all anonymous users.
+	// +optional
+	AllowAnon bool `json:"allowAnon,omitempty"`
+
+	// This list contains the all of the AWS IAM users which are affected
+	// by the policy statement.
+	// +optional
+	AWSPrincipals []AWSPrincipal `json:"awsPrincipals,omitempty"`
+
+	// This string contains the identifier for any federated web identity
+	// provider.
+	// +optional
+	Federated *string `json:"federated,omitempty"`
+
+	// Service define the services which can have access to this queue,
+	// e.g. sns.amazonaws.com or s3.amazonaws.com.
+	// +optional
+	Service []string `json:"service,omitempty"`
+}
+
+// AWSPrincipal wraps the potential values a policy
+// principal can take. Only one of the values should be set.
+type AWSPrincipal struct {
+	// IAMUserARN contains the ARN of an IAM user
+	// +optional
+	// +immutable
+	IAMUserARN *string `json:"iamUserArn,omitempty"`
+
+	// IAMUserARNRef contains the reference to an IAMUser
+	// +optional
+	IAMUserARNRef *xpv1.Reference `json:"iamUserArnRef,omitempty"`
+
+	// IAMUserARNSelector queries for an IAMUser to retrieve its ARN
+	// +optional
+	IAMUserARNSelector *xpv1.Selector `json:"iamUserArnSelector,omitempty"`
+
+	// AWSAccountID identifies an AWS account as the principal
+	// +optional
+	// +immutable
+	AWSAccountID *string `json:"awsAccountId,omitempty"`
+
+	// IAMRoleARN contains the ARN of an IAM role
+	// +optional
+	// +immutable
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef contains the reference to an IAMRole
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector queries for an IAM role to retrieve its ARN
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleArnSelector,omitempty"`
+}
+
+// Condition represents one condition inside of the set of conditions for
+// a queue policy
+type Condition struct {
+	// ConditionKey is the key condition being applied to the parent condition,
+	// e.g. aws:SourceArn.
+	ConditionKey string `json:"key"`
+
+	// ConditionStringValue is the expected string value of the key from the parent condition
+	// +optional
+	ConditionStringValue *string `json:"stringValue,omitempty"`
+
+	// SNSTopicARNRef references an SNSTopic to retrieve its ARN as the
+	// ConditionStringValue.
+	// +optional
+	SNSTopicARNRef *xpv1.Reference `json:"snsTopicArnRef,omitempty"`
+
+	// SNSTopicARNSelector selects a reference to an SNSTopic to retrieve its
+	// ARN as the ConditionStringValue.
+	// +optional
+	SNSTopicARNSelector *xpv1.Selector `json:"snsTopicArnSelector,omitempty"`
+
+	// BucketARNRef references a Bucket to retrieve its ARN as the
+	// ConditionStringValue.
+	// +optional
+	BucketARNRef *xpv1.Reference `json:"bucketArnRef,omitempty"`
+
+	// BucketARNSelector selects a reference to a Bucket to retrieve its ARN
+	// as the ConditionStringValue.
+	// +optional
+	BucketARNSelector *xpv1.Selector `json:"bucketArnSelector,omitempty"`
+
+	// ConditionDateValue is the expected string value of the key from the parent condition. The
+	// date value must be in ISO 8601 format. The time is always midnight UTC.
+	// +optional
+	ConditionDateValue *metav1.Time `json:"dateValue,omitempty"`
+
+	// ConditionNumericValue is the expected string value of the key from the parent condition
+	// +optional
+	ConditionNumericValue *int64 `json:"numericValue,omitempty"`
+
+	// ConditionBooleanValue is the expected boolean value of the key from the parent condition
+	// +optional
+	ConditionBooleanValue *bool `json:"booleanValue,omitempty"`
+}
+
+// A QueuePolicySpec defines the desired state of a QueuePolicy.
+type QueuePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       QueuePolicyParameters `json:"forProvider"`
+}
+
+// A QueuePolicyStatus represents the observed state of a QueuePolicy.
+type QueuePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A QueuePolicy is a managed resource that represents the access policy
+// of an AWS SQS Queue. It owns the Policy attribute of the queue, so the
+// policy field of the referenced Queue should be left empty.
+// +kubebuilder:printcolumn:name="QUEUEURL",type="string",JSONPath=".spec.forProvider.queueUrl"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type QueuePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QueuePolicySpec   `json:"spec"`
+	Status QueuePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueuePolicyList contains a list of QueuePolicies
+type QueuePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QueuePolicy `json:"items"`
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	identityv1alpha1 "github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	identityv1beta1 "github.com/crossplane/provider-aws/apis/identity/v1beta1"
+	notificationv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// ResolveReferences of this QueuePolicy
+func (mg *QueuePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.queueUrl
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.QueueURL),
+		Reference:    mg.Spec.ForProvider.QueueURLRef,
+		Selector:     mg.Spec.ForProvider.QueueURLSelector,
+		To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+		Extract:      sqsv1beta1.QueueURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.queueUrl")
+	}
+	mg.Spec.ForProvider.QueueURL = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.QueueURLRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.Statements {
+		statement := mg.Spec.ForProvider.Statements[i]
+		if err := ResolvePrincipal(ctx, r, statement.Principal, i); err != nil {
+			return err
+		}
+		if err := ResolvePrincipal(ctx, r, statement.NotPrincipal, i); err != nil {
+			return err
+		}
+		if err := ResolveConditions(ctx, r, statement.Condition, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolvePrincipal resolves all the IAMUser and IAMRole references in a QueuePrincipal
+func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *QueuePrincipal, statementIndex int) error {
+	if principal == nil {
+		return nil
+	}
+	for i := range principal.AWSPrincipals {
+		if principal.AWSPrincipals[i].IAMUserARNRef != nil || principal.AWSPrincipals[i].IAMUserARNSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(principal.AWSPrincipals[i].IAMUserARN),
+				Reference:    principal.AWSPrincipals[i].IAMUserARNRef,
+				Selector:     principal.AWSPrincipals[i].IAMUserARNSelector,
+				To:           reference.To{Managed: &identityv1alpha1.IAMUser{}, List: &identityv1alpha1.IAMUserList{}},
+				Extract:      identityv1alpha1.IAMUserARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.statements[%d].principal.awsPrincipals[%d].iamUserArn", statementIndex, i))
+			}
+			principal.AWSPrincipals[i].IAMUserARN = reference.ToPtrValue(rsp.ResolvedValue)
+			principal.AWSPrincipals[i].IAMUserARNRef = rsp.ResolvedReference
+		}
+
+		if principal.AWSPrincipals[i].IAMRoleARNRef != nil || principal.AWSPrincipals[i].IAMRoleARNSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(principal.AWSPrincipals[i].IAMRoleARN),
+				Reference:    principal.AWSPrincipals[i].IAMRoleARNRef,
+				Selector:     principal.AWSPrincipals[i].IAMRoleARNSelector,
+				To:           reference.To{Managed: &identityv1beta1.IAMRole{}, List: &identityv1beta1.IAMRoleList{}},
+				Extract:      identityv1beta1.IAMRoleARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.statements[%d].principal.awsPrincipals[%d].iamRoleArn", statementIndex, i))
+			}
+			principal.AWSPrincipals[i].IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+			principal.AWSPrincipals[i].IAMRoleARNRef = rsp.ResolvedReference
+		}
+	}
+	return nil
+}
+
+// ResolveConditions resolves the SNSTopic and Bucket references in the
+// conditions of a QueuePolicyStatement.
+func ResolveConditions(ctx context.Context, r *reference.APIResolver, conditions map[string]Condition, statementIndex int) error {
+	for k, c := range conditions {
+		if c.SNSTopicARNRef != nil || c.SNSTopicARNSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(c.ConditionStringValue),
+				Reference:    c.SNSTopicARNRef,
+				Selector:     c.SNSTopicARNSelector,
+				To:           reference.To{Managed: &notificationv1alpha1.SNSTopic{}, List: &notificationv1alpha1.SNSTopicList{}},
+				Extract:      notificationv1alpha1.SNSTopicARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.statements[%d].condition[%s].snsTopicArn", statementIndex, k))
+			}
+			c.ConditionStringValue = reference.ToPtrValue(rsp.ResolvedValue)
+			c.SNSTopicARNRef = rsp.ResolvedReference
+		}
+
+		if c.BucketARNRef != nil || c.BucketARNSelector != nil {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(c.ConditionStringValue),
+				Reference:    c.BucketARNRef,
+				Selector:     c.BucketARNSelector,
+				To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+				Extract:      s3v1beta1.BucketARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.statements[%d].condition[%s].bucketArn", statementIndex, k))
+			}
+			c.ConditionStringValue = reference.ToPtrValue(rsp.ResolvedValue)
+			c.BucketARNRef = rsp.ResolvedReference
+		}
+		conditions[k] = c
+	}
+	return nil
+}
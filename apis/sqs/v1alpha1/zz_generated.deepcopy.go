@@ -0,0 +1,348 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSPrincipal) DeepCopyInto(out *AWSPrincipal) {
+	*out = *in
+	if in.IAMUserARN != nil {
+		in, out := &in.IAMUserARN, &out.IAMUserARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMUserARNRef != nil {
+		in, out := &in.IAMUserARNRef, &out.IAMUserARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IAMUserARNSelector != nil {
+		in, out := &in.IAMUserARNSelector, &out.IAMUserARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSAccountID != nil {
+		in, out := &in.AWSAccountID, &out.AWSAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMRoleARN != nil {
+		in, out := &in.IAMRoleARN, &out.IAMRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMRoleARNRef != nil {
+		in, out := &in.IAMRoleARNRef, &out.IAMRoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.IAMRoleARNSelector != nil {
+		in, out := &in.IAMRoleARNSelector, &out.IAMRoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrincipal.
+func (in *AWSPrincipal) DeepCopy() *AWSPrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSPrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	if in.ConditionStringValue != nil {
+		in, out := &in.ConditionStringValue, &out.ConditionStringValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.SNSTopicARNRef != nil {
+		in, out := &in.SNSTopicARNRef, &out.SNSTopicARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SNSTopicARNSelector != nil {
+		in, out := &in.SNSTopicARNSelector, &out.SNSTopicARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketARNRef != nil {
+		in, out := &in.BucketARNRef, &out.BucketARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketARNSelector != nil {
+		in, out := &in.BucketARNSelector, &out.BucketARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConditionDateValue != nil {
+		in, out := &in.ConditionDateValue, &out.ConditionDateValue
+		*out = (*in).DeepCopy()
+	}
+	if in.ConditionNumericValue != nil {
+		in, out := &in.ConditionNumericValue, &out.ConditionNumericValue
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConditionBooleanValue != nil {
+		in, out := &in.ConditionBooleanValue, &out.ConditionBooleanValue
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicy) DeepCopyInto(out *QueuePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicy.
+func (in *QueuePolicy) DeepCopy() *QueuePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueuePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyList) DeepCopyInto(out *QueuePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]QueuePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyList.
+func (in *QueuePolicyList) DeepCopy() *QueuePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *QueuePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyParameters) DeepCopyInto(out *QueuePolicyParameters) {
+	*out = *in
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]QueuePolicyStatement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueueURL != nil {
+		in, out := &in.QueueURL, &out.QueueURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.QueueURLRef != nil {
+		in, out := &in.QueueURLRef, &out.QueueURLRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.QueueURLSelector != nil {
+		in, out := &in.QueueURLSelector, &out.QueueURLSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyParameters.
+func (in *QueuePolicyParameters) DeepCopy() *QueuePolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicySpec) DeepCopyInto(out *QueuePolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicySpec.
+func (in *QueuePolicySpec) DeepCopy() *QueuePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyStatement) DeepCopyInto(out *QueuePolicyStatement) {
+	*out = *in
+	if in.SID != nil {
+		in, out := &in.SID, &out.SID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
+		*out = new(QueuePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotPrincipal != nil {
+		in, out := &in.NotPrincipal, &out.NotPrincipal
+		*out = new(QueuePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotAction != nil {
+		in, out := &in.NotAction, &out.NotAction
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotResource != nil {
+		in, out := &in.NotResource, &out.NotResource
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = make(map[string]Condition, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyStatement.
+func (in *QueuePolicyStatement) DeepCopy() *QueuePolicyStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePolicyStatus) DeepCopyInto(out *QueuePolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePolicyStatus.
+func (in *QueuePolicyStatus) DeepCopy() *QueuePolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueuePrincipal) DeepCopyInto(out *QueuePrincipal) {
+	*out = *in
+	if in.AWSPrincipals != nil {
+		in, out := &in.AWSPrincipals, &out.AWSPrincipals
+		*out = make([]AWSPrincipal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Federated != nil {
+		in, out := &in.Federated, &out.Federated
+		*out = new(string)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueuePrincipal.
+func (in *QueuePrincipal) DeepCopy() *QueuePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(QueuePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -88,7 +88,8 @@ type QueueParameters struct {
 
 	// The queue's policy. A valid AWS policy. For more information
 	// about policy structure, see Overview of AWS IAM Policies (https://docs.aws.amazon.com/IAM/latest/UserGuide/PoliciesOverview.html)
-	// in the Amazon IAM User Guide.
+	// in the Amazon IAM User Guide. Leave this empty if the policy of the
+	// queue is managed by a QueuePolicy.
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
@@ -124,6 +125,15 @@ type QueueParameters struct {
 	// +optional
 	KMSMasterKeyID *string `json:"kmsMasterKeyId,omitempty"`
 
+	// KMSMasterKeyIDRef is a reference to a Key used to set KMSMasterKeyID.
+	// +optional
+	KMSMasterKeyIDRef *xpv1.Reference `json:"kmsMasterKeyIdRef,omitempty"`
+
+	// KMSMasterKeyIDSelector selects a reference to a Key used to set
+	// KMSMasterKeyID.
+	// +optional
+	KMSMasterKeyIDSelector *xpv1.Selector `json:"kmsMasterKeyIdSelector,omitempty"`
+
 	// KMSDataKeyReusePeriodSeconds - The length of time, in seconds, for which
 	// Amazon SQS can reuse a data key (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#data-keys)
 	// to encrypt or decrypt messages before calling AWS KMS again. An integer
@@ -9,6 +9,8 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 )
 
 // QueueARN returns ARN of the Queue resource.
@@ -22,6 +24,17 @@ func QueueARN() reference.ExtractValueFn {
 	}
 }
 
+// QueueURL returns URL of the Queue resource.
+func QueueURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*Queue)
+		if !ok {
+			return ""
+		}
+		return cr.Status.AtProvider.URL
+	}
+}
+
 // ResolveReferences of this Queue
 func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -41,5 +54,20 @@ func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN = aws.String(rsp.ResolvedValue)
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef = rsp.ResolvedReference
 	}
+
+	// Resolve spec.forProvider.kmsMasterKeyId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSMasterKeyID),
+		Reference:    mg.Spec.ForProvider.KMSMasterKeyIDRef,
+		Selector:     mg.Spec.ForProvider.KMSMasterKeyIDSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      kmsv1alpha1.KeyARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsMasterKeyId")
+	}
+	mg.Spec.ForProvider.KMSMasterKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KMSMasterKeyIDRef = rsp.ResolvedReference
+
 	return nil
 }
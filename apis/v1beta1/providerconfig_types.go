@@ -25,17 +25,137 @@ import (
 // A ProviderConfigSpec defines the desired state of a ProviderConfig.
 type ProviderConfigSpec struct {
 	xpv1.ProviderConfigSpec `json:",inline"`
+
+	// AssumeRoleChain is the list of IAM roles that will be assumed, in
+	// order, using the credentials configured in this ProviderConfig. Each
+	// role is assumed with the credentials obtained from the previous one,
+	// which allows reaching roles in other AWS accounts.
+	// +optional
+	AssumeRoleChain []AssumeRoleOptions `json:"assumeRoleChain,omitempty"`
+
+	// Endpoint configures the AWS API endpoints that requests are sent to.
+	// It can be used to target the endpoints of another partition, VPC
+	// endpoints or AWS-compatible APIs like LocalStack.
+	// +optional
+	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+}
+
+// EndpointConfig configures the AWS API endpoints that requests are sent to.
+type EndpointConfig struct {
+	// URL is the endpoint that requests to all services without an override
+	// in Services are sent to, e.g. http://localstack:4566.
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// SigningRegion is the region that is used to sign the requests sent to
+	// URL and to the service endpoint overrides. Defaults to the region of
+	// the managed resource.
+	// +optional
+	SigningRegion *string `json:"signingRegion,omitempty"`
+
+	// Services is the list of per-service endpoint overrides.
+	// +optional
+	Services []ServiceEndpoint `json:"services,omitempty"`
+
+	// Partition is the ID of the AWS partition whose endpoints are used. It's
+	// derived from the region of the managed resource by default.
+	// +kubebuilder:validation:Enum=aws;aws-cn;aws-us-gov;aws-iso;aws-iso-b
+	// +optional
+	Partition *string `json:"partition,omitempty"`
+
+	// UseFIPSEndpoint selects the FIPS 140-2 validated endpoints of the
+	// services.
+	// +optional
+	UseFIPSEndpoint *bool `json:"useFIPSEndpoint,omitempty"`
+
+	// UseDualStackEndpoint selects the endpoints of the services that
+	// support both IPv4 and IPv6.
+	// +optional
+	UseDualStackEndpoint *bool `json:"useDualStackEndpoint,omitempty"`
+
+	// S3UsePathStyle makes S3 clients use path-style addressing, i.e.
+	// http://s3.amazonaws.com/BUCKET/KEY, instead of virtual hosted-style
+	// addressing. It's usually required by S3-compatible APIs.
+	// +optional
+	S3UsePathStyle *bool `json:"s3UsePathStyle,omitempty"`
+}
+
+// ServiceEndpoint overrides the endpoint of a single AWS service.
+type ServiceEndpoint struct {
+	// ServiceID is the endpoint ID of the service, e.g. s3, ec2, sts,
+	// elasticloadbalancing or rds.
+	ServiceID string `json:"serviceID"`
+
+	// URL of the service endpoint.
+	URL string `json:"url"`
+
+	// SigningRegion is the region that is used to sign the requests sent to
+	// this endpoint. Overrides the SigningRegion of the endpoint
+	// configuration.
+	// +optional
+	SigningRegion *string `json:"signingRegion,omitempty"`
+}
+
+// AssumeRoleOptions define the options for assuming an IAM Role.
+type AssumeRoleOptions struct {
+	// RoleARN is the Amazon Resource Name (ARN) of the IAM Role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is the external ID used when assuming the role. It's
+	// typically required by roles in third party accounts.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// SessionName is the identifier of the assumed role session. A random
+	// name is generated if not given.
+	// +optional
+	SessionName *string `json:"sessionName,omitempty"`
+
+	// Duration of the role session. Credentials are refreshed automatically
+	// before they expire. Defaults to 15 minutes.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Tags is the list of session tags that are passed to the role session.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// TransitiveTagKeys is the list of session tag keys that persist through
+	// the following roles in the chain.
+	// +optional
+	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+}
+
+// Tag is a key-value pair that is passed as a session tag.
+type Tag struct {
+	// Key of the session tag.
+	Key string `json:"key"`
+
+	// Value of the session tag.
+	Value string `json:"value"`
 }
 
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// AccountID is the ID of the AWS account that the credentials of this
+	// ProviderConfig belong to.
+	// +optional
+	AccountID *string `json:"accountID,omitempty"`
+
+	// ARN is the Amazon Resource Name (ARN) of the identity that the
+	// credentials of this ProviderConfig belong to.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures how AWS controllers will connect to AWS API.
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".status.accountID"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
 // +kubebuilder:subresource:status
@@ -39,4 +39,5 @@ require (
 	k8s.io/client-go v0.18.8
 	sigs.k8s.io/controller-runtime v0.6.2
 	sigs.k8s.io/controller-tools v0.4.0
+	sigs.k8s.io/yaml v1.2.0
 )
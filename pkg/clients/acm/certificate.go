@@ -1,16 +1,42 @@
 package acm
 
 import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// validationRecordTTL is the TTL of the DNS records that are created to
+// validate certificates.
+const validationRecordTTL = 300
+
+// caCertKey is the key of the CA certificate in a TLS Secret written by
+// cert-manager.
+const caCertKey = "ca.crt"
+
+const (
+	errGetCertificateSecret = "cannot get certificate secret"
+	errNoCertificate        = "no PEM encoded certificate in certificate secret"
+	errNoPrivateKey         = "no private key in certificate secret"
+	errParseCertificate     = "cannot parse certificate in certificate secret"
+)
+
 // Client defines the CertificateManager operations
 type Client interface {
 	// GetCertificateRequest(*acm.GetCertificateInput) acm.GetCertificateRequest
@@ -22,6 +48,8 @@ type Client interface {
 	AddTagsToCertificateRequest(*acm.AddTagsToCertificateInput) acm.AddTagsToCertificateRequest
 	RenewCertificateRequest(*acm.RenewCertificateInput) acm.RenewCertificateRequest
 	RemoveTagsFromCertificateRequest(*acm.RemoveTagsFromCertificateInput) acm.RemoveTagsFromCertificateRequest
+	ImportCertificateRequest(*acm.ImportCertificateInput) acm.ImportCertificateRequest
+	ListCertificatesRequest(*acm.ListCertificatesInput) acm.ListCertificatesRequest
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -74,12 +102,76 @@ func GenerateCreateCertificateInput(name string, p *v1alpha1.CertificateParamete
 
 // GenerateCertificateStatus is used to produce CertificateExternalStatus from acm.certificateStatus
 func GenerateCertificateStatus(certificate acm.CertificateDetail) v1alpha1.CertificateExternalStatus {
-	return v1alpha1.CertificateExternalStatus{
+	s := v1alpha1.CertificateExternalStatus{
 		CertificateARN:     aws.StringValue(certificate.CertificateArn),
 		RenewalEligibility: certificate.RenewalEligibility,
 		Status:             certificate.Status,
 		Type:               certificate.Type,
+		Serial:             aws.StringValue(certificate.Serial),
+	}
+	for _, dv := range certificate.DomainValidationOptions {
+		v := v1alpha1.DomainValidation{
+			DomainName:       aws.StringValue(dv.DomainName),
+			ValidationStatus: string(dv.ValidationStatus),
+		}
+		if dv.ResourceRecord != nil {
+			v.ResourceRecord = &v1alpha1.ValidationResourceRecord{
+				Name:  aws.StringValue(dv.ResourceRecord.Name),
+				Type:  string(dv.ResourceRecord.Type),
+				Value: aws.StringValue(dv.ResourceRecord.Value),
+			}
+		}
+		s.DomainValidations = append(s.DomainValidations, v)
+	}
+	return s
+}
+
+// GetValidationRecords returns the distinct DNS records that validate the
+// domains of a certificate. A domain and its wildcard are validated by the
+// same record.
+func GetValidationRecords(s v1alpha1.CertificateExternalStatus) []v1alpha1.ValidationResourceRecord {
+	var records []v1alpha1.ValidationResourceRecord
+	seen := map[string]bool{}
+	for _, dv := range s.DomainValidations {
+		if dv.ResourceRecord == nil || seen[dv.ResourceRecord.Name] {
+			continue
+		}
+		seen[dv.ResourceRecord.Name] = true
+		records = append(records, *dv.ResourceRecord)
 	}
+	return records
+}
+
+// GenerateValidationRecordParameters returns the parameters of the Route53
+// record set that satisfies the supplied validation record.
+func GenerateValidationRecordParameters(zoneID string, r v1alpha1.ValidationResourceRecord) route53v1alpha1.ResourceRecordSetParameters {
+	return route53v1alpha1.ResourceRecordSetParameters{
+		Type:            r.Type,
+		TTL:             aws.Int64(validationRecordTTL),
+		ResourceRecords: []route53v1alpha1.ResourceRecord{{Value: r.Value}},
+		ZoneID:          aws.String(zoneID),
+	}
+}
+
+// GenerateValidationCondition returns the DomainValidated condition that
+// matches the validation state of the domains of a certificate.
+func GenerateValidationCondition(s v1alpha1.CertificateExternalStatus) xpv1.Condition {
+	if s.Status == acm.CertificateStatusValidationTimedOut {
+		return v1alpha1.ValidationFailed("validation of the certificate timed out")
+	}
+	if len(s.DomainValidations) == 0 {
+		return v1alpha1.ValidationPending()
+	}
+	for _, dv := range s.DomainValidations {
+		switch acm.DomainStatus(dv.ValidationStatus) { // nolint:exhaustive
+		case acm.DomainStatusFailed:
+			return v1alpha1.ValidationFailed("validation of domain " + dv.DomainName + " failed")
+		case acm.DomainStatusSuccess:
+		default:
+			return v1alpha1.ValidationPending()
+		}
+	}
+	return v1alpha1.ValidationSucceeded()
 }
 
 // LateInitializeCertificate fills the empty fields in *v1beta1.CertificateParameters with
@@ -89,6 +181,12 @@ func LateInitializeCertificate(in *v1alpha1.CertificateParameters, certificate *
 		return
 	}
 
+	// The domains of an imported certificate are given by the certificate in
+	// the Secret and may change with every import.
+	if in.CertificateSecretRef != nil {
+		return
+	}
+
 	in.DomainName = awsclients.LateInitializeString(in.DomainName, certificate.DomainName)
 
 	if aws.StringValue(in.CertificateAuthorityARN) == "" && certificate.CertificateAuthorityArn != nil {
@@ -125,7 +223,8 @@ func LateInitializeCertificate(in *v1alpha1.CertificateParameters, certificate *
 // IsCertificateUpToDate checks whether there is a change in any of the modifiable fields.
 func IsCertificateUpToDate(p v1alpha1.CertificateParameters, cd acm.CertificateDetail, tags []acm.Tag) bool { // nolint:gocyclo
 
-	if *p.CertificateTransparencyLoggingPreference != cd.Options.CertificateTransparencyLoggingPreference {
+	if p.CertificateSecretRef == nil && p.CertificateTransparencyLoggingPreference != nil && cd.Options != nil &&
+		*p.CertificateTransparencyLoggingPreference != cd.Options.CertificateTransparencyLoggingPreference {
 		return false
 	}
 
@@ -147,6 +246,95 @@ func IsCertificateUpToDate(p v1alpha1.CertificateParameters, cd acm.CertificateD
 	return !aws.BoolValue(p.RenewCertificate)
 }
 
+// ImportedCertificate is the PEM encoded content of a Kubernetes TLS Secret
+// that is imported into ACM.
+type ImportedCertificate struct {
+	Certificate      []byte
+	PrivateKey       []byte
+	CertificateChain []byte
+
+	// Serial is the serial number of the certificate in hexadecimal.
+	Serial string
+}
+
+// GetImportedCertificate returns the certificate to import from the
+// referenced Kubernetes TLS Secret.
+func GetImportedCertificate(ctx context.Context, kube client.Client, ref xpv1.SecretReference) (*ImportedCertificate, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrap(err, errGetCertificateSecret)
+	}
+	return ParseImportedCertificate(s.Data)
+}
+
+// ParseImportedCertificate returns the certificate to import from the data
+// of a Kubernetes TLS Secret. The first certificate in tls.crt is the one to
+// import and any that follow it make up its chain. If there are none, the
+// chain is taken from ca.crt.
+func ParseImportedCertificate(data map[string][]byte) (*ImportedCertificate, error) {
+	b, rest := pem.Decode(data[corev1.TLSCertKey])
+	if b == nil || b.Type != "CERTIFICATE" {
+		return nil, errors.New(errNoCertificate)
+	}
+	c, err := x509.ParseCertificate(b.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseCertificate)
+	}
+	if len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return nil, errors.New(errNoPrivateKey)
+	}
+	ic := &ImportedCertificate{
+		Certificate: pem.EncodeToMemory(b),
+		PrivateKey:  data[corev1.TLSPrivateKeyKey],
+		Serial:      c.SerialNumber.Text(16),
+	}
+	chain := bytes.TrimSpace(rest)
+	if len(chain) == 0 {
+		chain = bytes.TrimSpace(data[caCertKey])
+	}
+	if len(chain) != 0 {
+		ic.CertificateChain = chain
+	}
+	return ic, nil
+}
+
+// GenerateImportCertificateInput returns the input to import the given
+// certificate. The certificate is re-imported if an ARN is given. Tags can
+// only be set on the first import.
+func GenerateImportCertificateInput(arn string, ic *ImportedCertificate, p *v1alpha1.CertificateParameters) *acm.ImportCertificateInput {
+	m := &acm.ImportCertificateInput{
+		Certificate:      ic.Certificate,
+		PrivateKey:       ic.PrivateKey,
+		CertificateChain: ic.CertificateChain,
+	}
+	if arn != "" {
+		m.CertificateArn = aws.String(arn)
+		return m
+	}
+	if len(p.Tags) != 0 {
+		m.Tags = make([]acm.Tag, len(p.Tags))
+		for i, val := range p.Tags {
+			m.Tags[i] = acm.Tag{
+				Key:   aws.String(val.Key),
+				Value: aws.String(val.Value),
+			}
+		}
+	}
+	return m
+}
+
+// IsImportedCertificateUpToDate checks whether the certificate in ACM is the
+// one to import.
+func IsImportedCertificateUpToDate(ic *ImportedCertificate, s v1alpha1.CertificateExternalStatus) bool {
+	return normalizeSerial(ic.Serial) == normalizeSerial(s.Serial)
+}
+
+// normalizeSerial strips the separators and leading zeros ACM may add to
+// the hexadecimal serial number of a certificate.
+func normalizeSerial(s string) string {
+	return strings.TrimLeft(strings.ToLower(strings.ReplaceAll(s, ":", "")), "0")
+}
+
 // IsErrorNotFound returns true if the error code indicates that the item was not found
 func IsErrorNotFound(err error) bool {
 	if acmErr, ok := err.(awserr.Error); ok && acmErr.Code() == acm.ErrCodeResourceNotFoundException {
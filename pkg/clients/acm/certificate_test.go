@@ -1,10 +1,24 @@
 package acm
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
@@ -161,6 +175,36 @@ func TestGenerateCertificateStatus(t *testing.T) {
 				RenewalEligibility: acm.RenewalEligibilityEligible,
 			},
 		},
+		"DomainValidations": {
+			in: acm.CertificateDetail{
+				CertificateArn: aws.String(certificateArn),
+				DomainValidationOptions: []acm.DomainValidation{
+					{
+						DomainName:       aws.String(domainName),
+						ValidationStatus: acm.DomainStatusPendingValidation,
+						ResourceRecord:   &acm.ResourceRecord{Name: aws.String("_x1.somedomain."), Type: acm.RecordTypeCname, Value: aws.String("_x2.acm-validations.aws.")},
+					},
+					{
+						DomainName:       aws.String("other"),
+						ValidationStatus: acm.DomainStatusPendingValidation,
+					},
+				},
+			},
+			out: v1alpha1.CertificateExternalStatus{
+				CertificateARN: certificateArn,
+				DomainValidations: []v1alpha1.DomainValidation{
+					{
+						DomainName:       domainName,
+						ValidationStatus: string(acm.DomainStatusPendingValidation),
+						ResourceRecord:   &v1alpha1.ValidationResourceRecord{Name: "_x1.somedomain.", Type: "CNAME", Value: "_x2.acm-validations.aws."},
+					},
+					{
+						DomainName:       "other",
+						ValidationStatus: string(acm.DomainStatusPendingValidation),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -236,3 +280,268 @@ func TestIsCertificateUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetValidationRecords(t *testing.T) {
+	record := v1alpha1.ValidationResourceRecord{Name: "_x1.somedomain.", Type: "CNAME", Value: "_x2.acm-validations.aws."}
+
+	cases := map[string]struct {
+		in  v1alpha1.CertificateExternalStatus
+		out []v1alpha1.ValidationResourceRecord
+	}{
+		"SharedRecord": {
+			in: v1alpha1.CertificateExternalStatus{
+				DomainValidations: []v1alpha1.DomainValidation{
+					{DomainName: domainName, ResourceRecord: &record},
+					{DomainName: "*." + domainName, ResourceRecord: &record},
+				},
+			},
+			out: []v1alpha1.ValidationResourceRecord{record},
+		},
+		"NoRecordYet": {
+			in: v1alpha1.CertificateExternalStatus{
+				DomainValidations: []v1alpha1.DomainValidation{{DomainName: domainName}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GetValidationRecords(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GetValidationRecords(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateValidationCondition(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.CertificateExternalStatus
+		out xpv1.Condition
+	}{
+		"Succeeded": {
+			in: v1alpha1.CertificateExternalStatus{
+				Status: acm.CertificateStatusIssued,
+				DomainValidations: []v1alpha1.DomainValidation{
+					{DomainName: domainName, ValidationStatus: string(acm.DomainStatusSuccess)},
+				},
+			},
+			out: v1alpha1.ValidationSucceeded(),
+		},
+		"Pending": {
+			in: v1alpha1.CertificateExternalStatus{
+				Status: acm.CertificateStatusPendingValidation,
+				DomainValidations: []v1alpha1.DomainValidation{
+					{DomainName: domainName, ValidationStatus: string(acm.DomainStatusSuccess)},
+					{DomainName: "other", ValidationStatus: string(acm.DomainStatusPendingValidation)},
+				},
+			},
+			out: v1alpha1.ValidationPending(),
+		},
+		"NoValidationsYet": {
+			in:  v1alpha1.CertificateExternalStatus{Status: acm.CertificateStatusPendingValidation},
+			out: v1alpha1.ValidationPending(),
+		},
+		"DomainFailed": {
+			in: v1alpha1.CertificateExternalStatus{
+				Status: acm.CertificateStatusFailed,
+				DomainValidations: []v1alpha1.DomainValidation{
+					{DomainName: domainName, ValidationStatus: string(acm.DomainStatusFailed)},
+				},
+			},
+			out: v1alpha1.ValidationFailed("validation of domain somedomain failed"),
+		},
+		"TimedOut": {
+			in:  v1alpha1.CertificateExternalStatus{Status: acm.CertificateStatusValidationTimedOut},
+			out: v1alpha1.ValidationFailed("validation of the certificate timed out"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateValidationCondition(tc.in)
+			if diff := cmp.Diff(tc.out, r, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("GenerateValidationCondition(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func pemCertificate(t *testing.T, serial int64) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: domainName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestParseImportedCertificate(t *testing.T) {
+	leaf := pemCertificate(t, 0x1a2b)
+	intermediate := pemCertificate(t, 2)
+	ca := pemCertificate(t, 1)
+	key := []byte("somekey")
+
+	type want struct {
+		ic  *ImportedCertificate
+		err error
+	}
+
+	cases := map[string]struct {
+		data map[string][]byte
+		want want
+	}{
+		"ChainInCertificate": {
+			data: map[string][]byte{
+				corev1.TLSCertKey:       append(append([]byte{}, leaf...), intermediate...),
+				corev1.TLSPrivateKeyKey: key,
+				caCertKey:               ca,
+			},
+			want: want{
+				ic: &ImportedCertificate{
+					Certificate:      leaf,
+					PrivateKey:       key,
+					CertificateChain: intermediate[:len(intermediate)-1],
+					Serial:           "1a2b",
+				},
+			},
+		},
+		"ChainFromCA": {
+			data: map[string][]byte{
+				corev1.TLSCertKey:       leaf,
+				corev1.TLSPrivateKeyKey: key,
+				caCertKey:               ca,
+			},
+			want: want{
+				ic: &ImportedCertificate{
+					Certificate:      leaf,
+					PrivateKey:       key,
+					CertificateChain: ca[:len(ca)-1],
+					Serial:           "1a2b",
+				},
+			},
+		},
+		"NoChain": {
+			data: map[string][]byte{
+				corev1.TLSCertKey:       leaf,
+				corev1.TLSPrivateKeyKey: key,
+			},
+			want: want{
+				ic: &ImportedCertificate{
+					Certificate: leaf,
+					PrivateKey:  key,
+					Serial:      "1a2b",
+				},
+			},
+		},
+		"NoCertificate": {
+			data: map[string][]byte{
+				corev1.TLSPrivateKeyKey: key,
+			},
+			want: want{
+				err: errors.New(errNoCertificate),
+			},
+		},
+		"NoPrivateKey": {
+			data: map[string][]byte{
+				corev1.TLSCertKey: leaf,
+			},
+			want: want{
+				err: errors.New(errNoPrivateKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseImportedCertificate(tc.data)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ic, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateImportCertificateInput(t *testing.T) {
+	ic := &ImportedCertificate{
+		Certificate:      []byte("somecertificate"),
+		PrivateKey:       []byte("somekey"),
+		CertificateChain: []byte("somechain"),
+	}
+	p := &v1alpha1.CertificateParameters{
+		Tags: []v1alpha1.Tag{{Key: "key1", Value: "value1"}},
+	}
+
+	cases := map[string]struct {
+		arn  string
+		want *acm.ImportCertificateInput
+	}{
+		"Import": {
+			want: &acm.ImportCertificateInput{
+				Certificate:      ic.Certificate,
+				PrivateKey:       ic.PrivateKey,
+				CertificateChain: ic.CertificateChain,
+				Tags:             []acm.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+			},
+		},
+		"Reimport": {
+			arn: certificateArn,
+			want: &acm.ImportCertificateInput{
+				CertificateArn:   aws.String(certificateArn),
+				Certificate:      ic.Certificate,
+				PrivateKey:       ic.PrivateKey,
+				CertificateChain: ic.CertificateChain,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateImportCertificateInput(tc.arn, ic, p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsImportedCertificateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		serial string
+		s      v1alpha1.CertificateExternalStatus
+		want   bool
+	}{
+		"SameSerial": {
+			serial: "1a2b",
+			s:      v1alpha1.CertificateExternalStatus{Serial: "00:1a:2b"},
+			want:   true,
+		},
+		"DifferentSerial": {
+			serial: "1a2b",
+			s:      v1alpha1.CertificateExternalStatus{Serial: "1a:2c"},
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsImportedCertificateUpToDate(&ImportedCertificate{Serial: tc.serial}, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
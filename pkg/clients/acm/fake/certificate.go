@@ -35,6 +35,8 @@ type MockCertificateClient struct {
 	MockListTagsForCertificateRequest    func(*acm.ListTagsForCertificateInput) acm.ListTagsForCertificateRequest
 	MockRenewCertificateRequest          func(*acm.RenewCertificateInput) acm.RenewCertificateRequest
 	MockRemoveTagsFromCertificateRequest func(*acm.RemoveTagsFromCertificateInput) acm.RemoveTagsFromCertificateRequest
+	MockImportCertificateRequest         func(*acm.ImportCertificateInput) acm.ImportCertificateRequest
+	MockListCertificatesRequest          func(*acm.ListCertificatesInput) acm.ListCertificatesRequest
 }
 
 // DescribeCertificateRequest mocks DescribeCertificateRequest method
@@ -76,3 +78,13 @@ func (m *MockCertificateClient) RemoveTagsFromCertificateRequest(input *acm.Remo
 func (m *MockCertificateClient) AddTagsToCertificateRequest(input *acm.AddTagsToCertificateInput) acm.AddTagsToCertificateRequest {
 	return m.MockAddTagsToCertificateRequest(input)
 }
+
+// ImportCertificateRequest mocks ImportCertificateRequest method
+func (m *MockCertificateClient) ImportCertificateRequest(input *acm.ImportCertificateInput) acm.ImportCertificateRequest {
+	return m.MockImportCertificateRequest(input)
+}
+
+// ListCertificatesRequest mocks ListCertificatesRequest method
+func (m *MockCertificateClient) ListCertificatesRequest(input *acm.ListCertificatesInput) acm.ListCertificatesRequest {
+	return m.MockListCertificatesRequest(input)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmpca
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+)
+
+const (
+	// ConnectionDetailsPrivateKeyKey is the key of the PEM encoded private
+	// key of a generated certificate signing request in the connection
+	// secret.
+	ConnectionDetailsPrivateKeyKey = "privateKey"
+
+	// ConnectionDetailsPendingPrivateKeyKey is the key of the PEM encoded
+	// private key of a certificate that has been requested but not yet
+	// published in the connection secret.
+	ConnectionDetailsPendingPrivateKeyKey = "pendingPrivateKey"
+
+	// DefaultRenewBeforeInDays is the number of days before expiry that a
+	// certificate is re-issued when none is given.
+	DefaultRenewBeforeInDays = 30
+
+	errGetCSRSecret  = "cannot get certificate signing request secret"
+	errEmptyCSR      = "certificate signing request secret key is empty"
+	errGenerateKey   = "cannot generate private key"
+	errMarshalKey    = "cannot marshal private key"
+	errGenerateCSR   = "cannot generate certificate signing request"
+	errNoCertificate = "no PEM encoded certificate found"
+	errGetConnSecret = "cannot get connection secret"
+)
+
+// CertificateClient defines the ACM PCA operations to issue certificates
+type CertificateClient interface {
+	IssueCertificateRequest(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	GetCertificateRequest(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
+	RevokeCertificateRequest(*acmpca.RevokeCertificateInput) acmpca.RevokeCertificateRequest
+}
+
+// NewCertificateClient returns a new client using AWS credentials as JSON encoded data.
+func NewCertificateClient(conf *aws.Config) CertificateClient {
+	return acmpca.New(*conf)
+}
+
+// GetCertificateSigningRequest returns the PEM encoded certificate signing
+// request of the Certificate. It is read from the referenced Secret if there
+// is one. Otherwise a new private key is generated and returned along with
+// a certificate signing request for it.
+func GetCertificateSigningRequest(ctx context.Context, kube client.Client, p v1alpha1.CertificateParameters) (key []byte, csr []byte, err error) {
+	if ref := p.CertificateSigningRequestSecretRef; ref != nil {
+		s := &corev1.Secret{}
+		nn := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+		if err := kube.Get(ctx, nn, s); err != nil {
+			return nil, nil, errors.Wrap(err, errGetCSRSecret)
+		}
+		if len(s.Data[ref.Key]) == 0 {
+			return nil, nil, errors.New(errEmptyCSR)
+		}
+		return nil, s.Data[ref.Key], nil
+	}
+	return GeneratePrivateKeyAndCSR(p)
+}
+
+// GeneratePrivateKeyAndCSR generates a private key of the given key
+// algorithm and a certificate signing request for the given subject. Both
+// are PEM encoded.
+func GeneratePrivateKeyAndCSR(p v1alpha1.CertificateParameters) ([]byte, []byte, error) {
+	var key crypto.Signer
+	var err error
+	switch p.KeyAlgorithm {
+	case acmpca.KeyAlgorithmRsa4096:
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	case acmpca.KeyAlgorithmEcPrime256v1:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case acmpca.KeyAlgorithmEcSecp384r1:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGenerateKey)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errMarshalKey)
+	}
+
+	tmpl := &x509.CertificateRequest{
+		Subject:  generateSubject(p.Subject),
+		DNSNames: p.DNSNames,
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, tmpl, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGenerateCSR)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}), nil
+}
+
+func generateSubject(s *v1alpha1.CertificateSubject) pkix.Name {
+	n := pkix.Name{}
+	if s == nil {
+		return n
+	}
+	n.CommonName = s.CommonName
+	if s.Country != nil {
+		n.Country = []string{aws.StringValue(s.Country)}
+	}
+	if s.Locality != nil {
+		n.Locality = []string{aws.StringValue(s.Locality)}
+	}
+	if s.Organization != nil {
+		n.Organization = []string{aws.StringValue(s.Organization)}
+	}
+	if s.OrganizationalUnit != nil {
+		n.OrganizationalUnit = []string{aws.StringValue(s.OrganizationalUnit)}
+	}
+	if s.State != nil {
+		n.Province = []string{aws.StringValue(s.State)}
+	}
+	return n
+}
+
+// GenerateIdempotencyToken returns the idempotency token used to issue the
+// given generation of the certificate of a managed resource, so that a
+// retried request does not issue another certificate.
+func GenerateIdempotencyToken(uid types.UID, generation int64) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", uid, generation)))
+	// ACM PCA accepts idempotency tokens of up to 36 characters.
+	return hex.EncodeToString(h[:])[:32]
+}
+
+// GenerateCertificateIssueInput returns the input to issue a certificate for
+// the given certificate signing request.
+func GenerateCertificateIssueInput(p v1alpha1.CertificateParameters, csr []byte, token string) *acmpca.IssueCertificateInput {
+	return &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: p.CertificateAuthorityARN,
+		Csr:                     csr,
+		IdempotencyToken:        aws.String(token),
+		SigningAlgorithm:        p.SigningAlgorithm,
+		TemplateArn:             p.TemplateARN,
+		Validity: &acmpca.Validity{
+			Type:  p.Validity.Type,
+			Value: aws.Int64(p.Validity.Value),
+		},
+	}
+}
+
+// ParseCertificate parses the first PEM encoded certificate.
+func ParseCertificate(in string) (*x509.Certificate, error) {
+	b, _ := pem.Decode([]byte(in))
+	if b == nil {
+		return nil, errors.New(errNoCertificate)
+	}
+	return x509.ParseCertificate(b.Bytes)
+}
+
+// FormatSerial formats the serial number of a certificate as colon
+// separated hexadecimal bytes, the way ACM PCA expects it.
+func FormatSerial(serial *big.Int) string {
+	b := serial.Bytes()
+	parts := make([]string, len(b))
+	for i := range b {
+		parts[i] = fmt.Sprintf("%02x", b[i])
+	}
+	return strings.Join(parts, ":")
+}
+
+// GenerateCertificateObservation returns the observation of the issued
+// certificate.
+func GenerateCertificateObservation(arn string, c *x509.Certificate) v1alpha1.CertificateObservation {
+	notBefore, notAfter := metav1.NewTime(c.NotBefore), metav1.NewTime(c.NotAfter)
+	return v1alpha1.CertificateObservation{
+		CertificateARN: arn,
+		Serial:         FormatSerial(c.SerialNumber),
+		NotBefore:      &notBefore,
+		NotAfter:       &notAfter,
+	}
+}
+
+// GetRenewalWindow returns how long before its expiry the certificate is
+// renewed. A window that is not shorter than the validity of the certificate
+// would renew it as soon as it is issued, so a third of the validity is used
+// instead.
+func GetRenewalWindow(p v1alpha1.CertificateParameters, c *x509.Certificate) time.Duration {
+	days := int64(DefaultRenewBeforeInDays)
+	if p.RenewBeforeInDays != nil {
+		days = aws.Int64Value(p.RenewBeforeInDays)
+	}
+	window := time.Duration(days) * 24 * time.Hour
+	if validity := c.NotAfter.Sub(c.NotBefore); window >= validity {
+		window = validity / 3
+	}
+	return window
+}
+
+// IsCertificateDueForRenewal returns true if the certificate expires within
+// the renewal window of the given parameters.
+func IsCertificateDueForRenewal(p v1alpha1.CertificateParameters, c *x509.Certificate, now time.Time) bool {
+	return now.After(c.NotAfter.Add(-GetRenewalWindow(p, c)))
+}
+
+// GetConnectionSecretData returns the data of the connection secret the
+// certificate is published to. It returns nil if there is no such secret
+// yet.
+func GetConnectionSecretData(ctx context.Context, kube client.Client, ref *xpv1.SecretReference) (map[string][]byte, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, errors.Wrap(resource.IgnoreNotFound(err), errGetConnSecret)
+	}
+	return s.Data, nil
+}
+
+// MatchPrivateKey returns the first of the given PEM encoded private keys
+// that belongs to the public key of the certificate, or nil if none does.
+func MatchPrivateKey(c *x509.Certificate, keys ...[]byte) []byte {
+	pub, err := x509.MarshalPKIXPublicKey(c.PublicKey)
+	if err != nil {
+		return nil
+	}
+	for _, k := range keys {
+		b, _ := pem.Decode(k)
+		if b == nil {
+			continue
+		}
+		key, err := x509.ParsePKCS8PrivateKey(b.Bytes)
+		if err != nil {
+			continue
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			continue
+		}
+		kpub, err := x509.MarshalPKIXPublicKey(signer.Public())
+		if err == nil && bytes.Equal(pub, kpub) {
+			return k
+		}
+	}
+	return nil
+}
+
+// GetCertificateConnectionDetails returns the connection details of the
+// issued certificate.
+func GetCertificateConnectionDetails(out *acmpca.GetCertificateOutput) managed.ConnectionDetails {
+	if out == nil || out.Certificate == nil {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		ConnectionDetailsCertificateKey: []byte(aws.StringValue(out.Certificate)),
+	}
+	if out.CertificateChain != nil {
+		cd[ConnectionDetailsCertificateChainKey] = []byte(aws.StringValue(out.CertificateChain))
+	}
+	return cd
+}
+
+// IsCertificateNotFound returns true if the error code indicates that the
+// certificate was not found.
+func IsCertificateNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == acmpca.ErrCodeResourceNotFoundException
+	}
+	return false
+}
+
+// IsCertificateAlreadyRevoked returns true if the error code indicates that
+// the certificate has already been revoked.
+func IsCertificateAlreadyRevoked(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == acmpca.ErrCodeRequestAlreadyProcessedException
+	}
+	return false
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmpca
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	certificateAuthorityARN = "someauthorityarn"
+	errBoom                 = errors.New("boom")
+)
+
+func TestGetCertificateSigningRequest(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "csr", Namespace: "default"},
+		Key:             "csr.pem",
+	}
+	type want struct {
+		key bool
+		csr []byte
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		p    v1alpha1.CertificateParameters
+		want want
+	}{
+		"FromSecret": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+					s := obj.(*corev1.Secret)
+					s.Data = map[string][]byte{"csr.pem": []byte(csr)}
+					return nil
+				},
+			},
+			p:    v1alpha1.CertificateParameters{CertificateSigningRequestSecretRef: ref},
+			want: want{csr: []byte(csr)},
+		},
+		"EmptySecretKey": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			p:    v1alpha1.CertificateParameters{CertificateSigningRequestSecretRef: ref},
+			want: want{err: errors.New(errEmptyCSR)},
+		},
+		"SecretError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			p:    v1alpha1.CertificateParameters{CertificateSigningRequestSecretRef: ref},
+			want: want{err: errors.Wrap(errBoom, errGetCSRSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, got, err := GetCertificateSigningRequest(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetCertificateSigningRequest(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, key != nil); diff != "" {
+				t.Errorf("GetCertificateSigningRequest(...): -want key, +got key:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.csr, got); diff != "" {
+				t.Errorf("GetCertificateSigningRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePrivateKeyAndCSR(t *testing.T) {
+	p := v1alpha1.CertificateParameters{
+		Subject: &v1alpha1.CertificateSubject{
+			CommonName:   commonName,
+			Organization: aws.String(organization),
+		},
+		DNSNames:     []string{"example.com"},
+		KeyAlgorithm: acmpca.KeyAlgorithmEcPrime256v1,
+	}
+
+	key, csr, err := GeneratePrivateKeyAndCSR(p)
+	if err != nil {
+		t.Fatalf("GeneratePrivateKeyAndCSR(...): %s", err)
+	}
+
+	kb, _ := pem.Decode(key)
+	if kb == nil {
+		t.Fatalf("GeneratePrivateKeyAndCSR(...): private key is not PEM encoded")
+	}
+	k, err := x509.ParsePKCS8PrivateKey(kb.Bytes)
+	if err != nil {
+		t.Fatalf("GeneratePrivateKeyAndCSR(...): %s", err)
+	}
+	if _, ok := k.(*ecdsa.PrivateKey); !ok {
+		t.Errorf("GeneratePrivateKeyAndCSR(...): want ECDSA private key, got %T", k)
+	}
+
+	cb, _ := pem.Decode(csr)
+	if cb == nil {
+		t.Fatalf("GeneratePrivateKeyAndCSR(...): certificate signing request is not PEM encoded")
+	}
+	req, err := x509.ParseCertificateRequest(cb.Bytes)
+	if err != nil {
+		t.Fatalf("GeneratePrivateKeyAndCSR(...): %s", err)
+	}
+	if diff := cmp.Diff(commonName, req.Subject.CommonName); diff != "" {
+		t.Errorf("GeneratePrivateKeyAndCSR(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{organization}, req.Subject.Organization); diff != "" {
+		t.Errorf("GeneratePrivateKeyAndCSR(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(p.DNSNames, req.DNSNames); diff != "" {
+		t.Errorf("GeneratePrivateKeyAndCSR(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateCertificateIssueInput(t *testing.T) {
+	p := v1alpha1.CertificateParameters{
+		CertificateAuthorityARN: aws.String(certificateAuthorityARN),
+		SigningAlgorithm:        acmpca.SigningAlgorithmSha256withrsa,
+		Validity: v1alpha1.CertificateValidity{
+			Type:  acmpca.ValidityPeriodTypeDays,
+			Value: 90,
+		},
+	}
+	want := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(certificateAuthorityARN),
+		Csr:                     []byte(csr),
+		IdempotencyToken:        aws.String("token"),
+		SigningAlgorithm:        acmpca.SigningAlgorithmSha256withrsa,
+		Validity: &acmpca.Validity{
+			Type:  acmpca.ValidityPeriodTypeDays,
+			Value: aws.Int64(90),
+		},
+	}
+
+	got := GenerateCertificateIssueInput(p, []byte(csr), "token")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateCertificateIssueInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateIdempotencyToken(t *testing.T) {
+	first := GenerateIdempotencyToken(types.UID("uid"), 0)
+	if diff := cmp.Diff(first, GenerateIdempotencyToken(types.UID("uid"), 0)); diff != "" {
+		t.Errorf("GenerateIdempotencyToken(...): -want, +got:\n%s", diff)
+	}
+	if first == GenerateIdempotencyToken(types.UID("uid"), 1) {
+		t.Errorf("GenerateIdempotencyToken(...): want a different token for the next generation")
+	}
+	if len(first) > 36 {
+		t.Errorf("GenerateIdempotencyToken(...): token %q is longer than 36 characters", first)
+	}
+}
+
+func TestFormatSerial(t *testing.T) {
+	cases := map[string]struct {
+		in   *big.Int
+		want string
+	}{
+		"SingleByte": {
+			in:   big.NewInt(10),
+			want: "0a",
+		},
+		"MultipleBytes": {
+			in:   big.NewInt(0x1a2b3c),
+			want: "1a:2b:3c",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FormatSerial(tc.in)); diff != "" {
+				t.Errorf("FormatSerial(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseCertificate(t *testing.T) {
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	c, err := ParseCertificate(selfSignedCertificate(t, notAfter))
+	if err != nil {
+		t.Fatalf("ParseCertificate(...): %s", err)
+	}
+	if diff := cmp.Diff(notAfter, c.NotAfter.UTC()); diff != "" {
+		t.Errorf("ParseCertificate(...): -want, +got:\n%s", diff)
+	}
+
+	if _, err := ParseCertificate("notapem"); err == nil {
+		t.Errorf("ParseCertificate(...): want error for invalid PEM")
+	}
+}
+
+func TestIsCertificateDueForRenewal(t *testing.T) {
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		p         v1alpha1.CertificateParameters
+		notBefore time.Time
+		notAfter  time.Time
+		want      bool
+	}{
+		"NotDue": {
+			notAfter: now.Add(31 * 24 * time.Hour),
+			want:     false,
+		},
+		"DueWithDefault": {
+			notAfter: now.Add(29 * 24 * time.Hour),
+			want:     true,
+		},
+		"NotDueWithCustomWindow": {
+			p:        v1alpha1.CertificateParameters{RenewBeforeInDays: aws.Int64(7)},
+			notAfter: now.Add(8 * 24 * time.Hour),
+			want:     false,
+		},
+		"Expired": {
+			notAfter: now.Add(-time.Hour),
+			want:     true,
+		},
+		"ShortValidityJustIssued": {
+			notBefore: now.Add(-time.Hour),
+			notAfter:  now.Add(10 * 24 * time.Hour),
+			want:      false,
+		},
+		"ShortValidityTwoThirdsPassed": {
+			notBefore: now.Add(-7 * 24 * time.Hour),
+			notAfter:  now.Add(3 * 24 * time.Hour),
+			want:      true,
+		},
+		"WindowAsLongAsValidity": {
+			p:         v1alpha1.CertificateParameters{RenewBeforeInDays: aws.Int64(90)},
+			notBefore: now.Add(-time.Hour),
+			notAfter:  now.Add(90*24*time.Hour - time.Hour),
+			want:      false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCertificateDueForRenewal(tc.p, &x509.Certificate{NotBefore: tc.notBefore, NotAfter: tc.notAfter}, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsCertificateDueForRenewal(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatchPrivateKey(t *testing.T) {
+	key, _, err := GeneratePrivateKeyAndCSR(v1alpha1.CertificateParameters{KeyAlgorithm: acmpca.KeyAlgorithmEcPrime256v1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _, err := GeneratePrivateKeyAndCSR(v1alpha1.CertificateParameters{KeyAlgorithm: acmpca.KeyAlgorithmEcPrime256v1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := pem.Decode(key)
+	signer, err := x509.ParsePKCS8PrivateKey(b.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &x509.Certificate{PublicKey: signer.(*ecdsa.PrivateKey).Public()}
+
+	cases := map[string]struct {
+		keys [][]byte
+		want []byte
+	}{
+		"Match": {
+			keys: [][]byte{other, key},
+			want: key,
+		},
+		"NoMatch": {
+			keys: [][]byte{other, nil, []byte("notapem")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, MatchPrivateKey(c, tc.keys...)); diff != "" {
+				t.Errorf("MatchPrivateKey(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func selfSignedCertificate(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
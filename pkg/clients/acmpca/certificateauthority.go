@@ -17,15 +17,40 @@ limitations under the License.
 package acmpca
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 )
 
+const (
+	// ConnectionDetailsCertificateKey is the key of the PEM encoded
+	// certificate of the certificate authority in the connection secret.
+	ConnectionDetailsCertificateKey = "certificate"
+	// ConnectionDetailsCertificateChainKey is the key of the PEM encoded
+	// certificate chain of the certificate authority in the connection
+	// secret.
+	ConnectionDetailsCertificateChainKey = "certificateChain"
+
+	// DefaultRootValidityInDays is the validity of a ROOT certificate
+	// authority certificate when none is given.
+	DefaultRootValidityInDays = 3650
+	// DefaultSubordinateValidityInDays is the validity of a SUBORDINATE
+	// certificate authority certificate when none is given.
+	DefaultSubordinateValidityInDays = 1825
+
+	rootTemplate        = "RootCACertificate/V1"
+	subordinateTemplate = "SubordinateCACertificate_PathLen0/V1"
+)
+
 // Client defines the CertificateManager operations
 type Client interface {
 	CreateCertificateAuthorityRequest(*acmpca.CreateCertificateAuthorityInput) acmpca.CreateCertificateAuthorityRequest
@@ -35,6 +60,11 @@ type Client interface {
 	ListTagsRequest(*acmpca.ListTagsInput) acmpca.ListTagsRequest
 	UntagCertificateAuthorityRequest(*acmpca.UntagCertificateAuthorityInput) acmpca.UntagCertificateAuthorityRequest
 	TagCertificateAuthorityRequest(*acmpca.TagCertificateAuthorityInput) acmpca.TagCertificateAuthorityRequest
+	GetCertificateAuthorityCsrRequest(*acmpca.GetCertificateAuthorityCsrInput) acmpca.GetCertificateAuthorityCsrRequest
+	GetCertificateAuthorityCertificateRequest(*acmpca.GetCertificateAuthorityCertificateInput) acmpca.GetCertificateAuthorityCertificateRequest
+	ImportCertificateAuthorityCertificateRequest(*acmpca.ImportCertificateAuthorityCertificateInput) acmpca.ImportCertificateAuthorityCertificateRequest
+	IssueCertificateRequest(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	GetCertificateRequest(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -166,6 +196,12 @@ func IsCertificateAuthorityUpToDate(p *v1alpha1.CertificateAuthority, cd acmpca.
 		return false
 	}
 
+	// A certificate authority waiting for its certificate has to be
+	// activated before it can be used.
+	if cd.Status == acmpca.CertificateAuthorityStatusPendingCertificate {
+		return false
+	}
+
 	desired := aws.StringValue(p.Spec.ForProvider.Status)
 	if (desired == string(acmpca.CertificateAuthorityStatusActive) || desired == string(acmpca.CertificateAuthorityStatusDisabled)) && desired != string(cd.Status) {
 		return false
@@ -187,11 +223,85 @@ func IsCertificateAuthorityUpToDate(p *v1alpha1.CertificateAuthority, cd acmpca.
 
 // GenerateCertificateAuthorityExternalStatus is used to produce CertificateAuthorityExternalStatus from acmpca.certificateAuthorityStatus and v1alpha1.CertificateAuthority
 func GenerateCertificateAuthorityExternalStatus(certificateAuthority acmpca.CertificateAuthority) v1alpha1.CertificateAuthorityExternalStatus {
-	return v1alpha1.CertificateAuthorityExternalStatus{
+	s := v1alpha1.CertificateAuthorityExternalStatus{
 		CertificateAuthorityARN: aws.StringValue(certificateAuthority.Arn),
 		Serial:                  aws.StringValue(certificateAuthority.Serial),
 		Status:                  string(certificateAuthority.Status),
 	}
+	if certificateAuthority.NotAfter != nil {
+		t := metav1.NewTime(*certificateAuthority.NotAfter)
+		s.NotAfter = &t
+	}
+	return s
+}
+
+// HasCertificate returns true if a certificate has been imported into the
+// certificate authority in the given status.
+func HasCertificate(status acmpca.CertificateAuthorityStatus) bool {
+	switch status {
+	case acmpca.CertificateAuthorityStatusActive,
+		acmpca.CertificateAuthorityStatusDisabled,
+		acmpca.CertificateAuthorityStatusExpired:
+		return true
+	}
+	return false
+}
+
+// GenerateIssueCertificateInput returns the input to have the certificate
+// authority with signerARN sign the given CSR of the certificate authority
+// with caARN.
+func GenerateIssueCertificateInput(caARN, signerARN string, p v1alpha1.CertificateAuthorityParameters, alg acmpca.SigningAlgorithm, csr []byte) (*acmpca.IssueCertificateInput, error) {
+	parsed, err := arn.Parse(caARN)
+	if err != nil {
+		return nil, err
+	}
+	template := rootTemplate
+	validity := int64(DefaultRootValidityInDays)
+	if p.Type == acmpca.CertificateAuthorityTypeSubordinate {
+		template = subordinateTemplate
+		validity = DefaultSubordinateValidityInDays
+	}
+	if p.ValidityInDays != nil {
+		validity = aws.Int64Value(p.ValidityInDays)
+	}
+	// The ID of the certificate authority is unique and at most 36
+	// characters, which makes it a valid idempotency token that prevents a
+	// second certificate being issued for the same CSR.
+	return &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(signerARN),
+		Csr:                     csr,
+		IdempotencyToken:        aws.String(parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]),
+		SigningAlgorithm:        alg,
+		TemplateArn:             aws.String(fmt.Sprintf("arn:%s:acm-pca:::template/%s", parsed.Partition, template)),
+		Validity: &acmpca.Validity{
+			Type:  acmpca.ValidityPeriodTypeDays,
+			Value: aws.Int64(validity),
+		},
+	}, nil
+}
+
+// GetConnectionDetails returns the connection details of the certificate
+// authority that holds its certificate and certificate chain.
+func GetConnectionDetails(out *acmpca.GetCertificateAuthorityCertificateOutput) managed.ConnectionDetails {
+	if out == nil || out.Certificate == nil {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		ConnectionDetailsCertificateKey: []byte(aws.StringValue(out.Certificate)),
+	}
+	if out.CertificateChain != nil {
+		cd[ConnectionDetailsCertificateChainKey] = []byte(aws.StringValue(out.CertificateChain))
+	}
+	return cd
+}
+
+// IsErrorRequestInProgress returns true if the error code indicates that the
+// requested certificate has not been issued yet.
+func IsErrorRequestInProgress(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == acmpca.ErrCodeRequestInProgressException
+	}
+	return false
 }
 
 // IsErrorNotFound returns true if the error code indicates that the item was not found
@@ -22,6 +22,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -44,6 +46,11 @@ var (
 	state                          = "someState"
 	surname                        = "someSurname"
 	title                          = "someTitle"
+	caARN                          = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/11111111-2222-3333-4444-555555555555"
+	parentARN                      = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/66666666-7777-8888-9999-000000000000"
+	csr                            = "somecsr"
+	certificate                    = "somecertificate"
+	certificateChain               = "somecertificatechain"
 )
 
 func TestGenerateCreateCertificateAuthorityInput(t *testing.T) {
@@ -337,6 +344,20 @@ func TestIsCertificateAuthorityUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"PendingCertificate": {
+			args: args{
+				cd: acmpca.CertificateAuthority{
+					RevocationConfiguration: &acmpca.RevocationConfiguration{
+						CrlConfiguration: &acmpca.CrlConfiguration{
+							Enabled: aws.Bool(false),
+						},
+					},
+					Status: acmpca.CertificateAuthorityStatusPendingCertificate,
+				},
+				p: &v1alpha1.CertificateAuthority{},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -348,3 +369,126 @@ func TestIsCertificateAuthorityUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateIssueCertificateInput(t *testing.T) {
+	type args struct {
+		caARN     string
+		signerARN string
+		p         v1alpha1.CertificateAuthorityParameters
+		alg       acmpca.SigningAlgorithm
+	}
+	type want struct {
+		out *acmpca.IssueCertificateInput
+		err bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Root": {
+			args: args{
+				caARN:     caARN,
+				signerARN: caARN,
+				p:         v1alpha1.CertificateAuthorityParameters{Type: acmpca.CertificateAuthorityTypeRoot},
+				alg:       acmpca.SigningAlgorithmSha256withrsa,
+			},
+			want: want{
+				out: &acmpca.IssueCertificateInput{
+					CertificateAuthorityArn: aws.String(caARN),
+					Csr:                     []byte(csr),
+					IdempotencyToken:        aws.String("11111111-2222-3333-4444-555555555555"),
+					SigningAlgorithm:        acmpca.SigningAlgorithmSha256withrsa,
+					TemplateArn:             aws.String("arn:aws:acm-pca:::template/RootCACertificate/V1"),
+					Validity: &acmpca.Validity{
+						Type:  acmpca.ValidityPeriodTypeDays,
+						Value: aws.Int64(DefaultRootValidityInDays),
+					},
+				},
+			},
+		},
+		"Subordinate": {
+			args: args{
+				caARN:     caARN,
+				signerARN: parentARN,
+				p: v1alpha1.CertificateAuthorityParameters{
+					Type:           acmpca.CertificateAuthorityTypeSubordinate,
+					ValidityInDays: aws.Int64(30),
+				},
+				alg: acmpca.SigningAlgorithmSha512withecdsa,
+			},
+			want: want{
+				out: &acmpca.IssueCertificateInput{
+					CertificateAuthorityArn: aws.String(parentARN),
+					Csr:                     []byte(csr),
+					IdempotencyToken:        aws.String("11111111-2222-3333-4444-555555555555"),
+					SigningAlgorithm:        acmpca.SigningAlgorithmSha512withecdsa,
+					TemplateArn:             aws.String("arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"),
+					Validity: &acmpca.Validity{
+						Type:  acmpca.ValidityPeriodTypeDays,
+						Value: aws.Int64(30),
+					},
+				},
+			},
+		},
+		"InvalidARN": {
+			args: args{
+				caARN:     "notanarn",
+				signerARN: "notanarn",
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GenerateIssueCertificateInput(tc.args.caARN, tc.args.signerARN, tc.args.p, tc.args.alg, []byte(csr))
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GenerateIssueCertificateInput(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.out, got); diff != "" {
+				t.Errorf("GenerateIssueCertificateInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   *acmpca.GetCertificateAuthorityCertificateOutput
+		want managed.ConnectionDetails
+	}{
+		"Root": {
+			in: &acmpca.GetCertificateAuthorityCertificateOutput{
+				Certificate: aws.String(certificate),
+			},
+			want: managed.ConnectionDetails{
+				ConnectionDetailsCertificateKey: []byte(certificate),
+			},
+		},
+		"Subordinate": {
+			in: &acmpca.GetCertificateAuthorityCertificateOutput{
+				Certificate:      aws.String(certificate),
+				CertificateChain: aws.String(certificateChain),
+			},
+			want: managed.ConnectionDetails{
+				ConnectionDetailsCertificateKey:      []byte(certificate),
+				ConnectionDetailsCertificateChainKey: []byte(certificateChain),
+			},
+		},
+		"NoCertificate": {
+			in: &acmpca.GetCertificateAuthorityCertificateOutput{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
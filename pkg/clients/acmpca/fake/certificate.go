@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/acmpca"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.CertificateClient = (*MockCertificateClient)(nil)
+
+// MockCertificateClient is a type that implements all the methods for Certificate Client interface
+type MockCertificateClient struct {
+	MockIssueCertificateRequest  func(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	MockGetCertificateRequest    func(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
+	MockRevokeCertificateRequest func(*acmpca.RevokeCertificateInput) acmpca.RevokeCertificateRequest
+}
+
+// IssueCertificateRequest mocks IssueCertificateRequest method
+func (m *MockCertificateClient) IssueCertificateRequest(input *acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest {
+	return m.MockIssueCertificateRequest(input)
+}
+
+// GetCertificateRequest mocks GetCertificateRequest method
+func (m *MockCertificateClient) GetCertificateRequest(input *acmpca.GetCertificateInput) acmpca.GetCertificateRequest {
+	return m.MockGetCertificateRequest(input)
+}
+
+// RevokeCertificateRequest mocks RevokeCertificateRequest method
+func (m *MockCertificateClient) RevokeCertificateRequest(input *acmpca.RevokeCertificateInput) acmpca.RevokeCertificateRequest {
+	return m.MockRevokeCertificateRequest(input)
+}
@@ -27,15 +27,20 @@ var _ clientset.Client = (*MockCertificateAuthorityClient)(nil)
 
 // MockCertificateAuthorityClient is a type that implements all the methods for Certificate Authority Client interface
 type MockCertificateAuthorityClient struct {
-	MockCreateCertificateAuthorityRequest   func(*acmpca.CreateCertificateAuthorityInput) acmpca.CreateCertificateAuthorityRequest
-	MockCreatePermissionRequest             func(*acmpca.CreatePermissionInput) acmpca.CreatePermissionRequest
-	MockDeleteCertificateAuthorityRequest   func(*acmpca.DeleteCertificateAuthorityInput) acmpca.DeleteCertificateAuthorityRequest
-	MockDeletePermissionRequest             func(*acmpca.DeletePermissionInput) acmpca.DeletePermissionRequest
-	MockUpdateCertificateAuthorityRequest   func(*acmpca.UpdateCertificateAuthorityInput) acmpca.UpdateCertificateAuthorityRequest
-	MockDescribeCertificateAuthorityRequest func(*acmpca.DescribeCertificateAuthorityInput) acmpca.DescribeCertificateAuthorityRequest
-	MockListTagsRequest                     func(*acmpca.ListTagsInput) acmpca.ListTagsRequest
-	MockUntagCertificateAuthorityRequest    func(*acmpca.UntagCertificateAuthorityInput) acmpca.UntagCertificateAuthorityRequest
-	MockTagCertificateAuthorityRequest      func(*acmpca.TagCertificateAuthorityInput) acmpca.TagCertificateAuthorityRequest
+	MockCreateCertificateAuthorityRequest            func(*acmpca.CreateCertificateAuthorityInput) acmpca.CreateCertificateAuthorityRequest
+	MockCreatePermissionRequest                      func(*acmpca.CreatePermissionInput) acmpca.CreatePermissionRequest
+	MockDeleteCertificateAuthorityRequest            func(*acmpca.DeleteCertificateAuthorityInput) acmpca.DeleteCertificateAuthorityRequest
+	MockDeletePermissionRequest                      func(*acmpca.DeletePermissionInput) acmpca.DeletePermissionRequest
+	MockUpdateCertificateAuthorityRequest            func(*acmpca.UpdateCertificateAuthorityInput) acmpca.UpdateCertificateAuthorityRequest
+	MockDescribeCertificateAuthorityRequest          func(*acmpca.DescribeCertificateAuthorityInput) acmpca.DescribeCertificateAuthorityRequest
+	MockListTagsRequest                              func(*acmpca.ListTagsInput) acmpca.ListTagsRequest
+	MockUntagCertificateAuthorityRequest             func(*acmpca.UntagCertificateAuthorityInput) acmpca.UntagCertificateAuthorityRequest
+	MockTagCertificateAuthorityRequest               func(*acmpca.TagCertificateAuthorityInput) acmpca.TagCertificateAuthorityRequest
+	MockGetCertificateAuthorityCsrRequest            func(*acmpca.GetCertificateAuthorityCsrInput) acmpca.GetCertificateAuthorityCsrRequest
+	MockGetCertificateAuthorityCertificateRequest    func(*acmpca.GetCertificateAuthorityCertificateInput) acmpca.GetCertificateAuthorityCertificateRequest
+	MockImportCertificateAuthorityCertificateRequest func(*acmpca.ImportCertificateAuthorityCertificateInput) acmpca.ImportCertificateAuthorityCertificateRequest
+	MockIssueCertificateRequest                      func(*acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest
+	MockGetCertificateRequest                        func(*acmpca.GetCertificateInput) acmpca.GetCertificateRequest
 }
 
 // CreateCertificateAuthorityRequest mocks CreateCertificateAuthorityRequest method
@@ -82,3 +87,28 @@ func (m *MockCertificateAuthorityClient) UpdateCertificateAuthorityRequest(input
 func (m *MockCertificateAuthorityClient) DeletePermissionRequest(input *acmpca.DeletePermissionInput) acmpca.DeletePermissionRequest {
 	return m.MockDeletePermissionRequest(input)
 }
+
+// GetCertificateAuthorityCsrRequest mocks GetCertificateAuthorityCsrRequest method
+func (m *MockCertificateAuthorityClient) GetCertificateAuthorityCsrRequest(input *acmpca.GetCertificateAuthorityCsrInput) acmpca.GetCertificateAuthorityCsrRequest {
+	return m.MockGetCertificateAuthorityCsrRequest(input)
+}
+
+// GetCertificateAuthorityCertificateRequest mocks GetCertificateAuthorityCertificateRequest method
+func (m *MockCertificateAuthorityClient) GetCertificateAuthorityCertificateRequest(input *acmpca.GetCertificateAuthorityCertificateInput) acmpca.GetCertificateAuthorityCertificateRequest {
+	return m.MockGetCertificateAuthorityCertificateRequest(input)
+}
+
+// ImportCertificateAuthorityCertificateRequest mocks ImportCertificateAuthorityCertificateRequest method
+func (m *MockCertificateAuthorityClient) ImportCertificateAuthorityCertificateRequest(input *acmpca.ImportCertificateAuthorityCertificateInput) acmpca.ImportCertificateAuthorityCertificateRequest {
+	return m.MockImportCertificateAuthorityCertificateRequest(input)
+}
+
+// IssueCertificateRequest mocks IssueCertificateRequest method
+func (m *MockCertificateAuthorityClient) IssueCertificateRequest(input *acmpca.IssueCertificateInput) acmpca.IssueCertificateRequest {
+	return m.MockIssueCertificateRequest(input)
+}
+
+// GetCertificateRequest mocks GetCertificateRequest method
+func (m *MockCertificateAuthorityClient) GetCertificateRequest(input *acmpca.GetCertificateInput) acmpca.GetCertificateRequest {
+	return m.MockGetCertificateRequest(input)
+}
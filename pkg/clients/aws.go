@@ -21,7 +21,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/url"
 	"os"
 	"strconv"
@@ -33,11 +32,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/endpoints"
 	"github.com/aws/aws-sdk-go-v2/aws/external"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsv1 "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	stscredsv1 "github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	stsv1 "github.com/aws/aws-sdk-go/service/sts"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
 	"github.com/pkg/errors"
@@ -86,6 +88,8 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
+// The configs are cached per ProviderConfig and region, and they're reused
+// until the spec of the ProviderConfig or its credentials Secret changes.
 func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
@@ -97,28 +101,74 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	cfg, _, err := GetProviderConfigConfig(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	return SetResolver(ctx, mg, cfg), nil
+}
+
+// GetProviderConfigConfig returns a config for the given region that uses the
+// credentials of the given ProviderConfig, together with the version of these
+// credentials. The configs are cached per ProviderConfig and region, and
+// they're reused until the spec of the ProviderConfig or its credentials Secret
+// changes.
+func GetProviderConfigConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, string, error) {
+	data, version, err := GetCredentialsData(ctx, c, pc)
+	if err != nil {
+		return nil, "", err
+	}
+	cfg, err := DefaultConfigCache.GetConfig(configCacheKey(pc, region), version, func() (*aws.Config, error) {
+		return NewProviderConfigConfig(ctx, pc, data, region)
+	})
+	return cfg, version, err
+}
+
+// GetCredentialsData returns the credentials data referenced by the given
+// ProviderConfig, if any, together with the version that the configs built
+// from them are cached with. See configCacheVersion.
+func GetCredentialsData(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, string, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
-		return SetResolver(ctx, mg, cfg), err
+		return nil, configCacheVersion(pc, nil), nil
 	case xpv1.CredentialsSourceSecret:
 		csr := pc.Spec.Credentials.SecretRef
 		if csr == nil {
-			return nil, errors.New("no credentials secret referenced")
+			return nil, "", errors.New("no credentials secret referenced")
 		}
 		s := &corev1.Secret{}
 		if err := c.Get(ctx, types.NamespacedName{Namespace: csr.Namespace, Name: csr.Name}, s); err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials secret")
+			return nil, "", errors.Wrap(err, "cannot get credentials secret")
 		}
-		cfg, err := UseProviderSecret(ctx, s.Data[csr.Key], DefaultSection, region)
-		return SetResolver(ctx, mg, cfg), err
+		return s.Data[csr.Key], configCacheVersion(pc, s), nil
+	default:
+		return nil, "", errors.Errorf("credentials source %s is not currently supported", s)
+	}
+}
+
+// NewProviderConfigConfig returns a config for the given region that uses the
+// credentials configured in the given ProviderConfig.
+func NewProviderConfigConfig(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*aws.Config, error) {
+	var cfg *aws.Config
+	var err error
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceInjectedIdentity:
+		cfg, err = usePodServiceAccount(ctx, region, pc.Spec.Endpoint)
+	case xpv1.CredentialsSourceSecret:
+		cfg, err = UseProviderSecret(ctx, data, DefaultSection, region)
 	default:
 		return nil, errors.Errorf("credentials source %s is not currently supported", s)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return UseAssumeRoleChain(UseEndpointConfig(cfg, pc.Spec.Endpoint), pc.Spec.AssumeRoleChain), nil
 }
 
 // SetResolver parses annotations from the managed resource
-// and returns a configuration accordingly.
+// and returns a configuration accordingly. The endpoint service ID annotation
+// may contain a comma separated list of endpoint IDs. Requests to other
+// services are resolved by the existing resolver of the configuration.
 func SetResolver(ctx context.Context, mg resource.Managed, cfg *aws.Config) *aws.Config {
 	if ServiceID, ok := mg.GetAnnotations()["aws.alpha.crossplane.io/endpointServiceID"]; ok {
 		if URL, ok := mg.GetAnnotations()["aws.alpha.crossplane.io/endpointURL"]; ok {
@@ -129,9 +179,12 @@ func SetResolver(ctx context.Context, mg resource.Managed, cfg *aws.Config) *aws
 				endpoint.SigningRegion = Region
 			}
 
-			defaultResolver := endpoints.NewDefaultResolver()
+			var defaultResolver aws.EndpointResolver = endpoints.NewDefaultResolver()
+			if cfg.EndpointResolver != nil {
+				defaultResolver = cfg.EndpointResolver
+			}
 			endpointResolver := func(service, region string) (aws.Endpoint, error) {
-				if strings.Contains(ServiceID, service) {
+				if containsServiceID(ServiceID, service) {
 					return endpoint, nil
 				}
 
@@ -143,6 +196,17 @@ func SetResolver(ctx context.Context, mg resource.Managed, cfg *aws.Config) *aws
 	return cfg
 }
 
+// containsServiceID returns whether the given comma separated list of
+// endpoint IDs contains the given one.
+func containsServiceID(list, id string) bool {
+	for _, s := range strings.Split(list, ",") {
+		if strings.EqualFold(strings.TrimSpace(s), id) {
+			return true
+		}
+	}
+	return false
+}
+
 // UseProvider to produce a config that can be used to authenticate to AWS.
 // Deprecated: Use UseProviderConfig.
 func UseProvider(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
@@ -227,54 +291,40 @@ func UseProviderSecret(_ context.Context, data []byte, profile, region string) (
 }
 
 // UsePodServiceAccount assumes an IAM role configured via a ServiceAccount.
+// The credentials are refreshed before they expire.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
-//
-// TODO(hasheddan): This should be replaced by the implementation of the Web
-// Identity Token Provider in the following PR after merge and subsequent
-// release of AWS SDK: https://github.com/aws/aws-sdk-go-v2/pull/488
 func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws.Config, error) {
-	cfg, err := external.LoadDefaultAWSConfig()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load default AWS config")
-	}
-	cfg.Region = region
-	svc := sts.New(cfg)
+	return usePodServiceAccount(ctx, region, nil)
+}
 
-	b, err := ioutil.ReadFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read web identity token file in pod")
-	}
-	token := string(b)
-	sess := strconv.FormatInt(time.Now().UnixNano(), 10)
-	role := os.Getenv("AWS_ROLE_ARN")
-	resp, err := svc.AssumeRoleWithWebIdentityRequest(
-		&sts.AssumeRoleWithWebIdentityInput{
-			RoleSessionName:  &sess,
-			WebIdentityToken: &token,
-			RoleArn:          &role,
-		}).Send(ctx)
+// usePodServiceAccount assumes an IAM role configured via a ServiceAccount.
+// The role is assumed through the STS endpoint described by the given
+// endpoint configuration, if any.
+func usePodServiceAccount(ctx context.Context, region string, ec *v1beta1.EndpointConfig) (*aws.Config, error) {
+	dc, err := external.LoadDefaultAWSConfig()
 	if err != nil {
-		return nil, err
-	}
-	creds := aws.Credentials{
-		AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
-		SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
-		SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	shared := external.SharedConfig{
-		Credentials: creds,
-		Region:      region,
+	dc.Region = region
+	cfg := UseEndpointConfig(&dc, ec)
+	cfg.Credentials = stscreds.NewWebIdentityRoleProvider(sts.New(*cfg), os.Getenv("AWS_ROLE_ARN"), "",
+		stscreds.IdentityTokenFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")),
+		func(o *stscreds.WebIdentityRoleProviderOptions) {
+			o.ExpiryWindow = DefaultAssumeRoleExpiryWindow
+		})
+	if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+		return nil, errors.Wrap(err, "cannot assume role with web identity")
 	}
-	config, err := external.LoadDefaultAWSConfig(shared)
-	return &config, err
+	return cfg, nil
 }
 
 // NOTE(muvaf): ACK-generated controllers use aws/aws-sdk-go instead of
 // aws/aws-sdk-go-v2. These functions are implemented to be used by those controllers.
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
-// API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+// API by the AWSv1 clients. The configs are cached the same way as in
+// GetProviderConfigConfig.
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -287,29 +337,41 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+
+	data, version, err := GetCredentialsData(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := DefaultConfigCache.GetConfigV1(configCacheKey(pc, region), version, func() (*awsv1.Config, error) {
+		return NewProviderConfigConfigV1(ctx, pc, data, region)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return session.NewSession(SetResolverV1(ctx, mg, cfg))
+}
+
+// NewProviderConfigConfigV1 returns a V1 config for the given region that
+// uses the credentials configured in the given ProviderConfig.
+func NewProviderConfigConfigV1(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*awsv1.Config, error) {
+	var cfg *awsv1.Config
+	var err error
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
-		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, mg, DefaultSection, region)
+		cfg, err = usePodServiceAccountV1(ctx, region, pc.Spec.Endpoint)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use pod service account")
 		}
-		return session.NewSession(cfg)
 	case xpv1.CredentialsSourceSecret:
-		csr := pc.Spec.Credentials.SecretRef
-		if csr == nil {
-			return nil, errors.New("no credentials secret referenced")
-		}
-		s := &corev1.Secret{}
-		if err := c.Get(ctx, types.NamespacedName{Namespace: csr.Namespace, Name: csr.Name}, s); err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials secret")
-		}
-		cfg, err := UseProviderSecretV1(ctx, s.Data[csr.Key], mg, DefaultSection, region)
+		cfg, err = UseProviderSecretV1(ctx, data, DefaultSection, region)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot use secret")
 		}
-		return session.NewSession(cfg)
+	default:
+		return nil, errors.Errorf("credentials source %s is not currently supported", s)
 	}
-	return nil, errors.Errorf("credentials source %s is not currently supported", pc.Spec.Credentials.Source)
+	cfg, err = UseAssumeRoleChainV1(UseEndpointConfigV1(cfg, pc.Spec.Endpoint), pc.Spec.AssumeRoleChain)
+	return cfg, errors.Wrap(err, "cannot assume role chain")
 }
 
 // UseProviderSecretV1 retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from
@@ -318,64 +380,38 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 // [default]
 // aws_access_key_id = <YOUR_ACCESS_KEY_ID>
 // aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
-func UseProviderSecretV1(ctx context.Context, data []byte, mg resource.Managed, profile, region string) (*awsv1.Config, error) {
-	config, err := ini.InsensitiveLoad(data)
+func UseProviderSecretV1(_ context.Context, data []byte, profile, region string) (*awsv1.Config, error) {
+	creds, err := CredentialsIDSecret(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
 	}
-
-	iniProfile, err := config.GetSection(profile)
-	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("cannot get %s profile in credentials secret", profile))
-	}
-
-	accessKeyID := iniProfile.Key("aws_access_key_id")
-	secretAccessKey := iniProfile.Key("aws_secret_access_key")
-	sessionToken := iniProfile.Key("aws_session_token")
-
-	// NOTE(muvaf): Key function implementation never returns nil but still its
-	// type is pointer so we check to make sure its next versions doesn't break
-	// that implicit contract.
-	if accessKeyID == nil || secretAccessKey == nil || sessionToken == nil {
-		return nil, errors.New("returned key can be empty but cannot be nil")
-	}
-
-	creds := credentials.NewStaticCredentials(accessKeyID.Value(), secretAccessKey.Value(), sessionToken.Value())
-	return SetResolverV1(ctx, mg, awsv1.NewConfig().WithCredentials(creds).WithRegion(region)), nil
+	return awsv1.NewConfig().
+		WithCredentials(credentials.NewStaticCredentials(creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken)).
+		WithRegion(region), nil
 }
 
 // UsePodServiceAccountV1 assumes an IAM role configured via a ServiceAccount.
+// The credentials are refreshed before they expire.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
-func UsePodServiceAccountV1(ctx context.Context, _ []byte, mg resource.Managed, _, region string) (*awsv1.Config, error) {
-	cfg, err := external.LoadDefaultAWSConfig()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load default AWS config")
-	}
-	cfg.Region = region
-	svc := sts.New(cfg)
+func UsePodServiceAccountV1(ctx context.Context, _ []byte, _, region string) (*awsv1.Config, error) {
+	return usePodServiceAccountV1(ctx, region, nil)
+}
 
-	b, err := ioutil.ReadFile(os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to read web identity token file in pod")
-	}
-	token := string(b)
-	sess := strconv.FormatInt(time.Now().UnixNano(), 10)
-	role := os.Getenv("AWS_ROLE_ARN")
-	resp, err := svc.AssumeRoleWithWebIdentityRequest(
-		&sts.AssumeRoleWithWebIdentityInput{
-			RoleSessionName:  &sess,
-			WebIdentityToken: &token,
-			RoleArn:          &role,
-		}).Send(ctx)
+// usePodServiceAccountV1 assumes an IAM role configured via a ServiceAccount.
+// The role is assumed through the STS endpoint described by the given
+// endpoint configuration, if any.
+func usePodServiceAccountV1(ctx context.Context, region string, ec *v1beta1.EndpointConfig) (*awsv1.Config, error) {
+	sess, err := session.NewSession(UseEndpointConfigV1(awsv1.NewConfig().WithRegion(region), ec))
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "failed to create AWS session")
 	}
-	creds := credentials.NewStaticCredentials(
-		aws.StringValue(resp.Credentials.AccessKeyId),
-		aws.StringValue(resp.Credentials.SecretAccessKey),
-		aws.StringValue(resp.Credentials.SessionToken))
-
-	return SetResolverV1(ctx, mg, awsv1.NewConfig().WithCredentials(creds).WithRegion(region)), nil
+	p := stscredsv1.NewWebIdentityRoleProvider(stsv1.New(sess), os.Getenv("AWS_ROLE_ARN"), "", os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"))
+	p.ExpiryWindow = DefaultAssumeRoleExpiryWindow
+	creds := credentials.NewCredentials(p)
+	if _, err := creds.GetWithContext(ctx); err != nil {
+		return nil, errors.Wrap(err, "cannot assume role with web identity")
+	}
+	return awsv1.NewConfig().WithCredentials(creds).WithRegion(region), nil
 }
 
 // SetResolverV1 parses annotations from the managed resource
@@ -390,12 +426,16 @@ func SetResolverV1(ctx context.Context, mg resource.Managed, cfg *awsv1.Config)
 				endpoint.SigningRegion = Region
 			}
 
+			defaultResolver := endpointsv1.DefaultResolver()
+			if cfg.EndpointResolver != nil {
+				defaultResolver = cfg.EndpointResolver
+			}
 			endpointResolver := func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
-				if strings.Contains(ServiceID, service) {
+				if containsServiceID(ServiceID, service) {
 					return endpoint, nil
 				}
 
-				return endpointsv1.DefaultResolver().EndpointFor(service, region, optFns...)
+				return defaultResolver.EndpointFor(service, region, optFns...)
 			}
 			cfg.EndpointResolver = endpointsv1.ResolverFunc(endpointResolver)
 		}
@@ -403,6 +443,102 @@ func SetResolverV1(ctx context.Context, mg resource.Managed, cfg *awsv1.Config)
 	return cfg
 }
 
+// DefaultAssumeRoleExpiryWindow is the duration before the expiration of
+// assumed role credentials at which they are considered expired and get
+// refreshed.
+const DefaultAssumeRoleExpiryWindow = 1 * time.Minute
+
+// DefaultAssumeRoleDuration is the duration of an assumed role session when
+// none is specified.
+const DefaultAssumeRoleDuration = 15 * time.Minute
+
+// AssumeRoler is the subset of STS operations used to assume roles.
+type AssumeRoler interface {
+	AssumeRoleRequest(input *sts.AssumeRoleInput) sts.AssumeRoleRequest
+}
+
+// UseAssumeRoleChain returns a config whose credentials are obtained by
+// assuming the given roles in order, starting with the credentials of the
+// given config.
+func UseAssumeRoleChain(cfg *aws.Config, chain []v1beta1.AssumeRoleOptions) *aws.Config {
+	for _, o := range chain {
+		c := cfg.Copy()
+		c.Credentials = NewAssumeRoleCredentialsProvider(sts.New(*cfg), o)
+		cfg = &c
+	}
+	return cfg
+}
+
+// NewAssumeRoleCredentialsProvider returns a credentials provider that assumes
+// the given role and refreshes the credentials before they expire.
+func NewAssumeRoleCredentialsProvider(client AssumeRoler, o v1beta1.AssumeRoleOptions) aws.CredentialsProvider {
+	p := &aws.SafeCredentialsProvider{}
+	p.RetrieveFn = func() (aws.Credentials, error) {
+		resp, err := client.AssumeRoleRequest(GenerateAssumeRoleInput(o)).Send(context.Background())
+		if err != nil {
+			return aws.Credentials{}, errors.Wrapf(err, "cannot assume role %s", o.RoleARN)
+		}
+		return aws.Credentials{
+			AccessKeyID:     aws.StringValue(resp.Credentials.AccessKeyId),
+			SecretAccessKey: aws.StringValue(resp.Credentials.SecretAccessKey),
+			SessionToken:    aws.StringValue(resp.Credentials.SessionToken),
+			Source:          stscreds.ProviderName,
+			CanExpire:       true,
+			Expires:         aws.TimeValue(resp.Credentials.Expiration).Add(-DefaultAssumeRoleExpiryWindow),
+		}, nil
+	}
+	return p
+}
+
+// GenerateAssumeRoleInput returns the input for the AssumeRole call from the
+// given options.
+func GenerateAssumeRoleInput(o v1beta1.AssumeRoleOptions) *sts.AssumeRoleInput {
+	in := &sts.AssumeRoleInput{
+		RoleArn:           aws.String(o.RoleARN),
+		RoleSessionName:   o.SessionName,
+		ExternalId:        o.ExternalID,
+		DurationSeconds:   aws.Int64(int64(DefaultAssumeRoleDuration / time.Second)),
+		TransitiveTagKeys: o.TransitiveTagKeys,
+	}
+	if in.RoleSessionName == nil {
+		in.RoleSessionName = aws.String(strconv.FormatInt(time.Now().UnixNano(), 10))
+	}
+	if o.Duration != nil {
+		in.DurationSeconds = aws.Int64(int64(o.Duration.Duration / time.Second))
+	}
+	for _, t := range o.Tags {
+		in.Tags = append(in.Tags, sts.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	return in
+}
+
+// UseAssumeRoleChainV1 returns a V1 config whose credentials are obtained by
+// assuming the given roles in order, starting with the credentials of the
+// given config.
+func UseAssumeRoleChainV1(cfg *awsv1.Config, chain []v1beta1.AssumeRoleOptions) (*awsv1.Config, error) {
+	for _, o := range chain {
+		sess, err := session.NewSession(cfg)
+		if err != nil {
+			return nil, err
+		}
+		c := cfg.Copy().WithCredentials(stscredsv1.NewCredentialsWithClient(stsv1.New(sess), o.RoleARN, func(p *stscredsv1.AssumeRoleProvider) {
+			p.RoleSessionName = awsv1.StringValue(o.SessionName)
+			p.ExternalID = o.ExternalID
+			p.ExpiryWindow = DefaultAssumeRoleExpiryWindow
+			p.Duration = DefaultAssumeRoleDuration
+			if o.Duration != nil {
+				p.Duration = o.Duration.Duration
+			}
+			for _, t := range o.Tags {
+				p.Tags = append(p.Tags, &stsv1.Tag{Key: awsv1.String(t.Key), Value: awsv1.String(t.Value)})
+			}
+			p.TransitiveTagKeys = awsv1.StringSlice(o.TransitiveTagKeys)
+		}))
+		cfg = c
+	}
+	return cfg, nil
+}
+
 // TODO(muvaf): All the types that use CreateJSONPatch are known during
 // development time. In order to avoid unnecessary panic checks, we can generate
 // the code that creates a patch between two objects that share the same type.
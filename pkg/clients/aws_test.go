@@ -19,13 +19,23 @@ package aws
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 const (
@@ -454,3 +464,129 @@ func TestDiffLabels(t *testing.T) {
 		})
 	}
 }
+
+type mockAssumeRoler struct {
+	MockAssumeRoleRequest func(input *sts.AssumeRoleInput) sts.AssumeRoleRequest
+}
+
+func (m *mockAssumeRoler) AssumeRoleRequest(input *sts.AssumeRoleInput) sts.AssumeRoleRequest {
+	return m.MockAssumeRoleRequest(input)
+}
+
+func TestGenerateAssumeRoleInput(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/crossplane"
+	cases := map[string]struct {
+		in   v1beta1.AssumeRoleOptions
+		want *sts.AssumeRoleInput
+	}{
+		"AllFields": {
+			in: v1beta1.AssumeRoleOptions{
+				RoleARN:           roleARN,
+				ExternalID:        aws.String("external"),
+				SessionName:       aws.String("session"),
+				Duration:          &metav1.Duration{Duration: time.Hour},
+				Tags:              []v1beta1.Tag{{Key: "team", Value: "platform"}},
+				TransitiveTagKeys: []string{"team"},
+			},
+			want: &sts.AssumeRoleInput{
+				RoleArn:           aws.String(roleARN),
+				ExternalId:        aws.String("external"),
+				RoleSessionName:   aws.String("session"),
+				DurationSeconds:   aws.Int64(3600),
+				Tags:              []sts.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+				TransitiveTagKeys: []string{"team"},
+			},
+		},
+		"GeneratedSessionName": {
+			in: v1beta1.AssumeRoleOptions{
+				RoleARN: roleARN,
+			},
+			want: &sts.AssumeRoleInput{
+				RoleArn:         aws.String(roleARN),
+				DurationSeconds: aws.Int64(900),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAssumeRoleInput(tc.in)
+			if got.RoleSessionName == nil {
+				t.Errorf("RoleSessionName: expected a generated session name")
+			}
+			if tc.in.SessionName == nil {
+				got.RoleSessionName = nil
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewAssumeRoleCredentialsProvider(t *testing.T) {
+	errBoom := errors.New("boom")
+	expiration := time.Now().Add(time.Hour)
+
+	type want struct {
+		creds aws.Credentials
+		err   error
+	}
+
+	cases := map[string]struct {
+		client AssumeRoler
+		want   want
+	}{
+		"Successful": {
+			client: &mockAssumeRoler{
+				MockAssumeRoleRequest: func(input *sts.AssumeRoleInput) sts.AssumeRoleRequest {
+					return sts.AssumeRoleRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &sts.AssumeRoleOutput{
+							Credentials: &sts.Credentials{
+								AccessKeyId:     aws.String("id"),
+								SecretAccessKey: aws.String("secret"),
+								SessionToken:    aws.String("token"),
+								Expiration:      &expiration,
+							},
+						}},
+					}
+				},
+			},
+			want: want{
+				creds: aws.Credentials{
+					AccessKeyID:     "id",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Source:          stscreds.ProviderName,
+					CanExpire:       true,
+					Expires:         expiration.Add(-DefaultAssumeRoleExpiryWindow),
+				},
+			},
+		},
+		"FailedAssumeRole": {
+			client: &mockAssumeRoler{
+				MockAssumeRoleRequest: func(input *sts.AssumeRoleInput) sts.AssumeRoleRequest {
+					return sts.AssumeRoleRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+					}
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot assume role arn"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := NewAssumeRoleCredentialsProvider(tc.client, v1beta1.AssumeRoleOptions{RoleARN: "arn"})
+			creds, err := p.Retrieve(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.creds, creds); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
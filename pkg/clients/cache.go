@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DefaultConfigCache is the ConfigCache shared by all controllers.
+var DefaultConfigCache = NewConfigCache()
+
+// A ConfigCache caches the AWS configs built from ProviderConfigs so that the
+// credentials, including the temporary ones obtained from STS, are reused
+// across reconciles. A cached config is rebuilt when its version changes and
+// evicted when its ProviderConfig is deleted. The credentials providers of the
+// cached configs refresh expired credentials on their own.
+type ConfigCache struct {
+	mu        sync.Mutex
+	configs   map[string]*configCacheEntry
+	configsV1 map[string]*configCacheEntry
+}
+
+type configCacheEntry struct {
+	mu      sync.Mutex
+	version string
+	cfg     *aws.Config
+	cfgV1   *awsv1.Config
+}
+
+// NewConfigCache returns an empty ConfigCache.
+func NewConfigCache() *ConfigCache {
+	return &ConfigCache{
+		configs:   map[string]*configCacheEntry{},
+		configsV1: map[string]*configCacheEntry{},
+	}
+}
+
+// GetConfig returns a copy of the config cached with the given key. The config
+// is built using the supplied function if it's not cached yet or if it was
+// cached with a different version.
+func (cc *ConfigCache) GetConfig(key, version string, build func() (*aws.Config, error)) (*aws.Config, error) {
+	e := cc.entry(cc.configs, key)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cfg == nil || e.version != version {
+		cfg, err := build()
+		if err != nil {
+			return nil, err
+		}
+		e.cfg, e.version = cfg, version
+	}
+	cfg := e.cfg.Copy()
+	return &cfg, nil
+}
+
+// GetConfigV1 returns a copy of the V1 config cached with the given key. The
+// config is built using the supplied function if it's not cached yet or if it
+// was cached with a different version.
+func (cc *ConfigCache) GetConfigV1(key, version string, build func() (*awsv1.Config, error)) (*awsv1.Config, error) {
+	e := cc.entry(cc.configsV1, key)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.cfgV1 == nil || e.version != version {
+		cfg, err := build()
+		if err != nil {
+			return nil, err
+		}
+		e.cfgV1, e.version = cfg, version
+	}
+	return e.cfgV1.Copy(), nil
+}
+
+// Evict removes the configs of the named ProviderConfig for all regions from
+// the cache.
+func (cc *ConfigCache) Evict(name string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	for _, m := range []map[string]*configCacheEntry{cc.configs, cc.configsV1} {
+		for key := range m {
+			if strings.HasPrefix(key, name+"/") {
+				delete(m, key)
+			}
+		}
+	}
+}
+
+func (cc *ConfigCache) entry(m map[string]*configCacheEntry, key string) *configCacheEntry {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	e, ok := m[key]
+	if !ok {
+		e = &configCacheEntry{}
+		m[key] = e
+	}
+	return e
+}
+
+// configCacheKey returns the key of the configs of given ProviderConfig for
+// the given region. ProviderConfig names cannot contain "/".
+func configCacheKey(pc *v1beta1.ProviderConfig, region string) string {
+	return pc.GetName() + "/" + region
+}
+
+// configCacheVersion returns the version of the configs built from the given
+// ProviderConfig and its credentials Secret, if any. The generation of the
+// ProviderConfig is used rather than its resource version so that status
+// updates, like the ones of the health checks, do not rebuild the configs.
+// The resource version of the Secret changes whenever the credentials do.
+func configCacheVersion(pc *v1beta1.ProviderConfig, s *corev1.Secret) string {
+	v := strconv.FormatInt(pc.GetGeneration(), 10)
+	if s != nil {
+		v += "/" + s.GetResourceVersion()
+	}
+	return v
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestConfigCacheGetConfig(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type call struct {
+		version  string
+		buildErr error
+	}
+	type want struct {
+		builds int
+		err    error
+	}
+
+	cases := map[string]struct {
+		calls []call
+		want  want
+	}{
+		"BuildOnce": {
+			calls: []call{{version: "1"}, {version: "1"}, {version: "1"}},
+			want:  want{builds: 1},
+		},
+		"RebuildOnNewVersion": {
+			calls: []call{{version: "1"}, {version: "2"}, {version: "2"}},
+			want:  want{builds: 2},
+		},
+		"ErrorsAreNotCached": {
+			calls: []call{{version: "1", buildErr: errBoom}, {version: "1"}},
+			want:  want{builds: 2},
+		},
+		"BuildError": {
+			calls: []call{{version: "1", buildErr: errBoom}},
+			want:  want{builds: 1, err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cc := NewConfigCache()
+			builds := 0
+			var err error
+			for _, c := range tc.calls {
+				c := c
+				_, err = cc.GetConfig("pc/us-east-1", c.version, func() (*aws.Config, error) {
+					builds++
+					if c.buildErr != nil {
+						return nil, c.buildErr
+					}
+					return &aws.Config{Region: "us-east-1"}, nil
+				})
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.builds, builds); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigCacheReturnsCopies(t *testing.T) {
+	cc := NewConfigCache()
+	build := func() (*aws.Config, error) { return &aws.Config{Region: "us-east-1"}, nil }
+	buildV1 := func() (*awsv1.Config, error) { return awsv1.NewConfig().WithRegion("us-east-1"), nil }
+
+	cfg, _ := cc.GetConfig("pc/us-east-1", "1", build)
+	cfg.Region = "eu-west-1"
+	cfg, _ = cc.GetConfig("pc/us-east-1", "1", build)
+	if diff := cmp.Diff("us-east-1", cfg.Region); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+
+	cfgV1, _ := cc.GetConfigV1("pc/us-east-1", "1", buildV1)
+	cfgV1.Region = awsv1.String("eu-west-1")
+	cfgV1, _ = cc.GetConfigV1("pc/us-east-1", "1", buildV1)
+	if diff := cmp.Diff("us-east-1", awsv1.StringValue(cfgV1.Region)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestConfigCacheEvict(t *testing.T) {
+	cc := NewConfigCache()
+	builds := 0
+	build := func() (*aws.Config, error) { builds++; return &aws.Config{}, nil }
+	buildV1 := func() (*awsv1.Config, error) { builds++; return awsv1.NewConfig(), nil }
+
+	for _, key := range []string{"pc/us-east-1", "pc/eu-west-1", "pc-other/us-east-1"} {
+		_, _ = cc.GetConfig(key, "1", build)
+		_, _ = cc.GetConfigV1(key, "1", buildV1)
+	}
+	cc.Evict("pc")
+	builds = 0
+	for _, key := range []string{"pc/us-east-1", "pc/eu-west-1", "pc-other/us-east-1"} {
+		_, _ = cc.GetConfig(key, "1", build)
+		_, _ = cc.GetConfigV1(key, "1", buildV1)
+	}
+
+	// Only the configs of the evicted ProviderConfig should be rebuilt.
+	if diff := cmp.Diff(4, builds); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestConfigCacheVersion(t *testing.T) {
+	pc := func(generation int64, resourceVersion string) *v1beta1.ProviderConfig {
+		return &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Generation: generation, ResourceVersion: resourceVersion}}
+	}
+	secret := func(resourceVersion string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{ResourceVersion: resourceVersion}}
+	}
+
+	cases := map[string]struct {
+		a, b     string
+		wantSame bool
+	}{
+		"StatusUpdate": {
+			a:        configCacheVersion(pc(1, "10"), secret("5")),
+			b:        configCacheVersion(pc(1, "11"), secret("5")),
+			wantSame: true,
+		},
+		"SpecUpdate": {
+			a: configCacheVersion(pc(1, "10"), nil),
+			b: configCacheVersion(pc(2, "11"), nil),
+		},
+		"SecretUpdate": {
+			a: configCacheVersion(pc(1, "10"), secret("5")),
+			b: configCacheVersion(pc(1, "10"), secret("6")),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantSame, tc.a == tc.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
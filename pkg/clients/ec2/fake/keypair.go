@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.KeyPairClient = (*MockKeyPairClient)(nil)
+
+// MockKeyPairClient is a type that implements all the methods for KeyPairClient interface
+type MockKeyPairClient struct {
+	MockCreate     func(*ec2.CreateKeyPairInput) ec2.CreateKeyPairRequest
+	MockImport     func(*ec2.ImportKeyPairInput) ec2.ImportKeyPairRequest
+	MockDescribe   func(*ec2.DescribeKeyPairsInput) ec2.DescribeKeyPairsRequest
+	MockDelete     func(*ec2.DeleteKeyPairInput) ec2.DeleteKeyPairRequest
+	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateKeyPairRequest mocks CreateKeyPairRequest method
+func (m *MockKeyPairClient) CreateKeyPairRequest(input *ec2.CreateKeyPairInput) ec2.CreateKeyPairRequest {
+	return m.MockCreate(input)
+}
+
+// ImportKeyPairRequest mocks ImportKeyPairRequest method
+func (m *MockKeyPairClient) ImportKeyPairRequest(input *ec2.ImportKeyPairInput) ec2.ImportKeyPairRequest {
+	return m.MockImport(input)
+}
+
+// DescribeKeyPairsRequest mocks DescribeKeyPairsRequest method
+func (m *MockKeyPairClient) DescribeKeyPairsRequest(input *ec2.DescribeKeyPairsInput) ec2.DescribeKeyPairsRequest {
+	return m.MockDescribe(input)
+}
+
+// DeleteKeyPairRequest mocks DeleteKeyPairRequest method
+func (m *MockKeyPairClient) DeleteKeyPairRequest(input *ec2.DeleteKeyPairInput) ec2.DeleteKeyPairRequest {
+	return m.MockDelete(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockKeyPairClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockKeyPairClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
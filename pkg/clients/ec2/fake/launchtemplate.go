@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.LaunchTemplateClient = (*MockLaunchTemplateClient)(nil)
+
+// MockLaunchTemplateClient is a type that implements all the methods for LaunchTemplateClient interface
+type MockLaunchTemplateClient struct {
+	MockCreate           func(*ec2.CreateLaunchTemplateInput) ec2.CreateLaunchTemplateRequest
+	MockDescribe         func(*ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+	MockDescribeVersions func(*ec2.DescribeLaunchTemplateVersionsInput) ec2.DescribeLaunchTemplateVersionsRequest
+	MockCreateVersion    func(*ec2.CreateLaunchTemplateVersionInput) ec2.CreateLaunchTemplateVersionRequest
+	MockModify           func(*ec2.ModifyLaunchTemplateInput) ec2.ModifyLaunchTemplateRequest
+	MockDelete           func(*ec2.DeleteLaunchTemplateInput) ec2.DeleteLaunchTemplateRequest
+	MockCreateTags       func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags       func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateLaunchTemplateRequest mocks CreateLaunchTemplateRequest method
+func (m *MockLaunchTemplateClient) CreateLaunchTemplateRequest(input *ec2.CreateLaunchTemplateInput) ec2.CreateLaunchTemplateRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeLaunchTemplatesRequest mocks DescribeLaunchTemplatesRequest method
+func (m *MockLaunchTemplateClient) DescribeLaunchTemplatesRequest(input *ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest {
+	return m.MockDescribe(input)
+}
+
+// DescribeLaunchTemplateVersionsRequest mocks DescribeLaunchTemplateVersionsRequest method
+func (m *MockLaunchTemplateClient) DescribeLaunchTemplateVersionsRequest(input *ec2.DescribeLaunchTemplateVersionsInput) ec2.DescribeLaunchTemplateVersionsRequest {
+	return m.MockDescribeVersions(input)
+}
+
+// CreateLaunchTemplateVersionRequest mocks CreateLaunchTemplateVersionRequest method
+func (m *MockLaunchTemplateClient) CreateLaunchTemplateVersionRequest(input *ec2.CreateLaunchTemplateVersionInput) ec2.CreateLaunchTemplateVersionRequest {
+	return m.MockCreateVersion(input)
+}
+
+// ModifyLaunchTemplateRequest mocks ModifyLaunchTemplateRequest method
+func (m *MockLaunchTemplateClient) ModifyLaunchTemplateRequest(input *ec2.ModifyLaunchTemplateInput) ec2.ModifyLaunchTemplateRequest {
+	return m.MockModify(input)
+}
+
+// DeleteLaunchTemplateRequest mocks DeleteLaunchTemplateRequest method
+func (m *MockLaunchTemplateClient) DeleteLaunchTemplateRequest(input *ec2.DeleteLaunchTemplateInput) ec2.DeleteLaunchTemplateRequest {
+	return m.MockDelete(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockLaunchTemplateClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockLaunchTemplateClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
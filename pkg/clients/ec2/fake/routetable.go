@@ -32,6 +32,7 @@ type MockRouteTableClient struct {
 	MockDescribe     func(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
 	MockCreateRoute  func(*ec2.CreateRouteInput) ec2.CreateRouteRequest
 	MockDeleteRoute  func(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
+	MockReplaceRoute func(*ec2.ReplaceRouteInput) ec2.ReplaceRouteRequest
 	MockAssociate    func(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
 	MockDisassociate func(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
 	MockCreateTags   func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
@@ -72,6 +73,11 @@ func (m *MockRouteTableClient) DeleteRouteRequest(input *ec2.DeleteRouteInput) e
 	return m.MockDeleteRoute(input)
 }
 
+// ReplaceRouteRequest mocks ReplaceRouteRequest method
+func (m *MockRouteTableClient) ReplaceRouteRequest(input *ec2.ReplaceRouteInput) ec2.ReplaceRouteRequest {
+	return m.MockReplaceRoute(input)
+}
+
 // CreateTagsRequest mocks CreateTagsInput method
 func (m *MockRouteTableClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
 	return m.MockCreateTags(input)
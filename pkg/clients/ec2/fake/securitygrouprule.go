@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SecurityGroupRuleClient = (*MockSecurityGroupRuleClient)(nil)
+
+// MockSecurityGroupRuleClient is a type that implements all the methods for SecurityGroupRuleClient interface
+type MockSecurityGroupRuleClient struct {
+	MockDescribe                 func(*ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
+	MockAuthorizeIngress         func(*ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
+	MockAuthorizeEgress          func(*ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	MockRevokeIngress            func(*ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
+	MockRevokeEgress             func(*ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
+	MockUpdateIngressDescription func(*ec2.UpdateSecurityGroupRuleDescriptionsIngressInput) ec2.UpdateSecurityGroupRuleDescriptionsIngressRequest
+	MockUpdateEgressDescription  func(*ec2.UpdateSecurityGroupRuleDescriptionsEgressInput) ec2.UpdateSecurityGroupRuleDescriptionsEgressRequest
+}
+
+// DescribeSecurityGroupsRequest mocks DescribeSecurityGroupsRequest method
+func (m *MockSecurityGroupRuleClient) DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest {
+	return m.MockDescribe(input)
+}
+
+// AuthorizeSecurityGroupIngressRequest mocks AuthorizeSecurityGroupIngressRequest method
+func (m *MockSecurityGroupRuleClient) AuthorizeSecurityGroupIngressRequest(input *ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest {
+	return m.MockAuthorizeIngress(input)
+}
+
+// AuthorizeSecurityGroupEgressRequest mocks AuthorizeSecurityGroupEgressRequest method
+func (m *MockSecurityGroupRuleClient) AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest {
+	return m.MockAuthorizeEgress(input)
+}
+
+// RevokeSecurityGroupIngressRequest mocks RevokeSecurityGroupIngressRequest method
+func (m *MockSecurityGroupRuleClient) RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest {
+	return m.MockRevokeIngress(input)
+}
+
+// RevokeSecurityGroupEgressRequest mocks RevokeSecurityGroupEgressRequest method
+func (m *MockSecurityGroupRuleClient) RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest {
+	return m.MockRevokeEgress(input)
+}
+
+// UpdateSecurityGroupRuleDescriptionsIngressRequest mocks UpdateSecurityGroupRuleDescriptionsIngressRequest method
+func (m *MockSecurityGroupRuleClient) UpdateSecurityGroupRuleDescriptionsIngressRequest(input *ec2.UpdateSecurityGroupRuleDescriptionsIngressInput) ec2.UpdateSecurityGroupRuleDescriptionsIngressRequest {
+	return m.MockUpdateIngressDescription(input)
+}
+
+// UpdateSecurityGroupRuleDescriptionsEgressRequest mocks UpdateSecurityGroupRuleDescriptionsEgressRequest method
+func (m *MockSecurityGroupRuleClient) UpdateSecurityGroupRuleDescriptionsEgressRequest(input *ec2.UpdateSecurityGroupRuleDescriptionsEgressInput) ec2.UpdateSecurityGroupRuleDescriptionsEgressRequest {
+	return m.MockUpdateEgressDescription(input)
+}
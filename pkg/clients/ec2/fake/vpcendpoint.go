@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPCEndpointClient = (*MockVPCEndpointClient)(nil)
+
+// MockVPCEndpointClient is a type that implements all the methods for VPCEndpointClient interface
+type MockVPCEndpointClient struct {
+	MockCreate     func(*ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	MockDescribe   func(*ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	MockModify     func(*ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	MockDelete     func(*ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	MockCreateTags func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+	MockDeleteTags func(*ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// CreateVpcEndpointRequest mocks CreateVpcEndpointRequest method
+func (m *MockVPCEndpointClient) CreateVpcEndpointRequest(input *ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeVpcEndpointsRequest mocks DescribeVpcEndpointsRequest method
+func (m *MockVPCEndpointClient) DescribeVpcEndpointsRequest(input *ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest {
+	return m.MockDescribe(input)
+}
+
+// ModifyVpcEndpointRequest mocks ModifyVpcEndpointRequest method
+func (m *MockVPCEndpointClient) ModifyVpcEndpointRequest(input *ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest {
+	return m.MockModify(input)
+}
+
+// DeleteVpcEndpointsRequest mocks DeleteVpcEndpointsRequest method
+func (m *MockVPCEndpointClient) DeleteVpcEndpointsRequest(input *ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest {
+	return m.MockDelete(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockVPCEndpointClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTags(input)
+}
+
+// DeleteTagsRequest mocks DeleteTagsRequest method
+func (m *MockVPCEndpointClient) DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest {
+	return m.MockDeleteTags(input)
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// InstanceNotFound is the code that is returned by ec2 when the given
+	// InstanceID is not valid
+	InstanceNotFound = "InvalidInstanceID.NotFound"
+)
+
+// InstanceClient is the external client used for Instance Custom Resource
+type InstanceClient interface {
+	RunInstancesRequest(input *ec2.RunInstancesInput) ec2.RunInstancesRequest
+	DescribeInstancesRequest(input *ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest
+	DescribeInstanceAttributeRequest(input *ec2.DescribeInstanceAttributeInput) ec2.DescribeInstanceAttributeRequest
+	ModifyInstanceAttributeRequest(input *ec2.ModifyInstanceAttributeInput) ec2.ModifyInstanceAttributeRequest
+	TerminateInstancesRequest(input *ec2.TerminateInstancesInput) ec2.TerminateInstancesRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewInstanceClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewInstanceClient(cfg aws.Config) InstanceClient {
+	return ec2.New(cfg)
+}
+
+// IsInstanceNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsInstanceNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == InstanceNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRunInstancesInput returns the input to launch a single instance with
+// the given parameters. The user data is expected to be resolved already and
+// is base64 encoded here. The client token makes the call idempotent.
+func GenerateRunInstancesInput(token string, p v1alpha1.InstanceParameters, userData *string) *ec2.RunInstancesInput {
+	in := &ec2.RunInstancesInput{
+		ClientToken:                       aws.String(token),
+		MinCount:                          aws.Int64(1),
+		MaxCount:                          aws.Int64(1),
+		ImageId:                           p.ImageID,
+		InstanceType:                      ec2.InstanceType(aws.StringValue(p.InstanceType)),
+		KeyName:                           p.KeyName,
+		SubnetId:                          p.SubnetID,
+		SecurityGroupIds:                  p.SecurityGroupIDs,
+		BlockDeviceMappings:               GenerateBlockDeviceMappings(p.BlockDeviceMappings),
+		EbsOptimized:                      p.EBSOptimized,
+		PrivateIpAddress:                  p.PrivateIPAddress,
+		DisableApiTermination:             p.DisableAPITermination,
+		InstanceInitiatedShutdownBehavior: ec2.ShutdownBehavior(aws.StringValue(p.InstanceInitiatedShutdownBehavior)),
+		UserData:                          encodeUserData(userData),
+	}
+	if p.IAMInstanceProfile != nil {
+		in.IamInstanceProfile = &ec2.IamInstanceProfileSpecification{}
+		if isARN(*p.IAMInstanceProfile) {
+			in.IamInstanceProfile.Arn = p.IAMInstanceProfile
+		} else {
+			in.IamInstanceProfile.Name = p.IAMInstanceProfile
+		}
+	}
+	if p.Monitoring != nil {
+		in.Monitoring = &ec2.RunInstancesMonitoringEnabled{Enabled: p.Monitoring}
+	}
+	if lt := p.LaunchTemplate; lt != nil {
+		in.LaunchTemplate = &ec2.LaunchTemplateSpecification{
+			LaunchTemplateName: lt.LaunchTemplateName,
+			Version:            lt.Version,
+		}
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeInstance,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateBlockDeviceMappings converts the given block device mappings to
+// their ec2 counterparts.
+func GenerateBlockDeviceMappings(mappings []v1alpha1.BlockDeviceMapping) []ec2.BlockDeviceMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	res := make([]ec2.BlockDeviceMapping, len(mappings))
+	for i, m := range mappings {
+		res[i] = ec2.BlockDeviceMapping{
+			DeviceName:  aws.String(m.DeviceName),
+			NoDevice:    m.NoDevice,
+			VirtualName: m.VirtualName,
+		}
+		if m.EBS != nil {
+			res[i].Ebs = &ec2.EbsBlockDevice{
+				DeleteOnTermination: m.EBS.DeleteOnTermination,
+				Encrypted:           m.EBS.Encrypted,
+				Iops:                m.EBS.IOPS,
+				KmsKeyId:            m.EBS.KMSKeyID,
+				SnapshotId:          m.EBS.SnapshotID,
+				VolumeSize:          m.EBS.VolumeSize,
+				VolumeType:          ec2.VolumeType(aws.StringValue(m.EBS.VolumeType)),
+			}
+		}
+	}
+	return res
+}
+
+// GenerateInstanceObservation is used to produce v1alpha1.InstanceObservation
+// from ec2.Instance.
+func GenerateInstanceObservation(i ec2.Instance) v1alpha1.InstanceObservation {
+	o := v1alpha1.InstanceObservation{
+		InstanceID:       aws.StringValue(i.InstanceId),
+		PrivateDNSName:   aws.StringValue(i.PrivateDnsName),
+		PrivateIPAddress: aws.StringValue(i.PrivateIpAddress),
+		PublicDNSName:    aws.StringValue(i.PublicDnsName),
+		PublicIPAddress:  aws.StringValue(i.PublicIpAddress),
+		VPCID:            aws.StringValue(i.VpcId),
+	}
+	if i.Placement != nil {
+		o.AvailabilityZone = aws.StringValue(i.Placement.AvailabilityZone)
+	}
+	if i.State != nil {
+		o.State = string(i.State.Name)
+	}
+	return o
+}
+
+// LateInitializeInstance fills the empty fields in
+// *v1alpha1.InstanceParameters with the values seen in ec2.Instance.
+func LateInitializeInstance(in *v1alpha1.InstanceParameters, i *ec2.Instance) {
+	if i == nil {
+		return
+	}
+	in.ImageID = awsclients.LateInitializeStringPtr(in.ImageID, i.ImageId)
+	in.InstanceType = awsclients.LateInitializeStringPtr(in.InstanceType, awsclients.String(string(i.InstanceType)))
+	in.KeyName = awsclients.LateInitializeStringPtr(in.KeyName, i.KeyName)
+	in.SubnetID = awsclients.LateInitializeStringPtr(in.SubnetID, i.SubnetId)
+	in.PrivateIPAddress = awsclients.LateInitializeStringPtr(in.PrivateIPAddress, i.PrivateIpAddress)
+	in.EBSOptimized = awsclients.LateInitializeBoolPtr(in.EBSOptimized, i.EbsOptimized)
+	if len(in.SecurityGroupIDs) == 0 {
+		in.SecurityGroupIDs = observedInstanceSecurityGroupIDs(*i)
+	}
+	if in.Monitoring == nil && i.Monitoring != nil {
+		in.Monitoring = aws.Bool(i.Monitoring.State == ec2.MonitoringStateEnabled)
+	}
+}
+
+// IsInstanceUpToDate checks whether the security groups and the tags of the
+// instance are up to date. The attributes that have to be described
+// separately are not checked.
+func IsInstanceUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	return IsInstanceSecurityGroupsUpToDate(p, i) && v1beta1.CompareTags(p.Tags, i.Tags)
+}
+
+// IsInstanceSecurityGroupsUpToDate returns true if the instance is in exactly
+// the desired security groups.
+func IsInstanceSecurityGroupsUpToDate(p v1alpha1.InstanceParameters, i ec2.Instance) bool {
+	groups := observedInstanceSecurityGroupIDs(i)
+	return len(difference(p.SecurityGroupIDs, groups)) == 0 && len(difference(groups, p.SecurityGroupIDs)) == 0
+}
+
+func observedInstanceSecurityGroupIDs(i ec2.Instance) []string {
+	if len(i.SecurityGroups) == 0 {
+		return nil
+	}
+	ids := make([]string, len(i.SecurityGroups))
+	for k, g := range i.SecurityGroups {
+		ids[k] = aws.StringValue(g.GroupId)
+	}
+	return ids
+}
+
+func encodeUserData(d *string) *string {
+	if d == nil {
+		return nil
+	}
+	return aws.String(base64.StdEncoding.EncodeToString([]byte(*d)))
+}
+
+func isARN(s string) bool {
+	return strings.HasPrefix(s, "arn:")
+}
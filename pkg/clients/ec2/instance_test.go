@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	instanceToken    = "some uid"
+	instanceImageID  = "ami-123"
+	instanceType     = "t3.micro"
+	instanceSubnetID = "subnet-123"
+)
+
+func TestGenerateRunInstancesInput(t *testing.T) {
+	type args struct {
+		p        v1alpha1.InstanceParameters
+		userData *string
+	}
+
+	cases := map[string]struct {
+		args args
+		want *ec2.RunInstancesInput
+	}{
+		"AllFields": {
+			args: args{
+				p: v1alpha1.InstanceParameters{
+					ImageID:            aws.String(instanceImageID),
+					InstanceType:       aws.String(instanceType),
+					SubnetID:           aws.String(instanceSubnetID),
+					SecurityGroupIDs:   []string{"sg-a"},
+					IAMInstanceProfile: aws.String("bastion"),
+					Monitoring:         aws.Bool(true),
+					BlockDeviceMappings: []v1alpha1.BlockDeviceMapping{{
+						DeviceName: "/dev/xvda",
+						EBS:        &v1alpha1.EBSBlockDevice{VolumeSize: aws.Int64(20)},
+					}},
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{
+						LaunchTemplateName: aws.String("bastion"),
+						Version:            aws.String("2"),
+					},
+					Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				userData: aws.String("#!/bin/bash\necho hello"),
+			},
+			want: &ec2.RunInstancesInput{
+				ClientToken:        aws.String(instanceToken),
+				MinCount:           aws.Int64(1),
+				MaxCount:           aws.Int64(1),
+				ImageId:            aws.String(instanceImageID),
+				InstanceType:       ec2.InstanceType(instanceType),
+				SubnetId:           aws.String(instanceSubnetID),
+				SecurityGroupIds:   []string{"sg-a"},
+				IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Name: aws.String("bastion")},
+				Monitoring:         &ec2.RunInstancesMonitoringEnabled{Enabled: aws.Bool(true)},
+				BlockDeviceMappings: []ec2.BlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &ec2.EbsBlockDevice{VolumeSize: aws.Int64(20)},
+				}},
+				LaunchTemplate: &ec2.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("bastion"),
+					Version:            aws.String("2"),
+				},
+				UserData: aws.String("IyEvYmluL2Jhc2gKZWNobyBoZWxsbw=="),
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeInstance,
+					Tags:         []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				}},
+			},
+		},
+		"ProfileARN": {
+			args: args{
+				p: v1alpha1.InstanceParameters{
+					IAMInstanceProfile: aws.String("arn:aws:iam::123456789012:instance-profile/bastion"),
+				},
+			},
+			want: &ec2.RunInstancesInput{
+				ClientToken:        aws.String(instanceToken),
+				MinCount:           aws.Int64(1),
+				MaxCount:           aws.Int64(1),
+				IamInstanceProfile: &ec2.IamInstanceProfileSpecification{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/bastion")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRunInstancesInput(instanceToken, tc.args.p, tc.args.userData)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsInstanceUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.InstanceParameters
+		i ec2.Instance
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{"sg-a", "sg-b"},
+					Tags:             []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				i: ec2.Instance{
+					SecurityGroups: []ec2.GroupIdentifier{{GroupId: aws.String("sg-b")}, {GroupId: aws.String("sg-a")}},
+					Tags:           []ec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+			want: true,
+		},
+		"DifferentSecurityGroups": {
+			args: args{
+				p: v1alpha1.InstanceParameters{
+					SecurityGroupIDs: []string{"sg-a"},
+				},
+				i: ec2.Instance{
+					SecurityGroups: []ec2.GroupIdentifier{{GroupId: aws.String("sg-b")}},
+				},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				p: v1alpha1.InstanceParameters{
+					Tags: []v1beta1.Tag{{Key: "k", Value: "v"}},
+				},
+				i: ec2.Instance{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceUpToDate(tc.args.p, tc.args.i)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
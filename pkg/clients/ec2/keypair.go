@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	// KeyPairNotFound is the code that is returned by ec2 when the given
+	// key name is not valid
+	KeyPairNotFound = "InvalidKeyPair.NotFound"
+)
+
+// KeyPairClient is the external client used for KeyPair Custom Resource
+type KeyPairClient interface {
+	CreateKeyPairRequest(input *ec2.CreateKeyPairInput) ec2.CreateKeyPairRequest
+	ImportKeyPairRequest(input *ec2.ImportKeyPairInput) ec2.ImportKeyPairRequest
+	DescribeKeyPairsRequest(input *ec2.DescribeKeyPairsInput) ec2.DescribeKeyPairsRequest
+	DeleteKeyPairRequest(input *ec2.DeleteKeyPairInput) ec2.DeleteKeyPairRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewKeyPairClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewKeyPairClient(cfg aws.Config) KeyPairClient {
+	return ec2.New(cfg)
+}
+
+// IsKeyPairNotFoundErr returns true if the error is because the item doesn't
+// exist
+func IsKeyPairNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == KeyPairNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateKeyPairTagSpecifications returns the tag specifications used to tag
+// a key pair on creation.
+func GenerateKeyPairTagSpecifications(p v1alpha1.KeyPairParameters) []ec2.TagSpecification {
+	if len(p.Tags) == 0 {
+		return nil
+	}
+	return []ec2.TagSpecification{
+		{
+			ResourceType: ec2.ResourceTypeKeyPair,
+			Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+		},
+	}
+}
+
+// GenerateKeyPairObservation is used to produce v1alpha1.KeyPairObservation
+// from ec2.KeyPairInfo.
+func GenerateKeyPairObservation(k ec2.KeyPairInfo) v1alpha1.KeyPairObservation {
+	return v1alpha1.KeyPairObservation{
+		KeyFingerprint: aws.StringValue(k.KeyFingerprint),
+		KeyPairID:      aws.StringValue(k.KeyPairId),
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// LaunchTemplateNotFound is the code that is returned by ec2 when the
+	// given launch template name is not valid
+	LaunchTemplateNotFound = "InvalidLaunchTemplateName.NotFoundException"
+
+	// LaunchTemplateVersionLatest selects the latest version of a launch
+	// template.
+	LaunchTemplateVersionLatest = "$Latest"
+)
+
+// LaunchTemplateClient is the external client used for LaunchTemplate Custom
+// Resource
+type LaunchTemplateClient interface {
+	CreateLaunchTemplateRequest(input *ec2.CreateLaunchTemplateInput) ec2.CreateLaunchTemplateRequest
+	DescribeLaunchTemplatesRequest(input *ec2.DescribeLaunchTemplatesInput) ec2.DescribeLaunchTemplatesRequest
+	DescribeLaunchTemplateVersionsRequest(input *ec2.DescribeLaunchTemplateVersionsInput) ec2.DescribeLaunchTemplateVersionsRequest
+	CreateLaunchTemplateVersionRequest(input *ec2.CreateLaunchTemplateVersionInput) ec2.CreateLaunchTemplateVersionRequest
+	ModifyLaunchTemplateRequest(input *ec2.ModifyLaunchTemplateInput) ec2.ModifyLaunchTemplateRequest
+	DeleteLaunchTemplateRequest(input *ec2.DeleteLaunchTemplateInput) ec2.DeleteLaunchTemplateRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewLaunchTemplateClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLaunchTemplateClient(cfg aws.Config) LaunchTemplateClient {
+	return ec2.New(cfg)
+}
+
+// IsLaunchTemplateNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsLaunchTemplateNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == LaunchTemplateNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateLaunchTemplateInput returns the input to create a launch
+// template with the given name and parameters.
+func GenerateCreateLaunchTemplateInput(name string, p v1alpha1.LaunchTemplateParameters) *ec2.CreateLaunchTemplateInput {
+	in := &ec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(name),
+		LaunchTemplateData: GenerateRequestLaunchTemplateData(p.LaunchTemplateData),
+		VersionDescription: p.VersionDescription,
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeLaunchTemplate,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateRequestLaunchTemplateData converts the given launch template data
+// to its ec2 counterpart.
+func GenerateRequestLaunchTemplateData(d v1alpha1.LaunchTemplateData) *ec2.RequestLaunchTemplateData {
+	r := &ec2.RequestLaunchTemplateData{
+		ImageId:                           d.ImageID,
+		InstanceType:                      ec2.InstanceType(aws.StringValue(d.InstanceType)),
+		KeyName:                           d.KeyName,
+		SecurityGroupIds:                  d.SecurityGroupIDs,
+		UserData:                          encodeUserData(d.UserData),
+		EbsOptimized:                      d.EBSOptimized,
+		DisableApiTermination:             d.DisableAPITermination,
+		InstanceInitiatedShutdownBehavior: ec2.ShutdownBehavior(aws.StringValue(d.InstanceInitiatedShutdownBehavior)),
+	}
+	if d.IAMInstanceProfile != nil {
+		r.IamInstanceProfile = &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+		if isARN(*d.IAMInstanceProfile) {
+			r.IamInstanceProfile.Arn = d.IAMInstanceProfile
+		} else {
+			r.IamInstanceProfile.Name = d.IAMInstanceProfile
+		}
+	}
+	if d.Monitoring != nil {
+		r.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{Enabled: d.Monitoring}
+	}
+	for _, m := range d.BlockDeviceMappings {
+		bdm := ec2.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName:  aws.String(m.DeviceName),
+			NoDevice:    m.NoDevice,
+			VirtualName: m.VirtualName,
+		}
+		if m.EBS != nil {
+			bdm.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+				DeleteOnTermination: m.EBS.DeleteOnTermination,
+				Encrypted:           m.EBS.Encrypted,
+				Iops:                m.EBS.IOPS,
+				KmsKeyId:            m.EBS.KMSKeyID,
+				SnapshotId:          m.EBS.SnapshotID,
+				VolumeSize:          m.EBS.VolumeSize,
+				VolumeType:          ec2.VolumeType(aws.StringValue(m.EBS.VolumeType)),
+			}
+		}
+		r.BlockDeviceMappings = append(r.BlockDeviceMappings, bdm)
+	}
+	return r
+}
+
+// GenerateLaunchTemplateData converts the data of an observed launch template
+// version to v1alpha1.LaunchTemplateData.
+func GenerateLaunchTemplateData(r *ec2.ResponseLaunchTemplateData) (v1alpha1.LaunchTemplateData, error) {
+	d := v1alpha1.LaunchTemplateData{}
+	if r == nil {
+		return d, nil
+	}
+	d.ImageID = r.ImageId
+	d.InstanceType = awsclients.String(string(r.InstanceType))
+	d.KeyName = r.KeyName
+	d.SecurityGroupIDs = r.SecurityGroupIds
+	d.EBSOptimized = r.EbsOptimized
+	d.DisableAPITermination = r.DisableApiTermination
+	d.InstanceInitiatedShutdownBehavior = awsclients.String(string(r.InstanceInitiatedShutdownBehavior))
+	if r.UserData != nil {
+		ud, err := base64.StdEncoding.DecodeString(*r.UserData)
+		if err != nil {
+			return d, err
+		}
+		d.UserData = aws.String(string(ud))
+	}
+	if r.IamInstanceProfile != nil {
+		d.IAMInstanceProfile = r.IamInstanceProfile.Arn
+		if d.IAMInstanceProfile == nil {
+			d.IAMInstanceProfile = r.IamInstanceProfile.Name
+		}
+	}
+	if r.Monitoring != nil {
+		d.Monitoring = r.Monitoring.Enabled
+	}
+	for _, m := range r.BlockDeviceMappings {
+		bdm := v1alpha1.BlockDeviceMapping{
+			DeviceName:  aws.StringValue(m.DeviceName),
+			NoDevice:    m.NoDevice,
+			VirtualName: m.VirtualName,
+		}
+		if m.Ebs != nil {
+			bdm.EBS = &v1alpha1.EBSBlockDevice{
+				DeleteOnTermination: m.Ebs.DeleteOnTermination,
+				Encrypted:           m.Ebs.Encrypted,
+				IOPS:                m.Ebs.Iops,
+				KMSKeyID:            m.Ebs.KmsKeyId,
+				SnapshotID:          m.Ebs.SnapshotId,
+				VolumeSize:          m.Ebs.VolumeSize,
+				VolumeType:          awsclients.String(string(m.Ebs.VolumeType)),
+			}
+		}
+		d.BlockDeviceMappings = append(d.BlockDeviceMappings, bdm)
+	}
+	return d, nil
+}
+
+// IsLaunchTemplateDataUpToDate returns true if the observed launch template
+// version has the desired data.
+func IsLaunchTemplateDataUpToDate(d v1alpha1.LaunchTemplateData, r *ec2.ResponseLaunchTemplateData) (bool, error) {
+	observed, err := GenerateLaunchTemplateData(r)
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(d, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreFields(v1alpha1.LaunchTemplateData{}, "KeyNameRef", "KeyNameSelector", "SecurityGroupIDRefs", "SecurityGroupIDSelector")), nil
+}
+
+// GenerateLaunchTemplateObservation is used to produce
+// v1alpha1.LaunchTemplateObservation from ec2.LaunchTemplate.
+func GenerateLaunchTemplateObservation(t ec2.LaunchTemplate) v1alpha1.LaunchTemplateObservation {
+	return v1alpha1.LaunchTemplateObservation{
+		DefaultVersionNumber: aws.Int64Value(t.DefaultVersionNumber),
+		LatestVersionNumber:  aws.Int64Value(t.LatestVersionNumber),
+		LaunchTemplateID:     aws.StringValue(t.LaunchTemplateId),
+	}
+}
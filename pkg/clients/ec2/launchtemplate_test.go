@@ -0,0 +1,182 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	ltImageID      = "ami-123"
+	ltInstanceType = "t3.micro"
+	ltProfileARN   = "arn:aws:iam::123456789012:instance-profile/nodes"
+	ltUserData     = "#!/bin/bash\necho hello"
+	// base64 of ltUserData
+	ltUserDataEncoded = "IyEvYmluL2Jhc2gKZWNobyBoZWxsbw=="
+)
+
+func launchTemplateData() v1alpha1.LaunchTemplateData {
+	return v1alpha1.LaunchTemplateData{
+		ImageID:            aws.String(ltImageID),
+		InstanceType:       aws.String(ltInstanceType),
+		SecurityGroupIDs:   []string{"sg-a", "sg-b"},
+		IAMInstanceProfile: aws.String(ltProfileARN),
+		UserData:           aws.String(ltUserData),
+		Monitoring:         aws.Bool(true),
+		BlockDeviceMappings: []v1alpha1.BlockDeviceMapping{{
+			DeviceName: "/dev/xvda",
+			EBS: &v1alpha1.EBSBlockDevice{
+				VolumeSize: aws.Int64(20),
+				VolumeType: aws.String("gp2"),
+			},
+		}},
+	}
+}
+
+func responseLaunchTemplateData() *ec2.ResponseLaunchTemplateData {
+	return &ec2.ResponseLaunchTemplateData{
+		ImageId:            aws.String(ltImageID),
+		InstanceType:       ec2.InstanceType(ltInstanceType),
+		SecurityGroupIds:   []string{"sg-b", "sg-a"},
+		IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecification{Arn: aws.String(ltProfileARN)},
+		UserData:           aws.String(ltUserDataEncoded),
+		Monitoring:         &ec2.LaunchTemplatesMonitoring{Enabled: aws.Bool(true)},
+		BlockDeviceMappings: []ec2.LaunchTemplateBlockDeviceMapping{{
+			DeviceName: aws.String("/dev/xvda"),
+			Ebs: &ec2.LaunchTemplateEbsBlockDevice{
+				VolumeSize: aws.Int64(20),
+				VolumeType: ec2.VolumeTypeGp2,
+			},
+		}},
+	}
+}
+
+func TestIsLaunchTemplateDataUpToDate(t *testing.T) {
+	type args struct {
+		d v1alpha1.LaunchTemplateData
+		r *ec2.ResponseLaunchTemplateData
+	}
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SameData": {
+			args: args{
+				d: launchTemplateData(),
+				r: responseLaunchTemplateData(),
+			},
+			want: want{upToDate: true},
+		},
+		"DifferentInstanceType": {
+			args: args{
+				d: func() v1alpha1.LaunchTemplateData {
+					d := launchTemplateData()
+					d.InstanceType = aws.String("m5.large")
+					return d
+				}(),
+				r: responseLaunchTemplateData(),
+			},
+			want: want{upToDate: false},
+		},
+		"DifferentVolumeSize": {
+			args: args{
+				d: func() v1alpha1.LaunchTemplateData {
+					d := launchTemplateData()
+					d.BlockDeviceMappings[0].EBS.VolumeSize = aws.Int64(50)
+					return d
+				}(),
+				r: responseLaunchTemplateData(),
+			},
+			want: want{upToDate: false},
+		},
+		"InvalidUserData": {
+			args: args{
+				d: launchTemplateData(),
+				r: func() *ec2.ResponseLaunchTemplateData {
+					r := responseLaunchTemplateData()
+					r.UserData = aws.String("not base64!")
+					return r
+				}(),
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsLaunchTemplateDataUpToDate(tc.args.d, tc.args.r)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRequestLaunchTemplateData(t *testing.T) {
+	cases := map[string]struct {
+		d    v1alpha1.LaunchTemplateData
+		want *ec2.RequestLaunchTemplateData
+	}{
+		"AllFields": {
+			d: launchTemplateData(),
+			want: &ec2.RequestLaunchTemplateData{
+				ImageId:            aws.String(ltImageID),
+				InstanceType:       ec2.InstanceType(ltInstanceType),
+				SecurityGroupIds:   []string{"sg-a", "sg-b"},
+				IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Arn: aws.String(ltProfileARN)},
+				UserData:           aws.String(ltUserDataEncoded),
+				Monitoring:         &ec2.LaunchTemplatesMonitoringRequest{Enabled: aws.Bool(true)},
+				BlockDeviceMappings: []ec2.LaunchTemplateBlockDeviceMappingRequest{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs: &ec2.LaunchTemplateEbsBlockDeviceRequest{
+						VolumeSize: aws.Int64(20),
+						VolumeType: ec2.VolumeTypeGp2,
+					},
+				}},
+			},
+		},
+		"ProfileName": {
+			d: v1alpha1.LaunchTemplateData{IAMInstanceProfile: aws.String("nodes")},
+			want: &ec2.RequestLaunchTemplateData{
+				IamInstanceProfile: &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{Name: aws.String("nodes")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateRequestLaunchTemplateData(tc.d)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
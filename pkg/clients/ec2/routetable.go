@@ -2,6 +2,9 @@ package ec2
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
@@ -28,6 +31,10 @@ const (
 
 	// AssociationIDNotFound is the code that is returned when then given AssociationID is invalid
 	AssociationIDNotFound = "InvalidAssociationID.NotFound"
+
+	// vpcEndpointIDPrefix is the prefix of the IDs of VPC endpoints, which
+	// are reported as the gateway of the routes that target them.
+	vpcEndpointIDPrefix = "vpce-"
 )
 
 // RouteTableClient is the external client used for RouteTable Custom Resource
@@ -37,6 +44,7 @@ type RouteTableClient interface {
 	DescribeRouteTablesRequest(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
 	CreateRouteRequest(*ec2.CreateRouteInput) ec2.CreateRouteRequest
 	DeleteRouteRequest(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
+	ReplaceRouteRequest(*ec2.ReplaceRouteInput) ec2.ReplaceRouteRequest
 	AssociateRouteTableRequest(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
 	DisassociateRouteTableRequest(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
 	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
@@ -89,9 +97,16 @@ func GenerateRTObservation(rt ec2.RouteTable) v1alpha4.RouteTableObservation {
 		o.Routes = make([]v1alpha4.RouteState, len(rt.Routes))
 		for i, rt := range rt.Routes {
 			o.Routes[i] = v1alpha4.RouteState{
-				State:                string(rt.State),
-				DestinationCIDRBlock: aws.StringValue(rt.DestinationCidrBlock),
-				GatewayID:            aws.StringValue(rt.GatewayId),
+				State:                    string(rt.State),
+				Origin:                   string(rt.Origin),
+				DestinationCIDRBlock:     aws.StringValue(rt.DestinationCidrBlock),
+				DestinationIPv6CIDRBlock: aws.StringValue(rt.DestinationIpv6CidrBlock),
+				DestinationPrefixListID:  aws.StringValue(rt.DestinationPrefixListId),
+				GatewayID:                aws.StringValue(rt.GatewayId),
+				NatGatewayID:             aws.StringValue(rt.NatGatewayId),
+				NetworkInterfaceID:       aws.StringValue(rt.NetworkInterfaceId),
+				TransitGatewayID:         aws.StringValue(rt.TransitGatewayId),
+				VPCPeeringConnectionID:   aws.StringValue(rt.VpcPeeringConnectionId),
 			}
 		}
 	}
@@ -120,12 +135,25 @@ func LateInitializeRT(in *v1alpha4.RouteTableParameters, rt *ec2.RouteTable) { /
 	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, rt.VpcId)
 
 	if len(in.Routes) == 0 && len(rt.Routes) != 0 {
-		in.Routes = make([]v1alpha4.Route, len(rt.Routes))
-		for i, val := range rt.Routes {
-			in.Routes[i] = v1alpha4.Route{
-				DestinationCIDRBlock: val.DestinationCidrBlock,
-				GatewayID:            val.GatewayId,
+		in.Routes = make([]v1alpha4.Route, 0, len(rt.Routes))
+		for _, val := range rt.Routes {
+			if isGatewayEndpointRoute(val) {
+				continue
+			}
+			r := v1alpha4.Route{
+				DestinationCIDRBlock:     val.DestinationCidrBlock,
+				DestinationIPv6CIDRBlock: val.DestinationIpv6CidrBlock,
+				DestinationPrefixListID:  val.DestinationPrefixListId,
+				GatewayID:                val.GatewayId,
+				NatGatewayID:             val.NatGatewayId,
+				NetworkInterfaceID:       val.NetworkInterfaceId,
+				TransitGatewayID:         val.TransitGatewayId,
+				VPCPeeringConnectionID:   val.VpcPeeringConnectionId,
+			}
+			if strings.HasPrefix(aws.StringValue(val.GatewayId), vpcEndpointIDPrefix) {
+				r.GatewayID, r.VPCEndpointID = nil, val.GatewayId
 			}
+			in.Routes = append(in.Routes, r)
 		}
 	}
 
@@ -153,10 +181,11 @@ func CreateRTPatch(in ec2.RouteTable, target v1alpha4.RouteTableParameters) (*v1
 
 	// Add the default route for fair comparison.
 	for _, val := range in.Routes {
-		if *val.GatewayId == LocalGatewayID {
+		if aws.StringValue(val.GatewayId) == LocalGatewayID {
 			target.Routes = append([]v1alpha4.Route{{
-				GatewayID:            val.GatewayId,
-				DestinationCIDRBlock: val.DestinationCidrBlock,
+				GatewayID:                val.GatewayId,
+				DestinationCIDRBlock:     val.DestinationCidrBlock,
+				DestinationIPv6CIDRBlock: val.DestinationIpv6CidrBlock,
 			}}, target.Routes...)
 		}
 	}
@@ -180,9 +209,184 @@ func IsRtUpToDate(p v1alpha4.RouteTableParameters, rt ec2.RouteTable) (bool, err
 	if err != nil {
 		return false, err
 	}
+	create, replace, remove := DiffRoutes(p.Routes, rt.Routes, aws.BoolValue(p.PruneRoutes))
 	return cmp.Equal(&v1alpha4.RouteTableParameters{}, patch,
 		cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
 		cmpopts.IgnoreFields(v1alpha4.RouteTableParameters{}, "Region"),
-	), nil
+		cmpopts.IgnoreFields(v1alpha4.RouteTableParameters{}, "Routes"),
+		cmpopts.IgnoreFields(v1alpha4.RouteTableParameters{}, "PruneRoutes"),
+	) && len(create) == 0 && len(replace) == 0 && len(remove) == 0, nil
+}
+
+// routeTarget is the target of a route. Only one of its fields is expected
+// to be set.
+type routeTarget struct {
+	gateway              string
+	natGateway           string
+	networkInterface     string
+	transitGateway       string
+	vpcEndpoint          string
+	vpcPeeringConnection string
+}
+
+func specRouteTarget(r v1alpha4.Route) routeTarget {
+	return routeTarget{
+		gateway:              aws.StringValue(r.GatewayID),
+		natGateway:           aws.StringValue(r.NatGatewayID),
+		networkInterface:     aws.StringValue(r.NetworkInterfaceID),
+		transitGateway:       aws.StringValue(r.TransitGatewayID),
+		vpcEndpoint:          aws.StringValue(r.VPCEndpointID),
+		vpcPeeringConnection: aws.StringValue(r.VPCPeeringConnectionID),
+	}
+}
+
+// observedRouteTarget ignores the instance ID since routes to an instance are
+// reported with the ID of its primary network interface as well. Routes to a
+// VPC endpoint are reported with its ID as the gateway.
+func observedRouteTarget(r ec2.Route) routeTarget {
+	t := routeTarget{
+		gateway:              aws.StringValue(r.GatewayId),
+		natGateway:           aws.StringValue(r.NatGatewayId),
+		networkInterface:     aws.StringValue(r.NetworkInterfaceId),
+		transitGateway:       aws.StringValue(r.TransitGatewayId),
+		vpcPeeringConnection: aws.StringValue(r.VpcPeeringConnectionId),
+	}
+	if strings.HasPrefix(t.gateway, vpcEndpointIDPrefix) {
+		t.gateway, t.vpcEndpoint = "", t.gateway
+	}
+	return t
+}
+
+// A route is identified by its destination. IPv4 and IPv6 CIDR blocks and
+// prefix list IDs never overlap, so their concatenation is used as the key.
+func specRouteDestination(r v1alpha4.Route) string {
+	return aws.StringValue(r.DestinationCIDRBlock) + aws.StringValue(r.DestinationIPv6CIDRBlock) + aws.StringValue(r.DestinationPrefixListID)
+}
+
+func observedRouteDestination(r ec2.Route) string {
+	return aws.StringValue(r.DestinationCidrBlock) + aws.StringValue(r.DestinationIpv6CidrBlock) + aws.StringValue(r.DestinationPrefixListId)
+}
+
+// isGatewayEndpointRoute returns true if the supplied route is added by a
+// gateway VPC endpoint, which routes the prefix list of its service to
+// itself.
+func isGatewayEndpointRoute(r ec2.Route) bool {
+	return r.DestinationPrefixListId != nil && strings.HasPrefix(aws.StringValue(r.GatewayId), vpcEndpointIDPrefix)
+}
+
+// DiffRoutes returns the routes that have to be created, the routes whose
+// target has to be replaced and the routes that have to be deleted so that
+// the observed routes match the desired ones. Routes are only deleted if
+// prune is true. The local route, the routes that are not created with
+// CreateRoute, such as propagated routes, and the routes of gateway VPC
+// endpoints are never changed.
+func DiffRoutes(desired []v1alpha4.Route, observed []ec2.Route, prune bool) (create, replace []v1alpha4.Route, remove []ec2.Route) {
+	current := make(map[string]ec2.Route, len(observed))
+	for _, r := range observed {
+		if isGatewayEndpointRoute(r) {
+			continue
+		}
+		current[observedRouteDestination(r)] = r
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	for _, r := range desired {
+		if aws.StringValue(r.GatewayID) == LocalGatewayID {
+			continue
+		}
+		dst := specRouteDestination(r)
+		wanted[dst] = struct{}{}
+		o, ok := current[dst]
+		switch {
+		case !ok:
+			create = append(create, r)
+		case observedRouteTarget(o) != specRouteTarget(r):
+			replace = append(replace, r)
+		}
+	}
+	if !prune {
+		return create, replace, nil
+	}
+	for _, r := range observed {
+		if r.Origin != ec2.RouteOriginCreateRoute || isGatewayEndpointRoute(r) {
+			continue
+		}
+		if _, ok := wanted[observedRouteDestination(r)]; !ok {
+			remove = append(remove, r)
+		}
+	}
+	return create, replace, remove
+}
+
+// GenerateCreateRouteInput returns the input to create the supplied route in
+// the route table with the supplied ID.
+func GenerateCreateRouteInput(tableID string, r v1alpha4.Route) *ec2.CreateRouteInput {
+	return &ec2.CreateRouteInput{
+		RouteTableId:             aws.String(tableID),
+		DestinationCidrBlock:     r.DestinationCIDRBlock,
+		DestinationIpv6CidrBlock: r.DestinationIPv6CIDRBlock,
+		GatewayId:                r.GatewayID,
+		NatGatewayId:             r.NatGatewayID,
+		NetworkInterfaceId:       r.NetworkInterfaceID,
+		TransitGatewayId:         r.TransitGatewayID,
+		VpcPeeringConnectionId:   r.VPCPeeringConnectionID,
+	}
+}
+
+// GenerateReplaceRouteInput returns the input to replace the target of the
+// supplied route in the route table with the supplied ID.
+func GenerateReplaceRouteInput(tableID string, r v1alpha4.Route) *ec2.ReplaceRouteInput {
+	return &ec2.ReplaceRouteInput{
+		RouteTableId:             aws.String(tableID),
+		DestinationCidrBlock:     r.DestinationCIDRBlock,
+		DestinationIpv6CidrBlock: r.DestinationIPv6CIDRBlock,
+		GatewayId:                r.GatewayID,
+		NatGatewayId:             r.NatGatewayID,
+		NetworkInterfaceId:       r.NetworkInterfaceID,
+		TransitGatewayId:         r.TransitGatewayID,
+		VpcPeeringConnectionId:   r.VPCPeeringConnectionID,
+	}
+}
+
+// NOTE: The vendored aws-sdk-go-v2 predates prefix list destinations and
+// Gateway Load Balancer endpoint targets of routes. WithRouteParameters adds
+// them to the query of the route requests until the SDK is bumped.
+
+// WithRouteParameters adds the supplied prefix list destination and VPC
+// endpoint target to the supplied CreateRoute, ReplaceRoute or DeleteRoute
+// request. Empty values are not added.
+func WithRouteParameters(r *aws.Request, prefixListID, vpcEndpointID *string) {
+	params := url.Values{}
+	if v := aws.StringValue(prefixListID); v != "" {
+		params.Set("DestinationPrefixListId", v)
+	}
+	if v := aws.StringValue(vpcEndpointID); v != "" {
+		params.Set("VpcEndpointId", v)
+	}
+	if len(params) == 0 {
+		return
+	}
+	r.Handlers.Build.PushBack(func(r *aws.Request) {
+		if r.Error != nil || r.Body == nil {
+			return
+		}
+		if _, err := r.Body.Seek(0, 0); err != nil {
+			r.Error = err
+			return
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		body, err := url.ParseQuery(string(b))
+		if err != nil {
+			r.Error = err
+			return
+		}
+		for k := range params {
+			body.Set(k, params.Get(k))
+		}
+		r.SetBufferBody([]byte(body.Encode()))
+	})
 }
@@ -1,9 +1,12 @@
 package ec2
 
 import (
+	"io/ioutil"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
 
@@ -170,3 +173,178 @@ func TestCreateRTPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestWithRouteParameters(t *testing.T) {
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.Credentials = aws.AnonymousCredentials
+	cfg.EndpointResolver = aws.ResolveWithEndpointURL("https://ec2.example.com")
+	c := ec2.New(cfg)
+
+	cases := map[string]struct {
+		req          *aws.Request
+		prefixListID *string
+		vpcEndpoint  *string
+		want         url.Values
+	}{
+		"CreateRoute": {
+			req:          c.CreateRouteRequest(&ec2.CreateRouteInput{RouteTableId: aws.String(rtID)}).Request,
+			prefixListID: aws.String("pl-123"),
+			vpcEndpoint:  aws.String("vpce-123"),
+			want: url.Values{
+				"Action":                  {"CreateRoute"},
+				"DestinationPrefixListId": {"pl-123"},
+				"RouteTableId":            {rtID},
+				"Version":                 {"2016-11-15"},
+				"VpcEndpointId":           {"vpce-123"},
+			},
+		},
+		"DeleteRoute": {
+			req:          c.DeleteRouteRequest(&ec2.DeleteRouteInput{RouteTableId: aws.String(rtID)}).Request,
+			prefixListID: aws.String("pl-123"),
+			want: url.Values{
+				"Action":                  {"DeleteRoute"},
+				"DestinationPrefixListId": {"pl-123"},
+				"RouteTableId":            {rtID},
+				"Version":                 {"2016-11-15"},
+			},
+		},
+		"Nothing": {
+			req: c.ReplaceRouteRequest(&ec2.ReplaceRouteInput{RouteTableId: aws.String(rtID), GatewayId: aws.String("igw")}).Request,
+			want: url.Values{
+				"Action":       {"ReplaceRoute"},
+				"GatewayId":    {"igw"},
+				"RouteTableId": {rtID},
+				"Version":      {"2016-11-15"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			WithRouteParameters(tc.req, tc.prefixListID, tc.vpcEndpoint)
+			if err := tc.req.Build(); err != nil {
+				t.Fatalf("Build(): %s", err)
+			}
+			b, err := ioutil.ReadAll(tc.req.GetBody())
+			if err != nil {
+				t.Fatalf("ioutil.ReadAll(): %s", err)
+			}
+			got, err := url.ParseQuery(string(b))
+			if err != nil {
+				t.Fatalf("url.ParseQuery(): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffRoutes(t *testing.T) {
+	natID := "some nat"
+	igID := "some ig"
+	defaultCIDR := "0.0.0.0/0"
+	peerCIDR := "10.1.0.0/16"
+	defaultIPv6CIDR := "::/0"
+	localCIDR := "10.0.0.0/16"
+	local := LocalGatewayID
+
+	type want struct {
+		create  []v1alpha4.Route
+		replace []v1alpha4.Route
+		remove  []ec2.Route
+	}
+
+	cases := map[string]struct {
+		desired  []v1alpha4.Route
+		observed []ec2.Route
+		prune    bool
+		want     want
+	}{
+		"UpToDate": {
+			desired: []v1alpha4.Route{
+				{DestinationCIDRBlock: &localCIDR, GatewayID: &local},
+				{DestinationCIDRBlock: &defaultCIDR, NatGatewayID: &natID},
+			},
+			observed: []ec2.Route{
+				{DestinationCidrBlock: &localCIDR, GatewayId: &local, Origin: ec2.RouteOriginCreateRouteTable},
+				{DestinationCidrBlock: &defaultCIDR, NatGatewayId: &natID, Origin: ec2.RouteOriginCreateRoute},
+			},
+		},
+		"CreateIPv6": {
+			desired: []v1alpha4.Route{
+				{DestinationIPv6CIDRBlock: &defaultIPv6CIDR, GatewayID: &igID},
+			},
+			observed: []ec2.Route{
+				{DestinationCidrBlock: &localCIDR, GatewayId: &local, Origin: ec2.RouteOriginCreateRouteTable},
+			},
+			want: want{
+				create: []v1alpha4.Route{{DestinationIPv6CIDRBlock: &defaultIPv6CIDR, GatewayID: &igID}},
+			},
+		},
+		"ReplaceTarget": {
+			desired: []v1alpha4.Route{
+				{DestinationCIDRBlock: &defaultCIDR, NatGatewayID: &natID},
+			},
+			observed: []ec2.Route{
+				{DestinationCidrBlock: &defaultCIDR, GatewayId: &igID, Origin: ec2.RouteOriginCreateRoute},
+			},
+			want: want{
+				replace: []v1alpha4.Route{{DestinationCIDRBlock: &defaultCIDR, NatGatewayID: &natID}},
+			},
+		},
+		"PrefixListToEndpoint": {
+			desired: []v1alpha4.Route{
+				{DestinationPrefixListID: aws.String("pl-456"), VPCEndpointID: aws.String("vpce-456")},
+			},
+			observed: []ec2.Route{
+				{DestinationPrefixListId: aws.String("pl-123"), GatewayId: aws.String("vpce-123"), Origin: ec2.RouteOriginCreateRoute},
+				{DestinationPrefixListId: aws.String("pl-456"), GatewayId: aws.String("vpce-789"), Origin: ec2.RouteOriginCreateRoute},
+			},
+			want: want{
+				create: []v1alpha4.Route{{DestinationPrefixListID: aws.String("pl-456"), VPCEndpointID: aws.String("vpce-456")}},
+			},
+		},
+		"EndpointUpToDate": {
+			desired: []v1alpha4.Route{
+				{DestinationCIDRBlock: &defaultCIDR, VPCEndpointID: aws.String("vpce-123")},
+			},
+			observed: []ec2.Route{
+				{DestinationCidrBlock: &defaultCIDR, GatewayId: aws.String("vpce-123"), Origin: ec2.RouteOriginCreateRoute},
+			},
+		},
+		"KeepUnspecifiedRoutes": {
+			observed: []ec2.Route{
+				{DestinationCidrBlock: &defaultCIDR, NatGatewayId: &natID, Origin: ec2.RouteOriginCreateRoute},
+			},
+		},
+		"RemoveOnlyCreatedRoutes": {
+			prune: true,
+			observed: []ec2.Route{
+				{DestinationCidrBlock: &localCIDR, GatewayId: &local, Origin: ec2.RouteOriginCreateRouteTable},
+				{DestinationCidrBlock: &peerCIDR, GatewayId: &igID, Origin: ec2.RouteOriginEnableVgwRoutePropagation},
+				{DestinationPrefixListId: aws.String("pl-123"), GatewayId: aws.String("vpce-123"), Origin: ec2.RouteOriginCreateRoute},
+				{DestinationCidrBlock: &defaultCIDR, NatGatewayId: &natID, Origin: ec2.RouteOriginCreateRoute},
+			},
+			want: want{
+				remove: []ec2.Route{{DestinationCidrBlock: &defaultCIDR, NatGatewayId: &natID, Origin: ec2.RouteOriginCreateRoute}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffRoutes(tc.desired, tc.observed, tc.prune)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -177,11 +177,13 @@ func LateInitializeSG(in *v1beta1.SecurityGroupParameters, sg *ec2.SecurityGroup
 
 	// If there is a mismatch in lengths, then it's a sign for desire change
 	// that should be handled via add or remove.
-	if len(in.Egress) == len(sg.IpPermissionsEgress) {
-		in.Egress = LateInitializeIPPermissions(in.Egress, sg.IpPermissionsEgress)
+	egress := FilterDeclaredIPPermissions(sg.IpPermissionsEgress, in.Egress)
+	if len(in.Egress) == len(egress) {
+		in.Egress = LateInitializeIPPermissions(in.Egress, egress)
 	}
-	if len(in.Ingress) == len(sg.IpPermissions) {
-		in.Ingress = LateInitializeIPPermissions(in.Ingress, sg.IpPermissions)
+	ingress := FilterDeclaredIPPermissions(sg.IpPermissions, in.Ingress)
+	if len(in.Ingress) == len(ingress) {
+		in.Ingress = LateInitializeIPPermissions(in.Ingress, ingress)
 	}
 
 	if len(in.Tags) == 0 && len(sg.Tags) != 0 {
@@ -263,6 +265,80 @@ func LateInitializeIPPermissions(spec []v1beta1.IPPermission, o []ec2.IpPermissi
 	return spec
 }
 
+// FilterDeclaredIPPermissions returns the observed permissions with only the
+// sources that are declared in the given spec permissions. The rules of a
+// security group may also be managed by SecurityGroupRule resources, so the
+// rules that the SecurityGroup does not declare are not considered part of its
+// desired state.
+func FilterDeclaredIPPermissions(observed []ec2.IpPermission, declared []v1beta1.IPPermission) []ec2.IpPermission { // nolint:gocyclo
+	var result []ec2.IpPermission
+	for _, o := range observed {
+		f := ec2.IpPermission{
+			FromPort:   o.FromPort,
+			IpProtocol: o.IpProtocol,
+			ToPort:     o.ToPort,
+		}
+		for _, d := range declared {
+			if !strings.EqualFold(d.IPProtocol, awsclients.StringValue(o.IpProtocol)) ||
+				!isSamePort(d.FromPort, o.FromPort) || !isSamePort(d.ToPort, o.ToPort) {
+				continue
+			}
+			for _, r := range o.IpRanges {
+				for _, dr := range d.IPRanges {
+					if dr.CIDRIP == awsclients.StringValue(r.CidrIp) {
+						f.IpRanges = append(f.IpRanges, r)
+						break
+					}
+				}
+			}
+			for _, r := range o.Ipv6Ranges {
+				for _, dr := range d.IPv6Ranges {
+					if dr.CIDRIPv6 == awsclients.StringValue(r.CidrIpv6) {
+						f.Ipv6Ranges = append(f.Ipv6Ranges, r)
+						break
+					}
+				}
+			}
+			for _, p := range o.PrefixListIds {
+				for _, dp := range d.PrefixListIDs {
+					if dp.PrefixListID == awsclients.StringValue(p.PrefixListId) {
+						f.PrefixListIds = append(f.PrefixListIds, p)
+						break
+					}
+				}
+			}
+			for _, p := range o.UserIdGroupPairs {
+				for _, dp := range d.UserIDGroupPairs {
+					if isSameGroup(dp, p) {
+						f.UserIdGroupPairs = append(f.UserIdGroupPairs, p)
+						break
+					}
+				}
+			}
+			break
+		}
+		if len(f.IpRanges)+len(f.Ipv6Ranges)+len(f.PrefixListIds)+len(f.UserIdGroupPairs) != 0 {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// isSamePort returns true if the ports are the same. AWS does not return the
+// -1 port, so nil is considered equal to it.
+func isSamePort(spec, observed *int64) bool {
+	return awsgo.Int64Value(spec) == awsgo.Int64Value(observed) ||
+		(awsgo.Int64Value(spec) == -1 && observed == nil)
+}
+
+// isSameGroup returns true if the observed pair refers to the declared group.
+func isSameGroup(spec v1beta1.UserIDGroupPair, observed ec2.UserIdGroupPair) bool {
+	if spec.GroupID != nil {
+		return awsclients.StringValue(spec.GroupID) == awsclients.StringValue(observed.GroupId)
+	}
+	return awsclients.StringValue(spec.GroupName) == awsclients.StringValue(observed.GroupName)
+}
+
 // CreateSGPatch creates a *v1beta1.SecurityGroupParameters that has only the changed
 // values between the target *v1beta1.SecurityGroupParameters and the current
 // *ec2.SecurityGroup
@@ -274,8 +350,8 @@ func CreateSGPatch(in ec2.SecurityGroup, target v1beta1.SecurityGroupParameters)
 		VPCID:       in.VpcId,
 	}
 	currentParams.Tags = v1beta1.BuildFromEC2Tags(in.Tags)
-	currentParams.Ingress = GenerateIPPermissions(in.IpPermissions)
-	currentParams.Egress = GenerateIPPermissions(in.IpPermissionsEgress)
+	currentParams.Ingress = GenerateIPPermissions(FilterDeclaredIPPermissions(in.IpPermissions, target.Ingress))
+	currentParams.Egress = GenerateIPPermissions(FilterDeclaredIPPermissions(in.IpPermissionsEgress, target.Egress))
 	// NOTE(muvaf): Sending -1 as FromPort or ToPort is valid but the returned
 	// object does not have that value. So, in case we have sent -1, we assume
 	// that the returned value is also -1 in case if it's nil.
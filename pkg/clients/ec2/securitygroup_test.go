@@ -17,6 +17,7 @@ var (
 	sgName     = "some name"
 	sgProtocol = "tcp"
 	sgCidr     = "192.168.0.0/32"
+	sgRuleCidr = "10.0.0.0/16"
 	sgOwner    = "some owner"
 )
 
@@ -50,6 +51,20 @@ func sgIPPermission(port int) []ec2.IpPermission {
 	}
 }
 
+// sharedIPPermission returns the permissions of a group whose port is declared
+// by the SecurityGroup and that has additional rules managed by
+// SecurityGroupRules.
+func sharedIPPermission(port int) []ec2.IpPermission {
+	p := sgIPPermission(port)
+	p[0].IpRanges = append(p[0].IpRanges, ec2.IpRange{CidrIp: aws.String(sgRuleCidr)})
+	return append(p, ec2.IpPermission{
+		FromPort:   aws.Int64(443),
+		ToPort:     aws.Int64(443),
+		IpProtocol: aws.String(sgProtocol),
+		IpRanges:   []ec2.IpRange{{CidrIp: aws.String(sgRuleCidr)}},
+	})
+}
+
 func TestIsSGUpToDate(t *testing.T) {
 	type args struct {
 		sg ec2.SecurityGroup
@@ -77,6 +92,24 @@ func TestIsSGUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SharedGroup": {
+			args: args{
+				sg: ec2.SecurityGroup{
+					Description:         aws.String(sgDesc),
+					GroupName:           aws.String(sgName),
+					VpcId:               aws.String(sgVpc),
+					IpPermissions:       sharedIPPermission(80),
+					IpPermissionsEgress: sharedIPPermission(80),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description: sgDesc,
+					GroupName:   sgName,
+					VPCID:       aws.String(sgVpc),
+					Ingress:     specIPPermsision(80),
+				},
+			},
+			want: true,
+		},
 		"DifferentFields": {
 			args: args{
 				sg: ec2.SecurityGroup{
@@ -176,6 +209,29 @@ func TestCreateSGPatch(t *testing.T) {
 				patch: &v1beta1.SecurityGroupParameters{},
 			},
 		},
+		"SharedGroup": {
+			args: args{
+				sg: ec2.SecurityGroup{
+					Description:         aws.String(sgDesc),
+					GroupName:           aws.String(sgName),
+					IpPermissions:       sharedIPPermission(80),
+					IpPermissionsEgress: sharedIPPermission(80),
+					VpcId:               aws.String(sgVpc),
+				},
+				p: &v1beta1.SecurityGroupParameters{
+					Description: sgDesc,
+					GroupName:   sgName,
+					Egress:      specIPPermsision(80),
+					Ingress:     specIPPermsision(100),
+					VPCID:       aws.String(sgVpc),
+				},
+			},
+			want: want{
+				patch: &v1beta1.SecurityGroupParameters{
+					Ingress: specIPPermsision(100),
+				},
+			},
+		},
 		"DifferentFields": {
 			args: args{
 				sg: ec2.SecurityGroup{
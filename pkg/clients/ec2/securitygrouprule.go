@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+)
+
+const (
+	// InvalidPermissionNotFound is returned when you try to revoke a rule
+	// that does not exist.
+	InvalidPermissionNotFound = "InvalidPermission.NotFound"
+
+	errSourceCount = "exactly one of cidrBlock, ipv6CidrBlock, prefixListId or sourceSecurityGroupId must be set"
+)
+
+// SecurityGroupRuleClient is the external client used for SecurityGroupRule
+// Custom Resource
+type SecurityGroupRuleClient interface {
+	DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
+	AuthorizeSecurityGroupIngressRequest(input *ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
+	AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
+	RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
+	UpdateSecurityGroupRuleDescriptionsIngressRequest(input *ec2.UpdateSecurityGroupRuleDescriptionsIngressInput) ec2.UpdateSecurityGroupRuleDescriptionsIngressRequest
+	UpdateSecurityGroupRuleDescriptionsEgressRequest(input *ec2.UpdateSecurityGroupRuleDescriptionsEgressInput) ec2.UpdateSecurityGroupRuleDescriptionsEgressRequest
+}
+
+// NewSecurityGroupRuleClient generates client for AWS Security Group API
+func NewSecurityGroupRuleClient(cfg aws.Config) SecurityGroupRuleClient {
+	return ec2.New(cfg)
+}
+
+// IsRuleNotFoundErr returns true if the error is because the rule doesn't
+// exist.
+func IsRuleNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == InvalidPermissionNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateSecurityGroupRulePermission returns the ec2.IpPermission that
+// represents the given SecurityGroupRule. It returns an error if the
+// parameters do not have exactly one source set.
+func GenerateSecurityGroupRulePermission(p v1alpha1.SecurityGroupRuleParameters) (ec2.IpPermission, error) {
+	perm := ec2.IpPermission{
+		IpProtocol: aws.String(p.IPProtocol),
+		FromPort:   p.FromPort,
+		ToPort:     p.ToPort,
+	}
+	n := 0
+	if p.CIDRBlock != nil {
+		perm.IpRanges = []ec2.IpRange{{CidrIp: p.CIDRBlock, Description: p.Description}}
+		n++
+	}
+	if p.IPv6CIDRBlock != nil {
+		perm.Ipv6Ranges = []ec2.Ipv6Range{{CidrIpv6: p.IPv6CIDRBlock, Description: p.Description}}
+		n++
+	}
+	if p.PrefixListID != nil {
+		perm.PrefixListIds = []ec2.PrefixListId{{PrefixListId: p.PrefixListID, Description: p.Description}}
+		n++
+	}
+	if p.SourceSecurityGroupID != nil {
+		perm.UserIdGroupPairs = []ec2.UserIdGroupPair{{GroupId: p.SourceSecurityGroupID, Description: p.Description}}
+		n++
+	}
+	if n != 1 {
+		return ec2.IpPermission{}, errors.New(errSourceCount)
+	}
+	return perm, nil
+}
+
+// FindSecurityGroupRule looks for the permission described by the given
+// SecurityGroupRule in the observed ec2.SecurityGroup. Since rules have no
+// identifier of their own, they are matched by direction, protocol, ports and
+// source. It returns the observed description of the rule and whether it was
+// found.
+func FindSecurityGroupRule(p v1alpha1.SecurityGroupRuleParameters, sg ec2.SecurityGroup) (*string, bool) { // nolint:gocyclo
+	perms := sg.IpPermissions
+	if p.Type == v1alpha1.SecurityGroupRuleTypeEgress {
+		perms = sg.IpPermissionsEgress
+	}
+	for _, perm := range perms {
+		if !permissionMatches(p, perm) {
+			continue
+		}
+		switch {
+		case p.CIDRBlock != nil:
+			for _, r := range perm.IpRanges {
+				if aws.StringValue(r.CidrIp) == aws.StringValue(p.CIDRBlock) {
+					return r.Description, true
+				}
+			}
+		case p.IPv6CIDRBlock != nil:
+			for _, r := range perm.Ipv6Ranges {
+				if strings.EqualFold(aws.StringValue(r.CidrIpv6), aws.StringValue(p.IPv6CIDRBlock)) {
+					return r.Description, true
+				}
+			}
+		case p.PrefixListID != nil:
+			for _, r := range perm.PrefixListIds {
+				if aws.StringValue(r.PrefixListId) == aws.StringValue(p.PrefixListID) {
+					return r.Description, true
+				}
+			}
+		case p.SourceSecurityGroupID != nil:
+			for _, r := range perm.UserIdGroupPairs {
+				if aws.StringValue(r.GroupId) == aws.StringValue(p.SourceSecurityGroupID) {
+					return r.Description, true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// IsSecurityGroupRuleUpToDate checks whether the observed description of the
+// rule matches the desired one. Description is the only mutable field.
+func IsSecurityGroupRuleUpToDate(p v1alpha1.SecurityGroupRuleParameters, description *string) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(description)
+}
+
+func permissionMatches(p v1alpha1.SecurityGroupRuleParameters, perm ec2.IpPermission) bool {
+	protocol := normalizeProtocol(p.IPProtocol)
+	if protocol != normalizeProtocol(aws.StringValue(perm.IpProtocol)) {
+		return false
+	}
+	// Ports are ignored by AWS when all protocols are allowed.
+	if protocol == "-1" {
+		return true
+	}
+	return aws.Int64Value(p.FromPort) == aws.Int64Value(perm.FromPort) &&
+		aws.Int64Value(p.ToPort) == aws.Int64Value(perm.ToPort)
+}
+
+// normalizeProtocol converts the protocol numbers AWS accepts for the common
+// protocols to the names it returns.
+func normalizeProtocol(p string) string {
+	switch p = strings.ToLower(p); p {
+	case "all":
+		return "-1"
+	case "1":
+		return "icmp"
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	case "58":
+		return "icmpv6"
+	}
+	return p
+}
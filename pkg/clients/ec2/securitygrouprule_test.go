@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+)
+
+func TestFindSecurityGroupRule(t *testing.T) {
+	type want struct {
+		description *string
+		found       bool
+	}
+	cases := map[string]struct {
+		p    v1alpha1.SecurityGroupRuleParameters
+		sg   ec2.SecurityGroup
+		want want
+	}{
+		"CIDRBlock": {
+			p: v1alpha1.SecurityGroupRuleParameters{
+				Type:       v1alpha1.SecurityGroupRuleTypeIngress,
+				IPProtocol: "6",
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				CIDRBlock:  aws.String(sgCidr),
+			},
+			sg: ec2.SecurityGroup{IpPermissions: []ec2.IpPermission{{
+				IpProtocol: aws.String(sgProtocol),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpRanges: []ec2.IpRange{
+					{CidrIp: aws.String("10.0.0.0/8")},
+					{CidrIp: aws.String(sgCidr), Description: aws.String(sgDesc)},
+				},
+			}}},
+			want: want{description: aws.String(sgDesc), found: true},
+		},
+		"AllProtocolsIgnorePorts": {
+			p: v1alpha1.SecurityGroupRuleParameters{
+				Type:                  v1alpha1.SecurityGroupRuleTypeEgress,
+				IPProtocol:            "all",
+				FromPort:              aws.Int64(-1),
+				SourceSecurityGroupID: aws.String(sgID),
+			},
+			sg: ec2.SecurityGroup{IpPermissionsEgress: []ec2.IpPermission{{
+				IpProtocol:       aws.String("-1"),
+				UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String(sgID)}},
+			}}},
+			want: want{found: true},
+		},
+		"DifferentPorts": {
+			p: v1alpha1.SecurityGroupRuleParameters{
+				Type:         v1alpha1.SecurityGroupRuleTypeIngress,
+				IPProtocol:   sgProtocol,
+				FromPort:     aws.Int64(80),
+				ToPort:       aws.Int64(80),
+				PrefixListID: aws.String("pl-123"),
+			},
+			sg: ec2.SecurityGroup{IpPermissions: []ec2.IpPermission{{
+				IpProtocol:    aws.String(sgProtocol),
+				FromPort:      aws.Int64(443),
+				ToPort:        aws.Int64(443),
+				PrefixListIds: []ec2.PrefixListId{{PrefixListId: aws.String("pl-123")}},
+			}}},
+		},
+		"WrongDirection": {
+			p: v1alpha1.SecurityGroupRuleParameters{
+				Type:          v1alpha1.SecurityGroupRuleTypeEgress,
+				IPProtocol:    "-1",
+				IPv6CIDRBlock: aws.String("::/0"),
+			},
+			sg: ec2.SecurityGroup{IpPermissions: []ec2.IpPermission{{
+				IpProtocol: aws.String("-1"),
+				Ipv6Ranges: []ec2.Ipv6Range{{CidrIpv6: aws.String("::/0")}},
+			}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			description, found := FindSecurityGroupRule(tc.p, tc.sg)
+			if diff := cmp.Diff(tc.want, want{description: description, found: found}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("FindSecurityGroupRule(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSecurityGroupRulePermission(t *testing.T) {
+	type want struct {
+		perm ec2.IpPermission
+		err  bool
+	}
+	cases := map[string]struct {
+		p    v1alpha1.SecurityGroupRuleParameters
+		want want
+	}{
+		"SourceSecurityGroup": {
+			p: v1alpha1.SecurityGroupRuleParameters{
+				IPProtocol:            sgProtocol,
+				FromPort:              aws.Int64(5432),
+				ToPort:                aws.Int64(5432),
+				SourceSecurityGroupID: aws.String(sgID),
+				Description:           aws.String(sgDesc),
+			},
+			want: want{perm: ec2.IpPermission{
+				IpProtocol:       aws.String(sgProtocol),
+				FromPort:         aws.Int64(5432),
+				ToPort:           aws.Int64(5432),
+				UserIdGroupPairs: []ec2.UserIdGroupPair{{GroupId: aws.String(sgID), Description: aws.String(sgDesc)}},
+			}},
+		},
+		"NoSource": {
+			p:    v1alpha1.SecurityGroupRuleParameters{IPProtocol: sgProtocol},
+			want: want{err: true},
+		},
+		"MultipleSources": {
+			p: v1alpha1.SecurityGroupRuleParameters{
+				IPProtocol:   sgProtocol,
+				CIDRBlock:    aws.String(sgCidr),
+				PrefixListID: aws.String("pl-123"),
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			perm, err := GenerateSecurityGroupRulePermission(tc.p)
+			if diff := cmp.Diff(tc.want, want{perm: perm, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("GenerateSecurityGroupRulePermission(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayNotFound is the code that is returned by ec2 when the
+	// given TransitGatewayID is not valid
+	TransitGatewayNotFound = "InvalidTransitGatewayID.NotFound"
+)
+
+// TransitGatewayClient is the external client used for TransitGateway Custom
+// Resource
+type TransitGatewayClient interface {
+	CreateTransitGatewayRequest(input *ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest
+	DescribeTransitGatewaysRequest(input *ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest
+	DeleteTransitGatewayRequest(input *ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewTransitGatewayClient(cfg aws.Config) TransitGatewayClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsTransitGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateTransitGatewayInput returns the input to create a transit
+// gateway with the given parameters.
+func GenerateCreateTransitGatewayInput(p v1alpha1.TransitGatewayParameters) *ec2.CreateTransitGatewayInput {
+	in := &ec2.CreateTransitGatewayInput{
+		Description: p.Description,
+		Options: &ec2.TransitGatewayRequestOptions{
+			AmazonSideAsn:                p.AmazonSideASN,
+			AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValue(aws.StringValue(p.AutoAcceptSharedAttachments)),
+			DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValue(aws.StringValue(p.DefaultRouteTableAssociation)),
+			DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValue(aws.StringValue(p.DefaultRouteTablePropagation)),
+			DnsSupport:                   ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			VpnEcmpSupport:               ec2.VpnEcmpSupportValue(aws.StringValue(p.VPNECMPSupport)),
+		},
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGateway,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateTransitGatewayObservation is used to produce
+// v1alpha1.TransitGatewayObservation from ec2.TransitGateway.
+func GenerateTransitGatewayObservation(tg ec2.TransitGateway) v1alpha1.TransitGatewayObservation {
+	o := v1alpha1.TransitGatewayObservation{
+		OwnerID:           aws.StringValue(tg.OwnerId),
+		State:             string(tg.State),
+		TransitGatewayARN: aws.StringValue(tg.TransitGatewayArn),
+		TransitGatewayID:  aws.StringValue(tg.TransitGatewayId),
+	}
+	if tg.Options != nil {
+		o.AssociationDefaultRouteTableID = aws.StringValue(tg.Options.AssociationDefaultRouteTableId)
+		o.PropagationDefaultRouteTableID = aws.StringValue(tg.Options.PropagationDefaultRouteTableId)
+	}
+	return o
+}
+
+// LateInitializeTransitGateway fills the empty fields in
+// *v1alpha1.TransitGatewayParameters with the values seen in
+// ec2.TransitGateway.
+func LateInitializeTransitGateway(in *v1alpha1.TransitGatewayParameters, tg *ec2.TransitGateway) {
+	if tg == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, tg.Description)
+	if tg.Options == nil {
+		return
+	}
+	in.AmazonSideASN = awsclients.LateInitializeInt64Ptr(in.AmazonSideASN, tg.Options.AmazonSideAsn)
+	in.AutoAcceptSharedAttachments = awsclients.LateInitializeStringPtr(in.AutoAcceptSharedAttachments, awsclients.String(string(tg.Options.AutoAcceptSharedAttachments)))
+	in.DefaultRouteTableAssociation = awsclients.LateInitializeStringPtr(in.DefaultRouteTableAssociation, awsclients.String(string(tg.Options.DefaultRouteTableAssociation)))
+	in.DefaultRouteTablePropagation = awsclients.LateInitializeStringPtr(in.DefaultRouteTablePropagation, awsclients.String(string(tg.Options.DefaultRouteTablePropagation)))
+	in.DNSSupport = awsclients.LateInitializeStringPtr(in.DNSSupport, awsclients.String(string(tg.Options.DnsSupport)))
+	in.VPNECMPSupport = awsclients.LateInitializeStringPtr(in.VPNECMPSupport, awsclients.String(string(tg.Options.VpnEcmpSupport)))
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	tgID          = "tgw-123"
+	tgARN         = "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-123"
+	tgOwnerID     = "123456789012"
+	tgDescription = "some description"
+	tgRouteTable  = "tgw-rtb-123"
+	tgASN         = 64512
+)
+
+func TestGenerateCreateTransitGatewayInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.TransitGatewayParameters
+		out *ec2.CreateTransitGatewayInput
+	}{
+		"AllFilled": {
+			in: v1alpha1.TransitGatewayParameters{
+				Description:                  aws.String(tgDescription),
+				AmazonSideASN:                aws.Int64(tgASN),
+				AutoAcceptSharedAttachments:  aws.String("enable"),
+				DefaultRouteTableAssociation: aws.String("disable"),
+				DefaultRouteTablePropagation: aws.String("disable"),
+				DNSSupport:                   aws.String("enable"),
+				VPNECMPSupport:               aws.String("enable"),
+				Tags:                         []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			out: &ec2.CreateTransitGatewayInput{
+				Description: aws.String(tgDescription),
+				Options: &ec2.TransitGatewayRequestOptions{
+					AmazonSideAsn:                aws.Int64(tgASN),
+					AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValueEnable,
+					DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValueDisable,
+					DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValueDisable,
+					DnsSupport:                   ec2.DnsSupportValueEnable,
+					VpnEcmpSupport:               ec2.VpnEcmpSupportValueEnable,
+				},
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeTransitGateway,
+					Tags:         []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				}},
+			},
+		},
+		"Empty": {
+			in: v1alpha1.TransitGatewayParameters{},
+			out: &ec2.CreateTransitGatewayInput{
+				Options: &ec2.TransitGatewayRequestOptions{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateTransitGatewayInput(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTransitGatewayObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  ec2.TransitGateway
+		out v1alpha1.TransitGatewayObservation
+	}{
+		"AllFilled": {
+			in: ec2.TransitGateway{
+				OwnerId:           aws.String(tgOwnerID),
+				State:             ec2.TransitGatewayStateAvailable,
+				TransitGatewayArn: aws.String(tgARN),
+				TransitGatewayId:  aws.String(tgID),
+				Options: &ec2.TransitGatewayOptions{
+					AssociationDefaultRouteTableId: aws.String(tgRouteTable),
+					PropagationDefaultRouteTableId: aws.String(tgRouteTable),
+				},
+			},
+			out: v1alpha1.TransitGatewayObservation{
+				AssociationDefaultRouteTableID: tgRouteTable,
+				OwnerID:                        tgOwnerID,
+				PropagationDefaultRouteTableID: tgRouteTable,
+				State:                          string(ec2.TransitGatewayStateAvailable),
+				TransitGatewayARN:              tgARN,
+				TransitGatewayID:               tgID,
+			},
+		},
+		"NoOptions": {
+			in: ec2.TransitGateway{
+				State:            ec2.TransitGatewayStatePending,
+				TransitGatewayId: aws.String(tgID),
+			},
+			out: v1alpha1.TransitGatewayObservation{
+				State:            string(ec2.TransitGatewayStatePending),
+				TransitGatewayID: tgID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTransitGatewayObservation(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTransitGateway(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.TransitGatewayParameters
+		in   *ec2.TransitGateway
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.TransitGatewayParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayParameters{
+					Description: aws.String(tgDescription),
+					DNSSupport:  aws.String("enable"),
+				},
+				in: &ec2.TransitGateway{
+					Description: aws.String("other description"),
+					Options:     &ec2.TransitGatewayOptions{DnsSupport: ec2.DnsSupportValueDisable},
+				},
+			},
+			want: &v1alpha1.TransitGatewayParameters{
+				Description: aws.String(tgDescription),
+				DNSSupport:  aws.String("enable"),
+			},
+		},
+		"PartialFilled": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayParameters{
+					DNSSupport: aws.String("disable"),
+				},
+				in: &ec2.TransitGateway{
+					Description: aws.String(tgDescription),
+					Options: &ec2.TransitGatewayOptions{
+						AmazonSideAsn:                aws.Int64(tgASN),
+						AutoAcceptSharedAttachments:  ec2.AutoAcceptSharedAttachmentsValueDisable,
+						DefaultRouteTableAssociation: ec2.DefaultRouteTableAssociationValueEnable,
+						DefaultRouteTablePropagation: ec2.DefaultRouteTablePropagationValueEnable,
+						DnsSupport:                   ec2.DnsSupportValueEnable,
+						VpnEcmpSupport:               ec2.VpnEcmpSupportValueEnable,
+					},
+				},
+			},
+			want: &v1alpha1.TransitGatewayParameters{
+				Description:                  aws.String(tgDescription),
+				AmazonSideASN:                aws.Int64(tgASN),
+				AutoAcceptSharedAttachments:  aws.String("disable"),
+				DefaultRouteTableAssociation: aws.String("enable"),
+				DefaultRouteTablePropagation: aws.String("enable"),
+				DNSSupport:                   aws.String("disable"),
+				VPNECMPSupport:               aws.String("enable"),
+			},
+		},
+		"NoOptions": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayParameters{},
+				in:   &ec2.TransitGateway{Description: aws.String(tgDescription)},
+			},
+			want: &v1alpha1.TransitGatewayParameters{
+				Description: aws.String(tgDescription),
+			},
+		},
+		"NilObservation": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayParameters{},
+			},
+			want: &v1alpha1.TransitGatewayParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTransitGateway(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TransitGatewayAttachmentNotFound is the code that is returned by ec2
+	// when the given TransitGatewayAttachmentID is not valid
+	TransitGatewayAttachmentNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+)
+
+// TransitGatewayVPCAttachmentClient is the external client used for
+// TransitGatewayVPCAttachment Custom Resource
+type TransitGatewayVPCAttachmentClient interface {
+	CreateTransitGatewayVpcAttachmentRequest(input *ec2.CreateTransitGatewayVpcAttachmentInput) ec2.CreateTransitGatewayVpcAttachmentRequest
+	DescribeTransitGatewayVpcAttachmentsRequest(input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ec2.DescribeTransitGatewayVpcAttachmentsRequest
+	ModifyTransitGatewayVpcAttachmentRequest(input *ec2.ModifyTransitGatewayVpcAttachmentInput) ec2.ModifyTransitGatewayVpcAttachmentRequest
+	DeleteTransitGatewayVpcAttachmentRequest(input *ec2.DeleteTransitGatewayVpcAttachmentInput) ec2.DeleteTransitGatewayVpcAttachmentRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewTransitGatewayVPCAttachmentClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewTransitGatewayVPCAttachmentClient(cfg aws.Config) TransitGatewayVPCAttachmentClient {
+	return ec2.New(cfg)
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because
+// the item doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayAttachmentNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateTransitGatewayVPCAttachmentInput returns the input to create
+// a transit gateway VPC attachment with the given parameters.
+func GenerateCreateTransitGatewayVPCAttachmentInput(p v1alpha1.TransitGatewayVPCAttachmentParameters) *ec2.CreateTransitGatewayVpcAttachmentInput {
+	in := &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: p.TransitGatewayID,
+		VpcId:            p.VPCID,
+		SubnetIds:        p.SubnetIDs,
+		Options: &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{
+			DnsSupport:  ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			Ipv6Support: ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support)),
+		},
+	}
+	if len(p.Tags) != 0 {
+		in.TagSpecifications = []ec2.TagSpecification{
+			{
+				ResourceType: ec2.ResourceTypeTransitGatewayAttachment,
+				Tags:         v1beta1.GenerateEC2Tags(p.Tags),
+			},
+		}
+	}
+	return in
+}
+
+// GenerateTransitGatewayVPCAttachmentObservation is used to produce
+// v1alpha1.TransitGatewayVPCAttachmentObservation from
+// ec2.TransitGatewayVpcAttachment.
+func GenerateTransitGatewayVPCAttachmentObservation(a ec2.TransitGatewayVpcAttachment) v1alpha1.TransitGatewayVPCAttachmentObservation {
+	return v1alpha1.TransitGatewayVPCAttachmentObservation{
+		State:                      string(a.State),
+		TransitGatewayAttachmentID: aws.StringValue(a.TransitGatewayAttachmentId),
+		VPCOwnerID:                 aws.StringValue(a.VpcOwnerId),
+	}
+}
+
+// LateInitializeTransitGatewayVPCAttachment fills the empty fields in
+// *v1alpha1.TransitGatewayVPCAttachmentParameters with the values seen in
+// ec2.TransitGatewayVpcAttachment.
+func LateInitializeTransitGatewayVPCAttachment(in *v1alpha1.TransitGatewayVPCAttachmentParameters, a *ec2.TransitGatewayVpcAttachment) {
+	if a == nil || a.Options == nil {
+		return
+	}
+	in.DNSSupport = awsclients.LateInitializeStringPtr(in.DNSSupport, awsclients.String(string(a.Options.DnsSupport)))
+	in.IPv6Support = awsclients.LateInitializeStringPtr(in.IPv6Support, awsclients.String(string(a.Options.Ipv6Support)))
+}
+
+// IsTransitGatewayVPCAttachmentUpToDate checks whether there is a change in
+// any of the modifiable fields.
+func IsTransitGatewayVPCAttachmentUpToDate(p v1alpha1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) bool {
+	if len(difference(p.SubnetIDs, a.SubnetIds)) != 0 || len(difference(a.SubnetIds, p.SubnetIDs)) != 0 {
+		return false
+	}
+	if a.Options != nil {
+		if p.DNSSupport != nil && aws.StringValue(p.DNSSupport) != string(a.Options.DnsSupport) {
+			return false
+		}
+		if p.IPv6Support != nil && aws.StringValue(p.IPv6Support) != string(a.Options.Ipv6Support) {
+			return false
+		}
+	}
+	return v1beta1.CompareTags(p.Tags, a.Tags)
+}
+
+// GenerateModifyTransitGatewayVPCAttachmentInput returns the input to bring
+// the observed attachment to the desired state. It returns nil if only the
+// tags differ.
+func GenerateModifyTransitGatewayVPCAttachmentInput(id string, p v1alpha1.TransitGatewayVPCAttachmentParameters, a ec2.TransitGatewayVpcAttachment) *ec2.ModifyTransitGatewayVpcAttachmentInput {
+	in := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+		AddSubnetIds:               difference(p.SubnetIDs, a.SubnetIds),
+		RemoveSubnetIds:            difference(a.SubnetIds, p.SubnetIDs),
+		Options: &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{
+			DnsSupport:  ec2.DnsSupportValue(aws.StringValue(p.DNSSupport)),
+			Ipv6Support: ec2.Ipv6SupportValue(aws.StringValue(p.IPv6Support)),
+		},
+	}
+	optionsChanged := a.Options != nil &&
+		(in.Options.DnsSupport != a.Options.DnsSupport || in.Options.Ipv6Support != a.Options.Ipv6Support)
+	if len(in.AddSubnetIds) == 0 && len(in.RemoveSubnetIds) == 0 && !optionsChanged {
+		return nil
+	}
+	return in
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	tgaID      = "tgw-attach-123"
+	tgaVPCID   = "vpc-123"
+	tgaOwnerID = "123456789012"
+)
+
+func tgaAttachment() ec2.TransitGatewayVpcAttachment {
+	return ec2.TransitGatewayVpcAttachment{
+		TransitGatewayAttachmentId: aws.String(tgaID),
+		TransitGatewayId:           aws.String(tgID),
+		VpcId:                      aws.String(tgaVPCID),
+		VpcOwnerId:                 aws.String(tgaOwnerID),
+		State:                      ec2.TransitGatewayAttachmentStateAvailable,
+		SubnetIds:                  []string{"subnet-a"},
+		Options: &ec2.TransitGatewayVpcAttachmentOptions{
+			DnsSupport:  ec2.DnsSupportValueEnable,
+			Ipv6Support: ec2.Ipv6SupportValueDisable,
+		},
+		Tags: []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+	}
+}
+
+func TestGenerateCreateTransitGatewayVPCAttachmentInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.TransitGatewayVPCAttachmentParameters
+		out *ec2.CreateTransitGatewayVpcAttachmentInput
+	}{
+		"AllFilled": {
+			in: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				TransitGatewayID: aws.String(tgID),
+				VPCID:            aws.String(tgaVPCID),
+				SubnetIDs:        []string{"subnet-a"},
+				DNSSupport:       aws.String("enable"),
+				IPv6Support:      aws.String("disable"),
+				Tags:             []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			out: &ec2.CreateTransitGatewayVpcAttachmentInput{
+				TransitGatewayId: aws.String(tgID),
+				VpcId:            aws.String(tgaVPCID),
+				SubnetIds:        []string{"subnet-a"},
+				Options: &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{
+					DnsSupport:  ec2.DnsSupportValueEnable,
+					Ipv6Support: ec2.Ipv6SupportValueDisable,
+				},
+				TagSpecifications: []ec2.TagSpecification{{
+					ResourceType: ec2.ResourceTypeTransitGatewayAttachment,
+					Tags:         []ec2.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				}},
+			},
+		},
+		"NoTags": {
+			in: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				TransitGatewayID: aws.String(tgID),
+				VPCID:            aws.String(tgaVPCID),
+				SubnetIDs:        []string{"subnet-a"},
+			},
+			out: &ec2.CreateTransitGatewayVpcAttachmentInput{
+				TransitGatewayId: aws.String(tgID),
+				VpcId:            aws.String(tgaVPCID),
+				SubnetIds:        []string{"subnet-a"},
+				Options:          &ec2.CreateTransitGatewayVpcAttachmentRequestOptions{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateTransitGatewayVPCAttachmentInput(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTransitGatewayVPCAttachmentObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  ec2.TransitGatewayVpcAttachment
+		out v1alpha1.TransitGatewayVPCAttachmentObservation
+	}{
+		"AllFilled": {
+			in: tgaAttachment(),
+			out: v1alpha1.TransitGatewayVPCAttachmentObservation{
+				State:                      string(ec2.TransitGatewayAttachmentStateAvailable),
+				TransitGatewayAttachmentID: tgaID,
+				VPCOwnerID:                 tgaOwnerID,
+			},
+		},
+		"Empty": {
+			in:  ec2.TransitGatewayVpcAttachment{},
+			out: v1alpha1.TransitGatewayVPCAttachmentObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateTransitGatewayVPCAttachmentObservation(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTransitGatewayVPCAttachment(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.TransitGatewayVPCAttachmentParameters
+		in   *ec2.TransitGatewayVpcAttachment
+	}
+	a := tgaAttachment()
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.TransitGatewayVPCAttachmentParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayVPCAttachmentParameters{
+					DNSSupport:  aws.String("disable"),
+					IPv6Support: aws.String("enable"),
+				},
+				in: &a,
+			},
+			want: &v1alpha1.TransitGatewayVPCAttachmentParameters{
+				DNSSupport:  aws.String("disable"),
+				IPv6Support: aws.String("enable"),
+			},
+		},
+		"Empty": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayVPCAttachmentParameters{},
+				in:   &a,
+			},
+			want: &v1alpha1.TransitGatewayVPCAttachmentParameters{
+				DNSSupport:  aws.String("enable"),
+				IPv6Support: aws.String("disable"),
+			},
+		},
+		"NoOptions": {
+			args: args{
+				spec: &v1alpha1.TransitGatewayVPCAttachmentParameters{},
+				in:   &ec2.TransitGatewayVpcAttachment{},
+			},
+			want: &v1alpha1.TransitGatewayVPCAttachmentParameters{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTransitGatewayVPCAttachment(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTransitGatewayVPCAttachmentUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TransitGatewayVPCAttachmentParameters
+		want bool
+	}{
+		"SameFields": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:   []string{"subnet-a"},
+				DNSSupport:  aws.String("enable"),
+				IPv6Support: aws.String("disable"),
+				Tags:        []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			want: true,
+		},
+		"DifferentSubnets": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"subnet-a", "subnet-b"},
+				Tags:      []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			want: false,
+		},
+		"DifferentDNSSupport": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:  []string{"subnet-a"},
+				DNSSupport: aws.String("disable"),
+				Tags:       []v1beta1.Tag{{Key: "key", Value: "value"}},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"subnet-a"},
+				Tags:      []v1beta1.Tag{{Key: "key", Value: "other"}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTransitGatewayVPCAttachmentUpToDate(tc.p, tgaAttachment())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyTransitGatewayVPCAttachmentInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TransitGatewayVPCAttachmentParameters
+		want *ec2.ModifyTransitGatewayVpcAttachmentInput
+	}{
+		"OnlyTagsDiffer": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:   []string{"subnet-a"},
+				DNSSupport:  aws.String("enable"),
+				IPv6Support: aws.String("disable"),
+				Tags:        []v1beta1.Tag{{Key: "key", Value: "other"}},
+			},
+		},
+		"SubnetsAndOptionsDiffer": {
+			p: v1alpha1.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:   []string{"subnet-b"},
+				DNSSupport:  aws.String("disable"),
+				IPv6Support: aws.String("disable"),
+			},
+			want: &ec2.ModifyTransitGatewayVpcAttachmentInput{
+				TransitGatewayAttachmentId: aws.String(tgaID),
+				AddSubnetIds:               []string{"subnet-b"},
+				RemoveSubnetIds:            []string{"subnet-a"},
+				Options: &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{
+					DnsSupport:  ec2.DnsSupportValueDisable,
+					Ipv6Support: ec2.Ipv6SupportValueDisable,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyTransitGatewayVPCAttachmentInput(tgaID, tc.p, tgaAttachment())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
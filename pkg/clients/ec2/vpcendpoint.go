@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointNotFound is the code that is returned by ec2 when the given
+	// VPCEndpointID is not valid
+	VPCEndpointNotFound = "InvalidVpcEndpointId.NotFound"
+)
+
+// VPCEndpointClient is the external client used for VPCEndpoint Custom
+// Resource
+type VPCEndpointClient interface {
+	CreateVpcEndpointRequest(input *ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	DescribeVpcEndpointsRequest(input *ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	ModifyVpcEndpointRequest(input *ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	DeleteVpcEndpointsRequest(input *ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCEndpointClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewVPCEndpointClient(cfg aws.Config) VPCEndpointClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCEndpointNotFoundErr returns true if the error is because the item
+// doesn't exist
+func IsVPCEndpointNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateCreateVPCEndpointInput returns the input to create a VPC endpoint
+// with the given parameters. Tags are applied after creation since the
+// endpoint cannot be tagged on creation.
+func GenerateCreateVPCEndpointInput(p v1alpha4.VPCEndpointParameters) *ec2.CreateVpcEndpointInput {
+	return &ec2.CreateVpcEndpointInput{
+		VpcId:             p.VPCID,
+		ServiceName:       aws.String(p.ServiceName),
+		VpcEndpointType:   ec2.VpcEndpointType(aws.StringValue(p.VPCEndpointType)),
+		PolicyDocument:    p.PolicyDocument,
+		PrivateDnsEnabled: p.PrivateDNSEnabled,
+		RouteTableIds:     p.RouteTableIDs,
+		SubnetIds:         p.SubnetIDs,
+		SecurityGroupIds:  p.SecurityGroupIDs,
+	}
+}
+
+// GenerateVPCEndpointObservation is used to produce
+// v1alpha4.VPCEndpointObservation from ec2.VpcEndpoint.
+func GenerateVPCEndpointObservation(e ec2.VpcEndpoint) v1alpha4.VPCEndpointObservation {
+	o := v1alpha4.VPCEndpointObservation{
+		NetworkInterfaceIDs: e.NetworkInterfaceIds,
+		OwnerID:             aws.StringValue(e.OwnerId),
+		State:               string(e.State),
+		VPCEndpointID:       aws.StringValue(e.VpcEndpointId),
+	}
+	for _, d := range e.DnsEntries {
+		o.DNSEntries = append(o.DNSEntries, v1alpha4.DNSEntry{
+			DNSName:      aws.StringValue(d.DnsName),
+			HostedZoneID: aws.StringValue(d.HostedZoneId),
+		})
+	}
+	if e.LastError != nil {
+		o.LastErrorMessage = aws.StringValue(e.LastError.Message)
+	}
+	return o
+}
+
+// LateInitializeVPCEndpoint fills the empty fields in
+// *v1alpha4.VPCEndpointParameters with the values seen in ec2.VpcEndpoint.
+func LateInitializeVPCEndpoint(in *v1alpha4.VPCEndpointParameters, e *ec2.VpcEndpoint) {
+	if e == nil {
+		return
+	}
+	in.VPCEndpointType = awsclients.LateInitializeStringPtr(in.VPCEndpointType, awsclients.String(string(e.VpcEndpointType)))
+	in.PrivateDNSEnabled = awsclients.LateInitializeBoolPtr(in.PrivateDNSEnabled, e.PrivateDnsEnabled)
+}
+
+// IsVPCEndpointUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsVPCEndpointUpToDate(p v1alpha4.VPCEndpointParameters, e ec2.VpcEndpoint) (bool, error) {
+	if len(difference(p.RouteTableIDs, e.RouteTableIds)) != 0 || len(difference(e.RouteTableIds, p.RouteTableIDs)) != 0 {
+		return false, nil
+	}
+	if len(difference(p.SubnetIDs, e.SubnetIds)) != 0 || len(difference(e.SubnetIds, p.SubnetIDs)) != 0 {
+		return false, nil
+	}
+	groups := observedSecurityGroupIDs(e)
+	if len(difference(p.SecurityGroupIDs, groups)) != 0 || len(difference(groups, p.SecurityGroupIDs)) != 0 {
+		return false, nil
+	}
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		return false, nil
+	}
+	ok, err := isPolicyDocumentUpToDate(p.PolicyDocument, e.PolicyDocument)
+	if err != nil || !ok {
+		return false, err
+	}
+	return v1beta1.CompareTags(p.Tags, e.Tags), nil
+}
+
+// GenerateModifyVPCEndpointInput returns the input to bring the observed
+// endpoint to the desired state.
+func GenerateModifyVPCEndpointInput(id string, p v1alpha4.VPCEndpointParameters, e ec2.VpcEndpoint) *ec2.ModifyVpcEndpointInput {
+	groups := observedSecurityGroupIDs(e)
+	in := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId:          aws.String(id),
+		AddRouteTableIds:       difference(p.RouteTableIDs, e.RouteTableIds),
+		RemoveRouteTableIds:    difference(e.RouteTableIds, p.RouteTableIDs),
+		AddSubnetIds:           difference(p.SubnetIDs, e.SubnetIds),
+		RemoveSubnetIds:        difference(e.SubnetIds, p.SubnetIDs),
+		AddSecurityGroupIds:    difference(p.SecurityGroupIDs, groups),
+		RemoveSecurityGroupIds: difference(groups, p.SecurityGroupIDs),
+		PolicyDocument:         p.PolicyDocument,
+	}
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		in.PrivateDnsEnabled = p.PrivateDNSEnabled
+	}
+	return in
+}
+
+func observedSecurityGroupIDs(e ec2.VpcEndpoint) []string {
+	ids := make([]string, len(e.Groups))
+	for i, g := range e.Groups {
+		ids[i] = aws.StringValue(g.GroupId)
+	}
+	return ids
+}
+
+// isPolicyDocumentUpToDate returns true if the desired policy is semantically
+// equal to the observed one. A policy that is not specified is always up to
+// date.
+func isPolicyDocumentUpToDate(desired, observed *string) (bool, error) {
+	if desired == nil {
+		return true, nil
+	}
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(aws.StringValue(desired)), &d); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(aws.StringValue(observed)), &o); err != nil {
+		return false, err
+	}
+	return cmp.Equal(d, o), nil
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	m := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		m[v] = struct{}{}
+	}
+	var diff []string
+	for _, v := range a {
+		if _, ok := m[v]; !ok {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	vpceID       = "vpce-123"
+	vpcePolicy   = `{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}]}`
+	vpcePolicyWS = `{
+  "Statement": [{"Action": "*", "Effect": "Allow", "Principal": "*", "Resource": "*"}]
+}`
+	vpcePolicyReordered = `{"Statement":[{"Resource":"*","Principal":"*","Effect":"Allow","Action":"*"}]}`
+)
+
+func vpcEndpoint() ec2.VpcEndpoint {
+	return ec2.VpcEndpoint{
+		VpcEndpointId:     aws.String(vpceID),
+		PolicyDocument:    aws.String(vpcePolicy),
+		PrivateDnsEnabled: aws.Bool(true),
+		SubnetIds:         []string{"subnet-a"},
+		Groups:            []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-a")}},
+	}
+}
+
+func TestIsVPCEndpointUpToDate(t *testing.T) {
+	type args struct {
+		e ec2.VpcEndpoint
+		p v1alpha4.VPCEndpointParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+		err  bool
+	}{
+		"SameFields": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PolicyDocument:    aws.String(vpcePolicyWS),
+					PrivateDNSEnabled: aws.Bool(true),
+					SubnetIDs:         []string{"subnet-a"},
+					SecurityGroupIDs:  []string{"sg-a"},
+				},
+			},
+			want: true,
+		},
+		"ReorderedPolicyKeys": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PolicyDocument:   aws.String(vpcePolicyReordered),
+					SubnetIDs:        []string{"subnet-a"},
+					SecurityGroupIDs: []string{"sg-a"},
+				},
+			},
+			want: true,
+		},
+		"DifferentPolicy": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PolicyDocument:   aws.String(`{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*"}]}`),
+					SubnetIDs:        []string{"subnet-a"},
+					SecurityGroupIDs: []string{"sg-a"},
+				},
+			},
+			want: false,
+		},
+		"DifferentSecurityGroups": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					SubnetIDs:        []string{"subnet-a"},
+					SecurityGroupIDs: []string{"sg-b"},
+				},
+			},
+			want: false,
+		},
+		"DifferentPrivateDNS": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PrivateDNSEnabled: aws.Bool(false, aws.FieldRequired),
+					SubnetIDs:         []string{"subnet-a"},
+					SecurityGroupIDs:  []string{"sg-a"},
+				},
+			},
+			want: false,
+		},
+		"InvalidPolicy": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PolicyDocument:   aws.String("{"),
+					SubnetIDs:        []string{"subnet-a"},
+					SecurityGroupIDs: []string{"sg-a"},
+				},
+			},
+			want: false,
+			err:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsVPCEndpointUpToDate(tc.args.p, tc.args.e)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyVPCEndpointInput(t *testing.T) {
+	type args struct {
+		e ec2.VpcEndpoint
+		p v1alpha4.VPCEndpointParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *ec2.ModifyVpcEndpointInput
+	}{
+		"AddAndRemove": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PrivateDNSEnabled: aws.Bool(true),
+					RouteTableIDs:     []string{"rtb-a"},
+					SubnetIDs:         []string{"subnet-b"},
+					SecurityGroupIDs:  []string{"sg-a"},
+				},
+			},
+			want: &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:    aws.String(vpceID),
+				AddRouteTableIds: []string{"rtb-a"},
+				AddSubnetIds:     []string{"subnet-b"},
+				RemoveSubnetIds:  []string{"subnet-a"},
+			},
+		},
+		"PrivateDNSAndPolicy": {
+			args: args{
+				e: vpcEndpoint(),
+				p: v1alpha4.VPCEndpointParameters{
+					PolicyDocument:    aws.String(vpcePolicy),
+					PrivateDNSEnabled: aws.Bool(false, aws.FieldRequired),
+					SubnetIDs:         []string{"subnet-a"},
+					SecurityGroupIDs:  []string{"sg-a"},
+				},
+			},
+			want: &ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:     aws.String(vpceID),
+				PolicyDocument:    aws.String(vpcePolicy),
+				PrivateDnsEnabled: aws.Bool(false, aws.FieldRequired),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyVPCEndpointInput(vpceID, tc.args.p, tc.args.e)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
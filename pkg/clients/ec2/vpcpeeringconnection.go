@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+)
+
+const (
+	// VPCPeeringConnectionNotFound is the code that is returned by ec2 when
+	// the given VPCPeeringConnectionID is not valid
+	VPCPeeringConnectionNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionClient is the external client used for
+// VPCPeeringConnection Custom Resource
+type VPCPeeringConnectionClient interface {
+	CreateVpcPeeringConnectionRequest(input *ec2.CreateVpcPeeringConnectionInput) ec2.CreateVpcPeeringConnectionRequest
+	AcceptVpcPeeringConnectionRequest(input *ec2.AcceptVpcPeeringConnectionInput) ec2.AcceptVpcPeeringConnectionRequest
+	DescribeVpcPeeringConnectionsRequest(input *ec2.DescribeVpcPeeringConnectionsInput) ec2.DescribeVpcPeeringConnectionsRequest
+	DeleteVpcPeeringConnectionRequest(input *ec2.DeleteVpcPeeringConnectionInput) ec2.DeleteVpcPeeringConnectionRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DeleteTagsRequest(input *ec2.DeleteTagsInput) ec2.DeleteTagsRequest
+}
+
+// NewVPCPeeringConnectionClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewVPCPeeringConnectionClient(cfg aws.Config) VPCPeeringConnectionClient {
+	return ec2.New(cfg)
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCPeeringConnectionNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateVPCPeeringConnectionObservation is used to produce
+// v1alpha1.VPCPeeringConnectionObservation from ec2.VpcPeeringConnection.
+func GenerateVPCPeeringConnectionObservation(pc ec2.VpcPeeringConnection) v1alpha1.VPCPeeringConnectionObservation {
+	o := v1alpha1.VPCPeeringConnectionObservation{
+		AccepterVPCInfo:        generateVPCPeeringConnectionVPCInfo(pc.AccepterVpcInfo),
+		RequesterVPCInfo:       generateVPCPeeringConnectionVPCInfo(pc.RequesterVpcInfo),
+		VPCPeeringConnectionID: aws.StringValue(pc.VpcPeeringConnectionId),
+	}
+	if pc.Status != nil {
+		o.StatusCode = string(pc.Status.Code)
+		o.StatusMessage = aws.StringValue(pc.Status.Message)
+	}
+	if pc.ExpirationTime != nil {
+		o.ExpirationTime = &metav1.Time{Time: *pc.ExpirationTime}
+	}
+	return o
+}
+
+func generateVPCPeeringConnectionVPCInfo(in *ec2.VpcPeeringConnectionVpcInfo) v1alpha1.VPCPeeringConnectionVPCInfo {
+	if in == nil {
+		return v1alpha1.VPCPeeringConnectionVPCInfo{}
+	}
+	return v1alpha1.VPCPeeringConnectionVPCInfo{
+		CIDRBlock: aws.StringValue(in.CidrBlock),
+		OwnerID:   aws.StringValue(in.OwnerId),
+		Region:    aws.StringValue(in.Region),
+		VPCID:     aws.StringValue(in.VpcId),
+	}
+}
+
+// NeedsAcceptance returns true if the peering connection is waiting to be
+// accepted and the controller is asked to accept it.
+func NeedsAcceptance(p v1alpha1.VPCPeeringConnectionParameters, o v1alpha1.VPCPeeringConnectionObservation) bool {
+	return aws.BoolValue(p.AcceptRequest) && o.StatusCode == v1alpha1.VPCPeeringConnectionStatusPendingAcceptance
+}
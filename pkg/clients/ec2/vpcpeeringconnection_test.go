@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	pcxID        = "pcx-123"
+	pcxVPCID     = "vpc-123"
+	pcxPeerVPCID = "vpc-456"
+	pcxOwnerID   = "123456789012"
+	pcxRegion    = "us-east-1"
+	pcxCIDR      = "10.0.0.0/16"
+	pcxPeerCIDR  = "10.1.0.0/16"
+)
+
+func TestGenerateVPCPeeringConnectionObservation(t *testing.T) {
+	expiration := time.Now()
+	cases := map[string]struct {
+		in  ec2.VpcPeeringConnection
+		out v1alpha1.VPCPeeringConnectionObservation
+	}{
+		"AllFilled": {
+			in: ec2.VpcPeeringConnection{
+				VpcPeeringConnectionId: aws.String(pcxID),
+				ExpirationTime:         &expiration,
+				Status: &ec2.VpcPeeringConnectionStateReason{
+					Code:    ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+					Message: aws.String("Pending Acceptance by 123456789012"),
+				},
+				RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+					CidrBlock: aws.String(pcxCIDR),
+					OwnerId:   aws.String(pcxOwnerID),
+					Region:    aws.String(pcxRegion),
+					VpcId:     aws.String(pcxVPCID),
+				},
+				AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+					CidrBlock: aws.String(pcxPeerCIDR),
+					OwnerId:   aws.String(pcxOwnerID),
+					Region:    aws.String(pcxRegion),
+					VpcId:     aws.String(pcxPeerVPCID),
+				},
+			},
+			out: v1alpha1.VPCPeeringConnectionObservation{
+				VPCPeeringConnectionID: pcxID,
+				ExpirationTime:         &metav1.Time{Time: expiration},
+				StatusCode:             v1alpha1.VPCPeeringConnectionStatusPendingAcceptance,
+				StatusMessage:          "Pending Acceptance by 123456789012",
+				RequesterVPCInfo: v1alpha1.VPCPeeringConnectionVPCInfo{
+					CIDRBlock: pcxCIDR,
+					OwnerID:   pcxOwnerID,
+					Region:    pcxRegion,
+					VPCID:     pcxVPCID,
+				},
+				AccepterVPCInfo: v1alpha1.VPCPeeringConnectionVPCInfo{
+					CIDRBlock: pcxPeerCIDR,
+					OwnerID:   pcxOwnerID,
+					Region:    pcxRegion,
+					VPCID:     pcxPeerVPCID,
+				},
+			},
+		},
+		"Empty": {
+			in: ec2.VpcPeeringConnection{
+				VpcPeeringConnectionId: aws.String(pcxID),
+			},
+			out: v1alpha1.VPCPeeringConnectionObservation{
+				VPCPeeringConnectionID: pcxID,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateVPCPeeringConnectionObservation(tc.in)
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNeedsAcceptance(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.VPCPeeringConnectionParameters
+		o    v1alpha1.VPCPeeringConnectionObservation
+		want bool
+	}{
+		"PendingAndAccept": {
+			p:    v1alpha1.VPCPeeringConnectionParameters{AcceptRequest: aws.Bool(true)},
+			o:    v1alpha1.VPCPeeringConnectionObservation{StatusCode: v1alpha1.VPCPeeringConnectionStatusPendingAcceptance},
+			want: true,
+		},
+		"PendingAndNotAccept": {
+			p: v1alpha1.VPCPeeringConnectionParameters{},
+			o: v1alpha1.VPCPeeringConnectionObservation{StatusCode: v1alpha1.VPCPeeringConnectionStatusPendingAcceptance},
+		},
+		"AlreadyActive": {
+			p: v1alpha1.VPCPeeringConnectionParameters{AcceptRequest: aws.Bool(true)},
+			o: v1alpha1.VPCPeeringConnectionObservation{StatusCode: v1alpha1.VPCPeeringConnectionStatusActive},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsAcceptance(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
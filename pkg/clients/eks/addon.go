@@ -0,0 +1,292 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// The EKS add-on operations are not part of the AWS SDK version this provider
+// is built with. They are defined here following the REST JSON conventions of
+// the SDK so that they are serialized by the protocol handlers of the EKS
+// client.
+
+// Addon is an Amazon EKS add-on.
+type Addon struct {
+	_ struct{} `type:"structure"`
+
+	AddonArn              *string           `locationName:"addonArn" type:"string"`
+	AddonName             *string           `locationName:"addonName" type:"string"`
+	AddonVersion          *string           `locationName:"addonVersion" type:"string"`
+	ClusterName           *string           `locationName:"clusterName" type:"string"`
+	CreatedAt             *time.Time        `locationName:"createdAt" type:"timestamp"`
+	ModifiedAt            *time.Time        `locationName:"modifiedAt" type:"timestamp"`
+	ServiceAccountRoleArn *string           `locationName:"serviceAccountRoleArn" type:"string"`
+	Status                *string           `locationName:"status" type:"string"`
+	Tags                  map[string]string `locationName:"tags" type:"map"`
+}
+
+// CreateAddonInput is the input of the CreateAddon operation.
+type CreateAddonInput struct {
+	_ struct{} `type:"structure"`
+
+	AddonName             *string           `locationName:"addonName" type:"string" required:"true"`
+	AddonVersion          *string           `locationName:"addonVersion" type:"string"`
+	ClusterName           *string           `location:"uri" locationName:"name" type:"string" required:"true"`
+	ResolveConflicts      *string           `locationName:"resolveConflicts" type:"string"`
+	ServiceAccountRoleArn *string           `locationName:"serviceAccountRoleArn" type:"string"`
+	Tags                  map[string]string `locationName:"tags" type:"map"`
+}
+
+// CreateAddonOutput is the output of the CreateAddon operation.
+type CreateAddonOutput struct {
+	_ struct{} `type:"structure"`
+
+	Addon *Addon `locationName:"addon" type:"structure"`
+}
+
+// DescribeAddonInput is the input of the DescribeAddon operation.
+type DescribeAddonInput struct {
+	_ struct{} `type:"structure"`
+
+	AddonName   *string `location:"uri" locationName:"addonName" type:"string" required:"true"`
+	ClusterName *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+// DescribeAddonOutput is the output of the DescribeAddon operation.
+type DescribeAddonOutput struct {
+	_ struct{} `type:"structure"`
+
+	Addon *Addon `locationName:"addon" type:"structure"`
+}
+
+// UpdateAddonInput is the input of the UpdateAddon operation.
+type UpdateAddonInput struct {
+	_ struct{} `type:"structure"`
+
+	AddonName             *string `location:"uri" locationName:"addonName" type:"string" required:"true"`
+	AddonVersion          *string `locationName:"addonVersion" type:"string"`
+	ClusterName           *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	ResolveConflicts      *string `locationName:"resolveConflicts" type:"string"`
+	ServiceAccountRoleArn *string `locationName:"serviceAccountRoleArn" type:"string"`
+}
+
+// UpdateAddonOutput is the output of the UpdateAddon operation.
+type UpdateAddonOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *eks.Update `locationName:"update" type:"structure"`
+}
+
+// DeleteAddonInput is the input of the DeleteAddon operation.
+type DeleteAddonInput struct {
+	_ struct{} `type:"structure"`
+
+	AddonName   *string `location:"uri" locationName:"addonName" type:"string" required:"true"`
+	ClusterName *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+// DeleteAddonOutput is the output of the DeleteAddon operation.
+type DeleteAddonOutput struct {
+	_ struct{} `type:"structure"`
+
+	Addon *Addon `locationName:"addon" type:"structure"`
+}
+
+// CreateAddonRequest is the request type for the CreateAddon operation.
+type CreateAddonRequest struct {
+	*aws.Request
+}
+
+// Send marshals and sends the CreateAddon API request.
+func (r CreateAddonRequest) Send(ctx context.Context) (*CreateAddonOutput, error) {
+	r.Request.SetContext(ctx)
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*CreateAddonOutput), nil
+}
+
+// DescribeAddonRequest is the request type for the DescribeAddon operation.
+type DescribeAddonRequest struct {
+	*aws.Request
+}
+
+// Send marshals and sends the DescribeAddon API request.
+func (r DescribeAddonRequest) Send(ctx context.Context) (*DescribeAddonOutput, error) {
+	r.Request.SetContext(ctx)
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeAddonOutput), nil
+}
+
+// UpdateAddonRequest is the request type for the UpdateAddon operation.
+type UpdateAddonRequest struct {
+	*aws.Request
+}
+
+// Send marshals and sends the UpdateAddon API request.
+func (r UpdateAddonRequest) Send(ctx context.Context) (*UpdateAddonOutput, error) {
+	r.Request.SetContext(ctx)
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*UpdateAddonOutput), nil
+}
+
+// DeleteAddonRequest is the request type for the DeleteAddon operation.
+type DeleteAddonRequest struct {
+	*aws.Request
+}
+
+// Send marshals and sends the DeleteAddon API request.
+func (r DeleteAddonRequest) Send(ctx context.Context) (*DeleteAddonOutput, error) {
+	r.Request.SetContext(ctx)
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DeleteAddonOutput), nil
+}
+
+// AddonClient defines the EKS add-on operations.
+type AddonClient interface {
+	CreateAddonRequest(*CreateAddonInput) CreateAddonRequest
+	DescribeAddonRequest(*DescribeAddonInput) DescribeAddonRequest
+	UpdateAddonRequest(*UpdateAddonInput) UpdateAddonRequest
+	DeleteAddonRequest(*DeleteAddonInput) DeleteAddonRequest
+}
+
+// NewAddonClient creates a new AddonClient with provided AWS
+// Configurations/Credentials.
+func NewAddonClient(cfg aws.Config) AddonClient {
+	return &addonClient{client: eks.New(cfg)}
+}
+
+type addonClient struct {
+	client *eks.Client
+}
+
+func (c *addonClient) CreateAddonRequest(input *CreateAddonInput) CreateAddonRequest {
+	op := &aws.Operation{
+		Name:       "CreateAddon",
+		HTTPMethod: "POST",
+		HTTPPath:   "/clusters/{name}/addons",
+	}
+	return CreateAddonRequest{Request: c.client.NewRequest(op, input, &CreateAddonOutput{})}
+}
+
+func (c *addonClient) DescribeAddonRequest(input *DescribeAddonInput) DescribeAddonRequest {
+	op := &aws.Operation{
+		Name:       "DescribeAddon",
+		HTTPMethod: "GET",
+		HTTPPath:   "/clusters/{name}/addons/{addonName}",
+	}
+	return DescribeAddonRequest{Request: c.client.NewRequest(op, input, &DescribeAddonOutput{})}
+}
+
+func (c *addonClient) UpdateAddonRequest(input *UpdateAddonInput) UpdateAddonRequest {
+	op := &aws.Operation{
+		Name:       "UpdateAddon",
+		HTTPMethod: "POST",
+		HTTPPath:   "/clusters/{name}/addons/{addonName}/update",
+	}
+	return UpdateAddonRequest{Request: c.client.NewRequest(op, input, &UpdateAddonOutput{})}
+}
+
+func (c *addonClient) DeleteAddonRequest(input *DeleteAddonInput) DeleteAddonRequest {
+	op := &aws.Operation{
+		Name:       "DeleteAddon",
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/clusters/{name}/addons/{addonName}",
+	}
+	return DeleteAddonRequest{Request: c.client.NewRequest(op, input, &DeleteAddonOutput{})}
+}
+
+// GenerateCreateAddonInput from AddonParameters.
+func GenerateCreateAddonInput(name string, p *v1alpha1.AddonParameters) *CreateAddonInput {
+	return &CreateAddonInput{
+		AddonName:             aws.String(name),
+		AddonVersion:          p.AddonVersion,
+		ClusterName:           aws.String(p.ClusterName),
+		ResolveConflicts:      p.ResolveConflicts,
+		ServiceAccountRoleArn: p.ServiceAccountRoleARN,
+		Tags:                  p.Tags,
+	}
+}
+
+// GenerateUpdateAddonInput from AddonParameters.
+func GenerateUpdateAddonInput(name string, p *v1alpha1.AddonParameters) *UpdateAddonInput {
+	return &UpdateAddonInput{
+		AddonName:             aws.String(name),
+		AddonVersion:          p.AddonVersion,
+		ClusterName:           aws.String(p.ClusterName),
+		ResolveConflicts:      p.ResolveConflicts,
+		ServiceAccountRoleArn: p.ServiceAccountRoleARN,
+	}
+}
+
+// GenerateAddonObservation is used to produce v1alpha1.AddonObservation from
+// Addon.
+func GenerateAddonObservation(a *Addon) v1alpha1.AddonObservation {
+	if a == nil {
+		return v1alpha1.AddonObservation{}
+	}
+	o := v1alpha1.AddonObservation{
+		AddonARN:     aws.StringValue(a.AddonArn),
+		AddonVersion: aws.StringValue(a.AddonVersion),
+		Status:       v1alpha1.AddonStatusType(aws.StringValue(a.Status)),
+	}
+	if a.CreatedAt != nil {
+		o.CreatedAt = &metav1.Time{Time: *a.CreatedAt}
+	}
+	if a.ModifiedAt != nil {
+		o.ModifiedAt = &metav1.Time{Time: *a.ModifiedAt}
+	}
+	return o
+}
+
+// LateInitializeAddon fills the empty fields in *v1alpha1.AddonParameters with
+// the values seen in Addon.
+func LateInitializeAddon(in *v1alpha1.AddonParameters, a *Addon) {
+	if a == nil {
+		return
+	}
+	in.AddonVersion = awsclients.LateInitializeStringPtr(in.AddonVersion, a.AddonVersion)
+	in.ServiceAccountRoleARN = awsclients.LateInitializeStringPtr(in.ServiceAccountRoleARN, a.ServiceAccountRoleArn)
+	if len(in.Tags) == 0 && len(a.Tags) != 0 {
+		in.Tags = a.Tags
+	}
+}
+
+// IsAddonUpToDate checks whether the updatable fields of the add-on match the
+// desired ones.
+func IsAddonUpToDate(p *v1alpha1.AddonParameters, a *Addon) bool {
+	if a == nil {
+		return false
+	}
+	return aws.StringValue(p.AddonVersion) == aws.StringValue(a.AddonVersion) &&
+		aws.StringValue(p.ServiceAccountRoleARN) == aws.StringValue(a.ServiceAccountRoleArn)
+}
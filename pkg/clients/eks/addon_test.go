@@ -0,0 +1,273 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+var (
+	addonName    = "vpc-cni"
+	addonVersion = "v1.7.5-eksbuild.1"
+	addonRole    = "arn:aws:iam::123456789012:role/cni"
+	overwrite    = "OVERWRITE"
+)
+
+func TestAddonRequests(t *testing.T) {
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.EndpointResolver = aws.ResolveWithEndpointURL("https://eks.local")
+	c := NewAddonClient(cfg)
+
+	cases := map[string]struct {
+		req        *aws.Request
+		wantMethod string
+		wantPath   string
+	}{
+		"Create": {
+			req:        c.CreateAddonRequest(&CreateAddonInput{AddonName: &addonName, ClusterName: &clusterName}).Request,
+			wantMethod: "POST",
+			wantPath:   "/clusters/my-cool-cluster/addons",
+		},
+		"Describe": {
+			req:        c.DescribeAddonRequest(&DescribeAddonInput{AddonName: &addonName, ClusterName: &clusterName}).Request,
+			wantMethod: "GET",
+			wantPath:   "/clusters/my-cool-cluster/addons/vpc-cni",
+		},
+		"Update": {
+			req:        c.UpdateAddonRequest(&UpdateAddonInput{AddonName: &addonName, ClusterName: &clusterName}).Request,
+			wantMethod: "POST",
+			wantPath:   "/clusters/my-cool-cluster/addons/vpc-cni/update",
+		},
+		"Delete": {
+			req:        c.DeleteAddonRequest(&DeleteAddonInput{AddonName: &addonName, ClusterName: &clusterName}).Request,
+			wantMethod: "DELETE",
+			wantPath:   "/clusters/my-cool-cluster/addons/vpc-cni",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := tc.req.Build(); err != nil {
+				t.Fatalf("Build(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.wantMethod, tc.req.HTTPRequest.Method); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantPath, tc.req.HTTPRequest.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateAddonInput(t *testing.T) {
+	type args struct {
+		name string
+		p    *v1alpha1.AddonParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *CreateAddonInput
+	}{
+		"AllFields": {
+			args: args{
+				name: addonName,
+				p: &v1alpha1.AddonParameters{
+					ClusterName:           clusterName,
+					AddonVersion:          &addonVersion,
+					ResolveConflicts:      &overwrite,
+					ServiceAccountRoleARN: &addonRole,
+					Tags:                  map[string]string{"cool": "tag"},
+				},
+			},
+			want: &CreateAddonInput{
+				AddonName:             &addonName,
+				ClusterName:           &clusterName,
+				AddonVersion:          &addonVersion,
+				ResolveConflicts:      &overwrite,
+				ServiceAccountRoleArn: &addonRole,
+				Tags:                  map[string]string{"cool": "tag"},
+			},
+		},
+		"SomeFields": {
+			args: args{
+				name: addonName,
+				p: &v1alpha1.AddonParameters{
+					ClusterName: clusterName,
+				},
+			},
+			want: &CreateAddonInput{
+				AddonName:   &addonName,
+				ClusterName: &clusterName,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateAddonInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAddonObservation(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		a    *Addon
+		want v1alpha1.AddonObservation
+	}{
+		"AllFields": {
+			a: &Addon{
+				AddonArn:     aws.String("arn"),
+				AddonVersion: &addonVersion,
+				CreatedAt:    &now,
+				ModifiedAt:   &now,
+				Status:       aws.String("ACTIVE"),
+			},
+			want: v1alpha1.AddonObservation{
+				AddonARN:     "arn",
+				AddonVersion: addonVersion,
+				CreatedAt:    &v1.Time{Time: now},
+				ModifiedAt:   &v1.Time{Time: now},
+				Status:       v1alpha1.AddonStatusActive,
+			},
+		},
+		"Nil": {
+			want: v1alpha1.AddonObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateAddonObservation(tc.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAddon(t *testing.T) {
+	otherVersion := "v1.7.0-eksbuild.1"
+
+	type args struct {
+		p *v1alpha1.AddonParameters
+		a *Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.AddonParameters
+	}{
+		"AllFieldsEmpty": {
+			args: args{
+				p: &v1alpha1.AddonParameters{},
+				a: &Addon{
+					AddonVersion:          &addonVersion,
+					ServiceAccountRoleArn: &addonRole,
+					Tags:                  map[string]string{"cool": "tag"},
+				},
+			},
+			want: &v1alpha1.AddonParameters{
+				AddonVersion:          &addonVersion,
+				ServiceAccountRoleARN: &addonRole,
+				Tags:                  map[string]string{"cool": "tag"},
+			},
+		},
+		"SomeFieldsSet": {
+			args: args{
+				p: &v1alpha1.AddonParameters{
+					AddonVersion: &otherVersion,
+				},
+				a: &Addon{
+					AddonVersion: &addonVersion,
+				},
+			},
+			want: &v1alpha1.AddonParameters{
+				AddonVersion: &otherVersion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAddon(tc.args.p, tc.args.a)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAddonUpToDate(t *testing.T) {
+	otherVersion := "v1.7.0-eksbuild.1"
+
+	type args struct {
+		p *v1alpha1.AddonParameters
+		a *Addon
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: &v1alpha1.AddonParameters{AddonVersion: &addonVersion, ServiceAccountRoleARN: &addonRole},
+				a: &Addon{AddonVersion: &addonVersion, ServiceAccountRoleArn: &addonRole},
+			},
+			want: true,
+		},
+		"UpdateVersion": {
+			args: args{
+				p: &v1alpha1.AddonParameters{AddonVersion: &otherVersion, ServiceAccountRoleARN: &addonRole},
+				a: &Addon{AddonVersion: &addonVersion, ServiceAccountRoleArn: &addonRole},
+			},
+			want: false,
+		},
+		"UpdateRole": {
+			args: args{
+				p: &v1alpha1.AddonParameters{AddonVersion: &addonVersion},
+				a: &Addon{AddonVersion: &addonVersion, ServiceAccountRoleArn: &addonRole},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAddonUpToDate(tc.args.p, tc.args.a)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,9 +19,12 @@ package fake
 import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/eksiface"
+
+	clienteks "github.com/crossplane/provider-aws/pkg/clients/eks"
 )
 
 var _ eksiface.ClientAPI = &MockClient{}
+var _ clienteks.AddonClient = &MockAddonClient{}
 
 // MockClient is a fake implementation of cloudmemorystore.Client.
 type MockClient struct {
@@ -131,3 +134,31 @@ func (c *MockClient) CreateFargateProfileRequest(i *eks.CreateFargateProfileInpu
 func (c *MockClient) DeleteFargateProfileRequest(i *eks.DeleteFargateProfileInput) eks.DeleteFargateProfileRequest {
 	return c.MockDeleteFargateProfileRequest(i)
 }
+
+// MockAddonClient is a fake implementation of eks.AddonClient.
+type MockAddonClient struct {
+	MockCreateAddonRequest   func(*clienteks.CreateAddonInput) clienteks.CreateAddonRequest
+	MockDescribeAddonRequest func(*clienteks.DescribeAddonInput) clienteks.DescribeAddonRequest
+	MockUpdateAddonRequest   func(*clienteks.UpdateAddonInput) clienteks.UpdateAddonRequest
+	MockDeleteAddonRequest   func(*clienteks.DeleteAddonInput) clienteks.DeleteAddonRequest
+}
+
+// CreateAddonRequest calls the underlying MockCreateAddonRequest method.
+func (c *MockAddonClient) CreateAddonRequest(i *clienteks.CreateAddonInput) clienteks.CreateAddonRequest {
+	return c.MockCreateAddonRequest(i)
+}
+
+// DescribeAddonRequest calls the underlying MockDescribeAddonRequest method.
+func (c *MockAddonClient) DescribeAddonRequest(i *clienteks.DescribeAddonInput) clienteks.DescribeAddonRequest {
+	return c.MockDescribeAddonRequest(i)
+}
+
+// UpdateAddonRequest calls the underlying MockUpdateAddonRequest method.
+func (c *MockAddonClient) UpdateAddonRequest(i *clienteks.UpdateAddonInput) clienteks.UpdateAddonRequest {
+	return c.MockUpdateAddonRequest(i)
+}
+
+// DeleteAddonRequest calls the underlying MockDeleteAddonRequest method.
+func (c *MockAddonClient) DeleteAddonRequest(i *clienteks.DeleteAddonInput) clienteks.DeleteAddonRequest {
+	return c.MockDeleteAddonRequest(i)
+}
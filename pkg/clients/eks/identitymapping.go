@@ -0,0 +1,200 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+const (
+	// AWSAuthNamespace is the namespace of the aws-auth ConfigMap.
+	AWSAuthNamespace = "kube-system"
+	// AWSAuthName is the name of the aws-auth ConfigMap.
+	AWSAuthName = "aws-auth"
+
+	mapRolesKey = "mapRoles"
+	mapUsersKey = "mapUsers"
+
+	roleARNKey  = "rolearn"
+	userARNKey  = "userarn"
+	usernameKey = "username"
+	groupsKey   = "groups"
+
+	errParseMappings  = "cannot parse identity mappings in aws-auth ConfigMap"
+	errRenderMappings = "cannot render identity mappings of aws-auth ConfigMap"
+)
+
+// NewKubeClient returns a Kubernetes client for the cluster the supplied
+// kubeconfig points to.
+func NewKubeClient(kubeconfig []byte) (client.Client, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.New(cfg, client.Options{})
+}
+
+// IdentityMapping is an entry of the mapRoles or mapUsers section of the
+// aws-auth ConfigMap.
+type IdentityMapping struct {
+	RoleARN  string   `json:"rolearn,omitempty"`
+	UserARN  string   `json:"userarn,omitempty"`
+	Username string   `json:"username"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// GenerateAWSAuthConfigMap returns an empty aws-auth ConfigMap.
+func GenerateAWSAuthConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: AWSAuthNamespace,
+			Name:      AWSAuthName,
+		},
+	}
+}
+
+// GenerateIdentityMapping returns the aws-auth entry described by the
+// supplied IAMIdentityMappingParameters.
+func GenerateIdentityMapping(p *v1alpha1.IAMIdentityMappingParameters) IdentityMapping {
+	return IdentityMapping{
+		RoleARN:  p.RoleARN,
+		UserARN:  p.UserARN,
+		Username: p.Username,
+		Groups:   p.Groups,
+	}
+}
+
+// GetIdentityMapping returns the entry of the aws-auth ConfigMap with the
+// same IAM ARN as the supplied parameters, or nil if there is none.
+func GetIdentityMapping(cm *corev1.ConfigMap, p *v1alpha1.IAMIdentityMappingParameters) (*IdentityMapping, error) {
+	section, arnKey, arn := mappingKeys(p)
+	entries, err := parseMappings(cm, section)
+	if err != nil {
+		return nil, err
+	}
+	i := findMapping(entries, arnKey, arn)
+	if i == -1 {
+		return nil, nil
+	}
+	m := &IdentityMapping{}
+	b, err := yaml.Marshal(entries[i])
+	if err != nil {
+		return nil, errors.Wrap(err, errParseMappings)
+	}
+	return m, errors.Wrap(yaml.Unmarshal(b, m), errParseMappings)
+}
+
+// UpsertIdentityMapping writes the entry described by the supplied parameters
+// to the aws-auth ConfigMap, replacing the entry with the same IAM ARN if
+// there is one. Other entries are left untouched.
+func UpsertIdentityMapping(cm *corev1.ConfigMap, p *v1alpha1.IAMIdentityMappingParameters) error {
+	section, arnKey, arn := mappingKeys(p)
+	entries, err := parseMappings(cm, section)
+	if err != nil {
+		return err
+	}
+	entry := map[string]interface{}{arnKey: arn, usernameKey: p.Username}
+	if len(p.Groups) != 0 {
+		entry[groupsKey] = p.Groups
+	}
+	if i := findMapping(entries, arnKey, arn); i != -1 {
+		entries[i] = entry
+	} else {
+		entries = append(entries, entry)
+	}
+	return renderMappings(cm, section, entries)
+}
+
+// RemoveIdentityMapping removes the entry with the same IAM ARN as the
+// supplied parameters from the aws-auth ConfigMap. It returns false if there
+// was no such entry.
+func RemoveIdentityMapping(cm *corev1.ConfigMap, p *v1alpha1.IAMIdentityMappingParameters) (bool, error) {
+	section, arnKey, arn := mappingKeys(p)
+	entries, err := parseMappings(cm, section)
+	if err != nil {
+		return false, err
+	}
+	i := findMapping(entries, arnKey, arn)
+	if i == -1 {
+		return false, nil
+	}
+	entries = append(entries[:i], entries[i+1:]...)
+	return true, renderMappings(cm, section, entries)
+}
+
+// IsIdentityMappingUpToDate checks whether the observed aws-auth entry
+// matches the desired one.
+func IsIdentityMappingUpToDate(p *v1alpha1.IAMIdentityMappingParameters, m *IdentityMapping) bool {
+	if m == nil {
+		return false
+	}
+	return cmp.Equal(GenerateIdentityMapping(p), *m, cmpopts.EquateEmpty())
+}
+
+// mappingKeys returns the section of the aws-auth ConfigMap the mapping
+// belongs to, along with the key and value of the ARN identifying it.
+func mappingKeys(p *v1alpha1.IAMIdentityMappingParameters) (string, string, string) {
+	if p.UserARN != "" {
+		return mapUsersKey, userARNKey, p.UserARN
+	}
+	return mapRolesKey, roleARNKey, p.RoleARN
+}
+
+// parseMappings returns the entries of a section of the aws-auth ConfigMap.
+// They are handled as generic maps rather than IdentityMappings so
+// that fields of entries this provider does not manage are preserved.
+func parseMappings(cm *corev1.ConfigMap, section string) ([]map[string]interface{}, error) {
+	entries := []map[string]interface{}{}
+	if cm.Data[section] == "" {
+		return entries, nil
+	}
+	return entries, errors.Wrap(yaml.Unmarshal([]byte(cm.Data[section]), &entries), errParseMappings)
+}
+
+func renderMappings(cm *corev1.ConfigMap, section string, entries []map[string]interface{}) error {
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	if len(entries) == 0 {
+		delete(cm.Data, section)
+		return nil
+	}
+	b, err := yaml.Marshal(entries)
+	if err != nil {
+		return errors.Wrap(err, errRenderMappings)
+	}
+	cm.Data[section] = string(b)
+	return nil
+}
+
+func findMapping(entries []map[string]interface{}, arnKey, arn string) int {
+	for i, e := range entries {
+		if v, ok := e[arnKey].(string); ok && v == arn {
+			return i
+		}
+	}
+	return -1
+}
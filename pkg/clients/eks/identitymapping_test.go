@@ -0,0 +1,240 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+)
+
+var (
+	mappedRole = "arn:aws:iam::123456789012:role/admin"
+	mappedUser = "arn:aws:iam::123456789012:user/alice"
+	nodeRoles  = `- rolearn: arn:aws:iam::123456789012:role/node
+  username: system:node:{{EC2PrivateDNSName}}
+  groups:
+  - system:bootstrappers
+  - system:nodes
+`
+)
+
+func awsAuth(data map[string]string) *corev1.ConfigMap {
+	cm := GenerateAWSAuthConfigMap()
+	cm.Data = data
+	return cm
+}
+
+func TestGetIdentityMapping(t *testing.T) {
+	type args struct {
+		cm *corev1.ConfigMap
+		p  *v1alpha1.IAMIdentityMappingParameters
+	}
+	type want struct {
+		m   *IdentityMapping
+		err bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Role": {
+			args: args{
+				cm: awsAuth(map[string]string{mapRolesKey: nodeRoles + "- rolearn: " + mappedRole + "\n  username: admin\n  groups:\n  - system:masters\n"}),
+				p:  &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole},
+			},
+			want: want{
+				m: &IdentityMapping{RoleARN: mappedRole, Username: "admin", Groups: []string{"system:masters"}},
+			},
+		},
+		"User": {
+			args: args{
+				cm: awsAuth(map[string]string{mapUsersKey: "- userarn: " + mappedUser + "\n  username: alice\n"}),
+				p:  &v1alpha1.IAMIdentityMappingParameters{UserARN: mappedUser},
+			},
+			want: want{
+				m: &IdentityMapping{UserARN: mappedUser, Username: "alice"},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cm: awsAuth(map[string]string{mapRolesKey: nodeRoles}),
+				p:  &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole},
+			},
+			want: want{},
+		},
+		"EmptyConfigMap": {
+			args: args{
+				cm: awsAuth(nil),
+				p:  &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole},
+			},
+			want: want{},
+		},
+		"Malformed": {
+			args: args{
+				cm: awsAuth(map[string]string{mapRolesKey: "{"}),
+				p:  &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole},
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m, err := GetIdentityMapping(tc.args.cm, tc.args.p)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.m, m); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpsertIdentityMapping(t *testing.T) {
+	admin := &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole, Username: "admin", Groups: []string{"system:masters"}}
+
+	cases := map[string]struct {
+		cm   *corev1.ConfigMap
+		p    *v1alpha1.IAMIdentityMappingParameters
+		want map[string]string
+	}{
+		"AddToEmpty": {
+			cm:   awsAuth(nil),
+			p:    admin,
+			want: map[string]string{mapRolesKey: "- groups:\n  - system:masters\n  rolearn: " + mappedRole + "\n  username: admin\n"},
+		},
+		"AppendKeepsOtherEntries": {
+			cm: awsAuth(map[string]string{mapRolesKey: nodeRoles}),
+			p:  admin,
+			want: map[string]string{mapRolesKey: `- groups:
+  - system:bootstrappers
+  - system:nodes
+  rolearn: arn:aws:iam::123456789012:role/node
+  username: system:node:{{EC2PrivateDNSName}}
+- groups:
+  - system:masters
+  rolearn: ` + mappedRole + `
+  username: admin
+`},
+		},
+		"ReplaceExisting": {
+			cm:   awsAuth(map[string]string{mapRolesKey: "- rolearn: " + mappedRole + "\n  username: old\n"}),
+			p:    admin,
+			want: map[string]string{mapRolesKey: "- groups:\n  - system:masters\n  rolearn: " + mappedRole + "\n  username: admin\n"},
+		},
+		"User": {
+			cm:   awsAuth(map[string]string{mapRolesKey: nodeRoles}),
+			p:    &v1alpha1.IAMIdentityMappingParameters{UserARN: mappedUser, Username: "alice"},
+			want: map[string]string{mapRolesKey: nodeRoles, mapUsersKey: "- userarn: " + mappedUser + "\n  username: alice\n"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := UpsertIdentityMapping(tc.cm, tc.p); err != nil {
+				t.Fatalf("UpsertIdentityMapping(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cm.Data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveIdentityMapping(t *testing.T) {
+	cases := map[string]struct {
+		cm          *corev1.ConfigMap
+		p           *v1alpha1.IAMIdentityMappingParameters
+		wantRemoved bool
+		want        map[string]string
+	}{
+		"Remove": {
+			cm:          awsAuth(map[string]string{mapUsersKey: "- userarn: " + mappedUser + "\n  username: alice\n"}),
+			p:           &v1alpha1.IAMIdentityMappingParameters{UserARN: mappedUser},
+			wantRemoved: true,
+			want:        map[string]string{},
+		},
+		"NotFound": {
+			cm:   awsAuth(map[string]string{mapRolesKey: nodeRoles}),
+			p:    &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole},
+			want: map[string]string{mapRolesKey: nodeRoles},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			removed, err := RemoveIdentityMapping(tc.cm, tc.p)
+			if err != nil {
+				t.Fatalf("RemoveIdentityMapping(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.wantRemoved, removed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.cm.Data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIdentityMappingUpToDate(t *testing.T) {
+	type args struct {
+		p *v1alpha1.IAMIdentityMappingParameters
+		m *IdentityMapping
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole, Username: "admin", Groups: []string{}},
+				m: &IdentityMapping{RoleARN: mappedRole, Username: "admin"},
+			},
+			want: true,
+		},
+		"DifferentGroups": {
+			args: args{
+				p: &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole, Username: "admin", Groups: []string{"system:masters"}},
+				m: &IdentityMapping{RoleARN: mappedRole, Username: "admin"},
+			},
+			want: false,
+		},
+		"Missing": {
+			args: args{
+				p: &v1alpha1.IAMIdentityMappingParameters{RoleARN: mappedRole},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsIdentityMappingUpToDate(tc.args.p, tc.args.m)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,11 @@ limitations under the License.
 package eks
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -126,11 +131,19 @@ func GenerateNodeGroupObservation(ng *eks.Nodegroup) v1alpha1.NodeGroupObservati
 }
 
 // LateInitializeNodeGroup fills the empty fields in *v1alpha1.NodeGroupParameters with the
-// values seen in eks.Nodegroup.
-func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) { // nolint:gocyclo
+// values seen in eks.Nodegroup and its launch template.
+func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup, lt *NodeGroupLaunchTemplate) { // nolint:gocyclo
 	if ng == nil {
 		return
 	}
+	if lt != nil && (lt.ID != nil || lt.Name != nil) {
+		// NOTE: The ID and the name of a launch template cannot be given
+		// together, so we only late initialize the ID.
+		if in.LaunchTemplate == nil {
+			in.LaunchTemplate = &v1alpha1.LaunchTemplateSpecification{ID: lt.ID}
+		}
+		in.LaunchTemplate.Version = awsclients.LateInitializeStringPtr(in.LaunchTemplate.Version, lt.Version)
+	}
 	in.AMIType = awsclients.LateInitializeStringPtr(in.AMIType, awsclients.String(string(ng.AmiType)))
 	in.DiskSize = awsclients.LateInitializeInt64Ptr(in.DiskSize, ng.DiskSize)
 	if len(in.InstanceTypes) == 0 && len(ng.InstanceTypes) > 0 {
@@ -163,10 +176,13 @@ func LateInitializeNodeGroup(in *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup
 }
 
 // IsNodeGroupUpToDate checks whether there is a change in any of the modifiable fields.
-func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) bool { // nolint:gocyclo
+func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup, lt *NodeGroupLaunchTemplate) bool { // nolint:gocyclo
 	if !cmp.Equal(p.Tags, ng.Tags, cmpopts.EquateEmpty()) {
 		return false
 	}
+	if !IsLaunchTemplateUpToDate(p, lt) {
+		return false
+	}
 	if !cmp.Equal(p.Version, ng.Version) {
 		return false
 	}
@@ -190,3 +206,91 @@ func IsNodeGroupUpToDate(p *v1alpha1.NodeGroupParameters, ng *eks.Nodegroup) boo
 	}
 	return false
 }
+
+// IsLaunchTemplateUpToDate checks whether the node group uses the desired
+// version of its launch template. The launch template itself cannot be
+// changed.
+func IsLaunchTemplateUpToDate(p *v1alpha1.NodeGroupParameters, lt *NodeGroupLaunchTemplate) bool {
+	if p.LaunchTemplate == nil || p.LaunchTemplate.Version == nil || lt == nil {
+		return true
+	}
+	return aws.StringValue(p.LaunchTemplate.Version) == aws.StringValue(lt.Version)
+}
+
+// NOTE: The vendored aws-sdk-go-v2 predates launch template support in EKS
+// node groups. The functions below add the launch template to the request
+// and read it from the response bodies until the SDK is bumped.
+
+// NodeGroupLaunchTemplate is the launch template of a node group as reported
+// by DescribeNodegroup.
+type NodeGroupLaunchTemplate struct {
+	ID      *string `json:"id,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// WithLaunchTemplate makes the given CreateNodegroup or UpdateNodegroupVersion
+// request use the given launch template. Nothing is added if it is nil.
+func WithLaunchTemplate(r *awsv2.Request, lt *v1alpha1.LaunchTemplateSpecification) {
+	if lt == nil {
+		return
+	}
+	t := NodeGroupLaunchTemplate{ID: lt.ID, Name: lt.Name, Version: lt.Version}
+	r.Handlers.Build.PushBack(func(r *awsv2.Request) {
+		if r.Error != nil || r.Body == nil {
+			return
+		}
+		if _, err := r.Body.Seek(0, 0); err != nil {
+			r.Error = err
+			return
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		body := map[string]interface{}{}
+		if len(b) != 0 {
+			if err := json.Unmarshal(b, &body); err != nil {
+				r.Error = err
+				return
+			}
+		}
+		body["launchTemplate"] = t
+		if b, err = json.Marshal(body); err != nil {
+			r.Error = err
+			return
+		}
+		r.SetBufferBody(b)
+	})
+}
+
+// LaunchTemplateFrom returns a NodeGroupLaunchTemplate that is filled from the
+// response of the given DescribeNodegroup request once it is sent. Its fields
+// stay nil if the node group has no launch template.
+func LaunchTemplateFrom(r *awsv2.Request) *NodeGroupLaunchTemplate {
+	lt := &NodeGroupLaunchTemplate{}
+	r.Handlers.Unmarshal.PushFront(func(r *awsv2.Request) {
+		if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+			return
+		}
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+		body := struct {
+			Nodegroup struct {
+				LaunchTemplate *NodeGroupLaunchTemplate `json:"launchTemplate"`
+			} `json:"nodegroup"`
+		}{}
+		// A malformed body is reported by the SDK unmarshaller.
+		if err := json.Unmarshal(b, &body); err != nil || body.Nodegroup.LaunchTemplate == nil {
+			return
+		}
+		*lt = *body.Nodegroup.LaunchTemplate
+	})
+	return lt
+}
@@ -17,9 +17,15 @@ limitations under the License.
 package eks
 
 import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +39,9 @@ var (
 	diskSize = int64(20)
 	size     = int64(2)
 	nodeRole = "cool-role"
+	ltID     = "lt-0123456789abcdef0"
+	ltName   = "cool-template"
+	ltVer    = "2"
 )
 
 func TestGenerateCreateNodeGroupInput(t *testing.T) {
@@ -327,8 +336,9 @@ func TestGenerateUpdateNodeObservation(t *testing.T) {
 func TestLateInitializeNodeGroup(t *testing.T) {
 	ami := "AL2_x86_64"
 	type args struct {
-		p *v1alpha1.NodeGroupParameters
-		n *eks.Nodegroup
+		p  *v1alpha1.NodeGroupParameters
+		n  *eks.Nodegroup
+		lt *NodeGroupLaunchTemplate
 	}
 
 	cases := map[string]struct {
@@ -376,11 +386,41 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 				Version: &version,
 			},
 		},
+		"LaunchTemplate": {
+			args: args{
+				p:  &v1alpha1.NodeGroupParameters{},
+				n:  &eks.Nodegroup{},
+				lt: &NodeGroupLaunchTemplate{ID: &ltID, Name: &ltName, Version: &ltVer},
+			},
+			want: &v1alpha1.NodeGroupParameters{
+				LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{ID: &ltID, Version: &ltVer},
+			},
+		},
+		"LaunchTemplateVersion": {
+			args: args{
+				p: &v1alpha1.NodeGroupParameters{
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{Name: &ltName},
+				},
+				n:  &eks.Nodegroup{},
+				lt: &NodeGroupLaunchTemplate{ID: &ltID, Name: &ltName, Version: &ltVer},
+			},
+			want: &v1alpha1.NodeGroupParameters{
+				LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: &ltVer},
+			},
+		},
+		"NoLaunchTemplate": {
+			args: args{
+				p:  &v1alpha1.NodeGroupParameters{},
+				n:  &eks.Nodegroup{},
+				lt: &NodeGroupLaunchTemplate{},
+			},
+			want: &v1alpha1.NodeGroupParameters{},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			LateInitializeNodeGroup(tc.args.p, tc.args.n)
+			LateInitializeNodeGroup(tc.args.p, tc.args.n, tc.args.lt)
 			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
@@ -393,8 +433,9 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 	otherSize := int64(100)
 
 	type args struct {
-		p *v1alpha1.NodeGroupParameters
-		n *eks.Nodegroup
+		p  *v1alpha1.NodeGroupParameters
+		n  *eks.Nodegroup
+		lt *NodeGroupLaunchTemplate
 	}
 
 	cases := map[string]struct {
@@ -503,14 +544,145 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"LaunchTemplateUpToDate": {
+			args: args{
+				p: &v1alpha1.NodeGroupParameters{
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: &ltVer},
+				},
+				n:  &eks.Nodegroup{},
+				lt: &NodeGroupLaunchTemplate{ID: &ltID, Name: &ltName, Version: &ltVer},
+			},
+			want: true,
+		},
+		"UpdateLaunchTemplateVersion": {
+			args: args{
+				p: &v1alpha1.NodeGroupParameters{
+					LaunchTemplate: &v1alpha1.LaunchTemplateSpecification{Name: &ltName, Version: aws.String("3")},
+				},
+				n:  &eks.Nodegroup{},
+				lt: &NodeGroupLaunchTemplate{ID: &ltID, Name: &ltName, Version: &ltVer},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			upToDate := IsNodeGroupUpToDate(tc.args.p, tc.args.n)
+			upToDate := IsNodeGroupUpToDate(tc.args.p, tc.args.n, tc.args.lt)
 			if diff := cmp.Diff(tc.want, upToDate); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
+
+func testClient() *eks.Client {
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.Credentials = aws.AnonymousCredentials
+	cfg.EndpointResolver = aws.ResolveWithEndpointURL("https://eks.example.com")
+	return eks.New(cfg)
+}
+
+func TestWithLaunchTemplate(t *testing.T) {
+	c := testClient()
+
+	cases := map[string]struct {
+		req  *aws.Request
+		lt   *v1alpha1.LaunchTemplateSpecification
+		want map[string]interface{}
+	}{
+		"CreateNodegroup": {
+			req: c.CreateNodegroupRequest(&eks.CreateNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				NodeRole:      &nodeRole,
+				Subnets:       []string{"subnet1"},
+			}).Request,
+			lt: &v1alpha1.LaunchTemplateSpecification{Name: &ltName},
+			want: map[string]interface{}{
+				"launchTemplate": map[string]interface{}{"name": ltName},
+				"nodeRole":       nodeRole,
+				"nodegroupName":  ngName,
+				"subnets":        []interface{}{"subnet1"},
+			},
+		},
+		"UpdateNodegroupVersion": {
+			req: c.UpdateNodegroupVersionRequest(&eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Request,
+			lt: &v1alpha1.LaunchTemplateSpecification{ID: &ltID, Version: &ltVer},
+			want: map[string]interface{}{
+				"launchTemplate": map[string]interface{}{"id": ltID, "version": ltVer},
+			},
+		},
+		"NoLaunchTemplate": {
+			req: c.UpdateNodegroupVersionRequest(&eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &version,
+			}).Request,
+			want: map[string]interface{}{
+				"version": version,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			WithLaunchTemplate(tc.req, tc.lt)
+			if err := tc.req.Build(); err != nil {
+				t.Fatalf("r.Build(): %s", err)
+			}
+			b, err := ioutil.ReadAll(tc.req.GetBody())
+			if err != nil {
+				t.Fatalf("ioutil.ReadAll(): %s", err)
+			}
+			body := map[string]interface{}{}
+			if err := json.Unmarshal(b, &body); err != nil {
+				t.Fatalf("json.Unmarshal(): %s", err)
+			}
+			// The client request token is generated for every request.
+			delete(body, "clientRequestToken")
+			if diff := cmp.Diff(tc.want, body); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLaunchTemplateFrom(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		want *NodeGroupLaunchTemplate
+	}{
+		"LaunchTemplate": {
+			body: `{"nodegroup":{"nodegroupName":"my-cool-ng","launchTemplate":{"id":"lt-0123456789abcdef0","name":"cool-template","version":"2"}}}`,
+			want: &NodeGroupLaunchTemplate{ID: &ltID, Name: &ltName, Version: &ltVer},
+		},
+		"NoLaunchTemplate": {
+			body: `{"nodegroup":{"nodegroupName":"my-cool-ng"}}`,
+			want: &NodeGroupLaunchTemplate{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := testClient().DescribeNodegroupRequest(&eks.DescribeNodegroupInput{ClusterName: &clusterName, NodegroupName: &ngName})
+			lt := LaunchTemplateFrom(req.Request)
+			req.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(tc.body))}
+			req.Handlers.Unmarshal.Run(req.Request)
+			if req.Error != nil {
+				t.Fatalf("req.Handlers.Unmarshal.Run(): %s", req.Error)
+			}
+			if diff := cmp.Diff(tc.want, lt); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			out := req.Data.(*eks.DescribeNodegroupOutput)
+			if diff := cmp.Diff(ngName, aws.StringValue(out.Nodegroup.NodegroupName)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/elasticloadbalancingv2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// A Client handles CRUD operations for Elastic Load Balancing v2 resources.
+type Client elasticloadbalancingv2iface.ClientAPI
+
+// NewClient returns a new Elastic Load Balancing v2 client. Credentials must
+// be passed as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return elbv2.New(cfg)
+}
+
+// isErrorCode returns true if the cause of the given error is an AWS error
+// with the given code.
+func isErrorCode(err error, code string) bool {
+	if awsErr, ok := errors.Cause(err).(awserr.Error); ok && awsErr.Code() == code {
+		return true
+	}
+	return false
+}
+
+// IsLoadBalancerNotFound returns true if the error is because the load
+// balancer doesn't exist.
+func IsLoadBalancerNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeLoadBalancerNotFoundException)
+}
+
+// IsTargetGroupNotFound returns true if the error is because the target group
+// doesn't exist.
+func IsTargetGroupNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeTargetGroupNotFoundException)
+}
+
+// IsListenerNotFound returns true if the error is because the listener
+// doesn't exist.
+func IsListenerNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeListenerNotFoundException)
+}
+
+// IsRuleNotFound returns true if the error is because the listener rule
+// doesn't exist.
+func IsRuleNotFound(err error) bool {
+	return isErrorCode(err, elbv2.ErrCodeRuleNotFoundException)
+}
+
+// GenerateTags generates a list of elbv2.Tag from given list of v1alpha1.Tag
+func GenerateTags(tags []v1alpha1.Tag) []elbv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = elbv2.Tag{Key: aws.String(t.Key), Value: t.Value}
+	}
+	return res
+}
+
+// DiffTags returns the tags that should be added or updated and the keys of
+// the tags that should be removed so that the observed tags match the
+// desired ones.
+func DiffTags(desired []v1alpha1.Tag, observed []elbv2.Tag) (add []elbv2.Tag, remove []string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = aws.StringValue(t.Value)
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	addMap, remove := clients.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, elbv2.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(add, func(i, j int) bool {
+		return aws.StringValue(add[i].Key) < aws.StringValue(add[j].Key)
+	})
+	sort.Strings(remove)
+	return add, remove
+}
+
+// AreTagsUpToDate returns true if the observed tags match the desired ones.
+func AreTagsUpToDate(desired []v1alpha1.Tag, observed []elbv2.Tag) bool {
+	add, remove := DiffTags(desired, observed)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// GenerateActions generates a list of elbv2.Action from the given list of
+// v1alpha1.Action.
+func GenerateActions(actions []v1alpha1.Action) []elbv2.Action {
+	if len(actions) == 0 {
+		return nil
+	}
+	res := make([]elbv2.Action, len(actions))
+	for i, a := range actions {
+		res[i] = elbv2.Action{
+			Type:           elbv2.ActionTypeEnum(a.Type),
+			Order:          a.Order,
+			TargetGroupArn: a.TargetGroupARN,
+		}
+		if a.ForwardConfig != nil {
+			fc := &elbv2.ForwardActionConfig{}
+			for _, tg := range a.ForwardConfig.TargetGroups {
+				fc.TargetGroups = append(fc.TargetGroups, elbv2.TargetGroupTuple{
+					TargetGroupArn: tg.TargetGroupARN,
+					Weight:         tg.Weight,
+				})
+			}
+			if s := a.ForwardConfig.TargetGroupStickinessConfig; s != nil {
+				fc.TargetGroupStickinessConfig = &elbv2.TargetGroupStickinessConfig{
+					Enabled:         aws.Bool(s.Enabled),
+					DurationSeconds: s.DurationSeconds,
+				}
+			}
+			res[i].ForwardConfig = fc
+		}
+		if rc := a.RedirectConfig; rc != nil {
+			res[i].RedirectConfig = &elbv2.RedirectActionConfig{
+				StatusCode: elbv2.RedirectActionStatusCodeEnum(rc.StatusCode),
+				Host:       rc.Host,
+				Path:       rc.Path,
+				Port:       rc.Port,
+				Protocol:   rc.Protocol,
+				Query:      rc.Query,
+			}
+		}
+		if fr := a.FixedResponseConfig; fr != nil {
+			res[i].FixedResponseConfig = &elbv2.FixedResponseActionConfig{
+				StatusCode:  aws.String(fr.StatusCode),
+				ContentType: fr.ContentType,
+				MessageBody: fr.MessageBody,
+			}
+		}
+	}
+	return res
+}
+
+// generateV1alpha1Actions is the inverse of GenerateActions. It is used to
+// compare the observed actions with the desired ones.
+func generateV1alpha1Actions(actions []elbv2.Action) []v1alpha1.Action {
+	if len(actions) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.Action, len(actions))
+	for i, a := range actions {
+		res[i] = v1alpha1.Action{
+			Type:           string(a.Type),
+			Order:          a.Order,
+			TargetGroupARN: a.TargetGroupArn,
+		}
+		if a.ForwardConfig != nil {
+			fc := &v1alpha1.ForwardActionConfig{}
+			for _, tg := range a.ForwardConfig.TargetGroups {
+				fc.TargetGroups = append(fc.TargetGroups, v1alpha1.TargetGroupTuple{
+					TargetGroupARN: tg.TargetGroupArn,
+					Weight:         tg.Weight,
+				})
+			}
+			if s := a.ForwardConfig.TargetGroupStickinessConfig; s != nil {
+				fc.TargetGroupStickinessConfig = &v1alpha1.TargetGroupStickinessConfig{
+					Enabled:         aws.BoolValue(s.Enabled),
+					DurationSeconds: s.DurationSeconds,
+				}
+			}
+			res[i].ForwardConfig = fc
+		}
+		if rc := a.RedirectConfig; rc != nil {
+			res[i].RedirectConfig = &v1alpha1.RedirectActionConfig{
+				StatusCode: string(rc.StatusCode),
+				Host:       rc.Host,
+				Path:       rc.Path,
+				Port:       rc.Port,
+				Protocol:   rc.Protocol,
+				Query:      rc.Query,
+			}
+		}
+		if fr := a.FixedResponseConfig; fr != nil {
+			res[i].FixedResponseConfig = &v1alpha1.FixedResponseActionConfig{
+				StatusCode:  aws.StringValue(fr.StatusCode),
+				ContentType: fr.ContentType,
+				MessageBody: fr.MessageBody,
+			}
+		}
+	}
+	return res
+}
+
+// AreActionsUpToDate returns true if the observed actions match the desired
+// ones. AWS fills in the order of the actions and, for forward actions, both
+// the target group ARN and the forward configuration. Those are only
+// compared if they are set in the desired actions.
+func AreActionsUpToDate(desired []v1alpha1.Action, observed []elbv2.Action) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	obs := generateV1alpha1Actions(observed)
+	for i := range desired {
+		if desired[i].Order == nil {
+			obs[i].Order = nil
+		}
+		if desired[i].TargetGroupARN == nil {
+			obs[i].TargetGroupARN = nil
+		}
+		if desired[i].ForwardConfig == nil {
+			obs[i].ForwardConfig = nil
+		}
+		if desired[i].ForwardConfig != nil && obs[i].ForwardConfig != nil {
+			normalizeForwardConfig(*desired[i].ForwardConfig, obs[i].ForwardConfig)
+		}
+	}
+	return cmp.Equal(desired, obs, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}))
+}
+
+// normalizeForwardConfig clears the values in the observed forward
+// configuration that AWS defaults and that are not set in the desired one.
+func normalizeForwardConfig(desired v1alpha1.ForwardActionConfig, observed *v1alpha1.ForwardActionConfig) {
+	if desired.TargetGroupStickinessConfig == nil && observed.TargetGroupStickinessConfig != nil &&
+		!observed.TargetGroupStickinessConfig.Enabled {
+		observed.TargetGroupStickinessConfig = nil
+	}
+	if len(desired.TargetGroups) != len(observed.TargetGroups) {
+		return
+	}
+	for i := range desired.TargetGroups {
+		if desired.TargetGroups[i].Weight == nil {
+			observed.TargetGroups[i].Weight = nil
+		}
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+var (
+	tgARN      = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	otherTGARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/other-tg/2453ed029918f21f"
+)
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []elbv2.Tag
+		remove []string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []elbv2.Tag
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []v1alpha1.Tag{{Key: "k1", Value: aws.String("v1")}},
+			observed: []elbv2.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+			want: want{
+				remove: []string{},
+			},
+		},
+		"AddUpdateAndRemove": {
+			desired: []v1alpha1.Tag{
+				{Key: "k1", Value: aws.String("v1-new")},
+				{Key: "k2", Value: aws.String("v2")},
+			},
+			observed: []elbv2.Tag{
+				{Key: aws.String("k1"), Value: aws.String("v1")},
+				{Key: aws.String("k3"), Value: aws.String("v3")},
+			},
+			want: want{
+				add: []elbv2.Tag{
+					{Key: aws.String("k1"), Value: aws.String("v1-new")},
+					{Key: aws.String("k2"), Value: aws.String("v2")},
+				},
+				remove: []string{"k1", "k3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("DiffTags(...) add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("DiffTags(...) remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreActionsUpToDate(t *testing.T) {
+	observedForward := elbv2.Action{
+		Type:           elbv2.ActionTypeEnumForward,
+		Order:          aws.Int64(1),
+		TargetGroupArn: aws.String(tgARN),
+		ForwardConfig: &elbv2.ForwardActionConfig{
+			TargetGroups: []elbv2.TargetGroupTuple{{
+				TargetGroupArn: aws.String(tgARN),
+				Weight:         aws.Int64(1),
+			}},
+			TargetGroupStickinessConfig: &elbv2.TargetGroupStickinessConfig{Enabled: aws.Bool(false)},
+		},
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Action
+		observed []elbv2.Action
+		want     bool
+	}{
+		"ForwardWithTargetGroupARN": {
+			desired: []v1alpha1.Action{{
+				Type:              "forward",
+				TargetGroupARN:    aws.String(tgARN),
+				TargetGroupARNRef: &xpv1.Reference{Name: "my-tg"},
+			}},
+			observed: []elbv2.Action{observedForward},
+			want:     true,
+		},
+		"ForwardWithForwardConfig": {
+			desired: []v1alpha1.Action{{
+				Type: "forward",
+				ForwardConfig: &v1alpha1.ForwardActionConfig{
+					TargetGroups: []v1alpha1.TargetGroupTuple{{TargetGroupARN: aws.String(tgARN)}},
+				},
+			}},
+			observed: []elbv2.Action{observedForward},
+			want:     true,
+		},
+		"DifferentTargetGroup": {
+			desired: []v1alpha1.Action{{
+				Type:           "forward",
+				TargetGroupARN: aws.String(otherTGARN),
+			}},
+			observed: []elbv2.Action{observedForward},
+			want:     false,
+		},
+		"DifferentType": {
+			desired: []v1alpha1.Action{{
+				Type: "fixed-response",
+				FixedResponseConfig: &v1alpha1.FixedResponseActionConfig{
+					StatusCode: "404",
+				},
+			}},
+			observed: []elbv2.Action{observedForward},
+			want:     false,
+		},
+		"DifferentLength": {
+			desired:  nil,
+			observed: []elbv2.Action{observedForward},
+			want:     false,
+		},
+		"Redirect": {
+			desired: []v1alpha1.Action{{
+				Type: "redirect",
+				RedirectConfig: &v1alpha1.RedirectActionConfig{
+					StatusCode: "HTTP_301",
+					Protocol:   aws.String("HTTPS"),
+					Port:       aws.String("443"),
+				},
+			}},
+			observed: []elbv2.Action{{
+				Type:  elbv2.ActionTypeEnumRedirect,
+				Order: aws.Int64(1),
+				RedirectConfig: &elbv2.RedirectActionConfig{
+					StatusCode: elbv2.RedirectActionStatusCodeEnumHttp301,
+					Protocol:   aws.String("HTTPS"),
+					Port:       aws.String("443"),
+				},
+			}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreActionsUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AreActionsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
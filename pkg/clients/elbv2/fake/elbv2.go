@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/elasticloadbalancingv2iface"
+)
+
+var _ elasticloadbalancingv2iface.ClientAPI = &MockClient{}
+
+// MockClient is a fake implementation of elasticloadbalancingv2iface.ClientAPI.
+type MockClient struct {
+	elasticloadbalancingv2iface.ClientAPI
+
+	MockDescribeLoadBalancersRequest func(*elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest
+	MockCreateLoadBalancerRequest    func(*elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest
+	MockDeleteLoadBalancerRequest    func(*elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest
+	MockSetIpAddressTypeRequest      func(*elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest
+	MockSetSecurityGroupsRequest     func(*elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest
+	MockSetSubnetsRequest            func(*elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest
+	MockDescribeTargetGroupsRequest  func(*elbv2.DescribeTargetGroupsInput) elbv2.DescribeTargetGroupsRequest
+	MockCreateTargetGroupRequest     func(*elbv2.CreateTargetGroupInput) elbv2.CreateTargetGroupRequest
+	MockModifyTargetGroupRequest     func(*elbv2.ModifyTargetGroupInput) elbv2.ModifyTargetGroupRequest
+	MockDeleteTargetGroupRequest     func(*elbv2.DeleteTargetGroupInput) elbv2.DeleteTargetGroupRequest
+	MockDescribeListenersRequest     func(*elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest
+	MockCreateListenerRequest        func(*elbv2.CreateListenerInput) elbv2.CreateListenerRequest
+	MockModifyListenerRequest        func(*elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest
+	MockDeleteListenerRequest        func(*elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest
+	MockDescribeRulesRequest         func(*elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest
+	MockCreateRuleRequest            func(*elbv2.CreateRuleInput) elbv2.CreateRuleRequest
+	MockModifyRuleRequest            func(*elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest
+	MockSetRulePrioritiesRequest     func(*elbv2.SetRulePrioritiesInput) elbv2.SetRulePrioritiesRequest
+	MockDeleteRuleRequest            func(*elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest
+	MockDescribeTargetHealthRequest  func(*elbv2.DescribeTargetHealthInput) elbv2.DescribeTargetHealthRequest
+	MockRegisterTargetsRequest       func(*elbv2.RegisterTargetsInput) elbv2.RegisterTargetsRequest
+	MockDeregisterTargetsRequest     func(*elbv2.DeregisterTargetsInput) elbv2.DeregisterTargetsRequest
+	MockDescribeTagsRequest          func(*elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest
+	MockAddTagsRequest               func(*elbv2.AddTagsInput) elbv2.AddTagsRequest
+	MockRemoveTagsRequest            func(*elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest
+}
+
+// DescribeLoadBalancersRequest calls the underlying
+// MockDescribeLoadBalancersRequest method.
+func (c *MockClient) DescribeLoadBalancersRequest(i *elbv2.DescribeLoadBalancersInput) elbv2.DescribeLoadBalancersRequest {
+	return c.MockDescribeLoadBalancersRequest(i)
+}
+
+// CreateLoadBalancerRequest calls the underlying
+// MockCreateLoadBalancerRequest method.
+func (c *MockClient) CreateLoadBalancerRequest(i *elbv2.CreateLoadBalancerInput) elbv2.CreateLoadBalancerRequest {
+	return c.MockCreateLoadBalancerRequest(i)
+}
+
+// DeleteLoadBalancerRequest calls the underlying
+// MockDeleteLoadBalancerRequest method.
+func (c *MockClient) DeleteLoadBalancerRequest(i *elbv2.DeleteLoadBalancerInput) elbv2.DeleteLoadBalancerRequest {
+	return c.MockDeleteLoadBalancerRequest(i)
+}
+
+// SetIpAddressTypeRequest calls the underlying
+// MockSetIpAddressTypeRequest method.
+func (c *MockClient) SetIpAddressTypeRequest(i *elbv2.SetIpAddressTypeInput) elbv2.SetIpAddressTypeRequest {
+	return c.MockSetIpAddressTypeRequest(i)
+}
+
+// SetSecurityGroupsRequest calls the underlying
+// MockSetSecurityGroupsRequest method.
+func (c *MockClient) SetSecurityGroupsRequest(i *elbv2.SetSecurityGroupsInput) elbv2.SetSecurityGroupsRequest {
+	return c.MockSetSecurityGroupsRequest(i)
+}
+
+// SetSubnetsRequest calls the underlying
+// MockSetSubnetsRequest method.
+func (c *MockClient) SetSubnetsRequest(i *elbv2.SetSubnetsInput) elbv2.SetSubnetsRequest {
+	return c.MockSetSubnetsRequest(i)
+}
+
+// DescribeTargetGroupsRequest calls the underlying
+// MockDescribeTargetGroupsRequest method.
+func (c *MockClient) DescribeTargetGroupsRequest(i *elbv2.DescribeTargetGroupsInput) elbv2.DescribeTargetGroupsRequest {
+	return c.MockDescribeTargetGroupsRequest(i)
+}
+
+// CreateTargetGroupRequest calls the underlying
+// MockCreateTargetGroupRequest method.
+func (c *MockClient) CreateTargetGroupRequest(i *elbv2.CreateTargetGroupInput) elbv2.CreateTargetGroupRequest {
+	return c.MockCreateTargetGroupRequest(i)
+}
+
+// ModifyTargetGroupRequest calls the underlying
+// MockModifyTargetGroupRequest method.
+func (c *MockClient) ModifyTargetGroupRequest(i *elbv2.ModifyTargetGroupInput) elbv2.ModifyTargetGroupRequest {
+	return c.MockModifyTargetGroupRequest(i)
+}
+
+// DeleteTargetGroupRequest calls the underlying
+// MockDeleteTargetGroupRequest method.
+func (c *MockClient) DeleteTargetGroupRequest(i *elbv2.DeleteTargetGroupInput) elbv2.DeleteTargetGroupRequest {
+	return c.MockDeleteTargetGroupRequest(i)
+}
+
+// DescribeListenersRequest calls the underlying
+// MockDescribeListenersRequest method.
+func (c *MockClient) DescribeListenersRequest(i *elbv2.DescribeListenersInput) elbv2.DescribeListenersRequest {
+	return c.MockDescribeListenersRequest(i)
+}
+
+// CreateListenerRequest calls the underlying
+// MockCreateListenerRequest method.
+func (c *MockClient) CreateListenerRequest(i *elbv2.CreateListenerInput) elbv2.CreateListenerRequest {
+	return c.MockCreateListenerRequest(i)
+}
+
+// ModifyListenerRequest calls the underlying
+// MockModifyListenerRequest method.
+func (c *MockClient) ModifyListenerRequest(i *elbv2.ModifyListenerInput) elbv2.ModifyListenerRequest {
+	return c.MockModifyListenerRequest(i)
+}
+
+// DeleteListenerRequest calls the underlying
+// MockDeleteListenerRequest method.
+func (c *MockClient) DeleteListenerRequest(i *elbv2.DeleteListenerInput) elbv2.DeleteListenerRequest {
+	return c.MockDeleteListenerRequest(i)
+}
+
+// DescribeRulesRequest calls the underlying
+// MockDescribeRulesRequest method.
+func (c *MockClient) DescribeRulesRequest(i *elbv2.DescribeRulesInput) elbv2.DescribeRulesRequest {
+	return c.MockDescribeRulesRequest(i)
+}
+
+// CreateRuleRequest calls the underlying
+// MockCreateRuleRequest method.
+func (c *MockClient) CreateRuleRequest(i *elbv2.CreateRuleInput) elbv2.CreateRuleRequest {
+	return c.MockCreateRuleRequest(i)
+}
+
+// ModifyRuleRequest calls the underlying
+// MockModifyRuleRequest method.
+func (c *MockClient) ModifyRuleRequest(i *elbv2.ModifyRuleInput) elbv2.ModifyRuleRequest {
+	return c.MockModifyRuleRequest(i)
+}
+
+// SetRulePrioritiesRequest calls the underlying
+// MockSetRulePrioritiesRequest method.
+func (c *MockClient) SetRulePrioritiesRequest(i *elbv2.SetRulePrioritiesInput) elbv2.SetRulePrioritiesRequest {
+	return c.MockSetRulePrioritiesRequest(i)
+}
+
+// DeleteRuleRequest calls the underlying
+// MockDeleteRuleRequest method.
+func (c *MockClient) DeleteRuleRequest(i *elbv2.DeleteRuleInput) elbv2.DeleteRuleRequest {
+	return c.MockDeleteRuleRequest(i)
+}
+
+// DescribeTargetHealthRequest calls the underlying
+// MockDescribeTargetHealthRequest method.
+func (c *MockClient) DescribeTargetHealthRequest(i *elbv2.DescribeTargetHealthInput) elbv2.DescribeTargetHealthRequest {
+	return c.MockDescribeTargetHealthRequest(i)
+}
+
+// RegisterTargetsRequest calls the underlying
+// MockRegisterTargetsRequest method.
+func (c *MockClient) RegisterTargetsRequest(i *elbv2.RegisterTargetsInput) elbv2.RegisterTargetsRequest {
+	return c.MockRegisterTargetsRequest(i)
+}
+
+// DeregisterTargetsRequest calls the underlying
+// MockDeregisterTargetsRequest method.
+func (c *MockClient) DeregisterTargetsRequest(i *elbv2.DeregisterTargetsInput) elbv2.DeregisterTargetsRequest {
+	return c.MockDeregisterTargetsRequest(i)
+}
+
+// DescribeTagsRequest calls the underlying
+// MockDescribeTagsRequest method.
+func (c *MockClient) DescribeTagsRequest(i *elbv2.DescribeTagsInput) elbv2.DescribeTagsRequest {
+	return c.MockDescribeTagsRequest(i)
+}
+
+// AddTagsRequest calls the underlying
+// MockAddTagsRequest method.
+func (c *MockClient) AddTagsRequest(i *elbv2.AddTagsInput) elbv2.AddTagsRequest {
+	return c.MockAddTagsRequest(i)
+}
+
+// RemoveTagsRequest calls the underlying
+// MockRemoveTagsRequest method.
+func (c *MockClient) RemoveTagsRequest(i *elbv2.RemoveTagsInput) elbv2.RemoveTagsRequest {
+	return c.MockRemoveTagsRequest(i)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateListenerInput returns the input to create a listener with
+// the given parameters.
+func GenerateCreateListenerInput(p v1alpha1.ListenerParameters) *elbv2.CreateListenerInput {
+	return &elbv2.CreateListenerInput{
+		LoadBalancerArn: p.LoadBalancerARN,
+		Port:            aws.Int64(p.Port),
+		Protocol:        elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:       p.SSLPolicy,
+		Certificates:    generateCertificates(p.CertificateARN),
+		AlpnPolicy:      p.ALPNPolicy,
+		DefaultActions:  GenerateActions(p.DefaultActions),
+	}
+}
+
+// GenerateModifyListenerInput returns the input to update the given listener
+// to the desired state.
+func GenerateModifyListenerInput(arn string, p v1alpha1.ListenerParameters) *elbv2.ModifyListenerInput {
+	return &elbv2.ModifyListenerInput{
+		ListenerArn:    aws.String(arn),
+		Port:           aws.Int64(p.Port),
+		Protocol:       elbv2.ProtocolEnum(p.Protocol),
+		SslPolicy:      p.SSLPolicy,
+		Certificates:   generateCertificates(p.CertificateARN),
+		AlpnPolicy:     p.ALPNPolicy,
+		DefaultActions: GenerateActions(p.DefaultActions),
+	}
+}
+
+func generateCertificates(arn *string) []elbv2.Certificate {
+	if arn == nil {
+		return nil
+	}
+	return []elbv2.Certificate{{CertificateArn: arn}}
+}
+
+// LateInitializeListener fills the empty fields in
+// *v1alpha1.ListenerParameters with the values seen in elbv2.Listener.
+func LateInitializeListener(in *v1alpha1.ListenerParameters, l *elbv2.Listener) {
+	if l == nil {
+		return
+	}
+	in.SSLPolicy = clients.LateInitializeStringPtr(in.SSLPolicy, l.SslPolicy)
+	if in.CertificateARN == nil && len(l.Certificates) != 0 {
+		in.CertificateARN = l.Certificates[0].CertificateArn
+	}
+	if len(in.ALPNPolicy) == 0 && len(l.AlpnPolicy) != 0 {
+		in.ALPNPolicy = l.AlpnPolicy
+	}
+}
+
+// IsListenerUpToDate returns true if the observed listener matches the
+// desired state.
+func IsListenerUpToDate(p v1alpha1.ListenerParameters, l elbv2.Listener) bool {
+	var cert *string
+	if len(l.Certificates) != 0 {
+		cert = l.Certificates[0].CertificateArn
+	}
+	switch {
+	case p.Port != aws.Int64Value(l.Port),
+		p.Protocol != string(l.Protocol),
+		!equalStringPtr(p.SSLPolicy, l.SslPolicy),
+		!equalStringPtr(p.CertificateARN, cert),
+		len(p.ALPNPolicy) != 0 && !equalStrings(p.ALPNPolicy, l.AlpnPolicy):
+		return false
+	}
+	return AreActionsUpToDate(p.DefaultActions, l.DefaultActions)
+}
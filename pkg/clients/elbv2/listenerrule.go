@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+// Condition fields of a listener rule.
+const (
+	ConditionFieldHostHeader        = "host-header"
+	ConditionFieldPathPattern       = "path-pattern"
+	ConditionFieldHTTPHeader        = "http-header"
+	ConditionFieldHTTPRequestMethod = "http-request-method"
+	ConditionFieldQueryString       = "query-string"
+	ConditionFieldSourceIP          = "source-ip"
+)
+
+// GenerateCreateRuleInput returns the input to create a listener rule with
+// the given parameters.
+func GenerateCreateRuleInput(p v1alpha1.ListenerRuleParameters) *elbv2.CreateRuleInput {
+	return &elbv2.CreateRuleInput{
+		ListenerArn: p.ListenerARN,
+		Priority:    aws.Int64(p.Priority),
+		Conditions:  GenerateRuleConditions(p.Conditions),
+		Actions:     GenerateActions(p.Actions),
+	}
+}
+
+// GenerateModifyRuleInput returns the input to update the conditions and
+// actions of the given listener rule.
+func GenerateModifyRuleInput(arn string, p v1alpha1.ListenerRuleParameters) *elbv2.ModifyRuleInput {
+	return &elbv2.ModifyRuleInput{
+		RuleArn:    aws.String(arn),
+		Conditions: GenerateRuleConditions(p.Conditions),
+		Actions:    GenerateActions(p.Actions),
+	}
+}
+
+// GenerateRuleConditions generates a list of elbv2.RuleCondition from the
+// given list of v1alpha1.RuleCondition.
+func GenerateRuleConditions(conditions []v1alpha1.RuleCondition) []elbv2.RuleCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	res := make([]elbv2.RuleCondition, len(conditions))
+	for i, c := range conditions {
+		res[i] = elbv2.RuleCondition{Field: aws.String(c.Field)}
+		switch c.Field {
+		case ConditionFieldHostHeader:
+			res[i].HostHeaderConfig = &elbv2.HostHeaderConditionConfig{Values: c.HostHeaderValues}
+		case ConditionFieldPathPattern:
+			res[i].PathPatternConfig = &elbv2.PathPatternConditionConfig{Values: c.PathPatternValues}
+		case ConditionFieldHTTPHeader:
+			if c.HTTPHeaderConfig != nil {
+				res[i].HttpHeaderConfig = &elbv2.HttpHeaderConditionConfig{
+					HttpHeaderName: aws.String(c.HTTPHeaderConfig.HTTPHeaderName),
+					Values:         c.HTTPHeaderConfig.Values,
+				}
+			}
+		case ConditionFieldHTTPRequestMethod:
+			res[i].HttpRequestMethodConfig = &elbv2.HttpRequestMethodConditionConfig{Values: c.HTTPRequestMethodValues}
+		case ConditionFieldQueryString:
+			qs := &elbv2.QueryStringConditionConfig{}
+			for _, v := range c.QueryStringValues {
+				qs.Values = append(qs.Values, elbv2.QueryStringKeyValuePair{Key: v.Key, Value: aws.String(v.Value)})
+			}
+			res[i].QueryStringConfig = qs
+		case ConditionFieldSourceIP:
+			res[i].SourceIpConfig = &elbv2.SourceIpConditionConfig{Values: c.SourceIPValues}
+		}
+	}
+	return res
+}
+
+// generateV1alpha1RuleConditions is the inverse of GenerateRuleConditions.
+// It is used to compare the observed conditions with the desired ones.
+func generateV1alpha1RuleConditions(conditions []elbv2.RuleCondition) []v1alpha1.RuleCondition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.RuleCondition, len(conditions))
+	for i, c := range conditions {
+		res[i] = v1alpha1.RuleCondition{Field: aws.StringValue(c.Field)}
+		if c.HostHeaderConfig != nil {
+			res[i].HostHeaderValues = c.HostHeaderConfig.Values
+		}
+		if c.PathPatternConfig != nil {
+			res[i].PathPatternValues = c.PathPatternConfig.Values
+		}
+		if c.HttpHeaderConfig != nil {
+			res[i].HTTPHeaderConfig = &v1alpha1.HTTPHeaderConditionConfig{
+				HTTPHeaderName: aws.StringValue(c.HttpHeaderConfig.HttpHeaderName),
+				Values:         c.HttpHeaderConfig.Values,
+			}
+		}
+		if c.HttpRequestMethodConfig != nil {
+			res[i].HTTPRequestMethodValues = c.HttpRequestMethodConfig.Values
+		}
+		if c.QueryStringConfig != nil {
+			for _, v := range c.QueryStringConfig.Values {
+				res[i].QueryStringValues = append(res[i].QueryStringValues, v1alpha1.QueryStringKeyValuePair{
+					Key:   v.Key,
+					Value: aws.StringValue(v.Value),
+				})
+			}
+		}
+		if c.SourceIpConfig != nil {
+			res[i].SourceIPValues = c.SourceIpConfig.Values
+		}
+	}
+	return res
+}
+
+// AreRuleConditionsUpToDate returns true if the observed conditions match the
+// desired ones.
+func AreRuleConditionsUpToDate(desired []v1alpha1.RuleCondition, observed []elbv2.RuleCondition) bool {
+	return cmp.Equal(desired, generateV1alpha1RuleConditions(observed), cmpopts.EquateEmpty())
+}
+
+// IsListenerRulePriorityUpToDate returns true if the priority of the observed
+// rule matches the desired one.
+func IsListenerRulePriorityUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	return strconv.FormatInt(p.Priority, 10) == aws.StringValue(r.Priority)
+}
+
+// IsListenerRuleUpToDate returns true if the observed rule matches the
+// desired state.
+func IsListenerRuleUpToDate(p v1alpha1.ListenerRuleParameters, r elbv2.Rule) bool {
+	return IsListenerRulePriorityUpToDate(p, r) &&
+		AreRuleConditionsUpToDate(p.Conditions, r.Conditions) &&
+		AreActionsUpToDate(p.Actions, r.Actions)
+}
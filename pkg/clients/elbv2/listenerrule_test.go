@@ -0,0 +1,154 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+func TestGenerateRuleConditions(t *testing.T) {
+	cases := map[string]struct {
+		in  []v1alpha1.RuleCondition
+		out []elbv2.RuleCondition
+	}{
+		"Empty": {},
+		"AllFields": {
+			in: []v1alpha1.RuleCondition{
+				{Field: ConditionFieldHostHeader, HostHeaderValues: []string{"example.com"}},
+				{Field: ConditionFieldPathPattern, PathPatternValues: []string{"/api/*"}},
+				{Field: ConditionFieldHTTPHeader, HTTPHeaderConfig: &v1alpha1.HTTPHeaderConditionConfig{
+					HTTPHeaderName: "User-Agent",
+					Values:         []string{"*Mobile*"},
+				}},
+				{Field: ConditionFieldHTTPRequestMethod, HTTPRequestMethodValues: []string{"GET"}},
+				{Field: ConditionFieldQueryString, QueryStringValues: []v1alpha1.QueryStringKeyValuePair{
+					{Key: aws.String("version"), Value: "v1"},
+				}},
+				{Field: ConditionFieldSourceIP, SourceIPValues: []string{"10.0.0.0/8"}},
+			},
+			out: []elbv2.RuleCondition{
+				{
+					Field:            aws.String(ConditionFieldHostHeader),
+					HostHeaderConfig: &elbv2.HostHeaderConditionConfig{Values: []string{"example.com"}},
+				},
+				{
+					Field:             aws.String(ConditionFieldPathPattern),
+					PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: []string{"/api/*"}},
+				},
+				{
+					Field: aws.String(ConditionFieldHTTPHeader),
+					HttpHeaderConfig: &elbv2.HttpHeaderConditionConfig{
+						HttpHeaderName: aws.String("User-Agent"),
+						Values:         []string{"*Mobile*"},
+					},
+				},
+				{
+					Field:                   aws.String(ConditionFieldHTTPRequestMethod),
+					HttpRequestMethodConfig: &elbv2.HttpRequestMethodConditionConfig{Values: []string{"GET"}},
+				},
+				{
+					Field: aws.String(ConditionFieldQueryString),
+					QueryStringConfig: &elbv2.QueryStringConditionConfig{Values: []elbv2.QueryStringKeyValuePair{
+						{Key: aws.String("version"), Value: aws.String("v1")},
+					}},
+				},
+				{
+					Field:          aws.String(ConditionFieldSourceIP),
+					SourceIpConfig: &elbv2.SourceIpConditionConfig{Values: []string{"10.0.0.0/8"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateRuleConditions(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateRuleConditions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsListenerRuleUpToDate(t *testing.T) {
+	params := func(m ...func(*v1alpha1.ListenerRuleParameters)) v1alpha1.ListenerRuleParameters {
+		p := v1alpha1.ListenerRuleParameters{
+			Priority: 10,
+			Conditions: []v1alpha1.RuleCondition{
+				{Field: ConditionFieldPathPattern, PathPatternValues: []string{"/api/*"}},
+			},
+			Actions: []v1alpha1.Action{{Type: "forward", TargetGroupARN: aws.String(tgARN)}},
+		}
+		for _, f := range m {
+			f(&p)
+		}
+		return p
+	}
+	observed := elbv2.Rule{
+		Priority: aws.String("10"),
+		Conditions: []elbv2.RuleCondition{{
+			Field:             aws.String(ConditionFieldPathPattern),
+			PathPatternConfig: &elbv2.PathPatternConditionConfig{Values: []string{"/api/*"}},
+			Values:            []string{"/api/*"},
+		}},
+		Actions: []elbv2.Action{{
+			Type:           elbv2.ActionTypeEnumForward,
+			Order:          aws.Int64(1),
+			TargetGroupArn: aws.String(tgARN),
+		}},
+	}
+	cases := map[string]struct {
+		spec v1alpha1.ListenerRuleParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: params(),
+			want: true,
+		},
+		"PriorityChanged": {
+			spec: params(func(p *v1alpha1.ListenerRuleParameters) { p.Priority = 20 }),
+			want: false,
+		},
+		"ConditionsChanged": {
+			spec: params(func(p *v1alpha1.ListenerRuleParameters) {
+				p.Conditions[0].PathPatternValues = []string{"/v2/*"}
+			}),
+			want: false,
+		},
+		"ActionsChanged": {
+			spec: params(func(p *v1alpha1.ListenerRuleParameters) {
+				p.Actions[0].TargetGroupARN = aws.String(otherTGARN)
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsListenerRuleUpToDate(tc.spec, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsListenerRuleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateLoadBalancerInput returns the input to create a load balancer
+// with the given name and parameters.
+func GenerateCreateLoadBalancerInput(name string, p v1alpha1.LoadBalancerParameters) *elbv2.CreateLoadBalancerInput {
+	in := &elbv2.CreateLoadBalancerInput{
+		Name:           aws.String(name),
+		Type:           elbv2.LoadBalancerTypeEnum(aws.StringValue(p.Type)),
+		Scheme:         elbv2.LoadBalancerSchemeEnum(aws.StringValue(p.Scheme)),
+		IpAddressType:  elbv2.IpAddressType(aws.StringValue(p.IPAddressType)),
+		SecurityGroups: p.SecurityGroupIDs,
+		Tags:           GenerateTags(p.Tags),
+	}
+	if len(p.SubnetMappings) != 0 {
+		in.SubnetMappings = make([]elbv2.SubnetMapping, len(p.SubnetMappings))
+		for i, m := range p.SubnetMappings {
+			in.SubnetMappings[i] = elbv2.SubnetMapping{
+				SubnetId:           aws.String(m.SubnetID),
+				AllocationId:       m.AllocationID,
+				PrivateIPv4Address: m.PrivateIPv4Address,
+			}
+		}
+		return in
+	}
+	in.Subnets = p.SubnetIDs
+	return in
+}
+
+// GenerateLoadBalancerObservation is used to produce
+// v1alpha1.LoadBalancerObservation from elbv2.LoadBalancer.
+func GenerateLoadBalancerObservation(lb elbv2.LoadBalancer) v1alpha1.LoadBalancerObservation {
+	o := v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       aws.StringValue(lb.LoadBalancerArn),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		DNSName:               aws.StringValue(lb.DNSName),
+		VPCID:                 aws.StringValue(lb.VpcId),
+	}
+	if lb.State != nil {
+		o.State = string(lb.State.Code)
+	}
+	return o
+}
+
+// LateInitializeLoadBalancer fills the empty fields in
+// *v1alpha1.LoadBalancerParameters with the values seen in
+// elbv2.LoadBalancer.
+func LateInitializeLoadBalancer(in *v1alpha1.LoadBalancerParameters, lb *elbv2.LoadBalancer) {
+	if lb == nil {
+		return
+	}
+	in.Type = clients.LateInitializeStringPtr(in.Type, clients.String(string(lb.Type)))
+	in.Scheme = clients.LateInitializeStringPtr(in.Scheme, clients.String(string(lb.Scheme)))
+	in.IPAddressType = clients.LateInitializeStringPtr(in.IPAddressType, clients.String(string(lb.IpAddressType)))
+	if len(in.SecurityGroupIDs) == 0 && len(lb.SecurityGroups) != 0 {
+		in.SecurityGroupIDs = lb.SecurityGroups
+	}
+	if len(in.SubnetIDs) == 0 && len(lb.AvailabilityZones) != 0 {
+		in.SubnetIDs = observedSubnetIDs(lb)
+	}
+}
+
+// IsLoadBalancerUpToDate returns true if the mutable fields of the observed
+// load balancer and its tags match the desired state.
+func IsLoadBalancerUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer, tags []elbv2.Tag) bool {
+	return IsIPAddressTypeUpToDate(p, lb) &&
+		AreSecurityGroupsUpToDate(p, lb) &&
+		AreSubnetsUpToDate(p, lb) &&
+		AreTagsUpToDate(p.Tags, tags)
+}
+
+// IsIPAddressTypeUpToDate returns true if the IP address type of the
+// observed load balancer matches the desired one.
+func IsIPAddressTypeUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return p.IPAddressType == nil || aws.StringValue(p.IPAddressType) == string(lb.IpAddressType)
+}
+
+// AreSecurityGroupsUpToDate returns true if the security groups of the
+// observed load balancer match the desired ones.
+func AreSecurityGroupsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return len(p.SecurityGroupIDs) == 0 || equalStrings(p.SecurityGroupIDs, lb.SecurityGroups)
+}
+
+// AreSubnetsUpToDate returns true if the subnets of the observed load
+// balancer match the desired ones.
+func AreSubnetsUpToDate(p v1alpha1.LoadBalancerParameters, lb elbv2.LoadBalancer) bool {
+	return len(p.SubnetIDs) == 0 || equalStrings(p.SubnetIDs, observedSubnetIDs(&lb))
+}
+
+func observedSubnetIDs(lb *elbv2.LoadBalancer) []string {
+	ids := make([]string, 0, len(lb.AvailabilityZones))
+	for _, az := range lb.AvailabilityZones {
+		if az.SubnetId != nil {
+			ids = append(ids, aws.StringValue(az.SubnetId))
+		}
+	}
+	return ids
+}
+
+// equalStrings returns true if both lists contain the same strings
+// regardless of their order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ac := append([]string{}, a...)
+	bc := append([]string{}, b...)
+	sort.Strings(ac)
+	sort.Strings(bc)
+	for i := range ac {
+		if ac[i] != bc[i] {
+			return false
+		}
+	}
+	return true
+}
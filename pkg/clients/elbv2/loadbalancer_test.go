@@ -0,0 +1,203 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+)
+
+var (
+	lbName    = "my-lb"
+	subnetA   = "subnet-aaaa"
+	subnetB   = "subnet-bbbb"
+	sgID      = "sg-1234"
+	lbType    = "application"
+	lbScheme  = "internet-facing"
+	ipv4      = "ipv4"
+	dualstack = "dualstack"
+)
+
+func lbParams(m ...func(*v1alpha1.LoadBalancerParameters)) *v1alpha1.LoadBalancerParameters {
+	o := &v1alpha1.LoadBalancerParameters{
+		Type:             aws.String(lbType),
+		Scheme:           aws.String(lbScheme),
+		IPAddressType:    aws.String(ipv4),
+		SecurityGroupIDs: []string{sgID},
+		SubnetIDs:        []string{subnetA, subnetB},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func loadBalancer(m ...func(*elbv2.LoadBalancer)) *elbv2.LoadBalancer {
+	o := &elbv2.LoadBalancer{
+		LoadBalancerName: aws.String(lbName),
+		Type:             elbv2.LoadBalancerTypeEnumApplication,
+		Scheme:           elbv2.LoadBalancerSchemeEnumInternetFacing,
+		IpAddressType:    elbv2.IpAddressTypeIpv4,
+		SecurityGroups:   []string{sgID},
+		AvailabilityZones: []elbv2.AvailabilityZone{
+			{SubnetId: aws.String(subnetB), ZoneName: aws.String("us-east-1b")},
+			{SubnetId: aws.String(subnetA), ZoneName: aws.String("us-east-1a")},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestGenerateCreateLoadBalancerInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.LoadBalancerParameters
+		out elbv2.CreateLoadBalancerInput
+	}{
+		"Subnets": {
+			in: *lbParams(),
+			out: elbv2.CreateLoadBalancerInput{
+				Name:           aws.String(lbName),
+				Type:           elbv2.LoadBalancerTypeEnumApplication,
+				Scheme:         elbv2.LoadBalancerSchemeEnumInternetFacing,
+				IpAddressType:  elbv2.IpAddressTypeIpv4,
+				SecurityGroups: []string{sgID},
+				Subnets:        []string{subnetA, subnetB},
+			},
+		},
+		"SubnetMappings": {
+			in: *lbParams(func(p *v1alpha1.LoadBalancerParameters) {
+				p.Type = aws.String("network")
+				p.SecurityGroupIDs = nil
+				p.SubnetMappings = []v1alpha1.SubnetMapping{{
+					SubnetID:     subnetA,
+					AllocationID: aws.String("eipalloc-1234"),
+				}}
+			}),
+			out: elbv2.CreateLoadBalancerInput{
+				Name:          aws.String(lbName),
+				Type:          elbv2.LoadBalancerTypeEnumNetwork,
+				Scheme:        elbv2.LoadBalancerSchemeEnumInternetFacing,
+				IpAddressType: elbv2.IpAddressTypeIpv4,
+				SubnetMappings: []elbv2.SubnetMapping{{
+					SubnetId:     aws.String(subnetA),
+					AllocationId: aws.String("eipalloc-1234"),
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateCreateLoadBalancerInput(lbName, tc.in)
+			if diff := cmp.Diff(&tc.out, r); diff != "" {
+				t.Errorf("GenerateCreateLoadBalancerInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeLoadBalancer(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1alpha1.LoadBalancerParameters
+		in   *elbv2.LoadBalancer
+		want *v1alpha1.LoadBalancerParameters
+	}{
+		"AllFilledNoDiff": {
+			spec: lbParams(),
+			in:   loadBalancer(),
+			want: lbParams(),
+		},
+		"AllFilledExternalDiff": {
+			spec: lbParams(),
+			in: loadBalancer(func(lb *elbv2.LoadBalancer) {
+				lb.IpAddressType = elbv2.IpAddressTypeDualstack
+			}),
+			want: lbParams(),
+		},
+		"PartialFilled": {
+			spec: lbParams(func(p *v1alpha1.LoadBalancerParameters) {
+				p.IPAddressType = nil
+				p.SecurityGroupIDs = nil
+				p.SubnetIDs = nil
+			}),
+			in: loadBalancer(),
+			want: lbParams(func(p *v1alpha1.LoadBalancerParameters) {
+				p.SubnetIDs = []string{subnetB, subnetA}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeLoadBalancer(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeLoadBalancer(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoadBalancerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.LoadBalancerParameters
+		in   elbv2.LoadBalancer
+		want bool
+	}{
+		"UpToDateSubnetsInDifferentOrder": {
+			spec: *lbParams(),
+			in:   *loadBalancer(),
+			want: true,
+		},
+		"IPAddressTypeChanged": {
+			spec: *lbParams(func(p *v1alpha1.LoadBalancerParameters) {
+				p.IPAddressType = aws.String(dualstack)
+			}),
+			in:   *loadBalancer(),
+			want: false,
+		},
+		"SecurityGroupsChanged": {
+			spec: *lbParams(func(p *v1alpha1.LoadBalancerParameters) {
+				p.SecurityGroupIDs = []string{sgID, "sg-5678"}
+			}),
+			in:   *loadBalancer(),
+			want: false,
+		},
+		"SubnetsChanged": {
+			spec: *lbParams(func(p *v1alpha1.LoadBalancerParameters) {
+				p.SubnetIDs = []string{subnetA}
+			}),
+			in:   *loadBalancer(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLoadBalancerUpToDate(tc.spec, tc.in, nil)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsLoadBalancerUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
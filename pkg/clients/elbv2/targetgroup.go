@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elbv2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	clients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateTargetGroupInput returns the input to create a target group
+// with the given name and parameters.
+func GenerateCreateTargetGroupInput(name string, p v1alpha1.TargetGroupParameters) *elbv2.CreateTargetGroupInput {
+	in := &elbv2.CreateTargetGroupInput{
+		Name:                       aws.String(name),
+		Protocol:                   elbv2.ProtocolEnum(aws.StringValue(p.Protocol)),
+		Port:                       p.Port,
+		TargetType:                 elbv2.TargetTypeEnum(aws.StringValue(p.TargetType)),
+		VpcId:                      p.VPCID,
+		HealthCheckEnabled:         p.HealthCheckEnabled,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckProtocol:        elbv2.ProtocolEnum(aws.StringValue(p.HealthCheckProtocol)),
+		HealthCheckTimeoutSeconds:  p.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      p.HealthyThresholdCount,
+		UnhealthyThresholdCount:    p.UnhealthyThresholdCount,
+	}
+	if p.Matcher != nil {
+		in.Matcher = &elbv2.Matcher{HttpCode: p.Matcher}
+	}
+	return in
+}
+
+// GenerateModifyTargetGroupInput returns the input to update the health check
+// settings of the given target group.
+func GenerateModifyTargetGroupInput(arn string, p v1alpha1.TargetGroupParameters) *elbv2.ModifyTargetGroupInput {
+	in := &elbv2.ModifyTargetGroupInput{
+		TargetGroupArn:             aws.String(arn),
+		HealthCheckEnabled:         p.HealthCheckEnabled,
+		HealthCheckIntervalSeconds: p.HealthCheckIntervalSeconds,
+		HealthCheckPath:            p.HealthCheckPath,
+		HealthCheckPort:            p.HealthCheckPort,
+		HealthCheckProtocol:        elbv2.ProtocolEnum(aws.StringValue(p.HealthCheckProtocol)),
+		HealthCheckTimeoutSeconds:  p.HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:      p.HealthyThresholdCount,
+		UnhealthyThresholdCount:    p.UnhealthyThresholdCount,
+	}
+	if p.Matcher != nil {
+		in.Matcher = &elbv2.Matcher{HttpCode: p.Matcher}
+	}
+	return in
+}
+
+// GenerateTargetGroupObservation is used to produce
+// v1alpha1.TargetGroupObservation from elbv2.TargetGroup.
+func GenerateTargetGroupObservation(tg elbv2.TargetGroup) v1alpha1.TargetGroupObservation {
+	return v1alpha1.TargetGroupObservation{
+		TargetGroupARN:   aws.StringValue(tg.TargetGroupArn),
+		LoadBalancerARNs: tg.LoadBalancerArns,
+	}
+}
+
+// LateInitializeTargetGroup fills the empty fields in
+// *v1alpha1.TargetGroupParameters with the values seen in elbv2.TargetGroup.
+func LateInitializeTargetGroup(in *v1alpha1.TargetGroupParameters, tg *elbv2.TargetGroup) {
+	if tg == nil {
+		return
+	}
+	in.Protocol = clients.LateInitializeStringPtr(in.Protocol, clients.String(string(tg.Protocol)))
+	in.Port = clients.LateInitializeInt64Ptr(in.Port, tg.Port)
+	in.TargetType = clients.LateInitializeStringPtr(in.TargetType, clients.String(string(tg.TargetType)))
+	in.VPCID = clients.LateInitializeStringPtr(in.VPCID, tg.VpcId)
+	in.HealthCheckEnabled = clients.LateInitializeBoolPtr(in.HealthCheckEnabled, tg.HealthCheckEnabled)
+	in.HealthCheckIntervalSeconds = clients.LateInitializeInt64Ptr(in.HealthCheckIntervalSeconds, tg.HealthCheckIntervalSeconds)
+	in.HealthCheckPath = clients.LateInitializeStringPtr(in.HealthCheckPath, tg.HealthCheckPath)
+	in.HealthCheckPort = clients.LateInitializeStringPtr(in.HealthCheckPort, tg.HealthCheckPort)
+	in.HealthCheckProtocol = clients.LateInitializeStringPtr(in.HealthCheckProtocol, clients.String(string(tg.HealthCheckProtocol)))
+	in.HealthCheckTimeoutSeconds = clients.LateInitializeInt64Ptr(in.HealthCheckTimeoutSeconds, tg.HealthCheckTimeoutSeconds)
+	in.HealthyThresholdCount = clients.LateInitializeInt64Ptr(in.HealthyThresholdCount, tg.HealthyThresholdCount)
+	in.UnhealthyThresholdCount = clients.LateInitializeInt64Ptr(in.UnhealthyThresholdCount, tg.UnhealthyThresholdCount)
+	if tg.Matcher != nil {
+		in.Matcher = clients.LateInitializeStringPtr(in.Matcher, tg.Matcher.HttpCode)
+	}
+}
+
+// IsTargetGroupUpToDate returns true if the health check settings of the
+// observed target group and its tags match the desired state.
+func IsTargetGroupUpToDate(p v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup, tags []elbv2.Tag) bool {
+	return IsHealthCheckUpToDate(p, tg) && AreTagsUpToDate(p.Tags, tags)
+}
+
+// IsHealthCheckUpToDate returns true if the health check settings of the
+// observed target group match the desired ones.
+func IsHealthCheckUpToDate(p v1alpha1.TargetGroupParameters, tg elbv2.TargetGroup) bool {
+	observed := v1alpha1.TargetGroupParameters{}
+	LateInitializeTargetGroup(&observed, &tg)
+	switch {
+	case !equalBoolPtr(p.HealthCheckEnabled, observed.HealthCheckEnabled),
+		!equalInt64Ptr(p.HealthCheckIntervalSeconds, observed.HealthCheckIntervalSeconds),
+		!equalStringPtr(p.HealthCheckPath, observed.HealthCheckPath),
+		!equalStringPtr(p.HealthCheckPort, observed.HealthCheckPort),
+		!equalStringPtr(p.HealthCheckProtocol, observed.HealthCheckProtocol),
+		!equalInt64Ptr(p.HealthCheckTimeoutSeconds, observed.HealthCheckTimeoutSeconds),
+		!equalInt64Ptr(p.HealthyThresholdCount, observed.HealthyThresholdCount),
+		!equalInt64Ptr(p.UnhealthyThresholdCount, observed.UnhealthyThresholdCount),
+		!equalStringPtr(p.Matcher, observed.Matcher):
+		return false
+	}
+	return true
+}
+
+// The equal*Ptr functions consider an unset desired value to be up to date
+// since AWS chooses a default for it.
+
+func equalStringPtr(desired, observed *string) bool {
+	return desired == nil || aws.StringValue(desired) == aws.StringValue(observed)
+}
+
+func equalInt64Ptr(desired, observed *int64) bool {
+	return desired == nil || aws.Int64Value(desired) == aws.Int64Value(observed)
+}
+
+func equalBoolPtr(desired, observed *bool) bool {
+	return desired == nil || aws.BoolValue(desired) == aws.BoolValue(observed)
+}
+
+// GenerateTargetDescription returns the description of the target of the
+// given TargetGroupAttachment parameters.
+func GenerateTargetDescription(p v1alpha1.TargetGroupAttachmentParameters) elbv2.TargetDescription {
+	return elbv2.TargetDescription{
+		Id:               p.TargetID,
+		Port:             p.Port,
+		AvailabilityZone: p.AvailabilityZone,
+	}
+}
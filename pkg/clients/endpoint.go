@@ -0,0 +1,171 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// UsesS3PathStyle returns whether the S3 clients of the given managed resource
+// should use path-style addressing, as configured in the endpoint
+// configuration of its ProviderConfig.
+func UsesS3PathStyle(ctx context.Context, c client.Client, mg resource.Managed) (bool, error) {
+	if mg.GetProviderConfigReference() == nil {
+		return false, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return false, errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	return pc.Spec.Endpoint != nil && aws.BoolValue(pc.Spec.Endpoint.S3UsePathStyle), nil
+}
+
+// UseEndpointConfig returns a copy of the given config whose requests are sent
+// to the endpoints described by the given endpoint configuration.
+func UseEndpointConfig(cfg *aws.Config, ec *v1beta1.EndpointConfig) *aws.Config {
+	if ec == nil {
+		return cfg
+	}
+	c := cfg.Copy()
+	c.EndpointResolver = NewEndpointResolver(*ec)
+	return &c
+}
+
+// UseEndpointConfigV1 returns a copy of the given V1 config whose requests are
+// sent to the endpoints described by the given endpoint configuration.
+func UseEndpointConfigV1(cfg *awsv1.Config, ec *v1beta1.EndpointConfig) *awsv1.Config {
+	if ec == nil {
+		return cfg
+	}
+	return cfg.Copy().
+		WithEndpointResolver(NewEndpointResolverV1(*ec)).
+		WithS3ForcePathStyle(aws.BoolValue(ec.S3UsePathStyle))
+}
+
+// NewEndpointResolver returns an endpoint resolver for SDK v2 clients that
+// honors the given endpoint configuration.
+func NewEndpointResolver(ec v1beta1.EndpointConfig) aws.EndpointResolver {
+	return aws.EndpointResolverFunc(func(service, region string) (aws.Endpoint, error) {
+		e, err := ResolveEndpoint(ec, service, region)
+		if err != nil {
+			return aws.Endpoint{}, err
+		}
+		return aws.Endpoint{
+			URL:                e.URL,
+			PartitionID:        e.PartitionID,
+			SigningName:        e.SigningName,
+			SigningRegion:      e.SigningRegion,
+			SigningNameDerived: e.SigningNameDerived,
+			SigningMethod:      e.SigningMethod,
+		}, nil
+	})
+}
+
+// NewEndpointResolverV1 returns an endpoint resolver for SDK v1 clients that
+// honors the given endpoint configuration.
+func NewEndpointResolverV1(ec v1beta1.EndpointConfig) endpointsv1.Resolver {
+	return endpointsv1.ResolverFunc(func(service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+		return ResolveEndpoint(ec, service, region, optFns...)
+	})
+}
+
+// ResolveEndpoint resolves the endpoint of the service with given endpoint ID
+// in the given region according to the given endpoint configuration. The
+// modeled endpoints of the SDK v1 are used for both SDK versions so that the
+// partitions can be selected explicitly.
+func ResolveEndpoint(ec v1beta1.EndpointConfig, service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+	signingRegion := region
+	if ec.SigningRegion != nil {
+		signingRegion = *ec.SigningRegion
+	}
+	for _, s := range ec.Services {
+		if !strings.EqualFold(s.ServiceID, service) {
+			continue
+		}
+		if s.SigningRegion != nil {
+			signingRegion = *s.SigningRegion
+		}
+		return endpointsv1.ResolvedEndpoint{URL: s.URL, SigningRegion: signingRegion}, nil
+	}
+	if ec.URL != nil {
+		return endpointsv1.ResolvedEndpoint{URL: *ec.URL, SigningRegion: signingRegion}, nil
+	}
+
+	var r endpointsv1.Resolver = endpointsv1.DefaultResolver()
+	if ec.Partition != nil {
+		p, ok := partition(*ec.Partition)
+		if !ok {
+			return endpointsv1.ResolvedEndpoint{}, errors.Errorf("unknown partition %s", *ec.Partition)
+		}
+		r = p
+	}
+	if aws.BoolValue(ec.UseDualStackEndpoint) {
+		optFns = append(optFns, func(o *endpointsv1.Options) { o.UseDualStack = true })
+	}
+	if aws.BoolValue(ec.UseFIPSEndpoint) {
+		return resolveFIPSEndpoint(r, service, region, optFns...)
+	}
+	return r.EndpointFor(service, region, optFns...)
+}
+
+// resolveFIPSEndpoint returns the FIPS endpoint of the given service. The
+// FIPS endpoints modeled as pseudo regions are preferred. Otherwise the FIPS
+// endpoint is derived from the regular one using the {service}-fips hostname
+// convention.
+func resolveFIPSEndpoint(r endpointsv1.Resolver, service, region string, optFns ...func(*endpointsv1.Options)) (endpointsv1.ResolvedEndpoint, error) {
+	strict := append(append([]func(*endpointsv1.Options){}, optFns...), func(o *endpointsv1.Options) { o.StrictMatching = true })
+	for _, fr := range []string{"fips-" + region, region + "-fips"} {
+		if e, err := r.EndpointFor(service, fr, strict...); err == nil {
+			return e, nil
+		}
+	}
+	e, err := r.EndpointFor(service, region, optFns...)
+	if err != nil {
+		return e, err
+	}
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return endpointsv1.ResolvedEndpoint{}, errors.Wrap(err, "cannot parse endpoint URL")
+	}
+	if labels := strings.SplitN(u.Host, ".", 2); len(labels) == 2 && !strings.HasSuffix(labels[0], "-fips") {
+		u.Host = labels[0] + "-fips." + labels[1]
+	}
+	e.URL = u.String()
+	return e, nil
+}
+
+func partition(id string) (endpointsv1.Partition, bool) {
+	for _, p := range endpointsv1.DefaultPartitions() {
+		if p.ID() == id {
+			return p, true
+		}
+	}
+	return endpointsv1.Partition{}, false
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestResolveEndpoint(t *testing.T) {
+	type args struct {
+		ec      v1beta1.EndpointConfig
+		service string
+		region  string
+	}
+	type want struct {
+		url           string
+		signingRegion string
+		err           error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Default": {
+			args: args{
+				service: "ec2",
+				region:  "us-east-1",
+			},
+			want: want{
+				url:           "https://ec2.us-east-1.amazonaws.com",
+				signingRegion: "us-east-1",
+			},
+		},
+		"ServiceOverride": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					URL: aws.String("http://localstack:4566"),
+					Services: []v1beta1.ServiceEndpoint{{
+						ServiceID:     "s3",
+						URL:           "http://minio:9000",
+						SigningRegion: aws.String("eu-west-1"),
+					}},
+				},
+				service: "s3",
+				region:  "us-east-1",
+			},
+			want: want{
+				url:           "http://minio:9000",
+				signingRegion: "eu-west-1",
+			},
+		},
+		"ServiceOverrideIsExactMatch": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					Services: []v1beta1.ServiceEndpoint{{
+						ServiceID: "s3-control",
+						URL:       "http://minio:9000",
+					}},
+				},
+				service: "s3",
+				region:  "us-east-1",
+			},
+			want: want{
+				url:           "https://s3.amazonaws.com",
+				signingRegion: "us-east-1",
+			},
+		},
+		"GlobalURL": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					URL:           aws.String("http://localstack:4566"),
+					SigningRegion: aws.String("us-west-2"),
+				},
+				service: "sqs",
+				region:  "us-east-1",
+			},
+			want: want{
+				url:           "http://localstack:4566",
+				signingRegion: "us-west-2",
+			},
+		},
+		"Partition": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					Partition: aws.String("aws-cn"),
+				},
+				service: "ec2",
+				region:  "cn-northwest-9",
+			},
+			want: want{
+				url:           "https://ec2.cn-northwest-9.amazonaws.com.cn",
+				signingRegion: "cn-northwest-9",
+			},
+		},
+		"UnknownPartition": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					Partition: aws.String("aws-moon"),
+				},
+				service: "ec2",
+				region:  "us-east-1",
+			},
+			want: want{
+				err: errors.New("unknown partition aws-moon"),
+			},
+		},
+		"DualStack": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					UseDualStackEndpoint: aws.Bool(true),
+				},
+				service: "s3",
+				region:  "us-west-2",
+			},
+			want: want{
+				url:           "https://s3.dualstack.us-west-2.amazonaws.com",
+				signingRegion: "us-west-2",
+			},
+		},
+		"ModeledFIPS": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					UseFIPSEndpoint: aws.Bool(true),
+				},
+				service: "acm",
+				region:  "us-east-1",
+			},
+			want: want{
+				url:           "https://acm-fips.us-east-1.amazonaws.com",
+				signingRegion: "us-east-1",
+			},
+		},
+		"DerivedFIPS": {
+			args: args{
+				ec: v1beta1.EndpointConfig{
+					UseFIPSEndpoint: aws.Bool(true),
+				},
+				service: "eks",
+				region:  "eu-central-1",
+			},
+			want: want{
+				url:           "https://eks-fips.eu-central-1.amazonaws.com",
+				signingRegion: "eu-central-1",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := ResolveEndpoint(tc.args.ec, tc.args.service, tc.args.region)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.url, e.URL); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.signingRegion, e.SigningRegion); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUseEndpointConfigV1(t *testing.T) {
+	cases := map[string]struct {
+		ec        *v1beta1.EndpointConfig
+		pathStyle bool
+	}{
+		"NoEndpointConfig": {},
+		"PathStyle": {
+			ec:        &v1beta1.EndpointConfig{S3UsePathStyle: aws.Bool(true)},
+			pathStyle: true,
+		},
+		"VirtualHostedStyle": {
+			ec:        &v1beta1.EndpointConfig{S3UsePathStyle: aws.Bool(false)},
+			pathStyle: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := UseEndpointConfigV1(awsv1.NewConfig(), tc.ec)
+			if diff := cmp.Diff(tc.pathStyle, aws.BoolValue(cfg.S3ForcePathStyle)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUsesS3PathStyle(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		pathStyle bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		kube client.Client
+		want
+	}{
+		"NoProviderConfig": {
+			mg: &fake.Managed{},
+		},
+		"PathStyle": {
+			mg: &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "aws"}}},
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				obj.(*v1beta1.ProviderConfig).Spec.Endpoint = &v1beta1.EndpointConfig{S3UsePathStyle: aws.Bool(true)}
+				return nil
+			}},
+			want: want{pathStyle: true},
+		},
+		"NoEndpointConfig": {
+			mg:   &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "aws"}}},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+		},
+		"GetFailed": {
+			mg:   &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "aws"}}},
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			want: want{err: errors.Wrap(errBoom, "cannot get referenced ProviderConfig")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := UsesS3PathStyle(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pathStyle, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,11 +27,23 @@ var _ clientset.RoleClient = (*MockRoleClient)(nil)
 
 // MockRoleClient is a type that implements all the methods for RoleClient interface
 type MockRoleClient struct {
-	MockGetRoleRequest                func(*iam.GetRoleInput) iam.GetRoleRequest
-	MockCreateRoleRequest             func(*iam.CreateRoleInput) iam.CreateRoleRequest
-	MockDeleteRoleRequest             func(*iam.DeleteRoleInput) iam.DeleteRoleRequest
-	MockUpdateRoleRequest             func(*iam.UpdateRoleInput) iam.UpdateRoleRequest
-	MockUpdateAssumeRolePolicyRequest func(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
+	MockGetRoleRequest                       func(*iam.GetRoleInput) iam.GetRoleRequest
+	MockCreateRoleRequest                    func(*iam.CreateRoleInput) iam.CreateRoleRequest
+	MockDeleteRoleRequest                    func(*iam.DeleteRoleInput) iam.DeleteRoleRequest
+	MockUpdateRoleRequest                    func(*iam.UpdateRoleInput) iam.UpdateRoleRequest
+	MockUpdateAssumeRolePolicyRequest        func(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
+	MockListRolePoliciesRequest              func(*iam.ListRolePoliciesInput) iam.ListRolePoliciesRequest
+	MockGetRolePolicyRequest                 func(*iam.GetRolePolicyInput) iam.GetRolePolicyRequest
+	MockPutRolePolicyRequest                 func(*iam.PutRolePolicyInput) iam.PutRolePolicyRequest
+	MockDeleteRolePolicyRequest              func(*iam.DeleteRolePolicyInput) iam.DeleteRolePolicyRequest
+	MockListAttachedRolePoliciesRequest      func(*iam.ListAttachedRolePoliciesInput) iam.ListAttachedRolePoliciesRequest
+	MockAttachRolePolicyRequest              func(*iam.AttachRolePolicyInput) iam.AttachRolePolicyRequest
+	MockDetachRolePolicyRequest              func(*iam.DetachRolePolicyInput) iam.DetachRolePolicyRequest
+	MockGetInstanceProfileRequest            func(*iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest
+	MockCreateInstanceProfileRequest         func(*iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest
+	MockDeleteInstanceProfileRequest         func(*iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest
+	MockAddRoleToInstanceProfileRequest      func(*iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest
+	MockRemoveRoleFromInstanceProfileRequest func(*iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest
 }
 
 // GetRoleRequest mocks GetRoleRequest method
@@ -58,3 +70,63 @@ func (m *MockRoleClient) UpdateRoleRequest(input *iam.UpdateRoleInput) iam.Updat
 func (m *MockRoleClient) UpdateAssumeRolePolicyRequest(input *iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest {
 	return m.MockUpdateAssumeRolePolicyRequest(input)
 }
+
+// ListRolePoliciesRequest mocks ListRolePoliciesRequest method
+func (m *MockRoleClient) ListRolePoliciesRequest(input *iam.ListRolePoliciesInput) iam.ListRolePoliciesRequest {
+	return m.MockListRolePoliciesRequest(input)
+}
+
+// GetRolePolicyRequest mocks GetRolePolicyRequest method
+func (m *MockRoleClient) GetRolePolicyRequest(input *iam.GetRolePolicyInput) iam.GetRolePolicyRequest {
+	return m.MockGetRolePolicyRequest(input)
+}
+
+// PutRolePolicyRequest mocks PutRolePolicyRequest method
+func (m *MockRoleClient) PutRolePolicyRequest(input *iam.PutRolePolicyInput) iam.PutRolePolicyRequest {
+	return m.MockPutRolePolicyRequest(input)
+}
+
+// DeleteRolePolicyRequest mocks DeleteRolePolicyRequest method
+func (m *MockRoleClient) DeleteRolePolicyRequest(input *iam.DeleteRolePolicyInput) iam.DeleteRolePolicyRequest {
+	return m.MockDeleteRolePolicyRequest(input)
+}
+
+// ListAttachedRolePoliciesRequest mocks ListAttachedRolePoliciesRequest method
+func (m *MockRoleClient) ListAttachedRolePoliciesRequest(input *iam.ListAttachedRolePoliciesInput) iam.ListAttachedRolePoliciesRequest {
+	return m.MockListAttachedRolePoliciesRequest(input)
+}
+
+// AttachRolePolicyRequest mocks AttachRolePolicyRequest method
+func (m *MockRoleClient) AttachRolePolicyRequest(input *iam.AttachRolePolicyInput) iam.AttachRolePolicyRequest {
+	return m.MockAttachRolePolicyRequest(input)
+}
+
+// DetachRolePolicyRequest mocks DetachRolePolicyRequest method
+func (m *MockRoleClient) DetachRolePolicyRequest(input *iam.DetachRolePolicyInput) iam.DetachRolePolicyRequest {
+	return m.MockDetachRolePolicyRequest(input)
+}
+
+// GetInstanceProfileRequest mocks GetInstanceProfileRequest method
+func (m *MockRoleClient) GetInstanceProfileRequest(input *iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest {
+	return m.MockGetInstanceProfileRequest(input)
+}
+
+// CreateInstanceProfileRequest mocks CreateInstanceProfileRequest method
+func (m *MockRoleClient) CreateInstanceProfileRequest(input *iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest {
+	return m.MockCreateInstanceProfileRequest(input)
+}
+
+// DeleteInstanceProfileRequest mocks DeleteInstanceProfileRequest method
+func (m *MockRoleClient) DeleteInstanceProfileRequest(input *iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest {
+	return m.MockDeleteInstanceProfileRequest(input)
+}
+
+// AddRoleToInstanceProfileRequest mocks AddRoleToInstanceProfileRequest method
+func (m *MockRoleClient) AddRoleToInstanceProfileRequest(input *iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest {
+	return m.MockAddRoleToInstanceProfileRequest(input)
+}
+
+// RemoveRoleFromInstanceProfileRequest mocks RemoveRoleFromInstanceProfileRequest method
+func (m *MockRoleClient) RemoveRoleFromInstanceProfileRequest(input *iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest {
+	return m.MockRemoveRoleFromInstanceProfileRequest(input)
+}
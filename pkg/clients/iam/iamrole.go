@@ -2,6 +2,9 @@ package iam
 
 import (
 	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -19,6 +22,8 @@ import (
 const (
 	errCheckUpToDate    = "unable to determine if external resource is up to date"
 	errPolicyJSONEscape = "malformed AssumeRolePolicyDocument JSON"
+	errInlinePolicyJSON = "malformed inline policy JSON"
+	errTrustPolicy      = "cannot generate the trust policy of the service account"
 )
 
 // RoleClient is the external client used for IAMRole Custom Resource
@@ -28,6 +33,18 @@ type RoleClient interface {
 	DeleteRoleRequest(*iam.DeleteRoleInput) iam.DeleteRoleRequest
 	UpdateRoleRequest(*iam.UpdateRoleInput) iam.UpdateRoleRequest
 	UpdateAssumeRolePolicyRequest(*iam.UpdateAssumeRolePolicyInput) iam.UpdateAssumeRolePolicyRequest
+	ListRolePoliciesRequest(*iam.ListRolePoliciesInput) iam.ListRolePoliciesRequest
+	GetRolePolicyRequest(*iam.GetRolePolicyInput) iam.GetRolePolicyRequest
+	PutRolePolicyRequest(*iam.PutRolePolicyInput) iam.PutRolePolicyRequest
+	DeleteRolePolicyRequest(*iam.DeleteRolePolicyInput) iam.DeleteRolePolicyRequest
+	ListAttachedRolePoliciesRequest(*iam.ListAttachedRolePoliciesInput) iam.ListAttachedRolePoliciesRequest
+	AttachRolePolicyRequest(*iam.AttachRolePolicyInput) iam.AttachRolePolicyRequest
+	DetachRolePolicyRequest(*iam.DetachRolePolicyInput) iam.DetachRolePolicyRequest
+	GetInstanceProfileRequest(*iam.GetInstanceProfileInput) iam.GetInstanceProfileRequest
+	CreateInstanceProfileRequest(*iam.CreateInstanceProfileInput) iam.CreateInstanceProfileRequest
+	DeleteInstanceProfileRequest(*iam.DeleteInstanceProfileInput) iam.DeleteInstanceProfileRequest
+	AddRoleToInstanceProfileRequest(*iam.AddRoleToInstanceProfileInput) iam.AddRoleToInstanceProfileRequest
+	RemoveRoleFromInstanceProfileRequest(*iam.RemoveRoleFromInstanceProfileInput) iam.RemoveRoleFromInstanceProfileRequest
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
@@ -59,6 +76,55 @@ func GenerateCreateRoleInput(name string, p *v1beta1.IAMRoleParameters) *iam.Cre
 	return m
 }
 
+// AssumeRolePolicyDocument returns the trust policy document of the role. The
+// document generated from ServiceAccountTrust, if given, takes precedence over
+// AssumeRolePolicyDocument.
+func AssumeRolePolicyDocument(p v1beta1.IAMRoleParameters) (string, error) {
+	t := p.ServiceAccountTrust
+	if t == nil || t.OIDCProviderARN == "" {
+		return p.AssumeRolePolicyDocument, nil
+	}
+	doc, err := ServiceAccountAssumeRolePolicy(*t)
+	return doc, errors.Wrap(err, errTrustPolicy)
+}
+
+// ServiceAccountAssumeRolePolicy returns a trust policy document that allows
+// the given service account to assume a role through web identity federation.
+func ServiceAccountAssumeRolePolicy(t v1beta1.ServiceAccountTrust) (string, error) {
+	// The issuer is the part of the provider ARN that follows the resource
+	// type, e.g. oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE for
+	// arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE
+	issuer := t.OIDCProviderARN
+	if i := strings.Index(issuer, ":oidc-provider/"); i != -1 {
+		issuer = issuer[i+len(":oidc-provider/"):]
+	}
+	type statement struct {
+		Effect    string                       `json:"Effect"`
+		Principal map[string]string            `json:"Principal"`
+		Action    string                       `json:"Action"`
+		Condition map[string]map[string]string `json:"Condition"`
+	}
+	doc := struct {
+		Version   string      `json:"Version"`
+		Statement []statement `json:"Statement"`
+	}{
+		Version: "2012-10-17",
+		Statement: []statement{{
+			Effect:    "Allow",
+			Principal: map[string]string{"Federated": t.OIDCProviderARN},
+			Action:    "sts:AssumeRoleWithWebIdentity",
+			Condition: map[string]map[string]string{
+				"StringEquals": {
+					issuer + ":aud": "sts.amazonaws.com",
+					issuer + ":sub": "system:serviceaccount:" + t.Namespace + ":" + t.ServiceAccountName,
+				},
+			},
+		}},
+	}
+	b, err := json.Marshal(doc)
+	return string(b), err
+}
+
 // GenerateRoleObservation is used to produce IAMRoleExternalStatus from iam.Role
 func GenerateRoleObservation(role iam.Role) v1beta1.IAMRoleExternalStatus {
 	return v1beta1.IAMRoleExternalStatus{
@@ -100,7 +166,11 @@ func LateInitializeRole(in *v1beta1.IAMRoleParameters, role *iam.Role) {
 	if role == nil {
 		return
 	}
-	in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	// The trust policy of a role that trusts a service account is generated,
+	// so it is not written to the spec.
+	if in.ServiceAccountTrust == nil {
+		in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	}
 	in.Description = awsclients.LateInitializeStringPtr(in.Description, role.Description)
 	in.MaxSessionDuration = awsclients.LateInitializeInt64Ptr(in.MaxSessionDuration, role.MaxSessionDuration)
 	in.Path = awsclients.LateInitializeStringPtr(in.Path, role.Path)
@@ -151,3 +221,79 @@ func IsRoleUpToDate(in v1beta1.IAMRoleParameters, observed iam.Role) (bool, erro
 
 	return cmp.Equal(desired, &observed, cmpopts.IgnoreInterfaces(struct{ resource.AttributeReferencer }{})), nil
 }
+
+// IsPolicyDocumentEqual returns true if the given desired and observed policy
+// documents are semantically equal. Documents returned by the IAM API are
+// URL-encoded, so the observed one is unescaped before they are compared as
+// JSON.
+func IsPolicyDocumentEqual(desired, observed string) (bool, error) {
+	unescaped, err := url.QueryUnescape(observed)
+	if err != nil {
+		return false, errors.Wrap(err, errInlinePolicyJSON)
+	}
+	var jd, jo interface{}
+	if err := json.Unmarshal([]byte(desired), &jd); err != nil {
+		return false, errors.Wrap(err, errInlinePolicyJSON)
+	}
+	if err := json.Unmarshal([]byte(unescaped), &jo); err != nil {
+		return false, errors.Wrap(err, errInlinePolicyJSON)
+	}
+	return cmp.Equal(jd, jo), nil
+}
+
+// DiffInlinePolicies returns the inline policies that should be put and the
+// names of the ones that should be deleted so that the observed inline
+// policies match the desired ones.
+func DiffInlinePolicies(desired, observed map[string]string) (put map[string]string, remove []string, err error) {
+	put = map[string]string{}
+	for name, doc := range desired {
+		o, ok := observed[name]
+		if !ok {
+			put[name] = doc
+			continue
+		}
+		eq, err := IsPolicyDocumentEqual(doc, o)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !eq {
+			put[name] = doc
+		}
+	}
+	for name := range observed {
+		if _, ok := desired[name]; !ok {
+			remove = append(remove, name)
+		}
+	}
+	sort.Strings(remove)
+	return put, remove, nil
+}
+
+// DiffManagedPolicies returns the ARNs of the managed policies that should be
+// attached and detached so that the observed attachments match the desired
+// ones.
+func DiffManagedPolicies(desired, observed []string) (attach, detach []string) {
+	return diffStrings(desired, observed)
+}
+
+// diffStrings returns the elements of desired that are not observed and the
+// elements of observed that are not desired.
+func diffStrings(desired, observed []string) (add, remove []string) {
+	o := make(map[string]bool, len(observed))
+	for _, v := range observed {
+		o[v] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		d[v] = true
+		if !o[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range observed {
+		if !d[v] {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
@@ -1,6 +1,8 @@
 package iam
 
 import (
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -112,6 +114,52 @@ func TestGenerateCreateRoleInput(t *testing.T) {
 	}
 }
 
+func TestAssumeRolePolicyDocument(t *testing.T) {
+	providerARN := "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	trust := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+		`"Principal":{"Federated":"` + providerARN + `"},"Action":"sts:AssumeRoleWithWebIdentity",` +
+		`"Condition":{"StringEquals":{"oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE:aud":"sts.amazonaws.com",` +
+		`"oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE:sub":"system:serviceaccount:default:app"}}}]}`
+
+	cases := map[string]struct {
+		in   v1beta1.IAMRoleParameters
+		want string
+	}{
+		"NoServiceAccountTrust": {
+			in:   *roleParams(),
+			want: assumeRolePolicyDocument,
+		},
+		"UnresolvedServiceAccountTrust": {
+			in: *roleParams(func(p *v1beta1.IAMRoleParameters) {
+				p.ServiceAccountTrust = &v1beta1.ServiceAccountTrust{Namespace: "default", ServiceAccountName: "app"}
+			}),
+			want: assumeRolePolicyDocument,
+		},
+		"ServiceAccountTrust": {
+			in: *roleParams(func(p *v1beta1.IAMRoleParameters) {
+				p.ServiceAccountTrust = &v1beta1.ServiceAccountTrust{
+					OIDCProviderARN:    providerARN,
+					Namespace:          "default",
+					ServiceAccountName: "app",
+				}
+			}),
+			want: trust,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := AssumeRolePolicyDocument(tc.in)
+			if err != nil {
+				t.Fatalf("AssumeRolePolicyDocument(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AssumeRolePolicyDocument(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateRoleObservation(t *testing.T) {
 	cases := map[string]struct {
 		in  iam.Role
@@ -177,6 +225,19 @@ func TestLateInitializeRole(t *testing.T) {
 				p.Description = &description
 			}),
 		},
+		"ServiceAccountTrust": {
+			args: args{
+				spec: roleParams(func(p *v1beta1.IAMRoleParameters) {
+					p.AssumeRolePolicyDocument = ""
+					p.ServiceAccountTrust = &v1beta1.ServiceAccountTrust{}
+				}),
+				in: *role(),
+			},
+			want: roleParams(func(p *v1beta1.IAMRoleParameters) {
+				p.AssumeRolePolicyDocument = ""
+				p.ServiceAccountTrust = &v1beta1.ServiceAccountTrust{}
+			}),
+		},
 		"PointerFields": {
 			args: args{
 				spec: roleParams(),
@@ -285,3 +346,142 @@ func TestIsRoleUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPolicyDocumentEqual(t *testing.T) {
+	type want struct {
+		equal bool
+		err   bool
+	}
+
+	cases := map[string]struct {
+		a, b string
+		want want
+	}{
+		"EscapedAndFormatted": {
+			a:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			b:    url.QueryEscape(`{"Statement":[{"Resource":"*","Action":"s3:*","Effect":"Allow"}],"Version":"2012-10-17"}`),
+			want: want{equal: true},
+		},
+		"PlusAndPercent": {
+			a:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::a+b/100%"}]}`,
+			b:    strings.ReplaceAll(url.QueryEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::a+b/100%"}]}`), "+", "%20"),
+			want: want{equal: true},
+		},
+		"DesiredIsNotUnescaped": {
+			a:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "arn:aws:s3:::a+b"}]}`,
+			b:    url.PathEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::a b"}]}`),
+			want: want{equal: false},
+		},
+		"Different": {
+			a:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:*", "Resource": "*"}]}`,
+			b:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			want: want{equal: false},
+		},
+		"Malformed": {
+			a:    `{"Version":`,
+			b:    `{}`,
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsPolicyDocumentEqual(tc.a, tc.b)
+			if diff := cmp.Diff(tc.want, want{equal: got, err: err != nil}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffInlinePolicies(t *testing.T) {
+	doc := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	changed := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`
+
+	type args struct {
+		desired  map[string]string
+		observed map[string]string
+	}
+	type want struct {
+		put    map[string]string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				desired:  map[string]string{"p1": doc},
+				observed: map[string]string{"p1": url.QueryEscape(doc)},
+			},
+			want: want{put: map[string]string{}},
+		},
+		"PutAndRemove": {
+			args: args{
+				desired:  map[string]string{"p1": changed, "p2": doc},
+				observed: map[string]string{"p1": url.QueryEscape(doc), "p3": url.QueryEscape(doc)},
+			},
+			want: want{
+				put:    map[string]string{"p1": changed, "p2": doc},
+				remove: []string{"p3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove, err := DiffInlinePolicies(tc.args.desired, tc.args.observed)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, want{put: put, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffManagedPolicies(t *testing.T) {
+	type args struct {
+		desired  []string
+		observed []string
+	}
+	type want struct {
+		attach []string
+		detach []string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				desired:  []string{"arn1", "arn2"},
+				observed: []string{"arn2", "arn1"},
+			},
+			want: want{},
+		},
+		"AttachAndDetach": {
+			args: args{
+				desired:  []string{"arn1", "arn2"},
+				observed: []string{"arn2", "arn3"},
+			},
+			want: want{
+				attach: []string{"arn1"},
+				detach: []string{"arn3"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffManagedPolicies(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, want{attach: attach, detach: detach}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
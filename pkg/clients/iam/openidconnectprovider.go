@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"crypto/sha1" // nolint:gosec
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+const (
+	errGetDiscovery   = "cannot get the OpenID Connect discovery document"
+	errNoCertificates = "the OpenID Connect provider did not present any TLS certificates"
+)
+
+// OpenIDConnectProviderClient is the external client used for
+// OpenIDConnectProvider Custom Resource
+type OpenIDConnectProviderClient interface {
+	CreateOpenIDConnectProviderRequest(*iam.CreateOpenIDConnectProviderInput) iam.CreateOpenIDConnectProviderRequest
+	GetOpenIDConnectProviderRequest(*iam.GetOpenIDConnectProviderInput) iam.GetOpenIDConnectProviderRequest
+	DeleteOpenIDConnectProviderRequest(*iam.DeleteOpenIDConnectProviderInput) iam.DeleteOpenIDConnectProviderRequest
+	UpdateOpenIDConnectProviderThumbprintRequest(*iam.UpdateOpenIDConnectProviderThumbprintInput) iam.UpdateOpenIDConnectProviderThumbprintRequest
+	AddClientIDToOpenIDConnectProviderRequest(*iam.AddClientIDToOpenIDConnectProviderInput) iam.AddClientIDToOpenIDConnectProviderRequest
+	RemoveClientIDFromOpenIDConnectProviderRequest(*iam.RemoveClientIDFromOpenIDConnectProviderInput) iam.RemoveClientIDFromOpenIDConnectProviderRequest
+}
+
+// NewOpenIDConnectProviderClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewOpenIDConnectProviderClient(cfg aws.Config) OpenIDConnectProviderClient {
+	return iam.New(cfg)
+}
+
+// LateInitializeOpenIDConnectProvider fills the empty fields in
+// *v1alpha1.OpenIDConnectProviderParameters with the values seen in
+// iam.GetOpenIDConnectProviderOutput.
+func LateInitializeOpenIDConnectProvider(in *v1alpha1.OpenIDConnectProviderParameters, out *iam.GetOpenIDConnectProviderOutput) {
+	if out == nil {
+		return
+	}
+	if in.URL == "" && out.Url != nil {
+		// IAM returns the URL without its scheme.
+		in.URL = "https://" + aws.StringValue(out.Url)
+	}
+	if len(in.ClientIDList) == 0 {
+		in.ClientIDList = out.ClientIDList
+	}
+	if len(in.ThumbprintList) == 0 {
+		in.ThumbprintList = out.ThumbprintList
+	}
+}
+
+// IsOpenIDConnectProviderUpToDate checks whether the client IDs and the
+// thumbprints of the provider match the desired ones.
+func IsOpenIDConnectProviderUpToDate(in v1alpha1.OpenIDConnectProviderParameters, out iam.GetOpenIDConnectProviderOutput) bool {
+	add, remove := DiffClientIDs(in.ClientIDList, out.ClientIDList)
+	return len(add) == 0 && len(remove) == 0 && IsThumbprintListUpToDate(in.ThumbprintList, out.ThumbprintList)
+}
+
+// DiffClientIDs returns the client IDs that should be added to and removed
+// from the provider.
+func DiffClientIDs(desired, observed []string) (add, remove []string) {
+	return diffStrings(desired, observed)
+}
+
+// IsThumbprintListUpToDate returns true if the desired and the observed
+// thumbprints are the same regardless of their order and case.
+func IsThumbprintListUpToDate(desired, observed []string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	d := make([]string, len(desired))
+	o := make([]string, len(observed))
+	for i := range desired {
+		d[i] = strings.ToLower(desired[i])
+		o[i] = strings.ToLower(observed[i])
+	}
+	sort.Strings(d)
+	sort.Strings(o)
+	for i := range d {
+		if d[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetRootCAThumbprint returns the hex-encoded SHA-1 hash of the last
+// certificate in the chain presented by the given OpenID Connect issuer, which
+// is what IAM expects as the provider thumbprint.
+func GetRootCAThumbprint(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(url, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return "", errors.Wrap(err, errGetDiscovery)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, errGetDiscovery)
+	}
+	defer resp.Body.Close() // nolint:errcheck
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return "", errors.New(errNoCertificates)
+	}
+	root := resp.TLS.PeerCertificates[len(resp.TLS.PeerCertificates)-1]
+	sum := sha1.Sum(root.Raw) // nolint:gosec
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+)
+
+var (
+	oidcURL    = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	clientID   = "sts.amazonaws.com"
+	thumbprint = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
+)
+
+func TestLateInitializeOpenIDConnectProvider(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.OpenIDConnectProviderParameters
+		out  *iam.GetOpenIDConnectProviderOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.OpenIDConnectProviderParameters
+	}{
+		"AllFilled": {
+			args: args{
+				spec: &v1alpha1.OpenIDConnectProviderParameters{},
+				out: &iam.GetOpenIDConnectProviderOutput{
+					ClientIDList:   []string{clientID},
+					ThumbprintList: []string{thumbprint},
+					Url:            aws.String("oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"),
+				},
+			},
+			want: &v1alpha1.OpenIDConnectProviderParameters{
+				URL:            oidcURL,
+				ClientIDList:   []string{clientID},
+				ThumbprintList: []string{thumbprint},
+			},
+		},
+		"NoOverride": {
+			args: args{
+				spec: &v1alpha1.OpenIDConnectProviderParameters{
+					URL:            oidcURL,
+					ClientIDList:   []string{"other"},
+					ThumbprintList: []string{"other"},
+				},
+				out: &iam.GetOpenIDConnectProviderOutput{
+					ClientIDList:   []string{clientID},
+					ThumbprintList: []string{thumbprint},
+					Url:            aws.String("oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"),
+				},
+			},
+			want: &v1alpha1.OpenIDConnectProviderParameters{
+				URL:            oidcURL,
+				ClientIDList:   []string{"other"},
+				ThumbprintList: []string{"other"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeOpenIDConnectProvider(tc.args.spec, tc.args.out)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsOpenIDConnectProviderUpToDate(t *testing.T) {
+	type args struct {
+		spec v1alpha1.OpenIDConnectProviderParameters
+		out  iam.GetOpenIDConnectProviderOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				spec: v1alpha1.OpenIDConnectProviderParameters{
+					ClientIDList:   []string{clientID, "other"},
+					ThumbprintList: []string{thumbprint},
+				},
+				out: iam.GetOpenIDConnectProviderOutput{
+					ClientIDList:   []string{"other", clientID},
+					ThumbprintList: []string{"9E99A48A9960B14926BB7F3B02E22DA2B0AB7280"},
+				},
+			},
+			want: true,
+		},
+		"DifferentClientIDs": {
+			args: args{
+				spec: v1alpha1.OpenIDConnectProviderParameters{
+					ClientIDList:   []string{clientID},
+					ThumbprintList: []string{thumbprint},
+				},
+				out: iam.GetOpenIDConnectProviderOutput{
+					ClientIDList:   []string{"other"},
+					ThumbprintList: []string{thumbprint},
+				},
+			},
+			want: false,
+		},
+		"DifferentThumbprints": {
+			args: args{
+				spec: v1alpha1.OpenIDConnectProviderParameters{
+					ClientIDList:   []string{clientID},
+					ThumbprintList: []string{thumbprint},
+				},
+				out: iam.GetOpenIDConnectProviderOutput{
+					ClientIDList:   []string{clientID},
+					ThumbprintList: []string{thumbprint, "other"},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsOpenIDConnectProviderUpToDate(tc.args.spec, tc.args.out)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
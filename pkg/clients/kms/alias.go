@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+const (
+	aliasPrefix = "alias/"
+)
+
+// AliasClient is the external client used for Alias Custom Resource
+type AliasClient interface {
+	CreateAliasRequest(*kms.CreateAliasInput) kms.CreateAliasRequest
+	ListAliasesRequest(*kms.ListAliasesInput) kms.ListAliasesRequest
+	UpdateAliasRequest(*kms.UpdateAliasInput) kms.UpdateAliasRequest
+	DeleteAliasRequest(*kms.DeleteAliasInput) kms.DeleteAliasRequest
+}
+
+// NewAliasClient returns a new client using AWS credentials as JSON encoded data.
+func NewAliasClient(cfg aws.Config) AliasClient {
+	return kms.New(cfg)
+}
+
+// AliasName returns the name of the alias with the given external name as
+// expected by KMS, i.e. with the alias/ prefix.
+func AliasName(externalName string) string {
+	if strings.HasPrefix(externalName, aliasPrefix) {
+		return externalName
+	}
+	return aliasPrefix + externalName
+}
+
+// GetAlias returns the alias with the given name, or nil if there is no such
+// alias. KMS can not look up a single alias, so all aliases in the account
+// and region are listed.
+func GetAlias(ctx context.Context, name string, c AliasClient) (*kms.AliasListEntry, error) {
+	in := &kms.ListAliasesInput{}
+	for {
+		rsp, err := c.ListAliasesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rsp.Aliases {
+			if aws.StringValue(rsp.Aliases[i].AliasName) == name {
+				return &rsp.Aliases[i], nil
+			}
+		}
+		if !aws.BoolValue(rsp.Truncated) || rsp.NextMarker == nil {
+			return nil, nil
+		}
+		in = &kms.ListAliasesInput{Marker: rsp.NextMarker}
+	}
+}
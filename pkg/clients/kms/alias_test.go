@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type mockAliasClient struct {
+	AliasClient
+	list func(*kms.ListAliasesInput) kms.ListAliasesRequest
+}
+
+func (m *mockAliasClient) ListAliasesRequest(in *kms.ListAliasesInput) kms.ListAliasesRequest {
+	return m.list(in)
+}
+
+func TestAliasName(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"NoPrefix": {
+			name: "my-key",
+			want: "alias/my-key",
+		},
+		"Prefix": {
+			name: "alias/my-key",
+			want: "alias/my-key",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AliasName(tc.name)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAlias(t *testing.T) {
+	errBoom := errors.New("boom")
+	pages := map[string]*kms.ListAliasesOutput{
+		"": {
+			Aliases:    []kms.AliasListEntry{{AliasName: aws.String("alias/first")}},
+			NextMarker: aws.String("next"),
+			Truncated:  aws.Bool(true),
+		},
+		"next": {
+			Aliases: []kms.AliasListEntry{{AliasName: aws.String("alias/second"), TargetKeyId: aws.String(keyID)}},
+		},
+	}
+	list := func(in *kms.ListAliasesInput) kms.ListAliasesRequest {
+		return kms.ListAliasesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: pages[aws.StringValue(in.Marker)]},
+		}
+	}
+
+	type want struct {
+		alias *kms.AliasListEntry
+		err   error
+	}
+
+	cases := map[string]struct {
+		name   string
+		client AliasClient
+		want
+	}{
+		"FirstPage": {
+			name:   "alias/first",
+			client: &mockAliasClient{list: list},
+			want:   want{alias: &kms.AliasListEntry{AliasName: aws.String("alias/first")}},
+		},
+		"SecondPage": {
+			name:   "alias/second",
+			client: &mockAliasClient{list: list},
+			want:   want{alias: &kms.AliasListEntry{AliasName: aws.String("alias/second"), TargetKeyId: aws.String(keyID)}},
+		},
+		"NotFound": {
+			name:   "alias/third",
+			client: &mockAliasClient{list: list},
+		},
+		"ListFailed": {
+			name: "alias/first",
+			client: &mockAliasClient{list: func(*kms.ListAliasesInput) kms.ListAliasesRequest {
+				return kms.ListAliasesRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+				}
+			}},
+			want: want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, err := GetAlias(context.Background(), tc.name, tc.client)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.alias, a); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/kms"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AliasClient = (*MockAliasClient)(nil)
+
+// MockAliasClient is a type that implements all the methods for AliasClient interface
+type MockAliasClient struct {
+	MockCreate func(*kms.CreateAliasInput) kms.CreateAliasRequest
+	MockList   func(*kms.ListAliasesInput) kms.ListAliasesRequest
+	MockUpdate func(*kms.UpdateAliasInput) kms.UpdateAliasRequest
+	MockDelete func(*kms.DeleteAliasInput) kms.DeleteAliasRequest
+}
+
+// CreateAliasRequest mocks CreateAliasRequest method
+func (m *MockAliasClient) CreateAliasRequest(input *kms.CreateAliasInput) kms.CreateAliasRequest {
+	return m.MockCreate(input)
+}
+
+// ListAliasesRequest mocks ListAliasesRequest method
+func (m *MockAliasClient) ListAliasesRequest(input *kms.ListAliasesInput) kms.ListAliasesRequest {
+	return m.MockList(input)
+}
+
+// UpdateAliasRequest mocks UpdateAliasRequest method
+func (m *MockAliasClient) UpdateAliasRequest(input *kms.UpdateAliasInput) kms.UpdateAliasRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteAliasRequest mocks DeleteAliasRequest method
+func (m *MockAliasClient) DeleteAliasRequest(input *kms.DeleteAliasInput) kms.DeleteAliasRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/kms"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.KeyClient = (*MockKeyClient)(nil)
+
+// MockKeyClient is a type that implements all the methods for KeyClient interface
+type MockKeyClient struct {
+	MockCreate            func(*kms.CreateKeyInput) kms.CreateKeyRequest
+	MockDescribe          func(*kms.DescribeKeyInput) kms.DescribeKeyRequest
+	MockUpdateDescription func(*kms.UpdateKeyDescriptionInput) kms.UpdateKeyDescriptionRequest
+	MockEnable            func(*kms.EnableKeyInput) kms.EnableKeyRequest
+	MockDisable           func(*kms.DisableKeyInput) kms.DisableKeyRequest
+	MockGetPolicy         func(*kms.GetKeyPolicyInput) kms.GetKeyPolicyRequest
+	MockPutPolicy         func(*kms.PutKeyPolicyInput) kms.PutKeyPolicyRequest
+	MockGetRotationStatus func(*kms.GetKeyRotationStatusInput) kms.GetKeyRotationStatusRequest
+	MockEnableRotation    func(*kms.EnableKeyRotationInput) kms.EnableKeyRotationRequest
+	MockDisableRotation   func(*kms.DisableKeyRotationInput) kms.DisableKeyRotationRequest
+	MockListTags          func(*kms.ListResourceTagsInput) kms.ListResourceTagsRequest
+	MockTag               func(*kms.TagResourceInput) kms.TagResourceRequest
+	MockUntag             func(*kms.UntagResourceInput) kms.UntagResourceRequest
+	MockScheduleDeletion  func(*kms.ScheduleKeyDeletionInput) kms.ScheduleKeyDeletionRequest
+	MockCancelDeletion    func(*kms.CancelKeyDeletionInput) kms.CancelKeyDeletionRequest
+}
+
+// CreateKeyRequest mocks CreateKeyRequest method
+func (m *MockKeyClient) CreateKeyRequest(input *kms.CreateKeyInput) kms.CreateKeyRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeKeyRequest mocks DescribeKeyRequest method
+func (m *MockKeyClient) DescribeKeyRequest(input *kms.DescribeKeyInput) kms.DescribeKeyRequest {
+	return m.MockDescribe(input)
+}
+
+// UpdateKeyDescriptionRequest mocks UpdateKeyDescriptionRequest method
+func (m *MockKeyClient) UpdateKeyDescriptionRequest(input *kms.UpdateKeyDescriptionInput) kms.UpdateKeyDescriptionRequest {
+	return m.MockUpdateDescription(input)
+}
+
+// EnableKeyRequest mocks EnableKeyRequest method
+func (m *MockKeyClient) EnableKeyRequest(input *kms.EnableKeyInput) kms.EnableKeyRequest {
+	return m.MockEnable(input)
+}
+
+// DisableKeyRequest mocks DisableKeyRequest method
+func (m *MockKeyClient) DisableKeyRequest(input *kms.DisableKeyInput) kms.DisableKeyRequest {
+	return m.MockDisable(input)
+}
+
+// GetKeyPolicyRequest mocks GetKeyPolicyRequest method
+func (m *MockKeyClient) GetKeyPolicyRequest(input *kms.GetKeyPolicyInput) kms.GetKeyPolicyRequest {
+	return m.MockGetPolicy(input)
+}
+
+// PutKeyPolicyRequest mocks PutKeyPolicyRequest method
+func (m *MockKeyClient) PutKeyPolicyRequest(input *kms.PutKeyPolicyInput) kms.PutKeyPolicyRequest {
+	return m.MockPutPolicy(input)
+}
+
+// GetKeyRotationStatusRequest mocks GetKeyRotationStatusRequest method
+func (m *MockKeyClient) GetKeyRotationStatusRequest(input *kms.GetKeyRotationStatusInput) kms.GetKeyRotationStatusRequest {
+	return m.MockGetRotationStatus(input)
+}
+
+// EnableKeyRotationRequest mocks EnableKeyRotationRequest method
+func (m *MockKeyClient) EnableKeyRotationRequest(input *kms.EnableKeyRotationInput) kms.EnableKeyRotationRequest {
+	return m.MockEnableRotation(input)
+}
+
+// DisableKeyRotationRequest mocks DisableKeyRotationRequest method
+func (m *MockKeyClient) DisableKeyRotationRequest(input *kms.DisableKeyRotationInput) kms.DisableKeyRotationRequest {
+	return m.MockDisableRotation(input)
+}
+
+// ListResourceTagsRequest mocks ListResourceTagsRequest method
+func (m *MockKeyClient) ListResourceTagsRequest(input *kms.ListResourceTagsInput) kms.ListResourceTagsRequest {
+	return m.MockListTags(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockKeyClient) TagResourceRequest(input *kms.TagResourceInput) kms.TagResourceRequest {
+	return m.MockTag(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockKeyClient) UntagResourceRequest(input *kms.UntagResourceInput) kms.UntagResourceRequest {
+	return m.MockUntag(input)
+}
+
+// ScheduleKeyDeletionRequest mocks ScheduleKeyDeletionRequest method
+func (m *MockKeyClient) ScheduleKeyDeletionRequest(input *kms.ScheduleKeyDeletionInput) kms.ScheduleKeyDeletionRequest {
+	return m.MockScheduleDeletion(input)
+}
+
+// CancelKeyDeletionRequest mocks CancelKeyDeletionRequest method
+func (m *MockKeyClient) CancelKeyDeletionRequest(input *kms.CancelKeyDeletionInput) kms.CancelKeyDeletionRequest {
+	return m.MockCancelDeletion(input)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// DefaultPolicyName is the name of the only key policy a key can have.
+	DefaultPolicyName = "default"
+)
+
+// KeyClient is the external client used for Key Custom Resource
+type KeyClient interface {
+	CreateKeyRequest(*kms.CreateKeyInput) kms.CreateKeyRequest
+	DescribeKeyRequest(*kms.DescribeKeyInput) kms.DescribeKeyRequest
+	UpdateKeyDescriptionRequest(*kms.UpdateKeyDescriptionInput) kms.UpdateKeyDescriptionRequest
+	EnableKeyRequest(*kms.EnableKeyInput) kms.EnableKeyRequest
+	DisableKeyRequest(*kms.DisableKeyInput) kms.DisableKeyRequest
+	GetKeyPolicyRequest(*kms.GetKeyPolicyInput) kms.GetKeyPolicyRequest
+	PutKeyPolicyRequest(*kms.PutKeyPolicyInput) kms.PutKeyPolicyRequest
+	GetKeyRotationStatusRequest(*kms.GetKeyRotationStatusInput) kms.GetKeyRotationStatusRequest
+	EnableKeyRotationRequest(*kms.EnableKeyRotationInput) kms.EnableKeyRotationRequest
+	DisableKeyRotationRequest(*kms.DisableKeyRotationInput) kms.DisableKeyRotationRequest
+	ListResourceTagsRequest(*kms.ListResourceTagsInput) kms.ListResourceTagsRequest
+	TagResourceRequest(*kms.TagResourceInput) kms.TagResourceRequest
+	UntagResourceRequest(*kms.UntagResourceInput) kms.UntagResourceRequest
+	ScheduleKeyDeletionRequest(*kms.ScheduleKeyDeletionInput) kms.ScheduleKeyDeletionRequest
+	CancelKeyDeletionRequest(*kms.CancelKeyDeletionInput) kms.CancelKeyDeletionRequest
+}
+
+// NewKeyClient returns a new client using AWS credentials as JSON encoded data.
+func NewKeyClient(cfg aws.Config) KeyClient {
+	return kms.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == kms.ErrCodeNotFoundException {
+			return true
+		}
+	}
+	return false
+}
+
+// ObservedKey is the state of a key as reported by the different KMS APIs.
+type ObservedKey struct {
+	Metadata kms.KeyMetadata
+
+	// Policy is the default key policy of the key.
+	Policy *string
+
+	// RotationEnabled is nil if the rotation status of the key can not be
+	// observed, i.e. for asymmetric keys.
+	RotationEnabled *bool
+
+	Tags map[string]string
+}
+
+// IsRotationSupported returns true if the automatic rotation of the key
+// material can be configured for the given key.
+func IsRotationSupported(m kms.KeyMetadata) bool {
+	return (m.CustomerMasterKeySpec == "" || m.CustomerMasterKeySpec == kms.CustomerMasterKeySpecSymmetricDefault) &&
+		m.Origin != kms.OriginTypeExternal && m.KeyState == kms.KeyStateEnabled
+}
+
+// GenerateCreateKeyInput returns a CreateKeyInput from the given
+// KeyParameters.
+func GenerateCreateKeyInput(p *v1alpha1.KeyParameters) *kms.CreateKeyInput {
+	c := &kms.CreateKeyInput{
+		BypassPolicyLockoutSafetyCheck: p.BypassPolicyLockoutSafetyCheck,
+		CustomerMasterKeySpec:          kms.CustomerMasterKeySpec(aws.StringValue(p.CustomerMasterKeySpec)),
+		Description:                    p.Description,
+		KeyUsage:                       kms.KeyUsageType(aws.StringValue(p.KeyUsage)),
+		Policy:                         p.Policy,
+	}
+	for k, v := range p.Tags {
+		c.Tags = append(c.Tags, kms.Tag{TagKey: aws.String(k), TagValue: aws.String(v)})
+	}
+	return c
+}
+
+// GenerateTags returns the given KMS tags as a map.
+func GenerateTags(tags []kms.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.TagKey)] = aws.StringValue(t.TagValue)
+	}
+	return m
+}
+
+// LateInitializeKey fills the empty fields in *v1alpha1.KeyParameters with
+// the values seen in ObservedKey.
+func LateInitializeKey(in *v1alpha1.KeyParameters, o ObservedKey) {
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Metadata.Description)
+	if in.KeyUsage == nil && o.Metadata.KeyUsage != "" {
+		in.KeyUsage = aws.String(string(o.Metadata.KeyUsage))
+	}
+	if in.CustomerMasterKeySpec == nil && o.Metadata.CustomerMasterKeySpec != "" {
+		in.CustomerMasterKeySpec = aws.String(string(o.Metadata.CustomerMasterKeySpec))
+	}
+	if in.Enabled == nil && o.Metadata.KeyState != kms.KeyStatePendingDeletion {
+		in.Enabled = o.Metadata.Enabled
+	}
+	in.EnableKeyRotation = awsclients.LateInitializeBoolPtr(in.EnableKeyRotation, o.RotationEnabled)
+	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, o.Policy)
+}
+
+// GenerateKeyObservation is used to produce v1alpha1.KeyObservation from
+// kms.KeyMetadata.
+func GenerateKeyObservation(m kms.KeyMetadata) v1alpha1.KeyObservation {
+	o := v1alpha1.KeyObservation{
+		ARN:        aws.StringValue(m.Arn),
+		KeyID:      aws.StringValue(m.KeyId),
+		KeyState:   string(m.KeyState),
+		KeyManager: string(m.KeyManager),
+		Origin:     string(m.Origin),
+	}
+	if m.CreationDate != nil {
+		t := metav1.NewTime(*m.CreationDate)
+		o.CreationDate = &t
+	}
+	if m.DeletionDate != nil {
+		t := metav1.NewTime(*m.DeletionDate)
+		o.DeletionDate = &t
+	}
+	return o
+}
+
+// IsPolicyUpToDate returns true if the desired key policy is semantically
+// equal to the observed one. A policy that is not specified is always up to
+// date.
+func IsPolicyUpToDate(desired, observed *string) (bool, error) {
+	if desired == nil {
+		return true, nil
+	}
+	d, err := awsclients.CompactAndEscapeJSON(aws.StringValue(desired))
+	if err != nil {
+		return false, err
+	}
+	o, err := awsclients.CompactAndEscapeJSON(aws.StringValue(observed))
+	if err != nil {
+		return false, err
+	}
+	return d == o, nil
+}
+
+// IsKeyUpToDate checks whether there is a change in any of the modifiable
+// fields of the key.
+func IsKeyUpToDate(p v1alpha1.KeyParameters, o ObservedKey) (bool, error) {
+	if o.Metadata.KeyState == kms.KeyStatePendingDeletion {
+		return false, nil
+	}
+	if aws.StringValue(p.Description) != aws.StringValue(o.Metadata.Description) {
+		return false, nil
+	}
+	if p.Enabled != nil && aws.BoolValue(p.Enabled) != aws.BoolValue(o.Metadata.Enabled) {
+		return false, nil
+	}
+	if p.EnableKeyRotation != nil && o.RotationEnabled != nil && aws.BoolValue(p.EnableKeyRotation) != aws.BoolValue(o.RotationEnabled) {
+		return false, nil
+	}
+	if add, remove := awsclients.DiffTags(p.Tags, o.Tags); len(add) != 0 || len(remove) != 0 {
+		return false, nil
+	}
+	return IsPolicyUpToDate(p.Policy, o.Policy)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kms
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+)
+
+var (
+	keyID        = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	description  = "some key"
+	policy       = `{"Version":"2012-10-17","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111122223333:root"},"Action":"kms:*","Resource":"*"}]}`
+	prettyPolicy = `{
+  "Version" : "2012-10-17",
+  "Statement" : [ {
+    "Sid" : "Enable IAM User Permissions",
+    "Effect" : "Allow",
+    "Principal" : {
+      "AWS" : "arn:aws:iam::111122223333:root"
+    },
+    "Action" : "kms:*",
+    "Resource" : "*"
+  } ]
+}`
+)
+
+func observedKey(m ...func(*ObservedKey)) ObservedKey {
+	o := ObservedKey{
+		Metadata: kms.KeyMetadata{
+			KeyId:                 aws.String(keyID),
+			Description:           aws.String(description),
+			Enabled:               aws.Bool(true),
+			KeyState:              kms.KeyStateEnabled,
+			KeyUsage:              kms.KeyUsageTypeEncryptDecrypt,
+			CustomerMasterKeySpec: kms.CustomerMasterKeySpecSymmetricDefault,
+		},
+		Policy:          aws.String(prettyPolicy),
+		RotationEnabled: aws.Bool(false),
+		Tags:            map[string]string{"k": "v"},
+	}
+	for _, f := range m {
+		f(&o)
+	}
+	return o
+}
+
+func TestIsKeyUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		p v1alpha1.KeyParameters
+		o ObservedKey
+		want
+	}{
+		"UpToDate": {
+			p: v1alpha1.KeyParameters{
+				Description:       aws.String(description),
+				Enabled:           aws.Bool(true),
+				EnableKeyRotation: aws.Bool(false),
+				Policy:            aws.String(policy),
+				Tags:              map[string]string{"k": "v"},
+			},
+			o:    observedKey(),
+			want: want{upToDate: true},
+		},
+		"DescriptionChanged": {
+			p: v1alpha1.KeyParameters{
+				Description: aws.String("another key"),
+				Tags:        map[string]string{"k": "v"},
+			},
+			o: observedKey(),
+		},
+		"Disabled": {
+			p: v1alpha1.KeyParameters{
+				Description: aws.String(description),
+				Enabled:     aws.Bool(false),
+				Tags:        map[string]string{"k": "v"},
+			},
+			o: observedKey(),
+		},
+		"RotationChanged": {
+			p: v1alpha1.KeyParameters{
+				Description:       aws.String(description),
+				EnableKeyRotation: aws.Bool(true),
+				Tags:              map[string]string{"k": "v"},
+			},
+			o: observedKey(),
+		},
+		"RotationNotSupported": {
+			p: v1alpha1.KeyParameters{
+				Description:       aws.String(description),
+				EnableKeyRotation: aws.Bool(true),
+				Tags:              map[string]string{"k": "v"},
+			},
+			o:    observedKey(func(o *ObservedKey) { o.RotationEnabled = nil }),
+			want: want{upToDate: true},
+		},
+		"TagsChanged": {
+			p: v1alpha1.KeyParameters{
+				Description: aws.String(description),
+				Tags:        map[string]string{"k": "v2"},
+			},
+			o: observedKey(),
+		},
+		"PolicyChanged": {
+			p: v1alpha1.KeyParameters{
+				Description: aws.String(description),
+				Policy:      aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+				Tags:        map[string]string{"k": "v"},
+			},
+			o: observedKey(),
+		},
+		"PendingDeletion": {
+			p: v1alpha1.KeyParameters{
+				Description: aws.String(description),
+				Tags:        map[string]string{"k": "v"},
+			},
+			o: observedKey(func(o *ObservedKey) { o.Metadata.KeyState = kms.KeyStatePendingDeletion }),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, err := IsKeyUpToDate(tc.p, tc.o)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeKey(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.KeyParameters
+		o    ObservedKey
+		want v1alpha1.KeyParameters
+	}{
+		"AllFilled": {
+			p: v1alpha1.KeyParameters{Region: "us-east-1"},
+			o: observedKey(),
+			want: v1alpha1.KeyParameters{
+				Region:                "us-east-1",
+				Description:           aws.String(description),
+				KeyUsage:              aws.String(string(kms.KeyUsageTypeEncryptDecrypt)),
+				CustomerMasterKeySpec: aws.String(string(kms.CustomerMasterKeySpecSymmetricDefault)),
+				Enabled:               aws.Bool(true),
+				EnableKeyRotation:     aws.Bool(false),
+				Policy:                aws.String(prettyPolicy),
+			},
+		},
+		"NoOverride": {
+			p: v1alpha1.KeyParameters{
+				Description:           aws.String("another key"),
+				KeyUsage:              aws.String(string(kms.KeyUsageTypeEncryptDecrypt)),
+				CustomerMasterKeySpec: aws.String(string(kms.CustomerMasterKeySpecSymmetricDefault)),
+				Enabled:               aws.Bool(false),
+				EnableKeyRotation:     aws.Bool(true),
+				Policy:                aws.String(policy),
+			},
+			o: observedKey(),
+			want: v1alpha1.KeyParameters{
+				Description:           aws.String("another key"),
+				KeyUsage:              aws.String(string(kms.KeyUsageTypeEncryptDecrypt)),
+				CustomerMasterKeySpec: aws.String(string(kms.CustomerMasterKeySpecSymmetricDefault)),
+				Enabled:               aws.Bool(false),
+				EnableKeyRotation:     aws.Bool(true),
+				Policy:                aws.String(policy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeKey(&tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
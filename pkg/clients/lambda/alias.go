@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AliasClient is the external client used for Alias Custom Resource
+type AliasClient interface {
+	CreateAliasRequest(*lambda.CreateAliasInput) lambda.CreateAliasRequest
+	GetAliasRequest(*lambda.GetAliasInput) lambda.GetAliasRequest
+	UpdateAliasRequest(*lambda.UpdateAliasInput) lambda.UpdateAliasRequest
+	DeleteAliasRequest(*lambda.DeleteAliasInput) lambda.DeleteAliasRequest
+}
+
+// NewAliasClient returns a new client using AWS credentials as JSON encoded data.
+func NewAliasClient(cfg aws.Config) AliasClient {
+	return lambda.New(cfg)
+}
+
+func generateRoutingConfig(c *v1alpha1.AliasRoutingConfig) *lambda.AliasRoutingConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &lambda.AliasRoutingConfiguration{AdditionalVersionWeights: c.AdditionalVersionWeights}
+}
+
+// GenerateCreateAliasInput returns a CreateAliasInput from the given
+// AliasParameters.
+func GenerateCreateAliasInput(name string, p *v1alpha1.AliasParameters) *lambda.CreateAliasInput {
+	return &lambda.CreateAliasInput{
+		Name:            aws.String(name),
+		FunctionName:    p.FunctionName,
+		FunctionVersion: aws.String(p.FunctionVersion),
+		Description:     p.Description,
+		RoutingConfig:   generateRoutingConfig(p.RoutingConfig),
+	}
+}
+
+// GenerateUpdateAliasInput returns an UpdateAliasInput from the given
+// AliasParameters.
+func GenerateUpdateAliasInput(name string, p *v1alpha1.AliasParameters) *lambda.UpdateAliasInput {
+	u := &lambda.UpdateAliasInput{
+		Name:            aws.String(name),
+		FunctionName:    p.FunctionName,
+		FunctionVersion: aws.String(p.FunctionVersion),
+		Description:     p.Description,
+		RoutingConfig:   generateRoutingConfig(p.RoutingConfig),
+	}
+	// An empty routing configuration removes the additional version.
+	if u.RoutingConfig == nil {
+		u.RoutingConfig = &lambda.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]float64{}}
+	}
+	return u
+}
+
+// LateInitializeAlias fills the empty fields in *v1alpha1.AliasParameters with
+// the values seen in lambda.GetAliasOutput.
+func LateInitializeAlias(in *v1alpha1.AliasParameters, o *lambda.GetAliasOutput) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+}
+
+// GenerateAliasObservation is used to produce v1alpha1.AliasObservation from
+// lambda.GetAliasOutput.
+func GenerateAliasObservation(o lambda.GetAliasOutput) v1alpha1.AliasObservation {
+	return v1alpha1.AliasObservation{
+		AliasARN:   aws.StringValue(o.AliasArn),
+		RevisionID: aws.StringValue(o.RevisionId),
+	}
+}
+
+// IsAliasUpToDate checks whether there is a change in any of the modifiable
+// fields.
+func IsAliasUpToDate(p v1alpha1.AliasParameters, o lambda.GetAliasOutput) bool {
+	if p.FunctionVersion != aws.StringValue(o.FunctionVersion) {
+		return false
+	}
+	if aws.StringValue(p.Description) != aws.StringValue(o.Description) {
+		return false
+	}
+	var desired, observed map[string]float64
+	if p.RoutingConfig != nil {
+		desired = p.RoutingConfig.AdditionalVersionWeights
+	}
+	if o.RoutingConfig != nil {
+		observed = o.RoutingConfig.AdditionalVersionWeights
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty())
+}
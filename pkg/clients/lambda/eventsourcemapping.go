@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// States of an event source mapping.
+const (
+	EventSourceMappingStateCreating  = "Creating"
+	EventSourceMappingStateEnabling  = "Enabling"
+	EventSourceMappingStateEnabled   = "Enabled"
+	EventSourceMappingStateDisabling = "Disabling"
+	EventSourceMappingStateDisabled  = "Disabled"
+	EventSourceMappingStateUpdating  = "Updating"
+	EventSourceMappingStateDeleting  = "Deleting"
+)
+
+// EventSourceMappingClient is the external client used for EventSourceMapping
+// Custom Resource
+type EventSourceMappingClient interface {
+	CreateEventSourceMappingRequest(*lambda.CreateEventSourceMappingInput) lambda.CreateEventSourceMappingRequest
+	GetEventSourceMappingRequest(*lambda.GetEventSourceMappingInput) lambda.GetEventSourceMappingRequest
+	UpdateEventSourceMappingRequest(*lambda.UpdateEventSourceMappingInput) lambda.UpdateEventSourceMappingRequest
+	DeleteEventSourceMappingRequest(*lambda.DeleteEventSourceMappingInput) lambda.DeleteEventSourceMappingRequest
+}
+
+// NewEventSourceMappingClient returns a new client using AWS credentials as JSON encoded data.
+func NewEventSourceMappingClient(cfg aws.Config) EventSourceMappingClient {
+	return lambda.New(cfg)
+}
+
+func generateDestinationConfig(arn *string) *lambda.DestinationConfig {
+	if arn == nil {
+		return nil
+	}
+	return &lambda.DestinationConfig{OnFailure: &lambda.OnFailure{Destination: arn}}
+}
+
+// GenerateCreateEventSourceMappingInput returns a
+// CreateEventSourceMappingInput from the given EventSourceMappingParameters.
+func GenerateCreateEventSourceMappingInput(p *v1alpha1.EventSourceMappingParameters) *lambda.CreateEventSourceMappingInput {
+	c := &lambda.CreateEventSourceMappingInput{
+		FunctionName:                   p.FunctionName,
+		EventSourceArn:                 p.EventSourceARN,
+		BatchSize:                      p.BatchSize,
+		Enabled:                        p.Enabled,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		StartingPosition:               lambda.EventSourcePosition(aws.StringValue(p.StartingPosition)),
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		DestinationConfig:              generateDestinationConfig(p.OnFailureDestinationARN),
+	}
+	if p.StartingPositionTimestamp != nil {
+		c.StartingPositionTimestamp = &p.StartingPositionTimestamp.Time
+	}
+	return c
+}
+
+// GenerateUpdateEventSourceMappingInput returns an
+// UpdateEventSourceMappingInput from the given EventSourceMappingParameters.
+func GenerateUpdateEventSourceMappingInput(uuid string, p *v1alpha1.EventSourceMappingParameters) *lambda.UpdateEventSourceMappingInput {
+	return &lambda.UpdateEventSourceMappingInput{
+		UUID:                           aws.String(uuid),
+		FunctionName:                   p.FunctionName,
+		BatchSize:                      p.BatchSize,
+		Enabled:                        p.Enabled,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		DestinationConfig:              generateDestinationConfig(p.OnFailureDestinationARN),
+	}
+}
+
+func onFailureDestination(c *lambda.DestinationConfig) *string {
+	if c == nil || c.OnFailure == nil || aws.StringValue(c.OnFailure.Destination) == "" {
+		return nil
+	}
+	return c.OnFailure.Destination
+}
+
+// isEnabled returns whether the given state is, or is about to be, enabled.
+// The second return value is false if this can not be told from the state.
+func isEnabled(state string) (bool, bool) {
+	switch state {
+	case EventSourceMappingStateCreating, EventSourceMappingStateEnabling, EventSourceMappingStateEnabled:
+		return true, true
+	case EventSourceMappingStateDisabling, EventSourceMappingStateDisabled:
+		return false, true
+	}
+	return false, false
+}
+
+// LateInitializeEventSourceMapping fills the empty fields in
+// *v1alpha1.EventSourceMappingParameters with the values seen in
+// lambda.GetEventSourceMappingOutput.
+func LateInitializeEventSourceMapping(in *v1alpha1.EventSourceMappingParameters, o *lambda.GetEventSourceMappingOutput) {
+	if o == nil {
+		return
+	}
+	in.EventSourceARN = awsclients.LateInitializeStringPtr(in.EventSourceARN, o.EventSourceArn)
+	in.BatchSize = awsclients.LateInitializeInt64Ptr(in.BatchSize, o.BatchSize)
+	in.MaximumBatchingWindowInSeconds = awsclients.LateInitializeInt64Ptr(in.MaximumBatchingWindowInSeconds, o.MaximumBatchingWindowInSeconds)
+	in.BisectBatchOnFunctionError = awsclients.LateInitializeBoolPtr(in.BisectBatchOnFunctionError, o.BisectBatchOnFunctionError)
+	in.MaximumRecordAgeInSeconds = awsclients.LateInitializeInt64Ptr(in.MaximumRecordAgeInSeconds, o.MaximumRecordAgeInSeconds)
+	in.MaximumRetryAttempts = awsclients.LateInitializeInt64Ptr(in.MaximumRetryAttempts, o.MaximumRetryAttempts)
+	in.ParallelizationFactor = awsclients.LateInitializeInt64Ptr(in.ParallelizationFactor, o.ParallelizationFactor)
+	in.OnFailureDestinationARN = awsclients.LateInitializeStringPtr(in.OnFailureDestinationARN, onFailureDestination(o.DestinationConfig))
+	if enabled, ok := isEnabled(aws.StringValue(o.State)); ok {
+		in.Enabled = awsclients.LateInitializeBoolPtr(in.Enabled, aws.Bool(enabled))
+	}
+}
+
+// GenerateEventSourceMappingObservation is used to produce
+// v1alpha1.EventSourceMappingObservation from
+// lambda.GetEventSourceMappingOutput.
+func GenerateEventSourceMappingObservation(o lambda.GetEventSourceMappingOutput) v1alpha1.EventSourceMappingObservation {
+	obs := v1alpha1.EventSourceMappingObservation{
+		FunctionARN:           aws.StringValue(o.FunctionArn),
+		LastProcessingResult:  aws.StringValue(o.LastProcessingResult),
+		State:                 aws.StringValue(o.State),
+		StateTransitionReason: aws.StringValue(o.StateTransitionReason),
+	}
+	if o.LastModified != nil {
+		obs.LastModified = &metav1.Time{Time: *o.LastModified}
+	}
+	return obs
+}
+
+// isFunction returns whether the given function name, partial ARN or ARN,
+// optionally qualified by a version or alias, refers to the function with the
+// given ARN.
+func isFunction(name, arn string) bool {
+	if name == arn {
+		return true
+	}
+	// Names and partial ARNs, e.g. 123456789012:function:my-function, are
+	// suffixes of the full ARN.
+	if !strings.Contains(name, ":function:") {
+		name = "function:" + name
+	}
+	return strings.HasSuffix(arn, ":"+name)
+}
+
+// IsEventSourceMappingUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsEventSourceMappingUpToDate(p v1alpha1.EventSourceMappingParameters, o lambda.GetEventSourceMappingOutput) bool { // nolint:gocyclo
+	if p.Enabled != nil {
+		if enabled, ok := isEnabled(aws.StringValue(o.State)); ok && enabled != aws.BoolValue(p.Enabled) {
+			return false
+		}
+	}
+	return isFunction(aws.StringValue(p.FunctionName), aws.StringValue(o.FunctionArn)) &&
+		aws.Int64Value(p.BatchSize) == aws.Int64Value(o.BatchSize) &&
+		aws.Int64Value(p.MaximumBatchingWindowInSeconds) == aws.Int64Value(o.MaximumBatchingWindowInSeconds) &&
+		aws.BoolValue(p.BisectBatchOnFunctionError) == aws.BoolValue(o.BisectBatchOnFunctionError) &&
+		aws.Int64Value(p.MaximumRecordAgeInSeconds) == aws.Int64Value(o.MaximumRecordAgeInSeconds) &&
+		aws.Int64Value(p.MaximumRetryAttempts) == aws.Int64Value(o.MaximumRetryAttempts) &&
+		aws.Int64Value(p.ParallelizationFactor) == aws.Int64Value(o.ParallelizationFactor) &&
+		aws.StringValue(p.OnFailureDestinationARN) == aws.StringValue(onFailureDestination(o.DestinationConfig))
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+var functionARN = "arn:aws:lambda:us-east-1:123456789012:function:fn"
+
+func TestIsEventSourceMappingUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.EventSourceMappingParameters
+		o lambda.GetEventSourceMappingOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFieldsByName": {
+			args: args{
+				p: v1alpha1.EventSourceMappingParameters{
+					FunctionName: aws.String("fn"),
+					BatchSize:    aws.Int64(10),
+					Enabled:      aws.Bool(true),
+				},
+				o: lambda.GetEventSourceMappingOutput{
+					FunctionArn: aws.String(functionARN),
+					BatchSize:   aws.Int64(10),
+					State:       aws.String(EventSourceMappingStateEnabled),
+				},
+			},
+			want: true,
+		},
+		"SameFieldsByARN": {
+			args: args{
+				p: v1alpha1.EventSourceMappingParameters{
+					FunctionName: aws.String(functionARN),
+					BatchSize:    aws.Int64(10),
+				},
+				o: lambda.GetEventSourceMappingOutput{
+					FunctionArn: aws.String(functionARN),
+					BatchSize:   aws.Int64(10),
+				},
+			},
+			want: true,
+		},
+		"DifferentFunction": {
+			args: args{
+				p: v1alpha1.EventSourceMappingParameters{
+					FunctionName: aws.String("other-fn"),
+				},
+				o: lambda.GetEventSourceMappingOutput{
+					FunctionArn: aws.String(functionARN),
+				},
+			},
+			want: false,
+		},
+		"Disabled": {
+			args: args{
+				p: v1alpha1.EventSourceMappingParameters{
+					FunctionName: aws.String("fn"),
+					Enabled:      aws.Bool(false),
+				},
+				o: lambda.GetEventSourceMappingOutput{
+					FunctionArn: aws.String(functionARN),
+					State:       aws.String(EventSourceMappingStateEnabled),
+				},
+			},
+			want: false,
+		},
+		"DifferentDestination": {
+			args: args{
+				p: v1alpha1.EventSourceMappingParameters{
+					FunctionName:            aws.String("fn"),
+					OnFailureDestinationARN: aws.String("arn:aws:sqs:us-east-1:123456789012:dlq"),
+				},
+				o: lambda.GetEventSourceMappingOutput{
+					FunctionArn: aws.String(functionARN),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEventSourceMappingUpToDate(tc.args.p, tc.args.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EventSourceMappingClient = (*MockEventSourceMappingClient)(nil)
+
+// MockEventSourceMappingClient is a type that implements all the methods for EventSourceMappingClient interface
+type MockEventSourceMappingClient struct {
+	MockCreate func(*lambda.CreateEventSourceMappingInput) lambda.CreateEventSourceMappingRequest
+	MockGet    func(*lambda.GetEventSourceMappingInput) lambda.GetEventSourceMappingRequest
+	MockUpdate func(*lambda.UpdateEventSourceMappingInput) lambda.UpdateEventSourceMappingRequest
+	MockDelete func(*lambda.DeleteEventSourceMappingInput) lambda.DeleteEventSourceMappingRequest
+}
+
+// CreateEventSourceMappingRequest mocks CreateEventSourceMappingRequest method
+func (m *MockEventSourceMappingClient) CreateEventSourceMappingRequest(input *lambda.CreateEventSourceMappingInput) lambda.CreateEventSourceMappingRequest {
+	return m.MockCreate(input)
+}
+
+// GetEventSourceMappingRequest mocks GetEventSourceMappingRequest method
+func (m *MockEventSourceMappingClient) GetEventSourceMappingRequest(input *lambda.GetEventSourceMappingInput) lambda.GetEventSourceMappingRequest {
+	return m.MockGet(input)
+}
+
+// UpdateEventSourceMappingRequest mocks UpdateEventSourceMappingRequest method
+func (m *MockEventSourceMappingClient) UpdateEventSourceMappingRequest(input *lambda.UpdateEventSourceMappingInput) lambda.UpdateEventSourceMappingRequest {
+	return m.MockUpdate(input)
+}
+
+// DeleteEventSourceMappingRequest mocks DeleteEventSourceMappingRequest method
+func (m *MockEventSourceMappingClient) DeleteEventSourceMappingRequest(input *lambda.DeleteEventSourceMappingInput) lambda.DeleteEventSourceMappingRequest {
+	return m.MockDelete(input)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.FunctionClient = (*MockFunctionClient)(nil)
+
+// MockFunctionClient is a type that implements all the methods for FunctionClient interface
+type MockFunctionClient struct {
+	MockCreate              func(*lambda.CreateFunctionInput) lambda.CreateFunctionRequest
+	MockGet                 func(*lambda.GetFunctionInput) lambda.GetFunctionRequest
+	MockUpdateCode          func(*lambda.UpdateFunctionCodeInput) lambda.UpdateFunctionCodeRequest
+	MockUpdateConfiguration func(*lambda.UpdateFunctionConfigurationInput) lambda.UpdateFunctionConfigurationRequest
+	MockDelete              func(*lambda.DeleteFunctionInput) lambda.DeleteFunctionRequest
+	MockTag                 func(*lambda.TagResourceInput) lambda.TagResourceRequest
+	MockUntag               func(*lambda.UntagResourceInput) lambda.UntagResourceRequest
+}
+
+// CreateFunctionRequest mocks CreateFunctionRequest method
+func (m *MockFunctionClient) CreateFunctionRequest(input *lambda.CreateFunctionInput) lambda.CreateFunctionRequest {
+	return m.MockCreate(input)
+}
+
+// GetFunctionRequest mocks GetFunctionRequest method
+func (m *MockFunctionClient) GetFunctionRequest(input *lambda.GetFunctionInput) lambda.GetFunctionRequest {
+	return m.MockGet(input)
+}
+
+// UpdateFunctionCodeRequest mocks UpdateFunctionCodeRequest method
+func (m *MockFunctionClient) UpdateFunctionCodeRequest(input *lambda.UpdateFunctionCodeInput) lambda.UpdateFunctionCodeRequest {
+	return m.MockUpdateCode(input)
+}
+
+// UpdateFunctionConfigurationRequest mocks UpdateFunctionConfigurationRequest method
+func (m *MockFunctionClient) UpdateFunctionConfigurationRequest(input *lambda.UpdateFunctionConfigurationInput) lambda.UpdateFunctionConfigurationRequest {
+	return m.MockUpdateConfiguration(input)
+}
+
+// DeleteFunctionRequest mocks DeleteFunctionRequest method
+func (m *MockFunctionClient) DeleteFunctionRequest(input *lambda.DeleteFunctionInput) lambda.DeleteFunctionRequest {
+	return m.MockDelete(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockFunctionClient) TagResourceRequest(input *lambda.TagResourceInput) lambda.TagResourceRequest {
+	return m.MockTag(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockFunctionClient) UntagResourceRequest(input *lambda.UntagResourceInput) lambda.UntagResourceRequest {
+	return m.MockUntag(input)
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.PermissionClient = (*MockPermissionClient)(nil)
+
+// MockPermissionClient is a type that implements all the methods for PermissionClient interface
+type MockPermissionClient struct {
+	MockAdd       func(*lambda.AddPermissionInput) lambda.AddPermissionRequest
+	MockGetPolicy func(*lambda.GetPolicyInput) lambda.GetPolicyRequest
+	MockRemove    func(*lambda.RemovePermissionInput) lambda.RemovePermissionRequest
+}
+
+// AddPermissionRequest mocks AddPermissionRequest method
+func (m *MockPermissionClient) AddPermissionRequest(input *lambda.AddPermissionInput) lambda.AddPermissionRequest {
+	return m.MockAdd(input)
+}
+
+// GetPolicyRequest mocks GetPolicyRequest method
+func (m *MockPermissionClient) GetPolicyRequest(input *lambda.GetPolicyInput) lambda.GetPolicyRequest {
+	return m.MockGetPolicy(input)
+}
+
+// RemovePermissionRequest mocks RemovePermissionRequest method
+func (m *MockPermissionClient) RemovePermissionRequest(input *lambda.RemovePermissionInput) lambda.RemovePermissionRequest {
+	return m.MockRemove(input)
+}
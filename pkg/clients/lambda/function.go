@@ -0,0 +1,307 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ResourceNotFound is the code that is returned by Lambda when the given
+	// resource does not exist.
+	ResourceNotFound = "ResourceNotFoundException"
+
+	imageTagLatest = "latest"
+	digestPrefix   = "sha256:"
+)
+
+// FunctionClient is the external client used for Function Custom Resource
+type FunctionClient interface {
+	CreateFunctionRequest(*lambda.CreateFunctionInput) lambda.CreateFunctionRequest
+	GetFunctionRequest(*lambda.GetFunctionInput) lambda.GetFunctionRequest
+	UpdateFunctionCodeRequest(*lambda.UpdateFunctionCodeInput) lambda.UpdateFunctionCodeRequest
+	UpdateFunctionConfigurationRequest(*lambda.UpdateFunctionConfigurationInput) lambda.UpdateFunctionConfigurationRequest
+	DeleteFunctionRequest(*lambda.DeleteFunctionInput) lambda.DeleteFunctionRequest
+	TagResourceRequest(*lambda.TagResourceInput) lambda.TagResourceRequest
+	UntagResourceRequest(*lambda.UntagResourceInput) lambda.UntagResourceRequest
+}
+
+// NewFunctionClient returns a new client using AWS credentials as JSON encoded data.
+func NewFunctionClient(cfg aws.Config) FunctionClient {
+	return lambda.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == ResourceNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateImageURI returns the URI of the container image the given code
+// refers to, or an empty string if it refers to a deployment package.
+func GenerateImageURI(c v1alpha1.FunctionCode) string {
+	repo := aws.StringValue(c.ImageRepositoryURI)
+	if repo == "" {
+		return ""
+	}
+	tag := aws.StringValue(c.ImageTag)
+	switch {
+	case tag == "":
+		return repo + ":" + imageTagLatest
+	case strings.HasPrefix(tag, digestPrefix):
+		return repo + "@" + tag
+	default:
+		return repo + ":" + tag
+	}
+}
+
+// GenerateCreateFunctionInput returns a CreateFunctionInput from the given
+// FunctionParameters. If the function is deployed from a container image the
+// request must be customized using WithImageURI.
+func GenerateCreateFunctionInput(name string, p *v1alpha1.FunctionParameters) *lambda.CreateFunctionInput {
+	c := &lambda.CreateFunctionInput{
+		FunctionName: aws.String(name),
+		Code: &lambda.FunctionCode{
+			S3Bucket:        p.Code.S3Bucket,
+			S3Key:           p.Code.S3Key,
+			S3ObjectVersion: p.Code.S3ObjectVersion,
+		},
+		Description:      p.Description,
+		Handler:          p.Handler,
+		KMSKeyArn:        p.KMSKeyARN,
+		Layers:           p.Layers,
+		MemorySize:       p.MemorySize,
+		Publish:          p.Publish,
+		Role:             p.Role,
+		Runtime:          lambda.Runtime(aws.StringValue(p.Runtime)),
+		Tags:             p.Tags,
+		Timeout:          p.Timeout,
+		DeadLetterConfig: generateDeadLetterConfig(p.DeadLetterConfig),
+		TracingConfig:    generateTracingConfig(p.TracingConfig),
+		VpcConfig:        generateVPCConfig(p.VPCConfig),
+	}
+	if p.Environment != nil {
+		c.Environment = &lambda.Environment{Variables: p.Environment}
+	}
+	return c
+}
+
+// GenerateUpdateFunctionConfigurationInput returns an
+// UpdateFunctionConfigurationInput from the given FunctionParameters.
+func GenerateUpdateFunctionConfigurationInput(name string, p *v1alpha1.FunctionParameters) *lambda.UpdateFunctionConfigurationInput {
+	u := &lambda.UpdateFunctionConfigurationInput{
+		FunctionName:     aws.String(name),
+		Description:      p.Description,
+		Handler:          p.Handler,
+		KMSKeyArn:        p.KMSKeyARN,
+		Layers:           p.Layers,
+		MemorySize:       p.MemorySize,
+		Role:             p.Role,
+		Runtime:          lambda.Runtime(aws.StringValue(p.Runtime)),
+		Timeout:          p.Timeout,
+		DeadLetterConfig: generateDeadLetterConfig(p.DeadLetterConfig),
+		TracingConfig:    generateTracingConfig(p.TracingConfig),
+		VpcConfig:        generateVPCConfig(p.VPCConfig),
+	}
+	if p.Environment != nil {
+		u.Environment = &lambda.Environment{Variables: p.Environment}
+	}
+	return u
+}
+
+// GenerateUpdateFunctionCodeInput returns an UpdateFunctionCodeInput from the
+// given FunctionParameters. If the function is deployed from a container
+// image the request must be customized using WithImageURI.
+func GenerateUpdateFunctionCodeInput(name string, p *v1alpha1.FunctionParameters) *lambda.UpdateFunctionCodeInput {
+	return &lambda.UpdateFunctionCodeInput{
+		FunctionName:    aws.String(name),
+		Publish:         p.Publish,
+		S3Bucket:        p.Code.S3Bucket,
+		S3Key:           p.Code.S3Key,
+		S3ObjectVersion: p.Code.S3ObjectVersion,
+	}
+}
+
+func generateDeadLetterConfig(c *v1alpha1.DeadLetterConfig) *lambda.DeadLetterConfig {
+	if c == nil {
+		return nil
+	}
+	return &lambda.DeadLetterConfig{TargetArn: aws.String(c.TargetARN)}
+}
+
+func generateTracingConfig(c *v1alpha1.TracingConfig) *lambda.TracingConfig {
+	if c == nil {
+		return nil
+	}
+	return &lambda.TracingConfig{Mode: lambda.TracingMode(c.Mode)}
+}
+
+func generateVPCConfig(c *v1alpha1.VPCConfig) *lambda.VpcConfig {
+	if c == nil {
+		return nil
+	}
+	return &lambda.VpcConfig{SubnetIds: c.SubnetIDs, SecurityGroupIds: c.SecurityGroupIDs}
+}
+
+// GenerateFunctionParameters returns the configuration of the given function
+// as FunctionParameters. The code, the tags and the fields that can not be
+// observed are left empty.
+func GenerateFunctionParameters(c lambda.FunctionConfiguration) *v1alpha1.FunctionParameters { // nolint:gocyclo
+	p := &v1alpha1.FunctionParameters{
+		Description: c.Description,
+		Handler:     c.Handler,
+		KMSKeyARN:   c.KMSKeyArn,
+		MemorySize:  c.MemorySize,
+		Role:        c.Role,
+		Timeout:     c.Timeout,
+	}
+	if c.Runtime != "" {
+		p.Runtime = aws.String(string(c.Runtime))
+	}
+	if c.Environment != nil && len(c.Environment.Variables) != 0 {
+		p.Environment = c.Environment.Variables
+	}
+	for _, l := range c.Layers {
+		p.Layers = append(p.Layers, aws.StringValue(l.Arn))
+	}
+	if c.DeadLetterConfig != nil && c.DeadLetterConfig.TargetArn != nil {
+		p.DeadLetterConfig = &v1alpha1.DeadLetterConfig{TargetARN: aws.StringValue(c.DeadLetterConfig.TargetArn)}
+	}
+	if c.TracingConfig != nil && c.TracingConfig.Mode != "" {
+		p.TracingConfig = &v1alpha1.TracingConfig{Mode: string(c.TracingConfig.Mode)}
+	}
+	if c.VpcConfig != nil && (len(c.VpcConfig.SubnetIds) != 0 || len(c.VpcConfig.SecurityGroupIds) != 0) {
+		p.VPCConfig = &v1alpha1.VPCConfig{
+			SubnetIDs:        c.VpcConfig.SubnetIds,
+			SecurityGroupIDs: c.VpcConfig.SecurityGroupIds,
+		}
+	}
+	return p
+}
+
+// LateInitializeFunction fills the empty fields in *v1alpha1.FunctionParameters
+// with the values seen in lambda.FunctionConfiguration.
+func LateInitializeFunction(in *v1alpha1.FunctionParameters, c *lambda.FunctionConfiguration) { // nolint:gocyclo
+	if c == nil {
+		return
+	}
+	o := GenerateFunctionParameters(*c)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.Handler = awsclients.LateInitializeStringPtr(in.Handler, o.Handler)
+	in.Runtime = awsclients.LateInitializeStringPtr(in.Runtime, o.Runtime)
+	in.Role = awsclients.LateInitializeStringPtr(in.Role, o.Role)
+	in.KMSKeyARN = awsclients.LateInitializeStringPtr(in.KMSKeyARN, o.KMSKeyARN)
+	in.MemorySize = awsclients.LateInitializeInt64Ptr(in.MemorySize, o.MemorySize)
+	in.Timeout = awsclients.LateInitializeInt64Ptr(in.Timeout, o.Timeout)
+	if in.Environment == nil {
+		in.Environment = o.Environment
+	}
+	if in.Layers == nil {
+		in.Layers = o.Layers
+	}
+	if in.DeadLetterConfig == nil {
+		in.DeadLetterConfig = o.DeadLetterConfig
+	}
+	if in.TracingConfig == nil {
+		in.TracingConfig = o.TracingConfig
+	}
+	if in.VPCConfig == nil {
+		in.VPCConfig = o.VPCConfig
+	}
+}
+
+// GenerateFunctionObservation is used to produce v1alpha1.FunctionObservation
+// from lambda.FunctionConfiguration.
+func GenerateFunctionObservation(c lambda.FunctionConfiguration, ic ImageCode) v1alpha1.FunctionObservation {
+	o := v1alpha1.FunctionObservation{
+		FunctionARN:      aws.StringValue(c.FunctionArn),
+		CodeSHA256:       aws.StringValue(c.CodeSha256),
+		CodeSize:         aws.Int64Value(c.CodeSize),
+		PackageType:      ic.PackageType,
+		ImageURI:         ic.ImageURI,
+		ResolvedImageURI: ic.ResolvedImageURI,
+		LastModified:     aws.StringValue(c.LastModified),
+		LastUpdateStatus: string(c.LastUpdateStatus),
+		State:            string(c.State),
+		StateReason:      aws.StringValue(c.StateReason),
+		Version:          aws.StringValue(c.Version),
+		RevisionID:       aws.StringValue(c.RevisionId),
+	}
+	if o.PackageType == "" {
+		o.PackageType = v1alpha1.PackageTypeZip
+	}
+	return o
+}
+
+// IsFunctionConfigurationUpToDate checks whether there is a change in any of
+// the modifiable configuration fields of the function.
+func IsFunctionConfigurationUpToDate(p v1alpha1.FunctionParameters, c lambda.FunctionConfiguration) bool {
+	observed := GenerateFunctionParameters(c)
+	desired := p.DeepCopy()
+	for _, vpc := range []*v1alpha1.VPCConfig{observed.VPCConfig, desired.VPCConfig} {
+		if vpc == nil {
+			continue
+		}
+		sort.Strings(vpc.SubnetIDs)
+		sort.Strings(vpc.SecurityGroupIDs)
+	}
+	return cmp.Equal(observed, desired, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.FunctionParameters{}, "Region", "Code", "RoleRef", "RoleSelector", "Publish", "Tags"),
+		cmpopts.IgnoreFields(v1alpha1.VPCConfig{}, "SubnetIDRefs", "SubnetIDSelector", "SecurityGroupIDRefs", "SecurityGroupIDSelector"))
+}
+
+// GenerateFunctionCodeLocation returns the S3 location of the deployment
+// package of the given code, or nil if the code is a container image.
+func GenerateFunctionCodeLocation(c v1alpha1.FunctionCode) *v1alpha1.FunctionCodeLocation {
+	if c.S3Bucket == nil {
+		return nil
+	}
+	return &v1alpha1.FunctionCodeLocation{
+		S3Bucket:        aws.StringValue(c.S3Bucket),
+		S3Key:           aws.StringValue(c.S3Key),
+		S3ObjectVersion: aws.StringValue(c.S3ObjectVersion),
+	}
+}
+
+// IsFunctionCodeUpToDate checks whether the deployed code of the function is
+// the desired one. Since the source of a deployed package can not be observed
+// it is compared with the last applied one, and the code is compared by its
+// SHA-256 hash if one is given.
+func IsFunctionCodeUpToDate(p v1alpha1.FunctionParameters, c lambda.FunctionConfiguration, ic ImageCode, applied *v1alpha1.FunctionCodeLocation) bool {
+	if uri := GenerateImageURI(p.Code); uri != "" {
+		if uri != ic.ImageURI {
+			return false
+		}
+	} else if !cmp.Equal(GenerateFunctionCodeLocation(p.Code), applied) {
+		return false
+	}
+	return p.Code.SHA256 == nil || aws.StringValue(p.Code.SHA256) == aws.StringValue(c.CodeSha256)
+}
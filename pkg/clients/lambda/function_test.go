@@ -0,0 +1,248 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+var (
+	repoURI    = "123456789012.dkr.ecr.us-east-1.amazonaws.com/repo"
+	codeSHA256 = "c29tZSBoYXNo"
+	roleARN    = "arn:aws:iam::123456789012:role/lambda"
+	subnetA    = "subnet-a"
+	subnetB    = "subnet-b"
+)
+
+func TestGenerateImageURI(t *testing.T) {
+	cases := map[string]struct {
+		code v1alpha1.FunctionCode
+		want string
+	}{
+		"S3": {
+			code: v1alpha1.FunctionCode{S3Bucket: aws.String("bucket"), S3Key: aws.String("key")},
+			want: "",
+		},
+		"DefaultTag": {
+			code: v1alpha1.FunctionCode{ImageRepositoryURI: aws.String(repoURI)},
+			want: repoURI + ":latest",
+		},
+		"Tag": {
+			code: v1alpha1.FunctionCode{ImageRepositoryURI: aws.String(repoURI), ImageTag: aws.String("v1")},
+			want: repoURI + ":v1",
+		},
+		"Digest": {
+			code: v1alpha1.FunctionCode{ImageRepositoryURI: aws.String(repoURI), ImageTag: aws.String("sha256:abc")},
+			want: repoURI + "@sha256:abc",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateImageURI(tc.code)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFunctionCodeUpToDate(t *testing.T) {
+	type args struct {
+		p       v1alpha1.FunctionParameters
+		c       lambda.FunctionConfiguration
+		ic      ImageCode
+		applied *v1alpha1.FunctionCodeLocation
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoHash": {
+			args: args{
+				p:       v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{S3Bucket: aws.String("bucket"), S3Key: aws.String("key")}},
+				c:       lambda.FunctionConfiguration{CodeSha256: aws.String(codeSHA256)},
+				applied: &v1alpha1.FunctionCodeLocation{S3Bucket: "bucket", S3Key: "key"},
+			},
+			want: true,
+		},
+		"NoHashDifferentObjectVersion": {
+			args: args{
+				p:       v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{S3Bucket: aws.String("bucket"), S3Key: aws.String("key"), S3ObjectVersion: aws.String("2")}},
+				c:       lambda.FunctionConfiguration{CodeSha256: aws.String(codeSHA256)},
+				applied: &v1alpha1.FunctionCodeLocation{S3Bucket: "bucket", S3Key: "key", S3ObjectVersion: "1"},
+			},
+			want: false,
+		},
+		"NoHashNeverApplied": {
+			args: args{
+				p: v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{S3Bucket: aws.String("bucket"), S3Key: aws.String("key")}},
+				c: lambda.FunctionConfiguration{CodeSha256: aws.String(codeSHA256)},
+			},
+			want: false,
+		},
+		"SameHash": {
+			args: args{
+				p: v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{SHA256: aws.String(codeSHA256)}},
+				c: lambda.FunctionConfiguration{CodeSha256: aws.String(codeSHA256)},
+			},
+			want: true,
+		},
+		"DifferentHash": {
+			args: args{
+				p: v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{SHA256: aws.String("new")}},
+				c: lambda.FunctionConfiguration{CodeSha256: aws.String(codeSHA256)},
+			},
+			want: false,
+		},
+		"SameImage": {
+			args: args{
+				p:  v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{ImageRepositoryURI: aws.String(repoURI), ImageTag: aws.String("v1")}},
+				ic: ImageCode{PackageType: v1alpha1.PackageTypeImage, ImageURI: repoURI + ":v1"},
+			},
+			want: true,
+		},
+		"DifferentImage": {
+			args: args{
+				p:  v1alpha1.FunctionParameters{Code: v1alpha1.FunctionCode{ImageRepositoryURI: aws.String(repoURI), ImageTag: aws.String("v2")}},
+				ic: ImageCode{PackageType: v1alpha1.PackageTypeImage, ImageURI: repoURI + ":v1"},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsFunctionCodeUpToDate(tc.args.p, tc.args.c, tc.args.ic, tc.args.applied)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFunctionConfigurationUpToDate(t *testing.T) {
+	type args struct {
+		p v1alpha1.FunctionParameters
+		c lambda.FunctionConfiguration
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				p: v1alpha1.FunctionParameters{
+					Region:     "us-east-1",
+					Code:       v1alpha1.FunctionCode{S3Bucket: aws.String("bucket")},
+					Handler:    aws.String("main.handler"),
+					Runtime:    aws.String("python3.8"),
+					Role:       aws.String(roleARN),
+					MemorySize: aws.Int64(128),
+					VPCConfig:  &v1alpha1.VPCConfig{SubnetIDs: []string{subnetB, subnetA}},
+					Tags:       map[string]string{"k": "v"},
+				},
+				c: lambda.FunctionConfiguration{
+					Handler:     aws.String("main.handler"),
+					Runtime:     lambda.RuntimePython38,
+					Role:        aws.String(roleARN),
+					MemorySize:  aws.Int64(128),
+					VpcConfig:   &lambda.VpcConfigResponse{SubnetIds: []string{subnetA, subnetB}},
+					Environment: &lambda.EnvironmentResponse{},
+				},
+			},
+			want: true,
+		},
+		"DifferentFields": {
+			args: args{
+				p: v1alpha1.FunctionParameters{
+					Handler:     aws.String("main.handler"),
+					Runtime:     aws.String("python3.8"),
+					Environment: map[string]string{"k": "v"},
+				},
+				c: lambda.FunctionConfiguration{
+					Handler: aws.String("main.handler"),
+					Runtime: lambda.RuntimePython38,
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsFunctionConfigurationUpToDate(tc.args.p, tc.args.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeFunction(t *testing.T) {
+	type args struct {
+		p *v1alpha1.FunctionParameters
+		c *lambda.FunctionConfiguration
+	}
+
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.FunctionParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				p: &v1alpha1.FunctionParameters{Handler: aws.String("main.handler"), Timeout: aws.Int64(10)},
+				c: &lambda.FunctionConfiguration{Handler: aws.String("other.handler"), Timeout: aws.Int64(3)},
+			},
+			want: &v1alpha1.FunctionParameters{Handler: aws.String("main.handler"), Timeout: aws.Int64(10)},
+		},
+		"PartialFilled": {
+			args: args{
+				p: &v1alpha1.FunctionParameters{Handler: aws.String("main.handler")},
+				c: &lambda.FunctionConfiguration{
+					Handler:       aws.String("main.handler"),
+					Timeout:       aws.Int64(3),
+					MemorySize:    aws.Int64(128),
+					TracingConfig: &lambda.TracingConfigResponse{Mode: lambda.TracingModePassThrough},
+				},
+			},
+			want: &v1alpha1.FunctionParameters{
+				Handler:       aws.String("main.handler"),
+				Timeout:       aws.Int64(3),
+				MemorySize:    aws.Int64(128),
+				TracingConfig: &v1alpha1.TracingConfig{Mode: "PassThrough"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeFunction(tc.args.p, tc.args.c)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+)
+
+// NOTE: The vendored aws-sdk-go-v2 predates container image support
+// in Lambda, so it knows neither the PackageType of a function nor the
+// ImageUri of its code. The functions below add these fields to the request
+// and read them from the response bodies until the SDK is bumped.
+
+// ImageCode is the container image of a function as reported by GetFunction.
+type ImageCode struct {
+	PackageType      string
+	ImageURI         string
+	ResolvedImageURI string
+}
+
+// WithImageURI makes the given CreateFunction or UpdateFunctionCode request
+// deploy the container image with the given URI instead of a deployment
+// package.
+func WithImageURI(r *aws.Request, uri string) {
+	// CreateFunction requires a handler and a runtime for Zip packages only,
+	// which the vendored parameter validation does not know about.
+	r.Handlers.Validate.RemoveByName(defaults.ValidateParametersHandler.Name)
+	r.Handlers.Build.PushBack(func(r *aws.Request) {
+		if r.Body == nil {
+			return
+		}
+		if _, err := r.Body.Seek(0, 0); err != nil {
+			r.Error = err
+			return
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		body := map[string]interface{}{}
+		if len(b) != 0 {
+			if err := json.Unmarshal(b, &body); err != nil {
+				r.Error = err
+				return
+			}
+		}
+		switch r.Params.(type) {
+		case *lambda.CreateFunctionInput:
+			body["PackageType"] = "Image"
+			body["Code"] = map[string]interface{}{"ImageUri": uri}
+		case *lambda.UpdateFunctionCodeInput:
+			body["ImageUri"] = uri
+		}
+		if b, err = json.Marshal(body); err != nil {
+			r.Error = err
+			return
+		}
+		r.SetBufferBody(b)
+	})
+}
+
+// ImageCodeFrom returns an ImageCode that is filled from the response of the
+// given GetFunction request once it is sent.
+func ImageCodeFrom(r *aws.Request) *ImageCode {
+	ic := &ImageCode{}
+	r.Handlers.Unmarshal.PushFront(func(r *aws.Request) {
+		if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+			return
+		}
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+		body := struct {
+			Code struct {
+				ImageURI         string `json:"ImageUri"`
+				ResolvedImageURI string `json:"ResolvedImageUri"`
+			}
+			Configuration struct {
+				PackageType string
+			}
+		}{}
+		// A malformed body is reported by the SDK unmarshaller.
+		if err := json.Unmarshal(b, &body); err != nil {
+			return
+		}
+		ic.PackageType = body.Configuration.PackageType
+		ic.ImageURI = body.Code.ImageURI
+		ic.ResolvedImageURI = body.Code.ResolvedImageURI
+	})
+	return ic
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+)
+
+func testClient() *lambda.Client {
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.Credentials = aws.AnonymousCredentials
+	cfg.EndpointResolver = aws.ResolveWithEndpointURL("https://lambda.example.com")
+	return lambda.New(cfg)
+}
+
+func requestBody(t *testing.T, r *aws.Request) map[string]interface{} {
+	t.Helper()
+	if err := r.Build(); err != nil {
+		t.Fatalf("r.Build(): %s", err)
+	}
+	b, err := ioutil.ReadAll(r.GetBody())
+	if err != nil {
+		t.Fatalf("ioutil.ReadAll(): %s", err)
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatalf("json.Unmarshal(): %s", err)
+	}
+	return body
+}
+
+func TestWithImageURI(t *testing.T) {
+	uri := repoURI + ":v1"
+	c := testClient()
+
+	cases := map[string]struct {
+		req  *aws.Request
+		want map[string]interface{}
+	}{
+		"CreateFunction": {
+			req: c.CreateFunctionRequest(&lambda.CreateFunctionInput{
+				FunctionName: aws.String("fn"),
+				Code:         &lambda.FunctionCode{},
+				Role:         aws.String(roleARN),
+			}).Request,
+			want: map[string]interface{}{
+				"Code":         map[string]interface{}{"ImageUri": uri},
+				"FunctionName": "fn",
+				"PackageType":  "Image",
+				"Role":         roleARN,
+			},
+		},
+		"UpdateFunctionCode": {
+			req: c.UpdateFunctionCodeRequest(&lambda.UpdateFunctionCodeInput{
+				FunctionName: aws.String("fn"),
+				Publish:      aws.Bool(true),
+			}).Request,
+			want: map[string]interface{}{
+				"ImageUri": uri,
+				"Publish":  true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			WithImageURI(tc.req, uri)
+			if diff := cmp.Diff(tc.want, requestBody(t, tc.req)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestImageCodeFrom(t *testing.T) {
+	body := `{"Code":{"ImageUri":"repo:v1","ResolvedImageUri":"repo@sha256:abc","RepositoryType":"ECR"},` +
+		`"Configuration":{"FunctionName":"fn","PackageType":"Image"}}`
+
+	req := testClient().GetFunctionRequest(&lambda.GetFunctionInput{FunctionName: aws.String("fn")})
+	ic := ImageCodeFrom(req.Request)
+	req.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(body))}
+	req.Handlers.Unmarshal.Run(req.Request)
+	if req.Error != nil {
+		t.Fatalf("req.Handlers.Unmarshal.Run(): %s", req.Error)
+	}
+
+	want := &ImageCode{PackageType: "Image", ImageURI: "repo:v1", ResolvedImageURI: "repo@sha256:abc"}
+	if diff := cmp.Diff(want, ic); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	out := req.Data.(*lambda.GetFunctionOutput)
+	if diff := cmp.Diff("fn", aws.StringValue(out.Configuration.FunctionName)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
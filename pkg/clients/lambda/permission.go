@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+const (
+	errParsePolicy = "cannot parse the resource-based policy of the function"
+
+	conditionSourceARN        = "AWS:SourceArn"
+	conditionSourceAccount    = "AWS:SourceAccount"
+	conditionEventSourceToken = "lambda:EventSourceToken"
+)
+
+// PermissionClient is the external client used for Permission Custom Resource
+type PermissionClient interface {
+	AddPermissionRequest(*lambda.AddPermissionInput) lambda.AddPermissionRequest
+	GetPolicyRequest(*lambda.GetPolicyInput) lambda.GetPolicyRequest
+	RemovePermissionRequest(*lambda.RemovePermissionInput) lambda.RemovePermissionRequest
+}
+
+// NewPermissionClient returns a new client using AWS credentials as JSON encoded data.
+func NewPermissionClient(cfg aws.Config) PermissionClient {
+	return lambda.New(cfg)
+}
+
+// GenerateAddPermissionInput returns an AddPermissionInput from the given
+// PermissionParameters.
+func GenerateAddPermissionInput(statementID string, p *v1alpha1.PermissionParameters) *lambda.AddPermissionInput {
+	return &lambda.AddPermissionInput{
+		StatementId:      aws.String(statementID),
+		FunctionName:     p.FunctionName,
+		Qualifier:        p.Qualifier,
+		Action:           aws.String(p.Action),
+		Principal:        aws.String(p.Principal),
+		SourceArn:        p.SourceARN,
+		SourceAccount:    p.SourceAccount,
+		EventSourceToken: p.EventSourceToken,
+	}
+}
+
+// A PolicyStatement is a statement of the resource-based policy of a
+// function as returned by GetPolicy. The fields that may hold either a single
+// value or a list of values are kept raw.
+type PolicyStatement struct {
+	Sid       string                                `json:"Sid"`
+	Effect    string                                `json:"Effect"`
+	Principal json.RawMessage                       `json:"Principal"`
+	Action    json.RawMessage                       `json:"Action"`
+	Resource  json.RawMessage                       `json:"Resource"`
+	Condition map[string]map[string]json.RawMessage `json:"Condition,omitempty"`
+}
+
+// FindPolicyStatement returns the statement with the given ID from the given
+// resource-based policy document, or nil if there is no such statement.
+func FindPolicyStatement(policy, sid string) (*PolicyStatement, error) {
+	doc := struct {
+		Statement []PolicyStatement `json:"Statement"`
+	}{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, errors.Wrap(err, errParsePolicy)
+	}
+	for i := range doc.Statement {
+		if doc.Statement[i].Sid == sid {
+			return &doc.Statement[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// singleValue returns the value of a policy field that holds either a string
+// or a list with one string. It returns an empty string otherwise.
+func singleValue(raw json.RawMessage) string {
+	var v string
+	if err := json.Unmarshal(raw, &v); err == nil {
+		return v
+	}
+	var l []string
+	if err := json.Unmarshal(raw, &l); err != nil || len(l) != 1 {
+		return ""
+	}
+	return l[0]
+}
+
+// principal returns the service, account or wildcard of the statement
+// principal.
+func (s PolicyStatement) principal() string {
+	if p := singleValue(s.Principal); p != "" {
+		return p
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(s.Principal, &m); err != nil {
+		return ""
+	}
+	if svc, ok := m["Service"]; ok {
+		return singleValue(svc)
+	}
+	// Account principals are returned as the ARN of the account root user.
+	p := singleValue(m["AWS"])
+	if arn := strings.Split(p, ":"); len(arn) == 6 && arn[5] == "root" {
+		return arn[4]
+	}
+	return p
+}
+
+func (s PolicyStatement) condition(key string) string {
+	for _, c := range s.Condition {
+		if v, ok := c[key]; ok {
+			return singleValue(v)
+		}
+	}
+	return ""
+}
+
+// IsPermissionUpToDate checks whether the given statement grants the desired
+// permission.
+func IsPermissionUpToDate(p v1alpha1.PermissionParameters, s PolicyStatement) bool {
+	return p.Action == singleValue(s.Action) &&
+		p.Principal == s.principal() &&
+		aws.StringValue(p.SourceARN) == s.condition(conditionSourceARN) &&
+		aws.StringValue(p.SourceAccount) == s.condition(conditionSourceAccount) &&
+		aws.StringValue(p.EventSourceToken) == s.condition(conditionEventSourceToken)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+var policy = `{
+  "Version": "2012-10-17",
+  "Id": "default",
+  "Statement": [
+    {
+      "Sid": "s3",
+      "Effect": "Allow",
+      "Principal": {"Service": "s3.amazonaws.com"},
+      "Action": "lambda:InvokeFunction",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:fn",
+      "Condition": {
+        "StringEquals": {"AWS:SourceAccount": "123456789012"},
+        "ArnLike": {"AWS:SourceArn": "arn:aws:s3:::bucket"}
+      }
+    },
+    {
+      "Sid": "account",
+      "Effect": "Allow",
+      "Principal": {"AWS": "arn:aws:iam::210987654321:root"},
+      "Action": "lambda:GetFunction",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:fn"
+    },
+    {
+      "Sid": "lists",
+      "Effect": "Allow",
+      "Principal": {"Service": ["sns.amazonaws.com"]},
+      "Action": ["lambda:InvokeFunction"],
+      "Resource": ["arn:aws:lambda:us-east-1:123456789012:function:fn"],
+      "Condition": {
+        "ArnLike": {"AWS:SourceArn": ["arn:aws:sns:us-east-1:123456789012:topic"]}
+      }
+    }
+  ]
+}`
+
+func TestIsPermissionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		sid  string
+		p    v1alpha1.PermissionParameters
+		want bool
+	}{
+		"SameService": {
+			sid: "s3",
+			p: v1alpha1.PermissionParameters{
+				Action:        "lambda:InvokeFunction",
+				Principal:     "s3.amazonaws.com",
+				SourceARN:     aws.String("arn:aws:s3:::bucket"),
+				SourceAccount: aws.String("123456789012"),
+			},
+			want: true,
+		},
+		"DifferentSourceARN": {
+			sid: "s3",
+			p: v1alpha1.PermissionParameters{
+				Action:        "lambda:InvokeFunction",
+				Principal:     "s3.amazonaws.com",
+				SourceARN:     aws.String("arn:aws:s3:::other"),
+				SourceAccount: aws.String("123456789012"),
+			},
+			want: false,
+		},
+		"SameAccount": {
+			sid: "account",
+			p: v1alpha1.PermissionParameters{
+				Action:    "lambda:GetFunction",
+				Principal: "210987654321",
+			},
+			want: true,
+		},
+		"SameLists": {
+			sid: "lists",
+			p: v1alpha1.PermissionParameters{
+				Action:    "lambda:InvokeFunction",
+				Principal: "sns.amazonaws.com",
+				SourceARN: aws.String("arn:aws:sns:us-east-1:123456789012:topic"),
+			},
+			want: true,
+		},
+		"DifferentAction": {
+			sid: "account",
+			p: v1alpha1.PermissionParameters{
+				Action:    "lambda:InvokeFunction",
+				Principal: "210987654321",
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := FindPolicyStatement(policy, tc.sid)
+			if err != nil || s == nil {
+				t.Fatalf("FindPolicyStatement(...): %v, %v", s, err)
+			}
+			got := IsPermissionUpToDate(tc.p, *s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindPolicyStatement(t *testing.T) {
+	s, err := FindPolicyStatement(policy, "missing")
+	if diff := cmp.Diff((*PolicyStatement)(nil), s); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if err != nil {
+		t.Errorf("FindPolicyStatement(...): %s", err)
+	}
+	if _, err := FindPolicyStatement("{", "s3"); err == nil {
+		t.Errorf("FindPolicyStatement(...): expected an error for a malformed policy")
+	}
+}
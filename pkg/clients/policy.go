@@ -0,0 +1,166 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A PolicyStatement is a statement of a resource based policy, like the
+// policies of S3 buckets and SQS queues, whose references are resolved.
+type PolicyStatement struct {
+	SID          *string
+	Effect       string
+	Principal    *PolicyPrincipal
+	NotPrincipal *PolicyPrincipal
+	Action       []string
+	NotAction    []string
+	Resource     []string
+	NotResource  []string
+	Condition    map[string]PolicyCondition
+}
+
+// A PolicyPrincipal is the principal of a PolicyStatement.
+type PolicyPrincipal struct {
+	AllowAnon bool
+	// AWSPrincipals are the account IDs and ARNs of the AWS principals.
+	AWSPrincipals []*string
+	Federated     *string
+	Service       []string
+}
+
+// A PolicyCondition is a condition of a PolicyStatement. Only one of the
+// values should be set.
+type PolicyCondition struct {
+	Key          string
+	StringValue  *string
+	DateValue    *metav1.Time
+	NumericValue *int64
+	BooleanValue *bool
+}
+
+// SerializePolicy returns the given policy in the form of its JSON document.
+func SerializePolicy(version, id string, statements []PolicyStatement) (interface{}, error) {
+	m := make(map[string]interface{})
+	m["Version"] = version
+	if id != "" {
+		m["Id"] = id
+	}
+	slc := make([]interface{}, len(statements))
+	for i, s := range statements {
+		msg, err := SerializePolicyStatement(s)
+		if err != nil {
+			return nil, err
+		}
+		slc[i] = msg
+	}
+	m["Statement"] = slc
+	return m, nil
+}
+
+// SerializePolicyStatement returns the given statement in the form of its
+// JSON document.
+func SerializePolicyStatement(s PolicyStatement) (interface{}, error) {
+	m := make(map[string]interface{})
+	if s.Principal != nil {
+		m["Principal"] = SerializePolicyPrincipal(s.Principal)
+	}
+	if s.NotPrincipal != nil {
+		m["NotPrincipal"] = SerializePolicyPrincipal(s.NotPrincipal)
+	}
+	if len(s.Action) != 0 {
+		m["Action"] = tryFirst(s.Action)
+	}
+	if len(s.NotAction) != 0 {
+		m["NotAction"] = tryFirst(s.NotAction)
+	}
+	if len(s.Resource) != 0 {
+		m["Resource"] = tryFirst(s.Resource)
+	}
+	if len(s.NotResource) != 0 {
+		m["NotResource"] = tryFirst(s.NotResource)
+	}
+	if s.Condition != nil {
+		condition, err := SerializePolicyCondition(s.Condition)
+		if err != nil {
+			return nil, err
+		}
+		m["Condition"] = condition
+	}
+	m["Effect"] = s.Effect
+	if s.SID != nil {
+		m["Sid"] = *s.SID
+	}
+	return m, nil
+}
+
+// SerializePolicyPrincipal returns the given principal in the form of its
+// JSON document.
+func SerializePolicyPrincipal(p *PolicyPrincipal) interface{} {
+	if p.AllowAnon {
+		return "*"
+	}
+	m := make(map[string]interface{})
+	if p.Service != nil {
+		m["Service"] = tryFirst(p.Service)
+	}
+	if p.Federated != nil {
+		m["Federated"] = aws.StringValue(p.Federated)
+	}
+	if len(p.AWSPrincipals) == 1 {
+		m["AWS"] = aws.StringValue(p.AWSPrincipals[0])
+	} else if len(p.AWSPrincipals) > 1 {
+		values := make([]interface{}, len(p.AWSPrincipals))
+		for i := range p.AWSPrincipals {
+			values[i] = aws.StringValue(p.AWSPrincipals[i])
+		}
+		m["AWS"] = values
+	}
+	return m
+}
+
+// SerializePolicyCondition returns the given conditions, keyed by their
+// operators, in the form of their JSON document.
+func SerializePolicyCondition(c map[string]PolicyCondition) (interface{}, error) {
+	m := make(map[string]interface{})
+	for k, v := range c {
+		subMap := make(map[string]interface{})
+		switch {
+		case v.StringValue != nil:
+			subMap[v.Key] = *v.StringValue
+		case v.BooleanValue != nil:
+			subMap[v.Key] = *v.BooleanValue
+		case v.NumericValue != nil:
+			subMap[v.Key] = *v.NumericValue
+		case v.DateValue != nil:
+			subMap[v.Key] = v.DateValue.Time.Format("2006-01-02T15:04:05-0700")
+		default:
+			return nil, errors.Errorf("no value provided for key with value %s, condition %s", v.Key, k)
+		}
+		m[k] = subMap
+	}
+	return m, nil
+}
+
+func tryFirst(slc []string) interface{} {
+	if len(slc) == 1 {
+		return slc[0]
+	}
+	return slc
+}
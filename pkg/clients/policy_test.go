@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestSerializePolicyStatement(t *testing.T) {
+	type want struct {
+		out interface{}
+		err error
+	}
+
+	cases := map[string]struct {
+		in PolicyStatement
+		want
+	}{
+		"Minimal": {
+			in: PolicyStatement{Effect: "Allow"},
+			want: want{
+				out: map[string]interface{}{"Effect": "Allow"},
+			},
+		},
+		"AllFields": {
+			in: PolicyStatement{
+				SID:       aws.String("1"),
+				Effect:    "Deny",
+				Principal: &PolicyPrincipal{AWSPrincipals: []*string{aws.String("111122223333"), aws.String("444455556666")}},
+				NotPrincipal: &PolicyPrincipal{
+					Federated: aws.String("cognito-identity.amazonaws.com"),
+					Service:   []string{"sns.amazonaws.com"},
+				},
+				Action:      []string{"sqs:SendMessage"},
+				NotAction:   []string{"sqs:DeleteQueue", "sqs:PurgeQueue"},
+				Resource:    []string{"arn:aws:sqs:us-east-1:111122223333:queue"},
+				NotResource: []string{"arn:aws:sqs:us-east-1:111122223333:other"},
+				Condition: map[string]PolicyCondition{
+					"Bool": {Key: "aws:SecureTransport", BooleanValue: aws.Bool(false)},
+				},
+			},
+			want: want{
+				out: map[string]interface{}{
+					"Sid":    "1",
+					"Effect": "Deny",
+					"Principal": map[string]interface{}{
+						"AWS": []interface{}{"111122223333", "444455556666"},
+					},
+					"NotPrincipal": map[string]interface{}{
+						"Federated": "cognito-identity.amazonaws.com",
+						"Service":   "sns.amazonaws.com",
+					},
+					"Action":      "sqs:SendMessage",
+					"NotAction":   []string{"sqs:DeleteQueue", "sqs:PurgeQueue"},
+					"Resource":    "arn:aws:sqs:us-east-1:111122223333:queue",
+					"NotResource": "arn:aws:sqs:us-east-1:111122223333:other",
+					"Condition": map[string]interface{}{
+						"Bool": map[string]interface{}{"aws:SecureTransport": false},
+					},
+				},
+			},
+		},
+		"AnonymousPrincipal": {
+			in: PolicyStatement{Effect: "Allow", Principal: &PolicyPrincipal{AllowAnon: true}},
+			want: want{
+				out: map[string]interface{}{"Effect": "Allow", "Principal": "*"},
+			},
+		},
+		"ConditionWithoutValue": {
+			in: PolicyStatement{
+				Effect:    "Allow",
+				Condition: map[string]PolicyCondition{"StringEquals": {Key: "aws:SourceArn"}},
+			},
+			want: want{
+				err: errors.New("no value provided for key with value aws:SourceArn, condition StringEquals"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out, err := SerializePolicyStatement(tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.out, out); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ConnectionDetailsReaderEndpointKey is the key of the reader endpoint of a
+// DB cluster in its connection secret.
+const ConnectionDetailsReaderEndpointKey = "readerEndpoint"
+
+// DBClusterClient defines RDS operations used for DB clusters.
+type DBClusterClient interface {
+	CreateDBClusterRequest(*rds.CreateDBClusterInput) rds.CreateDBClusterRequest
+	DescribeDBClustersRequest(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	ModifyDBClusterRequest(*rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest
+	DeleteDBClusterRequest(*rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+}
+
+// NewDBClusterClient returns a new client using AWS credentials as JSON encoded data.
+func NewDBClusterClient(cfg aws.Config) DBClusterClient {
+	return rds.New(cfg)
+}
+
+// IsDBClusterNotFound returns true if the error is because the DB cluster
+// doesn't exist.
+func IsDBClusterNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBClusterNotFoundFault)
+}
+
+// GenerateCreateDBClusterInput from DBClusterParameters.
+func GenerateCreateDBClusterInput(name, password string, p *v1beta1.DBClusterParameters) *rds.CreateDBClusterInput {
+	c := &rds.CreateDBClusterInput{
+		DBClusterIdentifier:             aws.String(name),
+		AvailabilityZones:               p.AvailabilityZones,
+		BackupRetentionPeriod:           awsclients.Int64Address(p.BackupRetentionPeriod),
+		CharacterSetName:                p.CharacterSetName,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DatabaseName:                    p.DatabaseName,
+		DBClusterParameterGroupName:     p.DBClusterParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		EnableHttpEndpoint:              p.EnableHTTPEndpoint,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		Engine:                          aws.String(p.Engine),
+		EngineMode:                      p.EngineMode,
+		EngineVersion:                   p.EngineVersion,
+		KmsKeyId:                        p.KMSKeyID,
+		MasterUserPassword:              awsclients.String(password),
+		MasterUsername:                  p.MasterUsername,
+		Port:                            awsclients.Int64Address(p.Port),
+		PreferredBackupWindow:           p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:      p.PreferredMaintenanceWindow,
+		ScalingConfiguration:            generateScalingConfiguration(p.ScalingConfiguration),
+		StorageEncrypted:                p.StorageEncrypted,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = make([]rds.Tag, len(p.Tags))
+		for i, val := range p.Tags {
+			c.Tags[i] = rds.Tag{
+				Key:   aws.String(val.Key),
+				Value: aws.String(val.Value),
+			}
+		}
+	}
+	return c
+}
+
+func generateScalingConfiguration(s *v1beta1.ScalingConfiguration) *rds.ScalingConfiguration {
+	if s == nil {
+		return nil
+	}
+	return &rds.ScalingConfiguration{
+		AutoPause:             s.AutoPause,
+		MaxCapacity:           awsclients.Int64Address(s.MaxCapacity),
+		MinCapacity:           awsclients.Int64Address(s.MinCapacity),
+		SecondsUntilAutoPause: awsclients.Int64Address(s.SecondsUntilAutoPause),
+	}
+}
+
+// CreateDBClusterPatch creates a *v1beta1.DBClusterParameters that has only
+// the changed values between the target *v1beta1.DBClusterParameters and the
+// current *rds.DBCluster
+func CreateDBClusterPatch(in *rds.DBCluster, target *v1beta1.DBClusterParameters) (*v1beta1.DBClusterParameters, error) {
+	currentParams := &v1beta1.DBClusterParameters{}
+	LateInitializeDBCluster(currentParams, in)
+
+	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
+	if err != nil {
+		return nil, err
+	}
+	patch := &v1beta1.DBClusterParameters{}
+	if err := json.Unmarshal(jsonPatch, patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// GenerateModifyDBClusterInput from the patch of DBClusterParameters. The
+// current *rds.DBCluster is used to compute the log types that have to be
+// disabled.
+func GenerateModifyDBClusterInput(name string, p *v1beta1.DBClusterParameters, db *rds.DBCluster) *rds.ModifyDBClusterInput {
+	// NOTE: MasterUserPassword is set by the controller only if the password
+	// in the referenced secret has changed.
+	m := &rds.ModifyDBClusterInput{
+		DBClusterIdentifier:             aws.String(name),
+		AllowMajorVersionUpgrade:        p.AllowMajorVersionUpgrade,
+		ApplyImmediately:                p.ApplyModificationsImmediately,
+		BackupRetentionPeriod:           awsclients.Int64Address(p.BackupRetentionPeriod),
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DBClusterParameterGroupName:     p.DBClusterParameterGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		EnableHttpEndpoint:              p.EnableHTTPEndpoint,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		EngineVersion:                   p.EngineVersion,
+		Port:                            awsclients.Int64Address(p.Port),
+		PreferredBackupWindow:           p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:      p.PreferredMaintenanceWindow,
+		ScalingConfiguration:            generateScalingConfiguration(p.ScalingConfiguration),
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if len(p.EnableCloudwatchLogsExports) != 0 {
+		m.CloudwatchLogsExportConfiguration = &rds.CloudwatchLogsExportConfiguration{
+			EnableLogTypes:  difference(p.EnableCloudwatchLogsExports, db.EnabledCloudwatchLogsExports),
+			DisableLogTypes: difference(db.EnabledCloudwatchLogsExports, p.EnableCloudwatchLogsExports),
+		}
+	}
+	return m
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	m := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		m[v] = struct{}{}
+	}
+	var diff []string
+	for _, v := range a {
+		if _, ok := m[v]; !ok {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+// GenerateDBClusterObservation is used to produce
+// v1beta1.DBClusterObservation from rds.DBCluster.
+func GenerateDBClusterObservation(db rds.DBCluster) v1beta1.DBClusterObservation {
+	o := v1beta1.DBClusterObservation{
+		Status:              aws.StringValue(db.Status),
+		DBClusterARN:        aws.StringValue(db.DBClusterArn),
+		DBClusterResourceID: aws.StringValue(db.DbClusterResourceId),
+		Endpoint:            aws.StringValue(db.Endpoint),
+		ReaderEndpoint:      aws.StringValue(db.ReaderEndpoint),
+		Port:                int(aws.Int64Value(db.Port)),
+		HostedZoneID:        aws.StringValue(db.HostedZoneId),
+		MultiAZ:             aws.BoolValue(db.MultiAZ),
+		Capacity:            int(aws.Int64Value(db.Capacity)),
+	}
+	if db.ClusterCreateTime != nil {
+		t := metav1.NewTime(*db.ClusterCreateTime)
+		o.ClusterCreateTime = &t
+	}
+	if db.EarliestRestorableTime != nil {
+		t := metav1.NewTime(*db.EarliestRestorableTime)
+		o.EarliestRestorableTime = &t
+	}
+	if db.LatestRestorableTime != nil {
+		t := metav1.NewTime(*db.LatestRestorableTime)
+		o.LatestRestorableTime = &t
+	}
+	if len(db.DBClusterMembers) != 0 {
+		o.DBClusterMembers = make([]v1beta1.DBClusterMember, len(db.DBClusterMembers))
+		for i, val := range db.DBClusterMembers {
+			o.DBClusterMembers[i] = v1beta1.DBClusterMember{
+				DBInstanceIdentifier:          aws.StringValue(val.DBInstanceIdentifier),
+				DBClusterParameterGroupStatus: aws.StringValue(val.DBClusterParameterGroupStatus),
+				IsClusterWriter:               aws.BoolValue(val.IsClusterWriter),
+				PromotionTier:                 int(aws.Int64Value(val.PromotionTier)),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeDBCluster fills the empty fields in
+// *v1beta1.DBClusterParameters with the values seen in rds.DBCluster.
+func LateInitializeDBCluster(in *v1beta1.DBClusterParameters, db *rds.DBCluster) { // nolint:gocyclo
+	if db == nil {
+		return
+	}
+	in.Engine = awsclients.LateInitializeString(in.Engine, db.Engine)
+
+	in.BackupRetentionPeriod = awsclients.LateInitializeIntPtr(in.BackupRetentionPeriod, db.BackupRetentionPeriod)
+	in.CharacterSetName = awsclients.LateInitializeStringPtr(in.CharacterSetName, db.CharacterSetName)
+	in.CopyTagsToSnapshot = awsclients.LateInitializeBoolPtr(in.CopyTagsToSnapshot, db.CopyTagsToSnapshot)
+	in.DatabaseName = awsclients.LateInitializeStringPtr(in.DatabaseName, db.DatabaseName)
+	in.DBClusterParameterGroupName = awsclients.LateInitializeStringPtr(in.DBClusterParameterGroupName, db.DBClusterParameterGroup)
+	in.DBSubnetGroupName = awsclients.LateInitializeStringPtr(in.DBSubnetGroupName, db.DBSubnetGroup)
+	in.DeletionProtection = awsclients.LateInitializeBoolPtr(in.DeletionProtection, db.DeletionProtection)
+	in.EnableHTTPEndpoint = awsclients.LateInitializeBoolPtr(in.EnableHTTPEndpoint, db.HttpEndpointEnabled)
+	in.EnableIAMDatabaseAuthentication = awsclients.LateInitializeBoolPtr(in.EnableIAMDatabaseAuthentication, db.IAMDatabaseAuthenticationEnabled)
+	in.EngineMode = awsclients.LateInitializeStringPtr(in.EngineMode, db.EngineMode)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, db.KmsKeyId)
+	in.MasterUsername = awsclients.LateInitializeStringPtr(in.MasterUsername, db.MasterUsername)
+	in.Port = awsclients.LateInitializeIntPtr(in.Port, db.Port)
+	in.PreferredBackupWindow = awsclients.LateInitializeStringPtr(in.PreferredBackupWindow, db.PreferredBackupWindow)
+	in.PreferredMaintenanceWindow = awsclients.LateInitializeStringPtr(in.PreferredMaintenanceWindow, db.PreferredMaintenanceWindow)
+	in.StorageEncrypted = awsclients.LateInitializeBoolPtr(in.StorageEncrypted, db.StorageEncrypted)
+
+	if len(in.AvailabilityZones) == 0 && len(db.AvailabilityZones) != 0 {
+		in.AvailabilityZones = db.AvailabilityZones
+	}
+	if len(in.EnableCloudwatchLogsExports) == 0 && len(db.EnabledCloudwatchLogsExports) != 0 {
+		in.EnableCloudwatchLogsExports = db.EnabledCloudwatchLogsExports
+	}
+	if len(in.VPCSecurityGroupIDs) == 0 && len(db.VpcSecurityGroups) != 0 {
+		in.VPCSecurityGroupIDs = make([]string, len(db.VpcSecurityGroups))
+		for i, val := range db.VpcSecurityGroups {
+			in.VPCSecurityGroupIDs[i] = aws.StringValue(val.VpcSecurityGroupId)
+		}
+	}
+	if in.ScalingConfiguration == nil && db.ScalingConfigurationInfo != nil {
+		in.ScalingConfiguration = &v1beta1.ScalingConfiguration{
+			AutoPause:             db.ScalingConfigurationInfo.AutoPause,
+			MaxCapacity:           awsclients.LateInitializeIntPtr(nil, db.ScalingConfigurationInfo.MaxCapacity),
+			MinCapacity:           awsclients.LateInitializeIntPtr(nil, db.ScalingConfigurationInfo.MinCapacity),
+			SecondsUntilAutoPause: awsclients.LateInitializeIntPtr(nil, db.ScalingConfigurationInfo.SecondsUntilAutoPause),
+		}
+	}
+	in.EngineVersion = awsclients.LateInitializeStringPtr(in.EngineVersion, db.EngineVersion)
+	// Same as for RDS instances, AWS may report a more specific engine version
+	// than the one that is requested.
+	if strings.HasPrefix(aws.StringValue(db.EngineVersion), aws.StringValue(in.EngineVersion)) {
+		in.EngineVersion = db.EngineVersion
+	}
+}
+
+// IsDBClusterUpToDate checks whether there is a change in any of the
+// modifiable fields.
+func IsDBClusterUpToDate(ctx context.Context, kube client.Client, cr *v1beta1.DBCluster, db rds.DBCluster) (bool, error) {
+	_, pwdChanged, err := GetDBClusterPassword(ctx, kube, cr)
+	if err != nil {
+		return false, err
+	}
+	patch, err := CreateDBClusterPatch(&db, &cr.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(&v1beta1.DBClusterParameters{}, patch, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "AvailabilityZones"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "Tags"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "SkipFinalSnapshotBeforeDeletion"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "FinalDBSnapshotIdentifier"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "ApplyModificationsImmediately"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "AllowMajorVersionUpgrade"),
+		cmpopts.IgnoreFields(v1beta1.DBClusterParameters{}, "MasterPasswordSecretRef"),
+	) && !pwdChanged, nil
+}
+
+// GetDBClusterPassword fetches the referenced input password for a DBCluster
+// and determines whether it has changed or not.
+func GetDBClusterPassword(ctx context.Context, kube client.Client, cr *v1beta1.DBCluster) (newPwd string, changed bool, err error) {
+	return getPassword(ctx, kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+}
+
+// GetDBClusterConnectionDetails extracts managed.ConnectionDetails out of
+// v1beta1.DBCluster. The endpoint key holds the writer endpoint of the
+// cluster.
+func GetDBClusterConnectionDetails(in v1beta1.DBCluster) managed.ConnectionDetails {
+	if in.Status.AtProvider.Endpoint == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(in.Status.AtProvider.Port)),
+	}
+	if in.Status.AtProvider.ReaderEndpoint != "" {
+		conn[ConnectionDetailsReaderEndpointKey] = []byte(in.Status.AtProvider.ReaderEndpoint)
+	}
+	return conn
+}
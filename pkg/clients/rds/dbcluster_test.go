@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	readerAddress = "reader-address"
+)
+
+func TestCreateDBClusterPatch(t *testing.T) {
+	cases := map[string]struct {
+		db   *rds.DBCluster
+		p    *v1beta1.DBClusterParameters
+		want *v1beta1.DBClusterParameters
+	}{
+		"SameFields": {
+			db: &rds.DBCluster{
+				BackupRetentionPeriod: &retention64,
+				DatabaseName:          &dbName,
+				EngineVersion:         &engine,
+			},
+			p: &v1beta1.DBClusterParameters{
+				BackupRetentionPeriod: &retention,
+				DatabaseName:          &dbName,
+				EngineVersion:         &engine,
+			},
+			want: &v1beta1.DBClusterParameters{},
+		},
+		"DifferentFields": {
+			db: &rds.DBCluster{
+				BackupRetentionPeriod: &retention64,
+				DatabaseName:          &dbName,
+			},
+			p: &v1beta1.DBClusterParameters{
+				BackupRetentionPeriod: aws.IntAddress(aws.Int64(7)),
+				DatabaseName:          &dbName,
+			},
+			want: &v1beta1.DBClusterParameters{
+				BackupRetentionPeriod: aws.IntAddress(aws.Int64(7)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patch, err := CreateDBClusterPatch(tc.db, tc.p)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, patch); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyDBClusterInput(t *testing.T) {
+	cases := map[string]struct {
+		patch *v1beta1.DBClusterParameters
+		db    *rds.DBCluster
+		want  *rds.ModifyDBClusterInput
+	}{
+		"Empty": {
+			patch: &v1beta1.DBClusterParameters{},
+			db:    &rds.DBCluster{},
+			want:  &rds.ModifyDBClusterInput{DBClusterIdentifier: aws.String(name)},
+		},
+		"CloudwatchLogsExports": {
+			patch: &v1beta1.DBClusterParameters{
+				EnableCloudwatchLogsExports: []string{"audit", "error"},
+			},
+			db: &rds.DBCluster{
+				EnabledCloudwatchLogsExports: []string{"error", "slowquery"},
+			},
+			want: &rds.ModifyDBClusterInput{
+				DBClusterIdentifier: aws.String(name),
+				CloudwatchLogsExportConfiguration: &rds.CloudwatchLogsExportConfiguration{
+					EnableLogTypes:  []string{"audit"},
+					DisableLogTypes: []string{"slowquery"},
+				},
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateModifyDBClusterInput(name, tc.patch, tc.db)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetDBClusterConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		cr   v1beta1.DBCluster
+		want managed.ConnectionDetails
+	}{
+		"NoEndpoint": {
+			cr: v1beta1.DBCluster{},
+		},
+		"WriterAndReader": {
+			cr: v1beta1.DBCluster{
+				Status: v1beta1.DBClusterStatus{
+					AtProvider: v1beta1.DBClusterObservation{
+						Endpoint:       address,
+						ReaderEndpoint: readerAddress,
+						Port:           port,
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+				ConnectionDetailsReaderEndpointKey:        []byte(readerAddress),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetDBClusterConnectionDetails(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
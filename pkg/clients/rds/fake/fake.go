@@ -27,6 +27,12 @@ type MockRDSClient struct {
 	MockModify   func(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	MockDelete   func(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	MockAddTags  func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+
+	MockRestoreFromSnapshot  func(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	MockRestoreToPointInTime func(*rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest
+	MockRestoreFromS3        func(*rds.RestoreDBInstanceFromS3Input) rds.RestoreDBInstanceFromS3Request
+	MockCreateReadReplica    func(*rds.CreateDBInstanceReadReplicaInput) rds.CreateDBInstanceReadReplicaRequest
+	MockPromoteReadReplica   func(*rds.PromoteReadReplicaInput) rds.PromoteReadReplicaRequest
 }
 
 // DescribeDBInstancesRequest finds RDS Instance by name
@@ -53,3 +59,178 @@ func (m *MockRDSClient) DeleteDBInstanceRequest(i *rds.DeleteDBInstanceInput) rd
 func (m *MockRDSClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
 	return m.MockAddTags(i)
 }
+
+// RestoreDBInstanceFromDBSnapshotRequest restores RDS Instance from a DB snapshot
+func (m *MockRDSClient) RestoreDBInstanceFromDBSnapshotRequest(i *rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest {
+	return m.MockRestoreFromSnapshot(i)
+}
+
+// RestoreDBInstanceToPointInTimeRequest restores RDS Instance to a point in time
+func (m *MockRDSClient) RestoreDBInstanceToPointInTimeRequest(i *rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest {
+	return m.MockRestoreToPointInTime(i)
+}
+
+// RestoreDBInstanceFromS3Request restores RDS Instance from a backup in S3
+func (m *MockRDSClient) RestoreDBInstanceFromS3Request(i *rds.RestoreDBInstanceFromS3Input) rds.RestoreDBInstanceFromS3Request {
+	return m.MockRestoreFromS3(i)
+}
+
+// CreateDBInstanceReadReplicaRequest creates a Read Replica of RDS Instance
+func (m *MockRDSClient) CreateDBInstanceReadReplicaRequest(i *rds.CreateDBInstanceReadReplicaInput) rds.CreateDBInstanceReadReplicaRequest {
+	return m.MockCreateReadReplica(i)
+}
+
+// PromoteReadReplicaRequest promotes a Read Replica to a standalone RDS Instance
+func (m *MockRDSClient) PromoteReadReplicaRequest(i *rds.PromoteReadReplicaInput) rds.PromoteReadReplicaRequest {
+	return m.MockPromoteReadReplica(i)
+}
+
+// MockDBClusterClient for testing DB clusters.
+type MockDBClusterClient struct {
+	MockCreate   func(*rds.CreateDBClusterInput) rds.CreateDBClusterRequest
+	MockDescribe func(*rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest
+	MockModify   func(*rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest
+	MockDelete   func(*rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest
+	MockAddTags  func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+}
+
+// CreateDBClusterRequest mocks CreateDBClusterRequest method
+func (m *MockDBClusterClient) CreateDBClusterRequest(i *rds.CreateDBClusterInput) rds.CreateDBClusterRequest {
+	return m.MockCreate(i)
+}
+
+// DescribeDBClustersRequest mocks DescribeDBClustersRequest method
+func (m *MockDBClusterClient) DescribeDBClustersRequest(i *rds.DescribeDBClustersInput) rds.DescribeDBClustersRequest {
+	return m.MockDescribe(i)
+}
+
+// ModifyDBClusterRequest mocks ModifyDBClusterRequest method
+func (m *MockDBClusterClient) ModifyDBClusterRequest(i *rds.ModifyDBClusterInput) rds.ModifyDBClusterRequest {
+	return m.MockModify(i)
+}
+
+// DeleteDBClusterRequest mocks DeleteDBClusterRequest method
+func (m *MockDBClusterClient) DeleteDBClusterRequest(i *rds.DeleteDBClusterInput) rds.DeleteDBClusterRequest {
+	return m.MockDelete(i)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBClusterClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTags(i)
+}
+
+// MockDBParameterGroupClient for testing DB parameter groups.
+type MockDBParameterGroupClient struct {
+	MockCreate             func(*rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest
+	MockDescribe           func(*rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest
+	MockDescribeParameters func(*rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest
+	MockModify             func(*rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest
+	MockReset              func(*rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest
+	MockDelete             func(*rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest
+	MockListTags           func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTags            func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTags         func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// CreateDBParameterGroupRequest mocks CreateDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) CreateDBParameterGroupRequest(i *rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest {
+	return m.MockCreate(i)
+}
+
+// DescribeDBParameterGroupsRequest mocks DescribeDBParameterGroupsRequest method
+func (m *MockDBParameterGroupClient) DescribeDBParameterGroupsRequest(i *rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest {
+	return m.MockDescribe(i)
+}
+
+// DescribeDBParametersRequest mocks DescribeDBParametersRequest method
+func (m *MockDBParameterGroupClient) DescribeDBParametersRequest(i *rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest {
+	return m.MockDescribeParameters(i)
+}
+
+// ModifyDBParameterGroupRequest mocks ModifyDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ModifyDBParameterGroupRequest(i *rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest {
+	return m.MockModify(i)
+}
+
+// ResetDBParameterGroupRequest mocks ResetDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) ResetDBParameterGroupRequest(i *rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest {
+	return m.MockReset(i)
+}
+
+// DeleteDBParameterGroupRequest mocks DeleteDBParameterGroupRequest method
+func (m *MockDBParameterGroupClient) DeleteDBParameterGroupRequest(i *rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest {
+	return m.MockDelete(i)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBParameterGroupClient) ListTagsForResourceRequest(i *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTags(i)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBParameterGroupClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTags(i)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBParameterGroupClient) RemoveTagsFromResourceRequest(i *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTags(i)
+}
+
+// MockDBClusterParameterGroupClient for testing DB cluster parameter groups.
+type MockDBClusterParameterGroupClient struct {
+	MockCreate             func(*rds.CreateDBClusterParameterGroupInput) rds.CreateDBClusterParameterGroupRequest
+	MockDescribe           func(*rds.DescribeDBClusterParameterGroupsInput) rds.DescribeDBClusterParameterGroupsRequest
+	MockDescribeParameters func(*rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest
+	MockModify             func(*rds.ModifyDBClusterParameterGroupInput) rds.ModifyDBClusterParameterGroupRequest
+	MockReset              func(*rds.ResetDBClusterParameterGroupInput) rds.ResetDBClusterParameterGroupRequest
+	MockDelete             func(*rds.DeleteDBClusterParameterGroupInput) rds.DeleteDBClusterParameterGroupRequest
+	MockListTags           func(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	MockAddTags            func(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	MockRemoveTags         func(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// CreateDBClusterParameterGroupRequest mocks CreateDBClusterParameterGroupRequest method
+func (m *MockDBClusterParameterGroupClient) CreateDBClusterParameterGroupRequest(i *rds.CreateDBClusterParameterGroupInput) rds.CreateDBClusterParameterGroupRequest {
+	return m.MockCreate(i)
+}
+
+// DescribeDBClusterParameterGroupsRequest mocks DescribeDBClusterParameterGroupsRequest method
+func (m *MockDBClusterParameterGroupClient) DescribeDBClusterParameterGroupsRequest(i *rds.DescribeDBClusterParameterGroupsInput) rds.DescribeDBClusterParameterGroupsRequest {
+	return m.MockDescribe(i)
+}
+
+// DescribeDBClusterParametersRequest mocks DescribeDBClusterParametersRequest method
+func (m *MockDBClusterParameterGroupClient) DescribeDBClusterParametersRequest(i *rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest {
+	return m.MockDescribeParameters(i)
+}
+
+// ModifyDBClusterParameterGroupRequest mocks ModifyDBClusterParameterGroupRequest method
+func (m *MockDBClusterParameterGroupClient) ModifyDBClusterParameterGroupRequest(i *rds.ModifyDBClusterParameterGroupInput) rds.ModifyDBClusterParameterGroupRequest {
+	return m.MockModify(i)
+}
+
+// ResetDBClusterParameterGroupRequest mocks ResetDBClusterParameterGroupRequest method
+func (m *MockDBClusterParameterGroupClient) ResetDBClusterParameterGroupRequest(i *rds.ResetDBClusterParameterGroupInput) rds.ResetDBClusterParameterGroupRequest {
+	return m.MockReset(i)
+}
+
+// DeleteDBClusterParameterGroupRequest mocks DeleteDBClusterParameterGroupRequest method
+func (m *MockDBClusterParameterGroupClient) DeleteDBClusterParameterGroupRequest(i *rds.DeleteDBClusterParameterGroupInput) rds.DeleteDBClusterParameterGroupRequest {
+	return m.MockDelete(i)
+}
+
+// ListTagsForResourceRequest mocks ListTagsForResourceRequest method
+func (m *MockDBClusterParameterGroupClient) ListTagsForResourceRequest(i *rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest {
+	return m.MockListTags(i)
+}
+
+// AddTagsToResourceRequest mocks AddTagsToResourceRequest method
+func (m *MockDBClusterParameterGroupClient) AddTagsToResourceRequest(i *rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest {
+	return m.MockAddTags(i)
+}
+
+// RemoveTagsFromResourceRequest mocks RemoveTagsFromResourceRequest method
+func (m *MockDBClusterParameterGroupClient) RemoveTagsFromResourceRequest(i *rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest {
+	return m.MockRemoveTags(i)
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+)
+
+const (
+	// ParameterSourceUser is the source of the parameters that are set by
+	// the user, as opposed to the defaults of the parameter group family.
+	ParameterSourceUser = "user"
+
+	// MaxParametersPerRequest is the maximum number of parameters that can
+	// be modified or reset in a single request.
+	MaxParametersPerRequest = 20
+
+	parameterApplyTypeDynamic = "dynamic"
+
+	errNotOneParameterGroup = "expected exactly one parameter group"
+)
+
+// DBParameterGroupClient defines RDS operations used for DB parameter groups.
+type DBParameterGroupClient interface {
+	CreateDBParameterGroupRequest(*rds.CreateDBParameterGroupInput) rds.CreateDBParameterGroupRequest
+	DescribeDBParameterGroupsRequest(*rds.DescribeDBParameterGroupsInput) rds.DescribeDBParameterGroupsRequest
+	DescribeDBParametersRequest(*rds.DescribeDBParametersInput) rds.DescribeDBParametersRequest
+	ModifyDBParameterGroupRequest(*rds.ModifyDBParameterGroupInput) rds.ModifyDBParameterGroupRequest
+	ResetDBParameterGroupRequest(*rds.ResetDBParameterGroupInput) rds.ResetDBParameterGroupRequest
+	DeleteDBParameterGroupRequest(*rds.DeleteDBParameterGroupInput) rds.DeleteDBParameterGroupRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewDBParameterGroupClient returns a new client using AWS credentials as JSON encoded data.
+func NewDBParameterGroupClient(cfg aws.Config) DBParameterGroupClient {
+	return rds.New(cfg)
+}
+
+// DBClusterParameterGroupClient defines RDS operations used for DB cluster
+// parameter groups.
+type DBClusterParameterGroupClient interface {
+	CreateDBClusterParameterGroupRequest(*rds.CreateDBClusterParameterGroupInput) rds.CreateDBClusterParameterGroupRequest
+	DescribeDBClusterParameterGroupsRequest(*rds.DescribeDBClusterParameterGroupsInput) rds.DescribeDBClusterParameterGroupsRequest
+	DescribeDBClusterParametersRequest(*rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest
+	ModifyDBClusterParameterGroupRequest(*rds.ModifyDBClusterParameterGroupInput) rds.ModifyDBClusterParameterGroupRequest
+	ResetDBClusterParameterGroupRequest(*rds.ResetDBClusterParameterGroupInput) rds.ResetDBClusterParameterGroupRequest
+	DeleteDBClusterParameterGroupRequest(*rds.DeleteDBClusterParameterGroupInput) rds.DeleteDBClusterParameterGroupRequest
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// NewDBClusterParameterGroupClient returns a new client using AWS credentials as JSON encoded data.
+func NewDBClusterParameterGroupClient(cfg aws.Config) DBClusterParameterGroupClient {
+	return rds.New(cfg)
+}
+
+// IsParameterGroupNotFound returns true if the error is because the DB
+// parameter group or the DB cluster parameter group doesn't exist.
+func IsParameterGroupNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), rds.ErrCodeDBParameterGroupNotFoundFault) ||
+		strings.Contains(err.Error(), rds.ErrCodeDBClusterParameterGroupNotFoundFault)
+}
+
+// GenerateCreateDBParameterGroupInput from DBParameterGroupParameters.
+func GenerateCreateDBParameterGroupInput(name string, p v1beta1.DBParameterGroupParameters) *rds.CreateDBParameterGroupInput {
+	return &rds.CreateDBParameterGroupInput{
+		DBParameterGroupName:   aws.String(name),
+		DBParameterGroupFamily: aws.String(p.DBParameterGroupFamily),
+		Description:            aws.String(p.Description),
+		Tags:                   GenerateTags(p.Tags),
+	}
+}
+
+// GenerateCreateDBClusterParameterGroupInput from
+// DBClusterParameterGroupParameters.
+func GenerateCreateDBClusterParameterGroupInput(name string, p v1beta1.DBClusterParameterGroupParameters) *rds.CreateDBClusterParameterGroupInput {
+	return &rds.CreateDBClusterParameterGroupInput{
+		DBClusterParameterGroupName: aws.String(name),
+		DBParameterGroupFamily:      aws.String(p.DBParameterGroupFamily),
+		Description:                 aws.String(p.Description),
+		Tags:                        GenerateTags(p.Tags),
+	}
+}
+
+// A ParameterGroupClient performs the operations that DB parameter groups
+// and DB cluster parameter groups have in common, so that both can be managed
+// the same way.
+type ParameterGroupClient interface {
+	// DescribeParameterGroup returns the ARN of the parameter group with the
+	// given name.
+	DescribeParameterGroup(ctx context.Context, name string) (string, error)
+
+	// DescribeParameters returns the page of the user set parameters of the
+	// given parameter group that starts at the given marker, and the marker
+	// of the next page, if any.
+	DescribeParameters(ctx context.Context, name string, marker *string) ([]rds.Parameter, *string, error)
+
+	CreateParameterGroup(ctx context.Context, name string, p v1beta1.DBParameterGroupParameters) error
+	ModifyParameters(ctx context.Context, name string, params []rds.Parameter) error
+	ResetParameters(ctx context.Context, name string, params []rds.Parameter) error
+	DeleteParameterGroup(ctx context.Context, name string) error
+
+	ListTagsForResourceRequest(*rds.ListTagsForResourceInput) rds.ListTagsForResourceRequest
+	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RemoveTagsFromResourceRequest(*rds.RemoveTagsFromResourceInput) rds.RemoveTagsFromResourceRequest
+}
+
+// DBParameterGroups returns a ParameterGroupClient that operates on DB
+// parameter groups.
+func DBParameterGroups(c DBParameterGroupClient) ParameterGroupClient {
+	return &dbParameterGroups{DBParameterGroupClient: c}
+}
+
+type dbParameterGroups struct {
+	DBParameterGroupClient
+}
+
+func (c *dbParameterGroups) DescribeParameterGroup(ctx context.Context, name string) (string, error) {
+	rsp, err := c.DescribeDBParameterGroupsRequest(&rds.DescribeDBParameterGroupsInput{DBParameterGroupName: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(rsp.DBParameterGroups) != 1 {
+		return "", errors.New(errNotOneParameterGroup)
+	}
+	return aws.StringValue(rsp.DBParameterGroups[0].DBParameterGroupArn), nil
+}
+
+func (c *dbParameterGroups) DescribeParameters(ctx context.Context, name string, marker *string) ([]rds.Parameter, *string, error) {
+	rsp, err := c.DescribeDBParametersRequest(&rds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(name),
+		Source:               aws.String(ParameterSourceUser),
+		Marker:               marker,
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rsp.Parameters, rsp.Marker, nil
+}
+
+func (c *dbParameterGroups) CreateParameterGroup(ctx context.Context, name string, p v1beta1.DBParameterGroupParameters) error {
+	_, err := c.CreateDBParameterGroupRequest(GenerateCreateDBParameterGroupInput(name, p)).Send(ctx)
+	return err
+}
+
+func (c *dbParameterGroups) ModifyParameters(ctx context.Context, name string, params []rds.Parameter) error {
+	_, err := c.ModifyDBParameterGroupRequest(&rds.ModifyDBParameterGroupInput{DBParameterGroupName: aws.String(name), Parameters: params}).Send(ctx)
+	return err
+}
+
+func (c *dbParameterGroups) ResetParameters(ctx context.Context, name string, params []rds.Parameter) error {
+	_, err := c.ResetDBParameterGroupRequest(&rds.ResetDBParameterGroupInput{DBParameterGroupName: aws.String(name), Parameters: params}).Send(ctx)
+	return err
+}
+
+func (c *dbParameterGroups) DeleteParameterGroup(ctx context.Context, name string) error {
+	_, err := c.DeleteDBParameterGroupRequest(&rds.DeleteDBParameterGroupInput{DBParameterGroupName: aws.String(name)}).Send(ctx)
+	return err
+}
+
+// DBClusterParameterGroups returns a ParameterGroupClient that operates on DB
+// cluster parameter groups.
+func DBClusterParameterGroups(c DBClusterParameterGroupClient) ParameterGroupClient {
+	return &dbClusterParameterGroups{DBClusterParameterGroupClient: c}
+}
+
+type dbClusterParameterGroups struct {
+	DBClusterParameterGroupClient
+}
+
+func (c *dbClusterParameterGroups) DescribeParameterGroup(ctx context.Context, name string) (string, error) {
+	rsp, err := c.DescribeDBClusterParameterGroupsRequest(&rds.DescribeDBClusterParameterGroupsInput{DBClusterParameterGroupName: aws.String(name)}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(rsp.DBClusterParameterGroups) != 1 {
+		return "", errors.New(errNotOneParameterGroup)
+	}
+	return aws.StringValue(rsp.DBClusterParameterGroups[0].DBClusterParameterGroupArn), nil
+}
+
+func (c *dbClusterParameterGroups) DescribeParameters(ctx context.Context, name string, marker *string) ([]rds.Parameter, *string, error) {
+	rsp, err := c.DescribeDBClusterParametersRequest(&rds.DescribeDBClusterParametersInput{
+		DBClusterParameterGroupName: aws.String(name),
+		Source:                      aws.String(ParameterSourceUser),
+		Marker:                      marker,
+	}).Send(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rsp.Parameters, rsp.Marker, nil
+}
+
+func (c *dbClusterParameterGroups) CreateParameterGroup(ctx context.Context, name string, p v1beta1.DBParameterGroupParameters) error {
+	_, err := c.CreateDBClusterParameterGroupRequest(GenerateCreateDBClusterParameterGroupInput(name, v1beta1.DBClusterParameterGroupParameters(p))).Send(ctx)
+	return err
+}
+
+func (c *dbClusterParameterGroups) ModifyParameters(ctx context.Context, name string, params []rds.Parameter) error {
+	_, err := c.ModifyDBClusterParameterGroupRequest(&rds.ModifyDBClusterParameterGroupInput{DBClusterParameterGroupName: aws.String(name), Parameters: params}).Send(ctx)
+	return err
+}
+
+func (c *dbClusterParameterGroups) ResetParameters(ctx context.Context, name string, params []rds.Parameter) error {
+	_, err := c.ResetDBClusterParameterGroupRequest(&rds.ResetDBClusterParameterGroupInput{DBClusterParameterGroupName: aws.String(name), Parameters: params}).Send(ctx)
+	return err
+}
+
+func (c *dbClusterParameterGroups) DeleteParameterGroup(ctx context.Context, name string) error {
+	_, err := c.DeleteDBClusterParameterGroupRequest(&rds.DeleteDBClusterParameterGroupInput{DBClusterParameterGroupName: aws.String(name)}).Send(ctx)
+	return err
+}
+
+// GetParameters returns all parameters of the given parameter group that are
+// set by the user.
+func GetParameters(ctx context.Context, c ParameterGroupClient, name string) ([]rds.Parameter, error) {
+	var params []rds.Parameter
+	var marker *string
+	for {
+		page, next, err := c.DescribeParameters(ctx, name, marker)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, page...)
+		if next == nil {
+			return params, nil
+		}
+		marker = next
+	}
+}
+
+// DiffParameters returns the parameters that have to be modified and the ones
+// that have to be reset to their defaults so that the observed parameters
+// match the desired ones.
+func DiffParameters(desired []v1beta1.Parameter, observed []rds.Parameter) (modify, reset []rds.Parameter) {
+	current := make(map[string]rds.Parameter, len(observed))
+	for _, p := range observed {
+		current[aws.StringValue(p.ParameterName)] = p
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	for _, p := range desired {
+		wanted[p.ParameterName] = struct{}{}
+		if c, ok := current[p.ParameterName]; ok && aws.StringValue(c.ParameterValue) == p.ParameterValue {
+			continue
+		}
+		method := rds.ApplyMethodPendingReboot
+		if p.ApplyMethod != nil {
+			method = rds.ApplyMethod(aws.StringValue(p.ApplyMethod))
+		}
+		modify = append(modify, rds.Parameter{
+			ParameterName:  aws.String(p.ParameterName),
+			ParameterValue: aws.String(p.ParameterValue),
+			ApplyMethod:    method,
+		})
+	}
+	for name, p := range current {
+		if _, ok := wanted[name]; ok {
+			continue
+		}
+		method := rds.ApplyMethodPendingReboot
+		if aws.StringValue(p.ApplyType) == parameterApplyTypeDynamic {
+			method = rds.ApplyMethodImmediate
+		}
+		reset = append(reset, rds.Parameter{
+			ParameterName: aws.String(name),
+			ApplyMethod:   method,
+		})
+	}
+	sort.Slice(reset, func(i, j int) bool {
+		return aws.StringValue(reset[i].ParameterName) < aws.StringValue(reset[j].ParameterName)
+	})
+	return modify, reset
+}
+
+// SplitParameters splits the given parameters into batches that can be sent
+// in a single request.
+func SplitParameters(params []rds.Parameter) [][]rds.Parameter {
+	var batches [][]rds.Parameter
+	for len(params) > MaxParametersPerRequest {
+		batches = append(batches, params[:MaxParametersPerRequest])
+		params = params[MaxParametersPerRequest:]
+	}
+	if len(params) != 0 {
+		batches = append(batches, params)
+	}
+	return batches
+}
+
+// GenerateTags returns the given tags as RDS tags.
+func GenerateTags(tags []v1beta1.Tag) []rds.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]rds.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that have to be added and the keys of the tags
+// that have to be removed so that the observed tags match the desired ones.
+func DiffTags(desired []v1beta1.Tag, observed []rds.Tag) (add []rds.Tag, remove []string) {
+	current := make(map[string]string, len(observed))
+	for _, t := range observed {
+		current[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	for _, t := range desired {
+		wanted[t.Key] = struct{}{}
+		if v, ok := current[t.Key]; ok && v == t.Value {
+			continue
+		}
+		add = append(add, rds.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+	}
+	for k := range current {
+		if _, ok := wanted[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	sort.Strings(remove)
+	return add, remove
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+func TestDiffParameters(t *testing.T) {
+	immediate := string(rds.ApplyMethodImmediate)
+
+	type want struct {
+		modify []rds.Parameter
+		reset  []rds.Parameter
+	}
+
+	cases := map[string]struct {
+		desired  []v1beta1.Parameter
+		observed []rds.Parameter
+		want
+	}{
+		"UpToDate": {
+			desired:  []v1beta1.Parameter{{ParameterName: name, ParameterValue: value}},
+			observed: []rds.Parameter{{ParameterName: aws.String(name), ParameterValue: aws.String(value)}},
+			want:     want{},
+		},
+		"Added": {
+			desired: []v1beta1.Parameter{{ParameterName: name, ParameterValue: value, ApplyMethod: &immediate}},
+			want: want{
+				modify: []rds.Parameter{{ParameterName: aws.String(name), ParameterValue: aws.String(value), ApplyMethod: rds.ApplyMethodImmediate}},
+			},
+		},
+		"Changed": {
+			desired:  []v1beta1.Parameter{{ParameterName: name, ParameterValue: value}},
+			observed: []rds.Parameter{{ParameterName: aws.String(name), ParameterValue: aws.String("old")}},
+			want: want{
+				modify: []rds.Parameter{{ParameterName: aws.String(name), ParameterValue: aws.String(value), ApplyMethod: rds.ApplyMethodPendingReboot}},
+			},
+		},
+		"Removed": {
+			observed: []rds.Parameter{
+				{ParameterName: aws.String("static"), ParameterValue: aws.String(value), ApplyType: aws.String("static")},
+				{ParameterName: aws.String("dynamic"), ParameterValue: aws.String(value), ApplyType: aws.String("dynamic")},
+			},
+			want: want{
+				reset: []rds.Parameter{
+					{ParameterName: aws.String("dynamic"), ApplyMethod: rds.ApplyMethodImmediate},
+					{ParameterName: aws.String("static"), ApplyMethod: rds.ApplyMethodPendingReboot},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := DiffParameters(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.modify, modify); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSplitParameters(t *testing.T) {
+	params := func(n int) []rds.Parameter {
+		p := make([]rds.Parameter, n)
+		for i := range p {
+			p[i] = rds.Parameter{ParameterName: aws.String(name)}
+		}
+		return p
+	}
+
+	cases := map[string]struct {
+		in   []rds.Parameter
+		want []int
+	}{
+		"Empty": {
+			in: nil,
+		},
+		"SingleBatch": {
+			in:   params(MaxParametersPerRequest),
+			want: []int{MaxParametersPerRequest},
+		},
+		"MultipleBatches": {
+			in:   params(2*MaxParametersPerRequest + 1),
+			want: []int{MaxParametersPerRequest, MaxParametersPerRequest, 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []int
+			for _, b := range SplitParameters(tc.in) {
+				got = append(got, len(b))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []rds.Tag
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []v1beta1.Tag
+		observed []rds.Tag
+		want
+	}{
+		"UpToDate": {
+			desired:  []v1beta1.Tag{{Key: name, Value: value}},
+			observed: []rds.Tag{{Key: aws.String(name), Value: aws.String(value)}},
+		},
+		"Changed": {
+			desired: []v1beta1.Tag{{Key: name, Value: value}},
+			observed: []rds.Tag{
+				{Key: aws.String(name), Value: aws.String("old")},
+				{Key: aws.String("removed"), Value: aws.String(value)},
+			},
+			want: want{
+				add:    []rds.Tag{{Key: aws.String(name), Value: aws.String(value)}},
+				remove: []string{"removed"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetParameters(t *testing.T) {
+	errBoom := errors.New("boom")
+	next := "next"
+
+	type want struct {
+		params []rds.Parameter
+		err    error
+	}
+
+	cases := map[string]struct {
+		pages map[string]*rds.DescribeDBClusterParametersOutput
+		err   error
+		want
+	}{
+		"MultiplePages": {
+			pages: map[string]*rds.DescribeDBClusterParametersOutput{
+				"": {
+					Parameters: []rds.Parameter{{ParameterName: aws.String(name), ParameterValue: aws.String(value)}},
+					Marker:     aws.String(next),
+				},
+				next: {
+					Parameters: []rds.Parameter{{ParameterName: aws.String("wait_timeout"), ParameterValue: aws.String("60")}},
+				},
+			},
+			want: want{
+				params: []rds.Parameter{
+					{ParameterName: aws.String(name), ParameterValue: aws.String(value)},
+					{ParameterName: aws.String("wait_timeout"), ParameterValue: aws.String("60")},
+				},
+			},
+		},
+		"Failed": {
+			pages: map[string]*rds.DescribeDBClusterParametersOutput{"": {}},
+			err:   errBoom,
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			c := DBClusterParameterGroups(&fake.MockDBClusterParameterGroupClient{
+				MockDescribeParameters: func(i *rds.DescribeDBClusterParametersInput) rds.DescribeDBClusterParametersRequest {
+					return rds.DescribeDBClusterParametersRequest{
+						Request: &awsv2.Request{HTTPRequest: &http.Request{}, Retryer: awsv2.NoOpRetryer{}, Error: tc.err, Data: tc.pages[aws.StringValue(i.Marker)]},
+					}
+				},
+			})
+			params, err := GetParameters(context.Background(), c, name)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.params, params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -41,7 +41,8 @@ import (
 )
 
 const (
-	errGetPasswordSecretFailed = "cannot get password secret"
+	errGetPasswordSecretFailed   = "cannot get password secret"
+	errGetConnectionSecretFailed = "cannot get connection secret"
 )
 
 // Client defines RDS RDSClient operations
@@ -51,6 +52,11 @@ type Client interface {
 	ModifyDBInstanceRequest(*rds.ModifyDBInstanceInput) rds.ModifyDBInstanceRequest
 	DeleteDBInstanceRequest(*rds.DeleteDBInstanceInput) rds.DeleteDBInstanceRequest
 	AddTagsToResourceRequest(*rds.AddTagsToResourceInput) rds.AddTagsToResourceRequest
+	RestoreDBInstanceFromDBSnapshotRequest(*rds.RestoreDBInstanceFromDBSnapshotInput) rds.RestoreDBInstanceFromDBSnapshotRequest
+	RestoreDBInstanceToPointInTimeRequest(*rds.RestoreDBInstanceToPointInTimeInput) rds.RestoreDBInstanceToPointInTimeRequest
+	RestoreDBInstanceFromS3Request(*rds.RestoreDBInstanceFromS3Input) rds.RestoreDBInstanceFromS3Request
+	CreateDBInstanceReadReplicaRequest(*rds.CreateDBInstanceReadReplicaInput) rds.CreateDBInstanceReadReplicaRequest
+	PromoteReadReplicaRequest(*rds.PromoteReadReplicaInput) rds.PromoteReadReplicaRequest
 }
 
 // NewClient creates new RDS RDSClient with provided AWS Configurations/Credentials
@@ -120,27 +126,207 @@ func GenerateCreateDBInstanceInput(name, password string, p *v1beta1.RDSInstance
 		StorageType:                        p.StorageType,
 		VpcSecurityGroupIds:                p.VPCSecurityGroupIDs,
 	}
-	if len(p.ProcessorFeatures) != 0 {
-		c.ProcessorFeatures = make([]rds.ProcessorFeature, len(p.ProcessorFeatures))
-		for i, val := range p.ProcessorFeatures {
-			c.ProcessorFeatures[i] = rds.ProcessorFeature{
-				Name:  aws.String(val.Name),
-				Value: aws.String(val.Value),
-			}
-		}
+	c.ProcessorFeatures = generateProcessorFeatures(p.ProcessorFeatures)
+	c.Tags = GenerateTags(p.Tags)
+	return c
+}
+
+// GenerateRestoreDBInstanceFromSnapshotInput from RDSInstanceSpec
+func GenerateRestoreDBInstanceFromSnapshotInput(name string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceFromDBSnapshotInput {
+	c := &rds.RestoreDBInstanceFromDBSnapshotInput{
+		DBInstanceIdentifier:            aws.String(name),
+		AutoMinorVersionUpgrade:         p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                p.AvailabilityZone,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DBInstanceClass:                 aws.String(p.DBInstanceClass),
+		DBName:                          p.DBName,
+		DBParameterGroupName:            p.DBParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		Domain:                          p.Domain,
+		DomainIAMRoleName:               p.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		Engine:                          awsclients.String(p.Engine),
+		Iops:                            awsclients.Int64Address(p.IOPS),
+		LicenseModel:                    p.LicenseModel,
+		MultiAZ:                         p.MultiAZ,
+		OptionGroupName:                 p.OptionGroupName,
+		Port:                            awsclients.Int64Address(p.Port),
+		ProcessorFeatures:               generateProcessorFeatures(p.ProcessorFeatures),
+		PubliclyAccessible:              p.PubliclyAccessible,
+		StorageType:                     p.StorageType,
+		Tags:                            GenerateTags(p.Tags),
+		UseDefaultProcessorFeatures:     p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if p.RestoreFrom != nil && p.RestoreFrom.Snapshot != nil {
+		c.DBSnapshotIdentifier = aws.String(p.RestoreFrom.Snapshot.SnapshotIdentifier)
 	}
-	if len(p.Tags) != 0 {
-		c.Tags = make([]rds.Tag, len(p.Tags))
-		for i, val := range p.Tags {
-			c.Tags[i] = rds.Tag{
-				Key:   aws.String(val.Key),
-				Value: aws.String(val.Value),
-			}
+	return c
+}
+
+// GenerateRestoreDBInstanceToPointInTimeInput from RDSInstanceSpec
+func GenerateRestoreDBInstanceToPointInTimeInput(name string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceToPointInTimeInput {
+	c := &rds.RestoreDBInstanceToPointInTimeInput{
+		TargetDBInstanceIdentifier:      aws.String(name),
+		AutoMinorVersionUpgrade:         p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                p.AvailabilityZone,
+		CopyTagsToSnapshot:              p.CopyTagsToSnapshot,
+		DBInstanceClass:                 aws.String(p.DBInstanceClass),
+		DBName:                          p.DBName,
+		DBParameterGroupName:            p.DBParameterGroupName,
+		DBSubnetGroupName:               p.DBSubnetGroupName,
+		DeletionProtection:              p.DeletionProtection,
+		Domain:                          p.Domain,
+		DomainIAMRoleName:               p.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:     p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication: p.EnableIAMDatabaseAuthentication,
+		Engine:                          awsclients.String(p.Engine),
+		Iops:                            awsclients.Int64Address(p.IOPS),
+		LicenseModel:                    p.LicenseModel,
+		MultiAZ:                         p.MultiAZ,
+		OptionGroupName:                 p.OptionGroupName,
+		Port:                            awsclients.Int64Address(p.Port),
+		ProcessorFeatures:               generateProcessorFeatures(p.ProcessorFeatures),
+		PubliclyAccessible:              p.PubliclyAccessible,
+		StorageType:                     p.StorageType,
+		Tags:                            GenerateTags(p.Tags),
+		UseDefaultProcessorFeatures:     p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:             p.VPCSecurityGroupIDs,
+	}
+	if p.RestoreFrom != nil && p.RestoreFrom.PointInTime != nil {
+		pit := p.RestoreFrom.PointInTime
+		c.SourceDBInstanceIdentifier = pit.SourceDBInstanceIdentifier
+		c.SourceDbiResourceId = pit.SourceDbiResourceID
+		c.UseLatestRestorableTime = pit.UseLatestRestorableTime
+		if pit.RestoreTime != nil {
+			c.RestoreTime = &pit.RestoreTime.Time
 		}
 	}
 	return c
 }
 
+// GenerateRestoreDBInstanceFromS3Input from RDSInstanceSpec
+func GenerateRestoreDBInstanceFromS3Input(name, password string, p *v1beta1.RDSInstanceParameters) *rds.RestoreDBInstanceFromS3Input {
+	c := &rds.RestoreDBInstanceFromS3Input{
+		DBInstanceIdentifier:               aws.String(name),
+		AllocatedStorage:                   awsclients.Int64Address(p.AllocatedStorage),
+		AutoMinorVersionUpgrade:            p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                   p.AvailabilityZone,
+		BackupRetentionPeriod:              awsclients.Int64Address(p.BackupRetentionPeriod),
+		CopyTagsToSnapshot:                 p.CopyTagsToSnapshot,
+		DBInstanceClass:                    aws.String(p.DBInstanceClass),
+		DBName:                             p.DBName,
+		DBParameterGroupName:               p.DBParameterGroupName,
+		DBSecurityGroups:                   p.DBSecurityGroups,
+		DBSubnetGroupName:                  p.DBSubnetGroupName,
+		DeletionProtection:                 p.DeletionProtection,
+		EnableCloudwatchLogsExports:        p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication:    p.EnableIAMDatabaseAuthentication,
+		EnablePerformanceInsights:          p.EnablePerformanceInsights,
+		Engine:                             aws.String(p.Engine),
+		EngineVersion:                      p.EngineVersion,
+		Iops:                               awsclients.Int64Address(p.IOPS),
+		KmsKeyId:                           p.KMSKeyID,
+		LicenseModel:                       p.LicenseModel,
+		MasterUserPassword:                 awsclients.String(password),
+		MasterUsername:                     p.MasterUsername,
+		MonitoringInterval:                 awsclients.Int64Address(p.MonitoringInterval),
+		MonitoringRoleArn:                  p.MonitoringRoleARN,
+		MultiAZ:                            p.MultiAZ,
+		OptionGroupName:                    p.OptionGroupName,
+		PerformanceInsightsKMSKeyId:        p.PerformanceInsightsKMSKeyID,
+		PerformanceInsightsRetentionPeriod: awsclients.Int64Address(p.PerformanceInsightsRetentionPeriod),
+		Port:                               awsclients.Int64Address(p.Port),
+		PreferredBackupWindow:              p.PreferredBackupWindow,
+		PreferredMaintenanceWindow:         p.PreferredMaintenanceWindow,
+		ProcessorFeatures:                  generateProcessorFeatures(p.ProcessorFeatures),
+		PubliclyAccessible:                 p.PubliclyAccessible,
+		StorageEncrypted:                   p.StorageEncrypted,
+		StorageType:                        p.StorageType,
+		Tags:                               GenerateTags(p.Tags),
+		UseDefaultProcessorFeatures:        p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:                p.VPCSecurityGroupIDs,
+	}
+	if p.RestoreFrom != nil && p.RestoreFrom.S3 != nil {
+		c.S3BucketName = aws.String(p.RestoreFrom.S3.BucketName)
+		c.S3IngestionRoleArn = aws.String(p.RestoreFrom.S3.IngestionRoleARN)
+		c.S3Prefix = p.RestoreFrom.S3.Prefix
+		c.SourceEngine = aws.String(p.RestoreFrom.S3.SourceEngine)
+		c.SourceEngineVersion = aws.String(p.RestoreFrom.S3.SourceEngineVersion)
+	}
+	return c
+}
+
+// GenerateCreateDBInstanceReadReplicaInput from RDSInstanceSpec
+func GenerateCreateDBInstanceReadReplicaInput(name string, p *v1beta1.RDSInstanceParameters) *rds.CreateDBInstanceReadReplicaInput {
+	return &rds.CreateDBInstanceReadReplicaInput{
+		DBInstanceIdentifier:               aws.String(name),
+		SourceDBInstanceIdentifier:         p.SourceDBInstanceIdentifier,
+		SourceRegion:                       p.SourceRegion,
+		AutoMinorVersionUpgrade:            p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                   p.AvailabilityZone,
+		CopyTagsToSnapshot:                 p.CopyTagsToSnapshot,
+		DBInstanceClass:                    aws.String(p.DBInstanceClass),
+		DBParameterGroupName:               p.DBParameterGroupName,
+		DBSubnetGroupName:                  p.DBSubnetGroupName,
+		DeletionProtection:                 p.DeletionProtection,
+		Domain:                             p.Domain,
+		DomainIAMRoleName:                  p.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:        p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication:    p.EnableIAMDatabaseAuthentication,
+		EnablePerformanceInsights:          p.EnablePerformanceInsights,
+		Iops:                               awsclients.Int64Address(p.IOPS),
+		KmsKeyId:                           p.KMSKeyID,
+		MonitoringInterval:                 awsclients.Int64Address(p.MonitoringInterval),
+		MonitoringRoleArn:                  p.MonitoringRoleARN,
+		MultiAZ:                            p.MultiAZ,
+		OptionGroupName:                    p.OptionGroupName,
+		PerformanceInsightsKMSKeyId:        p.PerformanceInsightsKMSKeyID,
+		PerformanceInsightsRetentionPeriod: awsclients.Int64Address(p.PerformanceInsightsRetentionPeriod),
+		Port:                               awsclients.Int64Address(p.Port),
+		ProcessorFeatures:                  generateProcessorFeatures(p.ProcessorFeatures),
+		PubliclyAccessible:                 p.PubliclyAccessible,
+		StorageType:                        p.StorageType,
+		Tags:                               GenerateTags(p.Tags),
+		UseDefaultProcessorFeatures:        p.UseDefaultProcessorFeatures,
+		VpcSecurityGroupIds:                p.VPCSecurityGroupIDs,
+	}
+}
+
+// IsReadReplica returns true if the supplied parameters describe a Read
+// Replica.
+func IsReadReplica(p *v1beta1.RDSInstanceParameters) bool {
+	return aws.StringValue(p.SourceDBInstanceIdentifier) != ""
+}
+
+// IsReadReplicaInstance returns true if the supplied DB instance is a Read
+// Replica.
+func IsReadReplicaInstance(db rds.DBInstance) bool {
+	return aws.StringValue(db.ReadReplicaSourceDBInstanceIdentifier) != ""
+}
+
+// NeedsPromotion returns true if the supplied DB instance is a Read Replica
+// whose source is no longer set in the supplied parameters.
+func NeedsPromotion(p *v1beta1.RDSInstanceParameters, db rds.DBInstance) bool {
+	return !IsReadReplica(p) && IsReadReplicaInstance(db)
+}
+
+func generateProcessorFeatures(in []v1beta1.ProcessorFeature) []rds.ProcessorFeature {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]rds.ProcessorFeature, len(in))
+	for i, val := range in {
+		out[i] = rds.ProcessorFeature{
+			Name:  aws.String(val.Name),
+			Value: aws.String(val.Value),
+		}
+	}
+	return out
+}
+
 // CreatePatch creates a *v1beta1.RDSInstanceParameters that has only the changed
 // values between the target *v1beta1.RDSInstanceParameters and the current
 // *rds.DBInstance
@@ -450,6 +636,11 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 	if err != nil {
 		return false, err
 	}
+	pwdChanged = pwdChanged || r.Status.AtProvider.MasterUserPasswordPending
+	// Read Replicas inherit the master password of their source.
+	if IsReadReplicaInstance(db) {
+		pwdChanged = false
+	}
 	patch, err := CreatePatch(&db, &r.Spec.ForProvider)
 	if err != nil {
 		return false, err
@@ -463,28 +654,37 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ApplyModificationsImmediately"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowMajorVersionUpgrade"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
-	) && !pwdChanged, nil
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "RestoreFrom"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "SourceDBInstanceIdentifier"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "SourceRegion"),
+	) && !pwdChanged && !NeedsPromotion(&r.Spec.ForProvider, db), nil
 }
 
 // GetPassword fetches the referenced input password for an RDSInstance CRD and determines whether it has changed or not
 func GetPassword(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance) (newPwd string, changed bool, err error) {
-	if r.Spec.ForProvider.MasterPasswordSecretRef == nil {
+	return getPassword(ctx, kube, r.Spec.ForProvider.MasterPasswordSecretRef, r.Spec.WriteConnectionSecretToReference)
+}
+
+// getPassword fetches the password referenced by in and determines whether it
+// differs from the one stored in the connection secret referenced by out.
+func getPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, out *xpv1.SecretReference) (newPwd string, changed bool, err error) {
+	if in == nil {
 		return "", false, nil
 	}
 	nn := types.NamespacedName{
-		Name:      r.Spec.ForProvider.MasterPasswordSecretRef.Name,
-		Namespace: r.Spec.ForProvider.MasterPasswordSecretRef.Namespace,
+		Name:      in.Name,
+		Namespace: in.Namespace,
 	}
 	s := &corev1.Secret{}
 	if err := kube.Get(ctx, nn, s); err != nil {
 		return "", false, errors.Wrap(err, errGetPasswordSecretFailed)
 	}
-	newPwd = string(s.Data[r.Spec.ForProvider.MasterPasswordSecretRef.Key])
+	newPwd = string(s.Data[in.Key])
 
-	if r.Spec.WriteConnectionSecretToReference != nil {
+	if out != nil {
 		nn = types.NamespacedName{
-			Name:      r.Spec.WriteConnectionSecretToReference.Name,
-			Namespace: r.Spec.WriteConnectionSecretToReference.Namespace,
+			Name:      out.Name,
+			Namespace: out.Namespace,
 		}
 		s = &corev1.Secret{}
 		// the output secret may not exist yet, so we can skip returning an
@@ -500,6 +700,20 @@ func GetPassword(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance
 	return newPwd, changed, nil
 }
 
+// GetConnectionPassword returns the password that is published in the
+// connection secret of the supplied RDSInstance, if any.
+func GetConnectionPassword(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance) (string, error) {
+	ref := r.Spec.WriteConnectionSecretToReference
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(resource.IgnoreNotFound(err), errGetConnectionSecretFailed)
+	}
+	return string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey]), nil
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of v1beta1.RDSInstance.
 func GetConnectionDetails(in v1beta1.RDSInstance) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
@@ -179,6 +179,57 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"ReadReplica": {
+			args: args{
+				db: rds.DBInstance{
+					DBName:                                &dbName,
+					ReadReplicaSourceDBInstanceIdentifier: &name,
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName:                     &dbName,
+							SourceDBInstanceIdentifier: &name,
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		"MasterUserPasswordPending": {
+			args: args{
+				db: rds.DBInstance{
+					DBName: &dbName,
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName: &dbName,
+						},
+					},
+					Status: v1beta1.RDSInstanceStatus{
+						AtProvider: v1beta1.RDSInstanceObservation{MasterUserPasswordPending: true},
+					},
+				},
+			},
+			want: false,
+		},
+		"ReadReplicaNeedsPromotion": {
+			args: args{
+				db: rds.DBInstance{
+					DBName:                                &dbName,
+					ReadReplicaSourceDBInstanceIdentifier: &name,
+				},
+				r: v1beta1.RDSInstance{
+					Spec: v1beta1.RDSInstanceSpec{
+						ForProvider: v1beta1.RDSInstanceParameters{
+							DBName: &dbName,
+						},
+					},
+				},
+			},
+			want: false,
+		},
 		"DifferentFields": {
 			args: args{
 				db: rds.DBInstance{
@@ -1181,3 +1232,61 @@ func TestGenerateModifyDBInstanceInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRestoreDBInstanceToPointInTimeInput(t *testing.T) {
+	restoreTime := metav1.NewTime(time.Date(2020, 9, 1, 12, 0, 0, 0, time.UTC))
+	cases := map[string]struct {
+		params v1beta1.RDSInstanceParameters
+		want   rds.RestoreDBInstanceToPointInTimeInput
+	}{
+		"RestoreTime": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass: instanceClass,
+				Port:            &port,
+				Tags:            []v1beta1.Tag{{Key: name, Value: value}},
+				RestoreFrom: &v1beta1.RestoreBackupConfiguration{
+					Source: v1beta1.RestoreSourcePointInTime,
+					PointInTime: &v1beta1.PointInTimeRestoreBackupConfiguration{
+						SourceDBInstanceIdentifier: &dbName,
+						RestoreTime:                &restoreTime,
+					},
+				},
+			},
+			want: rds.RestoreDBInstanceToPointInTimeInput{
+				TargetDBInstanceIdentifier: &name,
+				DBInstanceClass:            &instanceClass,
+				Port:                       &port64,
+				Tags:                       []rds.Tag{{Key: &name, Value: &value}},
+				SourceDBInstanceIdentifier: &dbName,
+				RestoreTime:                &restoreTime.Time,
+			},
+		},
+		"LatestRestorableTime": {
+			params: v1beta1.RDSInstanceParameters{
+				DBInstanceClass: instanceClass,
+				RestoreFrom: &v1beta1.RestoreBackupConfiguration{
+					Source: v1beta1.RestoreSourcePointInTime,
+					PointInTime: &v1beta1.PointInTimeRestoreBackupConfiguration{
+						SourceDbiResourceID:     &resourceID,
+						UseLatestRestorableTime: &trueFlag,
+					},
+				},
+			},
+			want: rds.RestoreDBInstanceToPointInTimeInput{
+				TargetDBInstanceIdentifier: &name,
+				DBInstanceClass:            &instanceClass,
+				SourceDbiResourceId:        &resourceID,
+				UseLatestRestorableTime:    &trueFlag,
+			},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			got := GenerateRestoreDBInstanceToPointInTimeInput(name, &tc.params)
+			if diff := cmp.Diff(&tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -103,8 +103,17 @@ type BucketClient interface {
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
-func NewClient(cfg aws.Config) BucketClient {
-	return s3.New(cfg)
+// The client uses path-style addressing if pathStyle is true.
+func NewClient(cfg aws.Config, pathStyle bool) BucketClient {
+	return newS3Client(cfg, pathStyle)
+}
+
+// newS3Client returns a new S3 client that uses path-style addressing if
+// pathStyle is true.
+func newS3Client(cfg aws.Config, pathStyle bool) *s3.Client {
+	c := s3.New(cfg)
+	c.ForcePathStyle = pathStyle
+	return c
 }
 
 // IsNotFound helper function to test for NotFound error
@@ -17,13 +17,12 @@ limitations under the License.
 package s3
 
 import (
-	"fmt"
-
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha2"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 // BucketPolicyClient is the external client used for S3BucketPolicy Custom Resource
@@ -33,9 +32,10 @@ type BucketPolicyClient interface {
 	DeleteBucketPolicyRequest(input *s3.DeleteBucketPolicyInput) s3.DeleteBucketPolicyRequest
 }
 
-// NewBucketPolicyClient returns a new client given an aws config
-func NewBucketPolicyClient(cfg aws.Config) BucketPolicyClient {
-	return s3.New(cfg)
+// NewBucketPolicyClient returns a new client given an aws config. The client
+// uses path-style addressing if pathStyle is true.
+func NewBucketPolicyClient(cfg aws.Config, pathStyle bool) BucketPolicyClient {
+	return newS3Client(cfg, pathStyle)
 }
 
 // IsErrorPolicyNotFound returns true if the error code indicates that the item was not found
@@ -56,89 +56,21 @@ func IsErrorBucketNotFound(err error) bool {
 
 // Serialize is the custom marshaller for the BucketPolicyParameters
 func Serialize(p v1alpha2.BucketPolicyParameters) (interface{}, error) {
-	m := make(map[string]interface{})
-	m["Version"] = p.Version
-	if p.ID != "" {
-		m["Id"] = p.ID
-	}
-	slc := make([]interface{}, len(p.Statements))
+	statements := make([]awsclients.PolicyStatement, len(p.Statements))
 	for i, v := range p.Statements {
-		msg, err := SerializeBucketPolicyStatement(v)
-		if err != nil {
-			return nil, err
-		}
-		slc[i] = msg
+		statements[i] = generatePolicyStatement(v)
 	}
-	m["Statement"] = slc
-	return m, nil
+	return awsclients.SerializePolicy(p.Version, p.ID, statements)
 }
 
 // SerializeBucketPolicyStatement is the custom marshaller for the BucketPolicyStatement
-func SerializeBucketPolicyStatement(p v1alpha2.BucketPolicyStatement) (interface{}, error) { // nolint:gocyclo
-	m := make(map[string]interface{})
-	if p.Principal != nil {
-		principal, err := SerializeBucketPrincipal(p.Principal)
-		if err != nil {
-			return nil, err
-		}
-		m["Principal"] = principal
-	}
-	if p.NotPrincipal != nil {
-		notPrincipal, err := SerializeBucketPrincipal(p.NotPrincipal)
-		if err != nil {
-			return nil, err
-		}
-		m["NotPrincipal"] = notPrincipal
-	}
-	if checkExistsArray(p.Action) {
-		m["Action"] = tryFirst(p.Action)
-	}
-	if checkExistsArray(p.NotAction) {
-		m["NotAction"] = tryFirst(p.NotAction)
-	}
-	if checkExistsArray(p.Resource) {
-		m["Resource"] = tryFirst(p.Resource)
-	}
-	if checkExistsArray(p.NotResource) {
-		m["NotResource"] = tryFirst(p.NotResource)
-	}
-	if p.Condition != nil {
-		condition, err := SerializeBucketCondition(p.Condition)
-		if err != nil {
-			return nil, err
-		}
-		m["Condition"] = condition
-	}
-	m["Effect"] = p.Effect
-	if p.SID != nil {
-		m["Sid"] = *p.SID
-	}
-	return m, nil
+func SerializeBucketPolicyStatement(p v1alpha2.BucketPolicyStatement) (interface{}, error) {
+	return awsclients.SerializePolicyStatement(generatePolicyStatement(p))
 }
 
 // SerializeBucketPrincipal is the custom serializer for the BucketPrincipal
 func SerializeBucketPrincipal(p *v1alpha2.BucketPrincipal) (interface{}, error) {
-	all := "*"
-	if p.AllowAnon {
-		return all, nil
-	}
-	m := make(map[string]interface{})
-	if p.Service != nil {
-		m["Service"] = tryFirst(p.Service)
-	}
-	if p.Federated != nil {
-		m["Federated"] = aws.StringValue(p.Federated)
-	}
-	if len(p.AWSPrincipals) == 1 {
-		m["AWS"] = aws.StringValue(SerializeAWSPrincipal(p.AWSPrincipals[0]))
-	} else if len(p.AWSPrincipals) > 1 {
-		values := make([]interface{}, len(p.AWSPrincipals))
-		for i := range p.AWSPrincipals {
-			values[i] = aws.StringValue(SerializeAWSPrincipal(p.AWSPrincipals[i]))
-		}
-		m["AWS"] = values
-	}
-	return m, nil
+	return awsclients.SerializePolicyPrincipal(generatePolicyPrincipal(p)), nil
 }
 
 // SerializeAWSPrincipal converts an AWSPrincipal to a string
@@ -158,33 +90,51 @@ func SerializeAWSPrincipal(p v1alpha2.AWSPrincipal) *string {
 // SerializeBucketCondition converts the string -> Condition map
 // into a serialized version
 func SerializeBucketCondition(p map[string]v1alpha2.Condition) (interface{}, error) {
-	m := make(map[string]interface{})
-	for k, v := range p {
-		subMap := make(map[string]interface{})
-		switch {
-		case v.ConditionStringValue != nil:
-			subMap[v.ConditionKey] = *v.ConditionStringValue
-		case v.ConditionBooleanValue != nil:
-			subMap[v.ConditionKey] = *v.ConditionBooleanValue
-		case v.ConditionNumericValue != nil:
-			subMap[v.ConditionKey] = *v.ConditionNumericValue
-		case v.ConditionDateValue != nil:
-			subMap[v.ConditionKey] = v.ConditionDateValue.Time.Format("2006-01-02T15:04:05-0700")
-		default:
-			return nil, fmt.Errorf("no value provided for key with value %s, condition %s", v.ConditionKey, k)
-		}
-		m[k] = subMap
+	return awsclients.SerializePolicyCondition(generatePolicyCondition(p))
+}
+
+func generatePolicyStatement(p v1alpha2.BucketPolicyStatement) awsclients.PolicyStatement {
+	return awsclients.PolicyStatement{
+		SID:          p.SID,
+		Effect:       p.Effect,
+		Principal:    generatePolicyPrincipal(p.Principal),
+		NotPrincipal: generatePolicyPrincipal(p.NotPrincipal),
+		Action:       p.Action,
+		NotAction:    p.NotAction,
+		Resource:     p.Resource,
+		NotResource:  p.NotResource,
+		Condition:    generatePolicyCondition(p.Condition),
 	}
-	return m, nil
 }
 
-func checkExistsArray(slc []string) bool {
-	return len(slc) != 0
+func generatePolicyPrincipal(p *v1alpha2.BucketPrincipal) *awsclients.PolicyPrincipal {
+	if p == nil {
+		return nil
+	}
+	pp := &awsclients.PolicyPrincipal{
+		AllowAnon: p.AllowAnon,
+		Federated: p.Federated,
+		Service:   p.Service,
+	}
+	for _, a := range p.AWSPrincipals {
+		pp.AWSPrincipals = append(pp.AWSPrincipals, SerializeAWSPrincipal(a))
+	}
+	return pp
 }
 
-func tryFirst(slc []string) interface{} {
-	if len(slc) == 1 {
-		return slc[0]
+func generatePolicyCondition(p map[string]v1alpha2.Condition) map[string]awsclients.PolicyCondition {
+	if p == nil {
+		return nil
+	}
+	m := make(map[string]awsclients.PolicyCondition, len(p))
+	for k, v := range p {
+		m[k] = awsclients.PolicyCondition{
+			Key:          v.ConditionKey,
+			StringValue:  v.ConditionStringValue,
+			DateValue:    v.ConditionDateValue,
+			NumericValue: v.ConditionNumericValue,
+			BooleanValue: v.ConditionBooleanValue,
+		}
 	}
-	return slc
+	return m
 }
@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/secretsmanager"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SecretClient = (*MockSecretClient)(nil)
+
+// MockSecretClient is a type that implements all the methods for SecretClient interface
+type MockSecretClient struct {
+	MockCreate       func(*secretsmanager.CreateSecretInput) secretsmanager.CreateSecretRequest
+	MockDescribe     func(*secretsmanager.DescribeSecretInput) secretsmanager.DescribeSecretRequest
+	MockGetValue     func(*secretsmanager.GetSecretValueInput) secretsmanager.GetSecretValueRequest
+	MockPutValue     func(*secretsmanager.PutSecretValueInput) secretsmanager.PutSecretValueRequest
+	MockUpdate       func(*secretsmanager.UpdateSecretInput) secretsmanager.UpdateSecretRequest
+	MockRotate       func(*secretsmanager.RotateSecretInput) secretsmanager.RotateSecretRequest
+	MockCancelRotate func(*secretsmanager.CancelRotateSecretInput) secretsmanager.CancelRotateSecretRequest
+	MockRestore      func(*secretsmanager.RestoreSecretInput) secretsmanager.RestoreSecretRequest
+	MockDelete       func(*secretsmanager.DeleteSecretInput) secretsmanager.DeleteSecretRequest
+	MockTag          func(*secretsmanager.TagResourceInput) secretsmanager.TagResourceRequest
+	MockUntag        func(*secretsmanager.UntagResourceInput) secretsmanager.UntagResourceRequest
+}
+
+// CreateSecretRequest mocks CreateSecretRequest method
+func (m *MockSecretClient) CreateSecretRequest(input *secretsmanager.CreateSecretInput) secretsmanager.CreateSecretRequest {
+	return m.MockCreate(input)
+}
+
+// DescribeSecretRequest mocks DescribeSecretRequest method
+func (m *MockSecretClient) DescribeSecretRequest(input *secretsmanager.DescribeSecretInput) secretsmanager.DescribeSecretRequest {
+	return m.MockDescribe(input)
+}
+
+// GetSecretValueRequest mocks GetSecretValueRequest method
+func (m *MockSecretClient) GetSecretValueRequest(input *secretsmanager.GetSecretValueInput) secretsmanager.GetSecretValueRequest {
+	return m.MockGetValue(input)
+}
+
+// PutSecretValueRequest mocks PutSecretValueRequest method
+func (m *MockSecretClient) PutSecretValueRequest(input *secretsmanager.PutSecretValueInput) secretsmanager.PutSecretValueRequest {
+	return m.MockPutValue(input)
+}
+
+// UpdateSecretRequest mocks UpdateSecretRequest method
+func (m *MockSecretClient) UpdateSecretRequest(input *secretsmanager.UpdateSecretInput) secretsmanager.UpdateSecretRequest {
+	return m.MockUpdate(input)
+}
+
+// RotateSecretRequest mocks RotateSecretRequest method
+func (m *MockSecretClient) RotateSecretRequest(input *secretsmanager.RotateSecretInput) secretsmanager.RotateSecretRequest {
+	return m.MockRotate(input)
+}
+
+// CancelRotateSecretRequest mocks CancelRotateSecretRequest method
+func (m *MockSecretClient) CancelRotateSecretRequest(input *secretsmanager.CancelRotateSecretInput) secretsmanager.CancelRotateSecretRequest {
+	return m.MockCancelRotate(input)
+}
+
+// RestoreSecretRequest mocks RestoreSecretRequest method
+func (m *MockSecretClient) RestoreSecretRequest(input *secretsmanager.RestoreSecretInput) secretsmanager.RestoreSecretRequest {
+	return m.MockRestore(input)
+}
+
+// DeleteSecretRequest mocks DeleteSecretRequest method
+func (m *MockSecretClient) DeleteSecretRequest(input *secretsmanager.DeleteSecretInput) secretsmanager.DeleteSecretRequest {
+	return m.MockDelete(input)
+}
+
+// TagResourceRequest mocks TagResourceRequest method
+func (m *MockSecretClient) TagResourceRequest(input *secretsmanager.TagResourceInput) secretsmanager.TagResourceRequest {
+	return m.MockTag(input)
+}
+
+// UntagResourceRequest mocks UntagResourceRequest method
+func (m *MockSecretClient) UntagResourceRequest(input *secretsmanager.UntagResourceInput) secretsmanager.UntagResourceRequest {
+	return m.MockUntag(input)
+}
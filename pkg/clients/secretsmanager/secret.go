@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// VersionStageCurrent is the staging label of the current version of a
+	// secret.
+	VersionStageCurrent = "AWSCURRENT"
+
+	errGetValueSecret = "cannot get the Kubernetes secret that holds the value of the secret"
+	errMarshalValue   = "cannot marshal the data of the Kubernetes secret"
+)
+
+// SecretClient is the external client used for Secret Custom Resource
+type SecretClient interface {
+	CreateSecretRequest(*secretsmanager.CreateSecretInput) secretsmanager.CreateSecretRequest
+	DescribeSecretRequest(*secretsmanager.DescribeSecretInput) secretsmanager.DescribeSecretRequest
+	GetSecretValueRequest(*secretsmanager.GetSecretValueInput) secretsmanager.GetSecretValueRequest
+	PutSecretValueRequest(*secretsmanager.PutSecretValueInput) secretsmanager.PutSecretValueRequest
+	UpdateSecretRequest(*secretsmanager.UpdateSecretInput) secretsmanager.UpdateSecretRequest
+	RotateSecretRequest(*secretsmanager.RotateSecretInput) secretsmanager.RotateSecretRequest
+	CancelRotateSecretRequest(*secretsmanager.CancelRotateSecretInput) secretsmanager.CancelRotateSecretRequest
+	RestoreSecretRequest(*secretsmanager.RestoreSecretInput) secretsmanager.RestoreSecretRequest
+	DeleteSecretRequest(*secretsmanager.DeleteSecretInput) secretsmanager.DeleteSecretRequest
+	TagResourceRequest(*secretsmanager.TagResourceInput) secretsmanager.TagResourceRequest
+	UntagResourceRequest(*secretsmanager.UntagResourceInput) secretsmanager.UntagResourceRequest
+}
+
+// NewSecretClient returns a new client using AWS credentials as JSON encoded data.
+func NewSecretClient(cfg aws.Config) SecretClient {
+	return secretsmanager.New(cfg)
+}
+
+// IsNotFound returns true if the error is because the item doesn't exist
+func IsNotFound(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValueManaged returns true if the value of the secret is synced from a
+// Kubernetes Secret. The value of a secret that is rotated is owned by its
+// rotation function.
+func IsValueManaged(p v1alpha1.SecretParameters) bool {
+	return (p.StringSecretRef != nil || p.SecretRef != nil) && p.RotationLambdaARN == nil
+}
+
+// GetDesiredValue returns the value of the secret as given by the referenced
+// Kubernetes Secret, or nil if no Kubernetes Secret is referenced.
+func GetDesiredValue(ctx context.Context, kube client.Client, p v1alpha1.SecretParameters) (*string, error) {
+	switch {
+	case p.StringSecretRef != nil:
+		s := &corev1.Secret{}
+		nn := types.NamespacedName{Name: p.StringSecretRef.Name, Namespace: p.StringSecretRef.Namespace}
+		if err := kube.Get(ctx, nn, s); err != nil {
+			return nil, errors.Wrap(err, errGetValueSecret)
+		}
+		return aws.String(string(s.Data[p.StringSecretRef.Key])), nil
+	case p.SecretRef != nil:
+		s := &corev1.Secret{}
+		nn := types.NamespacedName{Name: p.SecretRef.Name, Namespace: p.SecretRef.Namespace}
+		if err := kube.Get(ctx, nn, s); err != nil {
+			return nil, errors.Wrap(err, errGetValueSecret)
+		}
+		data := make(map[string]string, len(s.Data))
+		for k, v := range s.Data {
+			data[k] = string(v)
+		}
+		// NOTE: json.Marshal sorts the keys of a map, so the same data always
+		// results in the same value.
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, errors.Wrap(err, errMarshalValue)
+		}
+		return aws.String(string(b)), nil
+	}
+	return nil, nil
+}
+
+// GenerateCreateSecretInput returns a CreateSecretInput from the given
+// SecretParameters.
+func GenerateCreateSecretInput(name string, p v1alpha1.SecretParameters, value *string) *secretsmanager.CreateSecretInput {
+	c := &secretsmanager.CreateSecretInput{
+		Name:         aws.String(name),
+		Description:  p.Description,
+		KmsKeyId:     p.KMSKeyID,
+		SecretString: value,
+	}
+	for k, v := range p.Tags {
+		c.Tags = append(c.Tags, secretsmanager.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return c
+}
+
+// GenerateTags returns the given Secrets Manager tags as a map.
+func GenerateTags(tags []secretsmanager.Tag) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	return m
+}
+
+// LateInitializeSecret fills the empty fields in *v1alpha1.SecretParameters
+// with the values seen in secretsmanager.DescribeSecretResponse.
+func LateInitializeSecret(in *v1alpha1.SecretParameters, o *secretsmanager.DescribeSecretResponse) {
+	if o == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, o.Description)
+	in.KMSKeyID = awsclients.LateInitializeStringPtr(in.KMSKeyID, o.KmsKeyId)
+}
+
+// GenerateSecretObservation is used to produce v1alpha1.SecretObservation
+// from secretsmanager.DescribeSecretResponse.
+func GenerateSecretObservation(o *secretsmanager.DescribeSecretResponse) v1alpha1.SecretObservation {
+	obs := v1alpha1.SecretObservation{
+		ARN:             aws.StringValue(o.ARN),
+		RotationEnabled: aws.BoolValue(o.RotationEnabled),
+	}
+	for id, stages := range o.VersionIdsToStages {
+		for _, s := range stages {
+			if s == VersionStageCurrent {
+				obs.VersionID = id
+			}
+		}
+	}
+	if o.LastChangedDate != nil {
+		t := metav1.NewTime(*o.LastChangedDate)
+		obs.LastChangedDate = &t
+	}
+	if o.LastRotatedDate != nil {
+		t := metav1.NewTime(*o.LastRotatedDate)
+		obs.LastRotatedDate = &t
+	}
+	if o.DeletedDate != nil {
+		t := metav1.NewTime(*o.DeletedDate)
+		obs.DeletedDate = &t
+	}
+	return obs
+}
+
+// IsSecretUpToDate checks whether there is a change in the description or
+// the encryption key of the secret.
+func IsSecretUpToDate(p v1alpha1.SecretParameters, o *secretsmanager.DescribeSecretResponse) bool {
+	return aws.StringValue(p.Description) == aws.StringValue(o.Description) &&
+		aws.StringValue(p.KMSKeyID) == aws.StringValue(o.KmsKeyId)
+}
+
+// IsRotationUpToDate checks whether the rotation of the secret is configured
+// as desired.
+func IsRotationUpToDate(p v1alpha1.SecretParameters, o *secretsmanager.DescribeSecretResponse) bool {
+	if p.RotationLambdaARN == nil {
+		return !aws.BoolValue(o.RotationEnabled)
+	}
+	if !aws.BoolValue(o.RotationEnabled) || aws.StringValue(p.RotationLambdaARN) != aws.StringValue(o.RotationLambdaARN) {
+		return false
+	}
+	if p.RotationRules == nil {
+		return true
+	}
+	return o.RotationRules != nil && p.RotationRules.AutomaticallyAfterDays == aws.Int64Value(o.RotationRules.AutomaticallyAfterDays)
+}
+
+// GenerateRotateSecretInput returns a RotateSecretInput from the given
+// SecretParameters.
+func GenerateRotateSecretInput(name string, p v1alpha1.SecretParameters) *secretsmanager.RotateSecretInput {
+	r := &secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(name),
+		RotationLambdaARN: p.RotationLambdaARN,
+	}
+	if p.RotationRules != nil {
+		r.RotationRules = &secretsmanager.RotationRulesType{AutomaticallyAfterDays: aws.Int64(p.RotationRules.AutomaticallyAfterDays)}
+	}
+	return r
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+)
+
+var (
+	secretARN = "arn:aws:secretsmanager:us-east-1:123456789012:secret:example-AbCdEf"
+	lambdaARN = "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	versionID = "EXAMPLE1-90ab-cdef-fedc-ba987SECRET1"
+
+	errBoom = errors.New("boom")
+)
+
+func TestGetDesiredValue(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			s := obj.(*corev1.Secret)
+			s.Data = map[string][]byte{"username": []byte("admin"), "password": []byte("s3cr3t")}
+			return nil
+		},
+	}
+
+	type want struct {
+		value *string
+		err   error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		p    v1alpha1.SecretParameters
+		want want
+	}{
+		"NoReference": {
+			kube: kube,
+			p:    v1alpha1.SecretParameters{},
+			want: want{},
+		},
+		"StringSecretRef": {
+			kube: kube,
+			p: v1alpha1.SecretParameters{
+				StringSecretRef: &xpv1.SecretKeySelector{Key: "password"},
+			},
+			want: want{value: aws.String("s3cr3t")},
+		},
+		"SecretRef": {
+			kube: kube,
+			p: v1alpha1.SecretParameters{
+				SecretRef: &xpv1.SecretReference{Name: "example", Namespace: "default"},
+			},
+			want: want{value: aws.String(`{"password":"s3cr3t","username":"admin"}`)},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			p: v1alpha1.SecretParameters{
+				StringSecretRef: &xpv1.SecretKeySelector{Key: "password"},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetValueSecret)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			value, err := GetDesiredValue(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.value, value); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSecretObservation(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		in   secretsmanager.DescribeSecretOutput
+		want v1alpha1.SecretObservation
+	}{
+		"AllFilled": {
+			in: secretsmanager.DescribeSecretOutput{
+				ARN:             aws.String(secretARN),
+				RotationEnabled: aws.Bool(true),
+				LastChangedDate: &now,
+				DeletedDate:     &now,
+				VersionIdsToStages: map[string][]string{
+					"previous": {"AWSPREVIOUS"},
+					versionID:  {VersionStageCurrent},
+				},
+			},
+			want: v1alpha1.SecretObservation{
+				ARN:             secretARN,
+				VersionID:       versionID,
+				RotationEnabled: true,
+				LastChangedDate: &metav1.Time{Time: now},
+				DeletedDate:     &metav1.Time{Time: now},
+			},
+		},
+		"Empty": {
+			in:   secretsmanager.DescribeSecretOutput{},
+			want: v1alpha1.SecretObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSecretObservation(&secretsmanager.DescribeSecretResponse{DescribeSecretOutput: &tc.in})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRotationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.SecretParameters
+		o    secretsmanager.DescribeSecretOutput
+		want bool
+	}{
+		"NotRotated": {
+			p:    v1alpha1.SecretParameters{},
+			o:    secretsmanager.DescribeSecretOutput{},
+			want: true,
+		},
+		"RotationNotWanted": {
+			p:    v1alpha1.SecretParameters{},
+			o:    secretsmanager.DescribeSecretOutput{RotationEnabled: aws.Bool(true), RotationLambdaARN: aws.String(lambdaARN)},
+			want: false,
+		},
+		"RotationNotEnabled": {
+			p:    v1alpha1.SecretParameters{RotationLambdaARN: aws.String(lambdaARN)},
+			o:    secretsmanager.DescribeSecretOutput{},
+			want: false,
+		},
+		"SameRules": {
+			p: v1alpha1.SecretParameters{
+				RotationLambdaARN: aws.String(lambdaARN),
+				RotationRules:     &v1alpha1.RotationRules{AutomaticallyAfterDays: 30},
+			},
+			o: secretsmanager.DescribeSecretOutput{
+				RotationEnabled:   aws.Bool(true),
+				RotationLambdaARN: aws.String(lambdaARN),
+				RotationRules:     &secretsmanager.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+			},
+			want: true,
+		},
+		"DifferentRules": {
+			p: v1alpha1.SecretParameters{
+				RotationLambdaARN: aws.String(lambdaARN),
+				RotationRules:     &v1alpha1.RotationRules{AutomaticallyAfterDays: 7},
+			},
+			o: secretsmanager.DescribeSecretOutput{
+				RotationEnabled:   aws.Bool(true),
+				RotationLambdaARN: aws.String(lambdaARN),
+				RotationRules:     &secretsmanager.RotationRulesType{AutomaticallyAfterDays: aws.Int64(30)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsRotationUpToDate(tc.p, &secretsmanager.DescribeSecretResponse{DescribeSecretOutput: &tc.o})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
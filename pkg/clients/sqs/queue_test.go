@@ -164,6 +164,15 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"PolicyManagedElsewhere": {
+			args: args{
+				p: v1beta1.QueueParameters{},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version":"2012-10-17"}`,
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
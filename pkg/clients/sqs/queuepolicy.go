@@ -0,0 +1,125 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// QueuePolicyClient is the external client used for QueuePolicy Custom Resource
+type QueuePolicyClient interface {
+	GetQueueAttributesRequest(*sqs.GetQueueAttributesInput) sqs.GetQueueAttributesRequest
+	SetQueueAttributesRequest(input *sqs.SetQueueAttributesInput) sqs.SetQueueAttributesRequest
+}
+
+// NewQueuePolicyClient returns a new client given an aws config
+func NewQueuePolicyClient(cfg aws.Config) QueuePolicyClient {
+	return sqs.New(cfg)
+}
+
+func generatePolicyStatement(p v1alpha1.QueuePolicyStatement, queueARN string) awsclients.PolicyStatement {
+	s := awsclients.PolicyStatement{
+		SID:          p.SID,
+		Effect:       p.Effect,
+		Principal:    generatePolicyPrincipal(p.Principal),
+		NotPrincipal: generatePolicyPrincipal(p.NotPrincipal),
+		Action:       p.Action,
+		NotAction:    p.NotAction,
+		Resource:     p.Resource,
+		NotResource:  p.NotResource,
+	}
+	if len(s.Resource) == 0 && len(s.NotResource) == 0 && queueARN != "" {
+		s.Resource = []string{queueARN}
+	}
+	if p.Condition != nil {
+		s.Condition = make(map[string]awsclients.PolicyCondition, len(p.Condition))
+		for k, v := range p.Condition {
+			s.Condition[k] = awsclients.PolicyCondition{
+				Key:          v.ConditionKey,
+				StringValue:  v.ConditionStringValue,
+				DateValue:    v.ConditionDateValue,
+				NumericValue: v.ConditionNumericValue,
+				BooleanValue: v.ConditionBooleanValue,
+			}
+		}
+	}
+	return s
+}
+
+func generatePolicyPrincipal(p *v1alpha1.QueuePrincipal) *awsclients.PolicyPrincipal {
+	if p == nil {
+		return nil
+	}
+	pp := &awsclients.PolicyPrincipal{
+		AllowAnon: p.AllowAnon,
+		Federated: p.Federated,
+		Service:   p.Service,
+	}
+	for _, a := range p.AWSPrincipals {
+		switch {
+		case a.AWSAccountID != nil:
+			pp.AWSPrincipals = append(pp.AWSPrincipals, a.AWSAccountID)
+		case a.IAMRoleARN != nil:
+			pp.AWSPrincipals = append(pp.AWSPrincipals, a.IAMRoleARN)
+		default:
+			pp.AWSPrincipals = append(pp.AWSPrincipals, a.IAMUserARN)
+		}
+	}
+	return pp
+}
+
+// GenerateQueuePolicy returns the JSON policy document described by the
+// given QueuePolicyParameters. The given queue ARN is used as the resource of
+// statements that do not specify one.
+func GenerateQueuePolicy(p v1alpha1.QueuePolicyParameters, queueARN string) (string, error) {
+	statements := make([]awsclients.PolicyStatement, len(p.Statements))
+	for i, v := range p.Statements {
+		statements[i] = generatePolicyStatement(v, queueARN)
+	}
+	body, err := awsclients.SerializePolicy(p.Version, p.ID, statements)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// IsQueuePolicyUpToDate returns true if the desired policy document is
+// semantically equal to the observed one.
+func IsQueuePolicyUpToDate(desired, observed string) (bool, error) {
+	if observed == "" {
+		return false, nil
+	}
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false, err
+	}
+	return cmp.Equal(d, o), nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+)
+
+var (
+	queueARN = "arn:aws:sqs:us-east-1:123456789012:queue"
+	topicARN = "arn:aws:sns:us-east-1:123456789012:topic"
+)
+
+func TestGenerateQueuePolicy(t *testing.T) {
+	type want struct {
+		policy string
+		err    error
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.QueuePolicyParameters
+		want want
+	}{
+		"DefaultsResourceToQueue": {
+			p: v1alpha1.QueuePolicyParameters{
+				Version: "2012-10-17",
+				Statements: []v1alpha1.QueuePolicyStatement{{
+					Effect:    "Allow",
+					Principal: &v1alpha1.QueuePrincipal{Service: []string{"sns.amazonaws.com"}},
+					Action:    []string{"sqs:SendMessage"},
+					Condition: map[string]v1alpha1.Condition{
+						"ArnEquals": {
+							ConditionKey:         "aws:SourceArn",
+							ConditionStringValue: aws.String(topicARN),
+						},
+					},
+				}},
+			},
+			want: want{
+				policy: `{"Statement":[{"Action":"sqs:SendMessage","Condition":{"ArnEquals":{"aws:SourceArn":"` + topicARN + `"}},"Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Resource":"` + queueARN + `"}],"Version":"2012-10-17"}`,
+			},
+		},
+		"ExplicitResources": {
+			p: v1alpha1.QueuePolicyParameters{
+				Version: "2012-10-17",
+				ID:      "id",
+				Statements: []v1alpha1.QueuePolicyStatement{{
+					SID:    aws.String("sid"),
+					Effect: "Deny",
+					Principal: &v1alpha1.QueuePrincipal{AWSPrincipals: []v1alpha1.AWSPrincipal{
+						{AWSAccountID: aws.String("123456789012")},
+						{IAMRoleARN: aws.String("arn:aws:iam::123456789012:role/role")},
+					}},
+					Action:      []string{"sqs:SendMessage", "sqs:ReceiveMessage"},
+					NotResource: []string{"arn"},
+				}},
+			},
+			want: want{
+				policy: `{"Id":"id","Statement":[{"Action":["sqs:SendMessage","sqs:ReceiveMessage"],"Effect":"Deny","NotResource":"arn","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:role/role"]},"Sid":"sid"}],"Version":"2012-10-17"}`,
+			},
+		},
+		"MissingConditionValue": {
+			p: v1alpha1.QueuePolicyParameters{
+				Version: "2012-10-17",
+				Statements: []v1alpha1.QueuePolicyStatement{{
+					Effect:    "Allow",
+					Principal: &v1alpha1.QueuePrincipal{AllowAnon: true},
+					Condition: map[string]v1alpha1.Condition{
+						"ArnEquals": {ConditionKey: "aws:SourceArn"},
+					},
+				}},
+			},
+			want: want{
+				err: errors.New("no value provided for key with value aws:SourceArn, condition ArnEquals"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			policy, err := GenerateQueuePolicy(tc.p, queueARN)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, policy); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsQueuePolicyUpToDate(t *testing.T) {
+	type args struct {
+		desired  string
+		observed string
+	}
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SameDocumentDifferentFormatting": {
+			args: args{
+				desired:  `{"Statement":[{"Effect":"Allow"}],"Version":"2012-10-17"}`,
+				observed: `{"Version": "2012-10-17", "Statement": [ {"Effect": "Allow"} ]}`,
+			},
+			want: want{upToDate: true},
+		},
+		"DifferentDocument": {
+			args: args{
+				desired:  `{"Statement":[{"Effect":"Allow"}],"Version":"2012-10-17"}`,
+				observed: `{"Statement":[{"Effect":"Deny"}],"Version":"2012-10-17"}`,
+			},
+			want: want{upToDate: false},
+		},
+		"NoObservedPolicy": {
+			args: args{
+				desired: `{"Version":"2012-10-17"}`,
+			},
+			want: want{upToDate: false},
+		},
+		"InvalidObservedPolicy": {
+			args: args{
+				desired:  `{"Version":"2012-10-17"}`,
+				observed: `{`,
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, err := IsQueuePolicyUpToDate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
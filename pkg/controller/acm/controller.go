@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -34,14 +35,17 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/acm/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 	awscommon "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acm"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
 )
 
 const (
 	errUnexpectedObject = "The managed resource is not an ACM resource"
 	errGet              = "failed to get Certificate with name"
 	errCreate           = "failed to create the Certificate resource"
+	errImport           = "failed to import the Certificate resource"
 	errDelete           = "failed to delete the Certificate resource"
 	errUpdate           = "failed to update the Certificate resource"
 	errSDK              = "empty Certificate received from ACM API"
@@ -53,6 +57,11 @@ const (
 	errRemoveTagsFailed     = "failed to remove tags for Certificate"
 	errRenewalFailed        = "failed to renew Certificate"
 	errIneligibleForRenewal = "ineligible to renew Certificate"
+
+	errGetValidationRecord    = "failed to get validation record of Certificate"
+	errUpsertValidationRecord = "failed to create validation record of Certificate"
+	errDeleteValidationRecord = "failed to delete validation record of Certificate"
+	errListCertificates       = "failed to list the Certificates that share validation records"
 )
 
 // SetupCertificate adds a controller that reconciles Certificates.
@@ -64,7 +73,7 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger) error {
 		For(&v1alpha1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient, newDNSClientFn: resourcerecordset.NewClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
@@ -73,8 +82,9 @@ func SetupCertificate(mgr ctrl.Manager, l logging.Logger) error {
 }
 
 type connector struct {
-	client      client.Client
-	newClientFn func(aws.Config) acm.Client
+	client         client.Client
+	newClientFn    func(aws.Config) acm.Client
+	newDNSClientFn func(aws.Config) resourcerecordset.Client
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -86,12 +96,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.client}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.client, dns: c.newDNSClientFn(*cfg)}, nil
 }
 
 type external struct {
 	client acm.Client
 	kube   client.Client
+	dns    resourcerecordset.Client
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -138,8 +149,25 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(acm.IsErrorNotFound, err), errListTagsFailed)
 	}
 
+	upToDate := acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags)
+	if ref := cr.Spec.ForProvider.CertificateSecretRef; ref != nil {
+		ic, err := acm.GetImportedCertificate(ctx, e.kube, *ref)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = upToDate && acm.IsImportedCertificateUpToDate(ic, cr.Status.AtProvider)
+	}
+	if zone := aws.StringValue(cr.Spec.ForProvider.ValidationZoneID); zone != "" {
+		cr.SetConditions(acm.GenerateValidationCondition(cr.Status.AtProvider))
+		recordsUpToDate, err := e.areValidationRecordsUpToDate(ctx, zone, cr.Status.AtProvider)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetValidationRecord)
+		}
+		upToDate = upToDate && recordsUpToDate
+	}
+
 	return managed.ExternalObservation{
-		ResourceUpToDate: acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags),
+		ResourceUpToDate: upToDate,
 		ResourceExists:   true,
 	}, nil
 }
@@ -151,6 +179,19 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if ref := cr.Spec.ForProvider.CertificateSecretRef; ref != nil {
+		ic, err := acm.GetImportedCertificate(ctx, e.kube, *ref)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		response, err := e.client.ImportCertificateRequest(acm.GenerateImportCertificateInput("", ic, &cr.Spec.ForProvider)).Send(ctx)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errImport)
+		}
+		meta.SetExternalName(cr, aws.StringValue(response.CertificateArn))
+		return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+	}
+
 	response, err := e.client.RequestCertificateRequest(acm.GenerateCreateCertificateInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
@@ -167,6 +208,29 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	// Re-import the Certificate under the same ARN when the Secret changed
+	if ref := cr.Spec.ForProvider.CertificateSecretRef; ref != nil {
+		ic, err := acm.GetImportedCertificate(ctx, e.kube, *ref)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if !acm.IsImportedCertificateUpToDate(ic, cr.Status.AtProvider) {
+			if _, err := e.client.ImportCertificateRequest(acm.GenerateImportCertificateInput(meta.GetExternalName(cr), ic, &cr.Spec.ForProvider)).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errImport)
+			}
+		}
+	}
+
+	// Create the records that let ACM validate the domains of the Certificate
+	if zone := aws.StringValue(cr.Spec.ForProvider.ValidationZoneID); zone != "" {
+		for _, r := range acm.GetValidationRecords(cr.Status.AtProvider) {
+			_, err := e.dns.ChangeResourceRecordSetsRequest(resourcerecordset.GenerateChangeResourceRecordSetsInput(r.Name, acm.GenerateValidationRecordParameters(zone, r), route53.ChangeActionUpsert)).Send(ctx)
+			if err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errUpsertValidationRecord)
+			}
+		}
+	}
+
 	// Update Certificate tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 
@@ -202,7 +266,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	// Update the Certificate Option
-	if cr.Spec.ForProvider.CertificateTransparencyLoggingPreference != nil {
+	if cr.Spec.ForProvider.CertificateTransparencyLoggingPreference != nil && cr.Spec.ForProvider.CertificateSecretRef == nil {
 		_, err := e.client.UpdateCertificateOptionsRequest(&awsacm.UpdateCertificateOptionsInput{
 			CertificateArn: aws.String(meta.GetExternalName(cr)),
 			Options:        &awsacm.CertificateOptions{CertificateTransparencyLoggingPreference: *cr.Spec.ForProvider.CertificateTransparencyLoggingPreference},
@@ -240,9 +304,104 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	// The validation records are removed before the Certificate so that a
+	// failure to remove them is retried rather than leaking them.
+	if err := e.deleteValidationRecords(ctx, cr); err != nil {
+		return err
+	}
+
 	_, err := e.client.DeleteCertificateRequest(&awsacm.DeleteCertificateInput{
 		CertificateArn: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
-
 	return errors.Wrap(resource.Ignore(acm.IsErrorNotFound, err), errDelete)
 }
+
+// deleteValidationRecords deletes the records that validate the domains of the
+// Certificate from its validation zone. ACM issues the same record to every
+// certificate of a domain in an account, so records that another certificate
+// still uses are left in place.
+func (e *external) deleteValidationRecords(ctx context.Context, cr *v1alpha1.Certificate) error {
+	zone := aws.StringValue(cr.Spec.ForProvider.ValidationZoneID)
+	records := acm.GetValidationRecords(cr.Status.AtProvider)
+	if zone == "" || len(records) == 0 {
+		return nil
+	}
+	inUse, err := e.validationRecordsInUse(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return errors.Wrap(err, errListCertificates)
+	}
+	for _, r := range records {
+		if inUse[r.Name] {
+			continue
+		}
+		rrset, err := resourcerecordset.GetResourceRecordSet(ctx, r.Name, acm.GenerateValidationRecordParameters(zone, r), e.dns)
+		if resourcerecordset.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, errGetValidationRecord)
+		}
+		// Route53 only deletes a record set that matches the existing one
+		// exactly, so it is built from what was observed.
+		p := route53v1alpha1.ResourceRecordSetParameters{ZoneID: aws.String(zone)}
+		resourcerecordset.LateInitialize(&p, rrset)
+		if _, err := e.dns.ChangeResourceRecordSetsRequest(resourcerecordset.GenerateChangeResourceRecordSetsInput(r.Name, p, route53.ChangeActionDelete)).Send(ctx); err != nil {
+			return errors.Wrap(err, errDeleteValidationRecord)
+		}
+	}
+	return nil
+}
+
+// validationRecordsInUse returns the names of the validation records of the
+// pending and issued certificates in the account other than the one with the
+// supplied ARN.
+func (e *external) validationRecordsInUse(ctx context.Context, arn string) (map[string]bool, error) {
+	inUse := map[string]bool{}
+	in := &awsacm.ListCertificatesInput{
+		CertificateStatuses: []awsacm.CertificateStatus{awsacm.CertificateStatusPendingValidation, awsacm.CertificateStatusIssued},
+	}
+	for {
+		rsp, err := e.client.ListCertificatesRequest(in).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range rsp.CertificateSummaryList {
+			if aws.StringValue(c.CertificateArn) == arn {
+				continue
+			}
+			d, err := e.client.DescribeCertificateRequest(&awsacm.DescribeCertificateInput{CertificateArn: c.CertificateArn}).Send(ctx)
+			if resource.Ignore(acm.IsErrorNotFound, err) != nil {
+				return nil, err
+			}
+			if err != nil || d.Certificate == nil {
+				continue
+			}
+			for _, r := range acm.GetValidationRecords(acm.GenerateCertificateStatus(*d.Certificate)) {
+				inUse[r.Name] = true
+			}
+		}
+		if rsp.NextToken == nil {
+			return inUse, nil
+		}
+		in.NextToken = rsp.NextToken
+	}
+}
+
+// areValidationRecordsUpToDate checks whether the records that validate the
+// domains of the Certificate exist in the validation zone.
+func (e *external) areValidationRecordsUpToDate(ctx context.Context, zone string, s v1alpha1.CertificateExternalStatus) (bool, error) {
+	for _, r := range acm.GetValidationRecords(s) {
+		p := acm.GenerateValidationRecordParameters(zone, r)
+		rrset, err := resourcerecordset.GetResourceRecordSet(ctx, r.Name, p, e.dns)
+		if resourcerecordset.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if upToDate, err := resourcerecordset.IsUpToDate(p, *rrset); err != nil || !upToDate {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -17,15 +17,28 @@ limitations under the License.
 package acm
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -36,19 +49,28 @@ import (
 	v1alpha1 "github.com/crossplane/provider-aws/apis/acm/v1alpha1"
 	acm "github.com/crossplane/provider-aws/pkg/clients/acm"
 	"github.com/crossplane/provider-aws/pkg/clients/acm/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
+	rrsfake "github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
 )
 
 var (
 	// an arbitrary managed resource
-	unexpecedItem  resource.Managed
-	domainName     = "some.site"
-	certificateArn = "somearn"
+	unexpecedItem       resource.Managed
+	domainName          = "some.site"
+	certificateArn      = "somearn"
+	otherCertificateArn = "otherarn"
+	zoneID              = "Z123"
+	recordName          = "_x1.some.site."
+	recordValue         = "_x2.acm-validations.aws."
+	importedSerial      = "1a:2b"
+	tlsSecretData       = newTLSSecretData()
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
 	acm acm.Client
+	dns resourcerecordset.Client
 	cr  resource.Managed
 }
 
@@ -94,6 +116,127 @@ func withCertificateArn() certificateModifier {
 	}
 }
 
+func withValidationZone() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		method := awsacm.ValidationMethodDns
+		r.Spec.ForProvider.ValidationMethod = &method
+		r.Spec.ForProvider.DomainValidationOptions = []*v1alpha1.DomainValidationOption{{DomainName: domainName}}
+		r.Spec.ForProvider.ValidationZoneID = aws.String(zoneID)
+	}
+}
+
+func withValidationStatus(s awsacm.CertificateStatus, ds awsacm.DomainStatus) certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Status.AtProvider.Status = s
+		r.Status.AtProvider.DomainValidations = []v1alpha1.DomainValidation{{
+			DomainName:       domainName,
+			ValidationStatus: string(ds),
+			ResourceRecord:   &v1alpha1.ValidationResourceRecord{Name: recordName, Type: "CNAME", Value: recordValue},
+		}}
+	}
+}
+
+func validatedCertificate(s awsacm.CertificateStatus, ds awsacm.DomainStatus) *awsacm.CertificateDetail {
+	return &awsacm.CertificateDetail{
+		CertificateArn: aws.String(certificateArn),
+		Options:        &awsacm.CertificateOptions{CertificateTransparencyLoggingPreference: awsacm.CertificateTransparencyLoggingPreferenceDisabled},
+		Status:         s,
+		DomainValidationOptions: []awsacm.DomainValidation{{
+			DomainName:       aws.String(domainName),
+			ValidationMethod: awsacm.ValidationMethodDns,
+			ValidationStatus: ds,
+			ResourceRecord:   &awsacm.ResourceRecord{Name: aws.String(recordName), Type: awsacm.RecordTypeCname, Value: aws.String(recordValue)},
+		}},
+	}
+}
+
+func listCertificates(err error, arns ...string) func(*awsacm.ListCertificatesInput) awsacm.ListCertificatesRequest {
+	return func(_ *awsacm.ListCertificatesInput) awsacm.ListCertificatesRequest {
+		o := &awsacm.ListCertificatesOutput{}
+		for _, arn := range arns {
+			o.CertificateSummaryList = append(o.CertificateSummaryList, awsacm.CertificateSummary{CertificateArn: aws.String(arn)})
+		}
+		return awsacm.ListCertificatesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: o, Error: err},
+		}
+	}
+}
+
+func validationRecordSets(rrs ...route53.ResourceRecordSet) func(*route53.ListResourceRecordSetsInput) route53.ListResourceRecordSetsRequest {
+	return func(_ *route53.ListResourceRecordSetsInput) route53.ListResourceRecordSetsRequest {
+		return route53.ListResourceRecordSetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &route53.ListResourceRecordSetsOutput{ResourceRecordSets: rrs}},
+		}
+	}
+}
+
+func validationRecordSet() route53.ResourceRecordSet {
+	return route53.ResourceRecordSet{
+		Name:            aws.String(recordName),
+		Type:            route53.RRTypeCname,
+		TTL:             aws.Int64(300),
+		ResourceRecords: []route53.ResourceRecord{{Value: aws.String(recordValue)}},
+	}
+}
+
+func changeResourceRecordSets(action route53.ChangeAction, err error) func(*route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest {
+	return func(input *route53.ChangeResourceRecordSetsInput) route53.ChangeResourceRecordSetsRequest {
+		if err == nil && (input.ChangeBatch.Changes[0].Action != action || aws.StringValue(input.HostedZoneId) != zoneID) {
+			err = errors.Errorf("unexpected change %v", input.ChangeBatch.Changes[0])
+		}
+		return route53.ChangeResourceRecordSetsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &route53.ChangeResourceRecordSetsOutput{}, Error: err},
+		}
+	}
+}
+
+func withCertificateSecret() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Spec.ForProvider.CertificateSecretRef = &xpv1.SecretReference{Name: "tls", Namespace: "default"}
+	}
+}
+
+func withSerial(serial string) certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Status.AtProvider.CertificateARN = certificateArn
+		r.Status.AtProvider.Serial = serial
+	}
+}
+
+// newTLSSecretData returns the data of a TLS Secret as written by
+// cert-manager, holding a self-signed certificate with serial 0x1a2b.
+func newTLSSecretData() map[string][]byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(0x1a2b),
+		Subject:      pkix.Name{CommonName: domainName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+	k, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return map[string][]byte{
+		corev1.TLSCertKey:       cert,
+		corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: k}),
+		"ca.crt":                cert,
+	}
+}
+
+func getTLSSecret(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+	obj.(*corev1.Secret).Data = tlsSecretData
+	return nil
+}
+
 func certificate(m ...certificateModifier) *v1alpha1.Certificate {
 	cr := &v1alpha1.Certificate{}
 	meta.SetExternalName(cr, certificateArn)
@@ -146,6 +289,68 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ValidationRecordMissing": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificateRequest: func(input *awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+						return awsacm.DescribeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{
+								Certificate: validatedCertificate(awsacm.CertificateStatusPendingValidation, awsacm.DomainStatusPendingValidation),
+							}},
+						}
+					},
+					MockListTagsForCertificateRequest: func(input *awsacm.ListTagsForCertificateInput) awsacm.ListTagsForCertificateRequest {
+						return awsacm.ListTagsForCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ListTagsForCertificateOutput{}},
+						}
+					},
+				},
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: validationRecordSets(),
+				},
+				cr: certificate(withValidationZone()),
+			},
+			want: want{
+				cr: certificate(withCertificateArn(), withValidationZone(),
+					withValidationStatus(awsacm.CertificateStatusPendingValidation, awsacm.DomainStatusPendingValidation),
+					withConditions(xpv1.Available(), v1alpha1.ValidationPending())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Validated": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificateRequest: func(input *awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+						return awsacm.DescribeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{
+								Certificate: validatedCertificate(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+							}},
+						}
+					},
+					MockListTagsForCertificateRequest: func(input *awsacm.ListTagsForCertificateInput) awsacm.ListTagsForCertificateRequest {
+						return awsacm.ListTagsForCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ListTagsForCertificateOutput{}},
+						}
+					},
+				},
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: validationRecordSets(validationRecordSet()),
+				},
+				cr: certificate(withValidationZone()),
+			},
+			want: want{
+				cr: certificate(withCertificateArn(), withValidationZone(),
+					withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+					withConditions(xpv1.Available(), v1alpha1.ValidationSucceeded())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -186,13 +391,75 @@ func TestObserve(t *testing.T) {
 				cr: certificate(),
 			},
 		},
+		"ImportedCertificateUpToDate": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificateRequest: func(input *awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+						return awsacm.DescribeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{
+								Certificate: &awsacm.CertificateDetail{
+									CertificateArn: aws.String(certificateArn),
+									DomainName:     aws.String(domainName),
+									Serial:         aws.String(importedSerial),
+								},
+							}},
+						}
+					},
+					MockListTagsForCertificateRequest: func(input *awsacm.ListTagsForCertificateInput) awsacm.ListTagsForCertificateRequest {
+						return awsacm.ListTagsForCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ListTagsForCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificate(withCertificateSecret()),
+			},
+			want: want{
+				cr: certificate(withCertificateSecret(), withSerial(importedSerial), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ImportedCertificateChanged": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificateRequest: func(input *awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+						return awsacm.DescribeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{
+								Certificate: &awsacm.CertificateDetail{
+									CertificateArn: aws.String(certificateArn),
+									DomainName:     aws.String(domainName),
+									Serial:         aws.String("01"),
+								},
+							}},
+						}
+					},
+					MockListTagsForCertificateRequest: func(input *awsacm.ListTagsForCertificateInput) awsacm.ListTagsForCertificateRequest {
+						return awsacm.ListTagsForCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ListTagsForCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificate(withCertificateSecret()),
+			},
+			want: want{
+				cr: certificate(withCertificateSecret(), withSerial("01"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			e := &external{
 				client: tc.acm,
+				dns:    tc.dns,
 				kube: &test.MockClient{
+					MockGet:    getTLSSecret,
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
 			}
@@ -268,6 +535,46 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCreate),
 			},
 		},
+		"ImportCertificate": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockImportCertificateRequest: func(input *awsacm.ImportCertificateInput) awsacm.ImportCertificateRequest {
+						if input.CertificateArn != nil {
+							t.Errorf("r: unexpected certificate ARN on first import")
+						}
+						if diff := cmp.Diff(bytes.TrimSpace(tlsSecretData["ca.crt"]), input.CertificateChain); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacm.ImportCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ImportCertificateOutput{
+								CertificateArn: aws.String(certificateArn),
+							}},
+						}
+					},
+				},
+				cr: certificate(withCertificateSecret()),
+			},
+			want: want{
+				cr:     certificate(withCertificateSecret()),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"ImportClientError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockImportCertificateRequest: func(input *awsacm.ImportCertificateInput) awsacm.ImportCertificateRequest {
+						return awsacm.ImportCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(withCertificateSecret()),
+			},
+			want: want{
+				cr:  certificate(withCertificateSecret()),
+				err: errors.Wrap(errBoom, errImport),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -275,6 +582,7 @@ func TestCreate(t *testing.T) {
 			e := &external{
 				client: tc.acm,
 				kube: &test.MockClient{
+					MockGet:    getTLSSecret,
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
 			}
@@ -339,6 +647,38 @@ func TestUpdate(t *testing.T) {
 				cr: certificate(),
 			},
 		},
+		"ReimportCertificate": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockImportCertificateRequest: func(input *awsacm.ImportCertificateInput) awsacm.ImportCertificateRequest {
+						if diff := cmp.Diff(certificateArn, aws.StringValue(input.CertificateArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if input.Tags != nil {
+							t.Errorf("r: unexpected tags on re-import")
+						}
+						return awsacm.ImportCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.ImportCertificateOutput{
+								CertificateArn: aws.String(certificateArn),
+							}},
+						}
+					},
+				},
+				cr: certificate(withCertificateSecret(), withSerial("01")),
+			},
+			want: want{
+				cr: certificate(withCertificateSecret(), withSerial("01")),
+			},
+		},
+		"ImportedCertificateUnchanged": {
+			args: args{
+				acm: &fake.MockCertificateClient{},
+				cr:  certificate(withCertificateSecret(), withSerial(importedSerial)),
+			},
+			want: want{
+				cr: certificate(withCertificateSecret(), withSerial(importedSerial)),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -418,11 +758,34 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errAddTagsFailed),
 			},
 		},
+		"CreateValidationRecords": {
+			args: args{
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSetsRequest: changeResourceRecordSets(route53.ChangeActionUpsert, nil),
+				},
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusPendingValidation, awsacm.DomainStatusPendingValidation)),
+			},
+			want: want{
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusPendingValidation, awsacm.DomainStatusPendingValidation)),
+			},
+		},
+		"CreateValidationRecordsError": {
+			args: args{
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSetsRequest: changeResourceRecordSets(route53.ChangeActionUpsert, errBoom),
+				},
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusPendingValidation, awsacm.DomainStatusPendingValidation)),
+			},
+			want: want{
+				cr:  certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusPendingValidation, awsacm.DomainStatusPendingValidation)),
+				err: errors.Wrap(errBoom, errUpsertValidationRecord),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			e := &external{client: tc.acm, dns: tc.dns, kube: &test.MockClient{MockGet: getTLSSecret}}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -465,6 +828,102 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DeleteValidationRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListCertificatesRequest: listCertificates(nil, certificateArn),
+					MockDeleteCertificateRequest: func(input *awsacm.DeleteCertificateInput) awsacm.DeleteCertificateRequest {
+						return awsacm.DeleteCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DeleteCertificateOutput{}},
+						}
+					},
+				},
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest:   validationRecordSets(validationRecordSet()),
+					MockChangeResourceRecordSetsRequest: changeResourceRecordSets(route53.ChangeActionDelete, nil),
+				},
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess)),
+			},
+			want: want{
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"ValidationRecordAlreadyDeleted": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListCertificatesRequest: listCertificates(nil),
+					MockDeleteCertificateRequest: func(input *awsacm.DeleteCertificateInput) awsacm.DeleteCertificateRequest {
+						return awsacm.DeleteCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DeleteCertificateOutput{}},
+						}
+					},
+				},
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest: validationRecordSets(),
+				},
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess)),
+			},
+			want: want{
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"ValidationRecordInUse": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListCertificatesRequest: listCertificates(nil, certificateArn, otherCertificateArn),
+					MockDescribeCertificateRequest: func(input *awsacm.DescribeCertificateInput) awsacm.DescribeCertificateRequest {
+						c := validatedCertificate(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess)
+						c.CertificateArn = input.CertificateArn
+						return awsacm.DescribeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DescribeCertificateOutput{Certificate: c}},
+						}
+					},
+					MockDeleteCertificateRequest: func(input *awsacm.DeleteCertificateInput) awsacm.DeleteCertificateRequest {
+						return awsacm.DeleteCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacm.DeleteCertificateOutput{}},
+						}
+					},
+				},
+				dns: &rrsfake.MockResourceRecordSetClient{},
+				cr:  certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess)),
+			},
+			want: want{
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"ListCertificatesError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListCertificatesRequest: listCertificates(errBoom),
+				},
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess)),
+			},
+			want: want{
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+					withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errListCertificates),
+			},
+		},
+		"DeleteValidationRecordError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListCertificatesRequest: listCertificates(nil),
+				},
+				dns: &rrsfake.MockResourceRecordSetClient{
+					MockListResourceRecordSetsRequest:   validationRecordSets(validationRecordSet()),
+					MockChangeResourceRecordSetsRequest: changeResourceRecordSets(route53.ChangeActionDelete, errBoom),
+				},
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess)),
+			},
+			want: want{
+				cr: certificate(withValidationZone(), withValidationStatus(awsacm.CertificateStatusIssued, awsacm.DomainStatusSuccess),
+					withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteValidationRecord),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -509,7 +968,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			e := &external{client: tc.acm, dns: tc.dns}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,344 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsacmpca "github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an ACMPCA Certificate resource"
+	errGet              = "failed to get ACMPCA Certificate with name"
+	errParse            = "failed to parse ACMPCA Certificate"
+	errCSR              = "failed to get the certificate signing request of the ACMPCA Certificate"
+	errCreate           = "failed to issue the ACMPCA Certificate"
+	errDelete           = "failed to revoke the ACMPCA Certificate"
+	errGetPending       = "failed to get the renewed ACMPCA Certificate"
+	errRevokeSuperseded = "failed to revoke the superseded ACMPCA Certificate"
+	errKubeUpdate       = "failed to update the ACMPCA Certificate custom resource"
+)
+
+// SetupCertificate adds a controller that reconciles ACMPCA Certificates.
+func SetupCertificate(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.CertificateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Certificate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.CertificateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCertificateClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) acmpca.CertificateClient
+}
+
+func (conn *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Certificate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, conn.client, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: conn.newClientFn(cfg), kube: conn.client, now: time.Now}, nil
+}
+
+type external struct {
+	client acmpca.CertificateClient
+	kube   client.Client
+	now    func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// An issued certificate cannot be deleted, only revoked. We consider it
+	// gone once we have revoked it.
+	if meta.WasDeleted(cr) && cr.GetCondition(xpv1.TypeReady).Reason == xpv1.ReasonDeleting {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.GetCertificateRequest(&awsacmpca.GetCertificateInput{
+		CertificateArn:          aws.String(meta.GetExternalName(cr)),
+		CertificateAuthorityArn: cr.Spec.ForProvider.CertificateAuthorityARN,
+	}).Send(ctx)
+	if acmpca.IsErrorRequestInProgress(err) {
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(acmpca.IsCertificateNotFound, err), errGet)
+	}
+
+	cert, err := acmpca.ParseCertificate(aws.StringValue(rsp.Certificate))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errParse)
+	}
+	setObservation(cr, meta.GetExternalName(cr), cert)
+
+	conn := acmpca.GetCertificateConnectionDetails(rsp.GetCertificateOutput)
+	upToDate := cr.Status.AtProvider.PendingCertificateARN == "" && cr.Status.AtProvider.SupersededSerial == ""
+
+	// The private key is published along with the certificate it belongs to,
+	// so that the two never mismatch. If the key got lost, the certificate is
+	// of no use and is renewed.
+	if generatesKey(cr) {
+		data, err := acmpca.GetConnectionSecretData(ctx, e.kube, cr.Spec.WriteConnectionSecretToReference)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		key := acmpca.MatchPrivateKey(cert, data[acmpca.ConnectionDetailsPrivateKeyKey], data[acmpca.ConnectionDetailsPendingPrivateKeyKey])
+		switch {
+		case key == nil:
+			upToDate = false
+		case bytes.Equal(key, data[acmpca.ConnectionDetailsPendingPrivateKeyKey]):
+			conn[acmpca.ConnectionDetailsPrivateKeyKey] = key
+			conn[acmpca.ConnectionDetailsPendingPrivateKeyKey] = []byte{}
+		default:
+			conn[acmpca.ConnectionDetailsPrivateKeyKey] = key
+		}
+	}
+
+	// A certificate that is about to expire is replaced by a new one issued
+	// for the same certificate signing request, or a new private key.
+	if !meta.WasDeleted(cr) && acmpca.IsCertificateDueForRenewal(cr.Spec.ForProvider, cert, e.now()) {
+		upToDate = false
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: conn,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	arn, conn, err := e.issue(ctx, cr, cr.Status.AtProvider.RenewalGeneration)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, arn)
+	return managed.ExternalCreation{ExternalNameAssigned: true, ConnectionDetails: conn}, nil
+}
+
+// Update renews the certificate. A renewed certificate is requested first.
+// Once it has been issued it replaces the current certificate, and the
+// superseded certificate is revoked after its replacement has been
+// published.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	switch {
+	case cr.Status.AtProvider.SupersededSerial != "":
+		if err := e.revoke(ctx, cr, cr.Status.AtProvider.SupersededSerial); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRevokeSuperseded)
+		}
+		cr.Status.AtProvider.SupersededSerial = ""
+		return managed.ExternalUpdate{}, nil
+	case cr.Status.AtProvider.PendingCertificateARN != "":
+		return e.promote(ctx, cr)
+	}
+
+	generation := cr.Status.AtProvider.RenewalGeneration + 1
+	arn, conn, err := e.issue(ctx, cr, generation)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	cr.Status.AtProvider.RenewalGeneration = generation
+	cr.Status.AtProvider.PendingCertificateARN = arn
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Certificate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if arn := cr.Status.AtProvider.PendingCertificateARN; arn != "" {
+		rsp, err := e.client.GetCertificateRequest(&awsacmpca.GetCertificateInput{
+			CertificateArn:          aws.String(arn),
+			CertificateAuthorityArn: cr.Spec.ForProvider.CertificateAuthorityARN,
+		}).Send(ctx)
+		if err != nil {
+			return errors.Wrap(resource.Ignore(acmpca.IsCertificateNotFound, err), errDelete)
+		}
+		cert, err := acmpca.ParseCertificate(aws.StringValue(rsp.Certificate))
+		if err != nil {
+			return errors.Wrap(err, errParse)
+		}
+		if err := e.revoke(ctx, cr, acmpca.FormatSerial(cert.SerialNumber)); err != nil {
+			return errors.Wrap(err, errDelete)
+		}
+	}
+	for _, serial := range []string{cr.Status.AtProvider.SupersededSerial, cr.Status.AtProvider.Serial} {
+		if serial == "" {
+			continue
+		}
+		if err := e.revoke(ctx, cr, serial); err != nil {
+			return errors.Wrap(err, errDelete)
+		}
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// issue requests a certificate for the given generation of the Certificate.
+// A generated private key is returned as pending until the certificate it
+// belongs to is published.
+func (e *external) issue(ctx context.Context, cr *v1alpha1.Certificate, generation int64) (string, managed.ConnectionDetails, error) {
+	key, csr, err := acmpca.GetCertificateSigningRequest(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errCSR)
+	}
+
+	token := acmpca.GenerateIdempotencyToken(cr.GetUID(), generation)
+	rsp, err := e.client.IssueCertificateRequest(acmpca.GenerateCertificateIssueInput(cr.Spec.ForProvider, csr, token)).Send(ctx)
+	if err != nil {
+		return "", nil, errors.Wrap(err, errCreate)
+	}
+
+	var conn managed.ConnectionDetails
+	if key != nil {
+		conn = managed.ConnectionDetails{acmpca.ConnectionDetailsPendingPrivateKeyKey: key}
+	}
+	return aws.StringValue(rsp.CertificateArn), conn, nil
+}
+
+// promote replaces the current certificate with the pending one once it has
+// been issued, and publishes it along with its private key.
+func (e *external) promote(ctx context.Context, cr *v1alpha1.Certificate) (managed.ExternalUpdate, error) {
+	arn := cr.Status.AtProvider.PendingCertificateARN
+	rsp, err := e.client.GetCertificateRequest(&awsacmpca.GetCertificateInput{
+		CertificateArn:          aws.String(arn),
+		CertificateAuthorityArn: cr.Spec.ForProvider.CertificateAuthorityARN,
+	}).Send(ctx)
+	if acmpca.IsErrorRequestInProgress(err) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if acmpca.IsCertificateNotFound(err) {
+		cr.Status.AtProvider.PendingCertificateARN = ""
+		return managed.ExternalUpdate{}, nil
+	}
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPending)
+	}
+	cert, err := acmpca.ParseCertificate(aws.StringValue(rsp.Certificate))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errParse)
+	}
+
+	conn := acmpca.GetCertificateConnectionDetails(rsp.GetCertificateOutput)
+	if generatesKey(cr) {
+		data, err := acmpca.GetConnectionSecretData(ctx, e.kube, cr.Spec.WriteConnectionSecretToReference)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		key := acmpca.MatchPrivateKey(cert, data[acmpca.ConnectionDetailsPendingPrivateKeyKey])
+		if key == nil {
+			// The private key of the renewed certificate was never published,
+			// so the certificate is of no use. It is revoked and a new one is
+			// requested.
+			if err := e.revoke(ctx, cr, acmpca.FormatSerial(cert.SerialNumber)); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRevokeSuperseded)
+			}
+			cr.Status.AtProvider.PendingCertificateARN = ""
+			return managed.ExternalUpdate{}, nil
+		}
+		conn[acmpca.ConnectionDetailsPrivateKeyKey] = key
+		conn[acmpca.ConnectionDetailsPendingPrivateKeyKey] = []byte{}
+	}
+
+	superseded, generation := cr.Status.AtProvider.Serial, cr.Status.AtProvider.RenewalGeneration
+	meta.SetExternalName(cr, arn)
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdate)
+	}
+	cr.Status.AtProvider = acmpca.GenerateCertificateObservation(arn, cert)
+	cr.Status.AtProvider.RenewalGeneration = generation
+	cr.Status.AtProvider.SupersededSerial = superseded
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (e *external) revoke(ctx context.Context, cr *v1alpha1.Certificate, serial string) error {
+	_, err := e.client.RevokeCertificateRequest(&awsacmpca.RevokeCertificateInput{
+		CertificateAuthorityArn: cr.Spec.ForProvider.CertificateAuthorityARN,
+		CertificateSerial:       aws.String(serial),
+		RevocationReason:        awsacmpca.RevocationReasonCessationOfOperation,
+	}).Send(ctx)
+	return resource.Ignore(acmpca.IsCertificateAlreadyRevoked, err)
+}
+
+// generatesKey returns true if the private key of the Certificate is
+// generated and published to its connection secret.
+func generatesKey(cr *v1alpha1.Certificate) bool {
+	return cr.Spec.ForProvider.CertificateSigningRequestSecretRef == nil && cr.Spec.WriteConnectionSecretToReference != nil
+}
+
+func setObservation(cr *v1alpha1.Certificate, arn string, cert *x509.Certificate) {
+	o := acmpca.GenerateCertificateObservation(arn, cert)
+	o.RenewalGeneration = cr.Status.AtProvider.RenewalGeneration
+	o.PendingCertificateARN = cr.Status.AtProvider.PendingCertificateARN
+	o.SupersededSerial = cr.Status.AtProvider.SupersededSerial
+	cr.Status.AtProvider = o
+}
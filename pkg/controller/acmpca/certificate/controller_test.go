@@ -0,0 +1,777 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsacmpca "github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/clients/acmpca/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem          resource.Managed
+	certificateArn          = "somecertificatearn"
+	certificateAuthorityArn = "someauthorityarn"
+	certificateChain        = "somecertificatechain"
+	serial                  = "01"
+
+	now       = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	notBefore = now.Add(-24 * time.Hour)
+	notAfter  = now.Add(90 * 24 * time.Hour)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	acmpca acmpca.CertificateClient
+	kube   client.Client
+	cr     resource.Managed
+}
+
+type certificateModifier func(*v1alpha1.Certificate)
+
+func withConditions(c ...xpv1.Condition) certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) certificateModifier {
+	return func(r *v1alpha1.Certificate) { meta.SetExternalName(r, n) }
+}
+
+func withDeletionTimestamp() certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.SetDeletionTimestamp(&metav1.Time{Time: now}) }
+}
+
+func withSerial() certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Status.AtProvider.Serial = serial }
+}
+
+func withConnectionSecret() certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "cert", Namespace: "default"}
+	}
+}
+
+func withPending(arn string, generation int64) certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		r.Status.AtProvider.PendingCertificateARN = arn
+		r.Status.AtProvider.RenewalGeneration = generation
+	}
+}
+
+func withSuperseded(s string) certificateModifier {
+	return func(r *v1alpha1.Certificate) { r.Status.AtProvider.SupersededSerial = s }
+}
+
+func withObservation(notAfter time.Time) certificateModifier {
+	return func(r *v1alpha1.Certificate) {
+		nb, na := metav1.NewTime(notBefore), metav1.NewTime(notAfter)
+		r.Status.AtProvider = v1alpha1.CertificateObservation{
+			CertificateARN: certificateArn,
+			Serial:         serial,
+			NotBefore:      &nb,
+			NotAfter:       &na,
+		}
+	}
+}
+
+func certificate(m ...certificateModifier) *v1alpha1.Certificate {
+	cr := &v1alpha1.Certificate{
+		Spec: v1alpha1.CertificateSpec{
+			ForProvider: v1alpha1.CertificateParameters{
+				CertificateAuthorityARN: aws.String(certificateAuthorityArn),
+				SigningAlgorithm:        awsacmpca.SigningAlgorithmSha256withecdsa,
+				KeyAlgorithm:            awsacmpca.KeyAlgorithmEcPrime256v1,
+				Subject:                 &v1alpha1.CertificateSubject{CommonName: "example.com"},
+				Validity: v1alpha1.CertificateValidity{
+					Type:  awsacmpca.ValidityPeriodTypeDays,
+					Value: 90,
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func pemCertificate(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+	_, cert := pemKeyAndCertificate(t, notBefore, notAfter)
+	return cert
+}
+
+// pemKeyAndCertificate returns a PKCS8 private key and a certificate for it.
+func pemKeyAndCertificate(t *testing.T, notBefore, notAfter time.Time) ([]byte, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: k}),
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func getCertificate(cert string, err error) func(*awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+	return func(*awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+		return awsacmpca.GetCertificateRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateOutput{
+				Certificate:      aws.String(cert),
+				CertificateChain: aws.String(certificateChain),
+			}, Error: err},
+		}
+	}
+}
+
+func getConnectionSecret(data map[string][]byte) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		obj.(*corev1.Secret).Data = data
+		return nil
+	}
+}
+
+func revokeCertificate(t *testing.T, want string) func(*awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+	return func(input *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+		if diff := cmp.Diff(want, aws.StringValue(input.CertificateSerial)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		return awsacmpca.RevokeCertificateRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.RevokeCertificateOutput{}},
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	valid := pemCertificate(t, notAfter)
+	expiringFrom := now.Add(-89 * 24 * time.Hour)
+	_, expiring := pemKeyAndCertificate(t, expiringFrom, now.Add(24*time.Hour))
+	key, withKey := pemKeyAndCertificate(t, notBefore, notAfter)
+	otherKey, _ := pemKeyAndCertificate(t, notBefore, notAfter)
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateOutput{
+								Certificate:      aws.String(valid),
+								CertificateChain: aws.String(certificateChain),
+							}},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						acmpca.ConnectionDetailsCertificateKey:      []byte(valid),
+						acmpca.ConnectionDetailsCertificateChainKey: []byte(certificateChain),
+					},
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: certificate(),
+			},
+			want: want{
+				cr: certificate(),
+			},
+		},
+		"InProgress": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsacmpca.ErrCodeRequestInProgressException, "", nil)},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DueForRenewal": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateOutput{
+								Certificate: aws.String(expiring),
+							}},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withObservation(now.Add(24*time.Hour)), withConditions(xpv1.Available()), func(r *v1alpha1.Certificate) {
+					nb := metav1.NewTime(expiringFrom)
+					r.Status.AtProvider.NotBefore = &nb
+				}),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						acmpca.ConnectionDetailsCertificateKey: []byte(expiring),
+					},
+				},
+			},
+		},
+		"PublishesPendingKeyWithCertificate": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: getCertificate(withKey, nil),
+				},
+				kube: &test.MockClient{MockGet: getConnectionSecret(map[string][]byte{
+					acmpca.ConnectionDetailsPrivateKeyKey:        otherKey,
+					acmpca.ConnectionDetailsPendingPrivateKeyKey: key,
+				})},
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret()),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret(), withObservation(notAfter), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						acmpca.ConnectionDetailsCertificateKey:       []byte(withKey),
+						acmpca.ConnectionDetailsCertificateChainKey:  []byte(certificateChain),
+						acmpca.ConnectionDetailsPrivateKeyKey:        key,
+						acmpca.ConnectionDetailsPendingPrivateKeyKey: {},
+					},
+				},
+			},
+		},
+		"PrivateKeyLost": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: getCertificate(withKey, nil),
+				},
+				kube: &test.MockClient{MockGet: getConnectionSecret(map[string][]byte{
+					acmpca.ConnectionDetailsPrivateKeyKey: otherKey,
+				})},
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret()),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret(), withObservation(notAfter), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						acmpca.ConnectionDetailsCertificateKey:      []byte(withKey),
+						acmpca.ConnectionDetailsCertificateChainKey: []byte(certificateChain),
+					},
+				},
+			},
+		},
+		"SupersededNotRevoked": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: getCertificate(valid, nil),
+				},
+				cr: certificate(withExternalName(certificateArn), withSuperseded("02")),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter), withSuperseded("02"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						acmpca.ConnectionDetailsCertificateKey:      []byte(valid),
+						acmpca.ConnectionDetailsCertificateChainKey: []byte(certificateChain),
+					},
+				},
+			},
+		},
+		"Revoked": {
+			args: args{
+				cr: certificate(withExternalName(certificateArn), withDeletionTimestamp(), withConditions(xpv1.Deleting())),
+			},
+			want: want{
+				cr:     certificate(withExternalName(certificateArn), withDeletionTimestamp(), withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotFound": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsacmpca.ErrCodeResourceNotFoundException, "", nil)},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn)),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateArn)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acmpca, kube: tc.kube, now: func() time.Time { return now }}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		key    bool
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"GeneratedKey": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						if len(input.Csr) == 0 {
+							t.Errorf("r: want a generated certificate signing request")
+						}
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(certificateArn),
+							}},
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:     certificate(withExternalName(certificateArn)),
+				key:    true,
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"IdempotencyToken": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						if diff := cmp.Diff(acmpca.GenerateIdempotencyToken("", 0), aws.StringValue(input.IdempotencyToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(certificateArn),
+							}},
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:     certificate(withExternalName(certificateArn)),
+				key:    true,
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(),
+			},
+			want: want{
+				cr:  certificate(),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acmpca}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.key, len(o.ConnectionDetails[acmpca.ConnectionDetailsPendingPrivateKeyKey]) > 0); diff != "" {
+				t.Errorf("r: -want private key, +got private key:\n%s", diff)
+			}
+			o.ConnectionDetails = nil
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	renewedKey, renewed := pemKeyAndCertificate(t, notBefore, notAfter)
+	otherKey, _ := pemKeyAndCertificate(t, notBefore, notAfter)
+	renewedArn := "renewedcertificatearn"
+
+	type want struct {
+		cr         resource.Managed
+		pendingKey bool
+		result     managed.ExternalUpdate
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RequestsRenewal": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						if diff := cmp.Diff(acmpca.GenerateIdempotencyToken("", 1), aws.StringValue(input.IdempotencyToken)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(renewedArn),
+							}},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter)),
+			},
+			want: want{
+				cr:         certificate(withExternalName(certificateArn), withObservation(notAfter), withPending(renewedArn, 1)),
+				pendingKey: true,
+			},
+		},
+		"RenewalInProgress": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: getCertificate("", awserr.New(awsacmpca.ErrCodeRequestInProgressException, "", nil)),
+				},
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter), withPending(renewedArn, 1)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter), withPending(renewedArn, 1)),
+			},
+		},
+		"PublishesRenewal": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest: getCertificate(renewed, nil),
+				},
+				kube: &test.MockClient{
+					MockGet: getConnectionSecret(map[string][]byte{
+						acmpca.ConnectionDetailsPrivateKeyKey:        otherKey,
+						acmpca.ConnectionDetailsPendingPrivateKeyKey: renewedKey,
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret(), withObservation(notAfter), withPending(renewedArn, 1)),
+			},
+			want: want{
+				cr: certificate(withExternalName(renewedArn), withConnectionSecret(), func(r *v1alpha1.Certificate) {
+					withObservation(notAfter)(r)
+					r.Status.AtProvider.CertificateARN = renewedArn
+					r.Status.AtProvider.RenewalGeneration = 1
+					r.Status.AtProvider.SupersededSerial = serial
+				}),
+				result: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					acmpca.ConnectionDetailsCertificateKey:       []byte(renewed),
+					acmpca.ConnectionDetailsCertificateChainKey:  []byte(certificateChain),
+					acmpca.ConnectionDetailsPrivateKeyKey:        renewedKey,
+					acmpca.ConnectionDetailsPendingPrivateKeyKey: {},
+				}},
+			},
+		},
+		"RenewalKeyLost": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockGetCertificateRequest:    getCertificate(renewed, nil),
+					MockRevokeCertificateRequest: revokeCertificate(t, serial),
+				},
+				kube: &test.MockClient{
+					MockGet: getConnectionSecret(map[string][]byte{
+						acmpca.ConnectionDetailsPrivateKeyKey: otherKey,
+					}),
+				},
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret(), withObservation(notAfter), withPending(renewedArn, 1)),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withConnectionSecret(), withObservation(notAfter), withPending("", 1)),
+			},
+		},
+		"RevokesSuperseded": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockRevokeCertificateRequest: revokeCertificate(t, "02"),
+				},
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter), withSuperseded("02")),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withObservation(notAfter)),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acmpca, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pendingKey, len(o.ConnectionDetails[acmpca.ConnectionDetailsPendingPrivateKeyKey]) > 0); diff != "" {
+				t.Errorf("r: -want pending private key, +got pending private key:\n%s", diff)
+			}
+			if tc.want.pendingKey {
+				o.ConnectionDetails = nil
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockRevokeCertificateRequest: func(input *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+						if diff := cmp.Diff(serial, aws.StringValue(input.CertificateSerial)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.RevokeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.RevokeCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn), withSerial()),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withSerial(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"RevokesSuperseded": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockRevokeCertificateRequest: func(input *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+						return awsacmpca.RevokeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.RevokeCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn), withSerial(), withSuperseded("02")),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withSerial(), withSuperseded("02"), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyRevoked": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockRevokeCertificateRequest: func(input *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+						return awsacmpca.RevokeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsacmpca.ErrCodeRequestAlreadyProcessedException, "", nil)},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn), withSerial()),
+			},
+			want: want{
+				cr: certificate(withExternalName(certificateArn), withSerial(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				acmpca: &fake.MockCertificateClient{
+					MockRevokeCertificateRequest: func(input *awsacmpca.RevokeCertificateInput) awsacmpca.RevokeCertificateRequest {
+						return awsacmpca.RevokeCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificate(withExternalName(certificateArn), withSerial()),
+			},
+			want: want{
+				cr:  certificate(withExternalName(certificateArn), withSerial()),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.acmpca}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -51,6 +51,15 @@ const (
 	errListTagsFailed       = "failed to list tags for ACMPCA"
 	errRemoveTagsFailed     = "failed to remove tags for ACMPCA"
 	errCertificateAuthority = "failed to update the ACMPCA resource"
+
+	errGetCertificate    = "failed to get the certificate of the ACMPCA resource"
+	errGetCsr            = "failed to get the certificate signing request of the ACMPCA resource"
+	errNoParent          = "parent certificate authority is required to sign a SUBORDINATE ACMPCA resource"
+	errGetParent         = "failed to get the parent ACMPCA resource"
+	errParentNotActive   = "parent ACMPCA resource is not ACTIVE"
+	errIssueCertificate  = "failed to issue the certificate of the ACMPCA resource"
+	errGetIssued         = "failed to get the issued certificate of the ACMPCA resource"
+	errImportCertificate = "failed to import the certificate of the ACMPCA resource"
 )
 
 // SetupCertificateAuthority adds a controller that reconciles ACMPCA.
@@ -63,7 +72,7 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CertificateAuthorityGroupVersionKind),
 			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
-			managed.WithConnectionPublishers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 
 			// TODO: implement tag initializer
 
@@ -127,10 +136,26 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
 	}
-	cr.SetConditions(xpv1.Available())
+	switch certificateAuthority.Status {
+	case awsacmpca.CertificateAuthorityStatusCreating, awsacmpca.CertificateAuthorityStatusPendingCertificate:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
 
 	cr.Status.AtProvider = acmpca.GenerateCertificateAuthorityExternalStatus(certificateAuthority)
 
+	var conn managed.ConnectionDetails
+	if acmpca.HasCertificate(certificateAuthority.Status) {
+		cert, err := e.client.GetCertificateAuthorityCertificateRequest(&awsacmpca.GetCertificateAuthorityCertificateInput{
+			CertificateAuthorityArn: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetCertificate)
+		}
+		conn = acmpca.GetConnectionDetails(cert.GetCertificateAuthorityCertificateOutput)
+	}
+
 	tags, err := e.client.ListTagsRequest(&awsacmpca.ListTagsInput{
 		CertificateAuthorityArn: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
@@ -140,8 +165,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
+		ResourceExists:    true,
+		ResourceUpToDate:  acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
+		ConnectionDetails: conn,
 	}, nil
 }
 
@@ -168,6 +194,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	// A new Certificate Authority needs its certificate imported before
+	// anything else about it can be updated.
+	if cr.Status.AtProvider.Status == string(awsacmpca.CertificateAuthorityStatusPendingCertificate) {
+		return managed.ExternalUpdate{}, e.activate(ctx, cr)
+	}
+
 	// Update the Certificate Authority tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 		tags := make([]awsacmpca.Tag, len(cr.Spec.ForProvider.Tags))
@@ -208,6 +240,68 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, errors.Wrap(err, errCertificateAuthority)
 }
 
+// activate signs the CSR of the Certificate Authority, either by itself for a
+// ROOT or by its parent for a SUBORDINATE, and imports the issued certificate.
+func (e *external) activate(ctx context.Context, cr *v1alpha1.CertificateAuthority) error {
+	caARN := meta.GetExternalName(cr)
+	csr, err := e.client.GetCertificateAuthorityCsrRequest(&awsacmpca.GetCertificateAuthorityCsrInput{
+		CertificateAuthorityArn: aws.String(caARN),
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errGetCsr)
+	}
+
+	signerARN, alg := caARN, cr.Spec.ForProvider.CertificateAuthorityConfiguration.SigningAlgorithm
+	if cr.Spec.ForProvider.Type == awsacmpca.CertificateAuthorityTypeSubordinate {
+		if cr.Spec.ForProvider.ParentCertificateAuthorityARN == nil {
+			return errors.New(errNoParent)
+		}
+		signerARN = aws.StringValue(cr.Spec.ForProvider.ParentCertificateAuthorityARN)
+		parent, err := e.client.DescribeCertificateAuthorityRequest(&awsacmpca.DescribeCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(signerARN),
+		}).Send(ctx)
+		if err != nil {
+			return errors.Wrap(err, errGetParent)
+		}
+		if parent.CertificateAuthority == nil || parent.CertificateAuthority.Status != awsacmpca.CertificateAuthorityStatusActive {
+			return errors.New(errParentNotActive)
+		}
+		alg = parent.CertificateAuthority.CertificateAuthorityConfiguration.SigningAlgorithm
+	}
+
+	input, err := acmpca.GenerateIssueCertificateInput(caARN, signerARN, cr.Spec.ForProvider, alg, []byte(aws.StringValue(csr.Csr)))
+	if err != nil {
+		return errors.Wrap(err, errIssueCertificate)
+	}
+	issued, err := e.client.IssueCertificateRequest(input).Send(ctx)
+	if err != nil {
+		return errors.Wrap(err, errIssueCertificate)
+	}
+
+	cert, err := e.client.GetCertificateRequest(&awsacmpca.GetCertificateInput{
+		CertificateArn:          issued.CertificateArn,
+		CertificateAuthorityArn: aws.String(signerARN),
+	}).Send(ctx)
+	if acmpca.IsErrorRequestInProgress(err) {
+		// The certificate is issued asynchronously. We try again in the next
+		// reconcile with the same idempotency token.
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetIssued)
+	}
+
+	in := &awsacmpca.ImportCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		Certificate:             []byte(aws.StringValue(cert.Certificate)),
+	}
+	if cr.Spec.ForProvider.Type == awsacmpca.CertificateAuthorityTypeSubordinate {
+		in.CertificateChain = []byte(aws.StringValue(cert.CertificateChain))
+	}
+	_, err = e.client.ImportCertificateAuthorityCertificateRequest(in).Send(ctx)
+	return errors.Wrap(err, errImportCertificate)
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1alpha1.CertificateAuthority)
 	if !ok {
@@ -56,6 +56,12 @@ var (
 	state                      = "someState"
 	surname                    = "someSurname"
 	title                      = "someTitle"
+	parentArn                  = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/66666666-7777-8888-9999-000000000000"
+	pendingArn                 = "arn:aws:acm-pca:us-east-1:123456789012:certificate-authority/11111111-2222-3333-4444-555555555555"
+	issuedArn                  = "someissuedarn"
+	csr                        = "somecsr"
+	certificate                = "somecertificate"
+	certificateChain           = "somecertificatechain"
 
 	errBoom = errors.New("boom")
 )
@@ -96,6 +102,21 @@ func withCertificateAuthorityStatus() certificateAuthorityModifier {
 	}
 }
 
+func withPendingCertificate(t awsacmpca.CertificateAuthorityType) certificateAuthorityModifier {
+	return func(r *v1alpha1.CertificateAuthority) {
+		r.Spec.ForProvider.Type = t
+		r.Spec.ForProvider.CertificateAuthorityConfiguration.SigningAlgorithm = awsacmpca.SigningAlgorithmSha256withrsa
+		r.Status.AtProvider.Status = string(awsacmpca.CertificateAuthorityStatusPendingCertificate)
+		meta.SetExternalName(r, pendingArn)
+	}
+}
+
+func withParent() certificateAuthorityModifier {
+	return func(r *v1alpha1.CertificateAuthority) {
+		r.Spec.ForProvider.ParentCertificateAuthorityARN = aws.String(parentArn)
+	}
+}
+
 func certificateAuthority(m ...certificateAuthorityModifier) *v1alpha1.CertificateAuthority {
 	cr := &v1alpha1.CertificateAuthority{}
 	meta.SetExternalName(cr, certificateAuthorityArn)
@@ -172,6 +193,132 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PendingCertificate": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockDescribeCertificateAuthorityRequest: func(*awsacmpca.DescribeCertificateAuthorityInput) awsacmpca.DescribeCertificateAuthorityRequest {
+						return awsacmpca.DescribeCertificateAuthorityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.DescribeCertificateAuthorityOutput{
+								CertificateAuthority: &awsacmpca.CertificateAuthority{
+									Arn:    aws.String(certificateAuthorityArn),
+									Type:   awsacmpca.CertificateAuthorityTypeRoot,
+									Status: awsacmpca.CertificateAuthorityStatusPendingCertificate,
+									RevocationConfiguration: &awsacmpca.RevocationConfiguration{
+										CrlConfiguration: &awsacmpca.CrlConfiguration{
+											Enabled: aws.Bool(false),
+										},
+									},
+									CertificateAuthorityConfiguration: &awsacmpca.CertificateAuthorityConfiguration{
+										Subject: &awsacmpca.ASN1Subject{},
+									},
+								},
+							}},
+						}
+					},
+					MockListTagsRequest: func(input *awsacmpca.ListTagsInput) awsacmpca.ListTagsRequest {
+						return awsacmpca.ListTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.ListTagsOutput{}},
+						}
+					},
+				},
+				cr: certificateAuthority(),
+			},
+			want: want{
+				cr: certificateAuthority(withCertificateAuthorityType(), withConditions(xpv1.Creating()), func(r *v1alpha1.CertificateAuthority) {
+					r.Status.AtProvider.Status = string(awsacmpca.CertificateAuthorityStatusPendingCertificate)
+				}),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ActiveWithCertificate": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockDescribeCertificateAuthorityRequest: func(*awsacmpca.DescribeCertificateAuthorityInput) awsacmpca.DescribeCertificateAuthorityRequest {
+						return awsacmpca.DescribeCertificateAuthorityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.DescribeCertificateAuthorityOutput{
+								CertificateAuthority: &awsacmpca.CertificateAuthority{
+									Arn:    aws.String(certificateAuthorityArn),
+									Type:   awsacmpca.CertificateAuthorityTypeRoot,
+									Status: awsacmpca.CertificateAuthorityStatusActive,
+									RevocationConfiguration: &awsacmpca.RevocationConfiguration{
+										CrlConfiguration: &awsacmpca.CrlConfiguration{
+											Enabled: aws.Bool(false),
+										},
+									},
+									CertificateAuthorityConfiguration: &awsacmpca.CertificateAuthorityConfiguration{
+										Subject: &awsacmpca.ASN1Subject{},
+									},
+								},
+							}},
+						}
+					},
+					MockListTagsRequest: func(input *awsacmpca.ListTagsInput) awsacmpca.ListTagsRequest {
+						return awsacmpca.ListTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.ListTagsOutput{}},
+						}
+					},
+					MockGetCertificateAuthorityCertificateRequest: func(input *awsacmpca.GetCertificateAuthorityCertificateInput) awsacmpca.GetCertificateAuthorityCertificateRequest {
+						return awsacmpca.GetCertificateAuthorityCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCertificateOutput{
+								Certificate: aws.String(certificate),
+							}},
+						}
+					},
+				},
+				cr: certificateAuthority(),
+			},
+			want: want{
+				cr: certificateAuthority(withCertificateAuthorityType(), withCertificateAuthorityStatus(), withConditions(xpv1.Available()), func(r *v1alpha1.CertificateAuthority) {
+					r.Status.AtProvider.Status = string(awsacmpca.CertificateAuthorityStatusActive)
+				}),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						acmpca.ConnectionDetailsCertificateKey: []byte(certificate),
+					},
+				},
+			},
+		},
+		"GetCertificateError": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockDescribeCertificateAuthorityRequest: func(*awsacmpca.DescribeCertificateAuthorityInput) awsacmpca.DescribeCertificateAuthorityRequest {
+						return awsacmpca.DescribeCertificateAuthorityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.DescribeCertificateAuthorityOutput{
+								CertificateAuthority: &awsacmpca.CertificateAuthority{
+									Arn:    aws.String(certificateAuthorityArn),
+									Type:   awsacmpca.CertificateAuthorityTypeRoot,
+									Status: awsacmpca.CertificateAuthorityStatusActive,
+									RevocationConfiguration: &awsacmpca.RevocationConfiguration{
+										CrlConfiguration: &awsacmpca.CrlConfiguration{
+											Enabled: aws.Bool(false),
+										},
+									},
+									CertificateAuthorityConfiguration: &awsacmpca.CertificateAuthorityConfiguration{
+										Subject: &awsacmpca.ASN1Subject{},
+									},
+								},
+							}},
+						}
+					},
+					MockGetCertificateAuthorityCertificateRequest: func(input *awsacmpca.GetCertificateAuthorityCertificateInput) awsacmpca.GetCertificateAuthorityCertificateRequest {
+						return awsacmpca.GetCertificateAuthorityCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificateAuthority(),
+			},
+			want: want{
+				cr: certificateAuthority(withCertificateAuthorityType(), withCertificateAuthorityStatus(), withConditions(xpv1.Available()), func(r *v1alpha1.CertificateAuthority) {
+					r.Status.AtProvider.Status = string(awsacmpca.CertificateAuthorityStatusActive)
+				}),
+				err: errors.Wrap(errBoom, errGetCertificate),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -438,6 +585,206 @@ func TestUpdate(t *testing.T) {
 				err: errors.Wrap(errBoom, errCertificateAuthority),
 			},
 		},
+		"ActivateRoot": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(input *awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String(csr),
+							}},
+						}
+					},
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						if diff := cmp.Diff(pendingArn, aws.StringValue(input.CertificateAuthorityArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(issuedArn),
+							}},
+						}
+					},
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateOutput{
+								Certificate: aws.String(certificate),
+							}},
+						}
+					},
+					MockImportCertificateAuthorityCertificateRequest: func(input *awsacmpca.ImportCertificateAuthorityCertificateInput) awsacmpca.ImportCertificateAuthorityCertificateRequest {
+						if diff := cmp.Diff([]byte(certificate), input.Certificate); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if input.CertificateChain != nil {
+							t.Errorf("r: unexpected certificate chain for ROOT certificate authority")
+						}
+						return awsacmpca.ImportCertificateAuthorityCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.ImportCertificateAuthorityCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+		},
+		"ActivateSubordinate": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(input *awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String(csr),
+							}},
+						}
+					},
+					MockDescribeCertificateAuthorityRequest: func(*awsacmpca.DescribeCertificateAuthorityInput) awsacmpca.DescribeCertificateAuthorityRequest {
+						return awsacmpca.DescribeCertificateAuthorityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.DescribeCertificateAuthorityOutput{
+								CertificateAuthority: &awsacmpca.CertificateAuthority{
+									Status: awsacmpca.CertificateAuthorityStatusActive,
+									CertificateAuthorityConfiguration: &awsacmpca.CertificateAuthorityConfiguration{
+										SigningAlgorithm: awsacmpca.SigningAlgorithmSha512withecdsa,
+									},
+								},
+							}},
+						}
+					},
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						if diff := cmp.Diff(parentArn, aws.StringValue(input.CertificateAuthorityArn)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(awsacmpca.SigningAlgorithmSha512withecdsa, input.SigningAlgorithm); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(issuedArn),
+							}},
+						}
+					},
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateOutput{
+								Certificate:      aws.String(certificate),
+								CertificateChain: aws.String(certificateChain),
+							}},
+						}
+					},
+					MockImportCertificateAuthorityCertificateRequest: func(input *awsacmpca.ImportCertificateAuthorityCertificateInput) awsacmpca.ImportCertificateAuthorityCertificateRequest {
+						if diff := cmp.Diff([]byte(certificateChain), input.CertificateChain); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsacmpca.ImportCertificateAuthorityCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.ImportCertificateAuthorityCertificateOutput{}},
+						}
+					},
+				},
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeSubordinate), withParent()),
+			},
+			want: want{
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeSubordinate), withParent()),
+			},
+		},
+		"ActivateSubordinateWithoutParent": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(input *awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String(csr),
+							}},
+						}
+					},
+				},
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeSubordinate)),
+			},
+			want: want{
+				cr:  certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeSubordinate)),
+				err: errors.New(errNoParent),
+			},
+		},
+		"ActivateParentNotActive": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(input *awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String(csr),
+							}},
+						}
+					},
+					MockDescribeCertificateAuthorityRequest: func(*awsacmpca.DescribeCertificateAuthorityInput) awsacmpca.DescribeCertificateAuthorityRequest {
+						return awsacmpca.DescribeCertificateAuthorityRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.DescribeCertificateAuthorityOutput{
+								CertificateAuthority: &awsacmpca.CertificateAuthority{
+									Status: awsacmpca.CertificateAuthorityStatusPendingCertificate,
+								},
+							}},
+						}
+					},
+				},
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeSubordinate), withParent()),
+			},
+			want: want{
+				cr:  certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeSubordinate), withParent()),
+				err: errors.New(errParentNotActive),
+			},
+		},
+		"ActivateCertificateInProgress": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(input *awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String(csr),
+							}},
+						}
+					},
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.IssueCertificateOutput{
+								CertificateArn: aws.String(issuedArn),
+							}},
+						}
+					},
+					MockGetCertificateRequest: func(input *awsacmpca.GetCertificateInput) awsacmpca.GetCertificateRequest {
+						return awsacmpca.GetCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(awsacmpca.ErrCodeRequestInProgressException, "", nil)},
+						}
+					},
+				},
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+		},
+		"ActivateIssueError": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsrRequest: func(input *awsacmpca.GetCertificateAuthorityCsrInput) awsacmpca.GetCertificateAuthorityCsrRequest {
+						return awsacmpca.GetCertificateAuthorityCsrRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsacmpca.GetCertificateAuthorityCsrOutput{
+								Csr: aws.String(csr),
+							}},
+						}
+					},
+					MockIssueCertificateRequest: func(input *awsacmpca.IssueCertificateInput) awsacmpca.IssueCertificateRequest {
+						return awsacmpca.IssueCertificateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeRoot)),
+			},
+			want: want{
+				cr:  certificateAuthority(withPendingCertificate(awsacmpca.CertificateAuthorityTypeRoot)),
+				err: errors.Wrap(errBoom, errIssueCertificate),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -22,6 +22,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
+	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificate"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
@@ -41,23 +42,40 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cluster"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
+	"github.com/crossplane/provider-aws/pkg/controller/database/dbcluster"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/parametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/backup"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/globaltable"
 	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/table"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/elasticip"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/keypair"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygrouprule"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/addon"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/fargateprofile"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/iamidentitymapping"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listenerrule"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroupattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamaccesskey"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamgrouppolicyattachment"
@@ -67,6 +85,13 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamrolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuser"
 	"github.com/crossplane/provider-aws/pkg/controller/identity/iamuserpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/identity/openidconnectprovider"
+	kmsalias "github.com/crossplane/provider-aws/pkg/controller/kms/alias"
+	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/alias"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/eventsourcemapping"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/permission"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	"github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
@@ -74,9 +99,11 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53/resourcerecordset"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/activity"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/statemachine"
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
+	"github.com/crossplane/provider-aws/pkg/controller/sqs/queuepolicy"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
@@ -84,6 +111,7 @@ import (
 func Setup(mgr ctrl.Manager, l logging.Logger) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger) error{
 		config.Setup,
+		config.SetupHealth,
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
 		cluster.SetupCacheCluster,
@@ -91,7 +119,14 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		eks.SetupCluster,
 		elb.SetupELB,
 		elbattachment.SetupELBAttachment,
+		loadbalancer.SetupLoadBalancer,
+		targetgroup.SetupTargetGroup,
+		listener.SetupListener,
+		listenerrule.SetupListenerRule,
+		targetgroupattachment.SetupTargetGroupAttachment,
 		nodegroup.SetupNodeGroup,
+		addon.SetupAddon,
+		iamidentitymapping.SetupIAMIdentityMapping,
 		s3.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
 		iamaccesskey.SetupIAMAccessKey,
@@ -103,14 +138,27 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		iamuserpolicyattachment.SetupIAMUserPolicyAttachment,
 		iamgrouppolicyattachment.SetupIAMGroupPolicyAttachment,
 		iamrolepolicyattachment.SetupIAMRolePolicyAttachment,
+		openidconnectprovider.SetupOpenIDConnectProvider,
 		vpc.SetupVPC,
 		subnet.SetupSubnet,
 		securitygroup.SetupSecurityGroup,
+		securitygrouprule.SetupSecurityGroupRule,
+		vpcpeeringconnection.SetupVPCPeeringConnection,
+		transitgateway.SetupTransitGateway,
+		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
+		vpcendpoint.SetupVPCEndpoint,
+		keypair.SetupKeyPair,
+		launchtemplate.SetupLaunchTemplate,
+		instance.SetupInstance,
 		internetgateway.SetupInternetGateway,
 		natgateway.SetupNatGateway,
 		routetable.SetupRouteTable,
 		dbsubnetgroup.SetupDBSubnetGroup,
+		dbcluster.SetupDBCluster,
+		parametergroup.SetupDBParameterGroup,
+		parametergroup.SetupDBClusterParameterGroup,
 		certificateauthority.SetupCertificateAuthority,
+		certificate.SetupCertificate,
 		certificateauthoritypermission.SetupCertificateAuthorityPermission,
 		acm.SetupCertificate,
 		resourcerecordset.SetupResourceRecordSet,
@@ -118,6 +166,7 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		snstopic.SetupSNSTopic,
 		snssubscription.SetupSubscription,
 		queue.SetupQueue,
+		queuepolicy.SetupQueuePolicy,
 		redshift.SetupCluster,
 		elasticip.SetupElasticIP,
 		repository.SetupRepository,
@@ -139,6 +188,13 @@ func Setup(mgr ctrl.Manager, l logging.Logger) error {
 		table.SetupTable,
 		backup.SetupBackup,
 		globaltable.SetupGlobalTable,
+		function.SetupFunction,
+		alias.SetupAlias,
+		permission.SetupPermission,
+		eventsourcemapping.SetupEventSourceMapping,
+		key.SetupKey,
+		kmsalias.SetupAlias,
+		secret.SetupSecret,
 	} {
 		if err := setup(mgr, l); err != nil {
 			return err
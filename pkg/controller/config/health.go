@@ -0,0 +1,289 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+)
+
+const (
+	healthTimeout = 2 * time.Minute
+
+	// healthPollInterval is the interval at which the credentials of healthy
+	// ProviderConfigs are validated.
+	healthPollInterval = 10 * time.Minute
+
+	// healthRetryInterval is the interval at which the credentials of failing
+	// ProviderConfigs are validated.
+	healthRetryInterval = 1 * time.Minute
+
+	errGetPC                = "cannot get ProviderConfig"
+	errGetConfig            = "cannot get AWS config"
+	errGetCallerIdentity    = "cannot get caller identity"
+	errUpdateProviderStatus = "cannot update ProviderConfig status"
+)
+
+// Event reasons.
+const (
+	reasonCredentialsError   event.Reason = "CredentialsError"
+	reasonCredentialsRotated event.Reason = "CredentialsRotated"
+)
+
+// ReasonCredentialsError indicates that the credentials of a ProviderConfig
+// cannot be used to authenticate to AWS.
+const ReasonCredentialsError xpv1.ConditionReason = "CredentialsError"
+
+// CredentialsError returns a condition that indicates the credentials of a
+// ProviderConfig cannot be used to authenticate to AWS.
+func CredentialsError(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCredentialsError,
+		Message:            err.Error(),
+	}
+}
+
+// partitionRegions are the regions whose STS endpoints are used to validate
+// the credentials of ProviderConfigs targeting a partition.
+var partitionRegions = map[string]string{
+	"aws":        "us-east-1",
+	"aws-cn":     "cn-north-1",
+	"aws-us-gov": "us-gov-west-1",
+	"aws-iso":    "us-iso-east-1",
+	"aws-iso-b":  "us-isob-east-1",
+}
+
+// SetupHealth adds a controller that periodically validates the credentials
+// of ProviderConfigs by calling STS GetCallerIdentity.
+func SetupHealth(mgr ctrl.Manager, l logging.Logger) error {
+	name := "health/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	r := NewHealthReconciler(mgr.GetClient(),
+		WithLogger(l.WithValues("controller", name)),
+		WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	// Secrets are watched as well since rotating the credentials does not
+	// change the generation of the ProviderConfigs that reference them.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: providerConfigsForSecret(mgr.GetClient())}).
+		Complete(r)
+}
+
+// providerConfigsForSecret returns a mapper that enqueues the ProviderConfigs
+// whose credentials are read from a Secret.
+func providerConfigsForSecret(c client.Reader) handler.ToRequestsFunc {
+	return func(o handler.MapObject) []reconcile.Request {
+		l := &v1beta1.ProviderConfigList{}
+		if err := c.List(context.Background(), l); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, pc := range l.Items {
+			ref := pc.Spec.Credentials.SecretRef
+			if pc.Spec.Credentials.Source != xpv1.CredentialsSourceSecret || ref == nil {
+				continue
+			}
+			if ref.Name == o.Meta.GetName() && ref.Namespace == o.Meta.GetNamespace() {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: pc.GetName()}})
+			}
+		}
+		return reqs
+	}
+}
+
+// A HealthReconcilerOption configures a HealthReconciler.
+type HealthReconcilerOption func(*HealthReconciler)
+
+// WithLogger specifies how the HealthReconciler should log messages.
+func WithLogger(l logging.Logger) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.log = l
+	}
+}
+
+// WithRecorder specifies how the HealthReconciler should record events.
+func WithRecorder(er event.Recorder) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.record = er
+	}
+}
+
+// WithConfigFn specifies how the HealthReconciler should get the AWS config
+// of a ProviderConfig.
+func WithConfigFn(fn func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, string, error)) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.configFn = fn
+	}
+}
+
+// WithConfigCache specifies the ConfigCache that the configs of deleted
+// ProviderConfigs should be evicted from.
+func WithConfigCache(cc *awsclients.ConfigCache) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.cache = cc
+	}
+}
+
+// WithNewClientFn specifies how the HealthReconciler should create STS
+// clients.
+func WithNewClientFn(fn func(aws.Config) sts.Client) HealthReconcilerOption {
+	return func(r *HealthReconciler) {
+		r.newClientFn = fn
+	}
+}
+
+// A HealthReconciler validates the credentials of ProviderConfigs and reports
+// the identity they belong to in their status.
+type HealthReconciler struct {
+	client      client.Client
+	configFn    func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, string, error)
+	newClientFn func(aws.Config) sts.Client
+	cache       *awsclients.ConfigCache
+
+	// versions are the credentials versions of the ProviderConfigs seen
+	// during the last successful validation.
+	mu       sync.Mutex
+	versions map[string]string
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// NewHealthReconciler returns a HealthReconciler of ProviderConfigs.
+func NewHealthReconciler(c client.Client, o ...HealthReconcilerOption) *HealthReconciler {
+	r := &HealthReconciler{
+		client:      c,
+		configFn:    awsclients.GetProviderConfigConfig,
+		newClientFn: sts.NewClient,
+		cache:       awsclients.DefaultConfigCache,
+		versions:    map[string]string{},
+		log:         logging.NewNopLogger(),
+		record:      event.NewNopRecorder(),
+	}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
+}
+
+// Reconcile a ProviderConfig by validating its credentials.
+func (r *HealthReconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		if kerrors.IsNotFound(err) {
+			r.forget(req.Name)
+		}
+		log.Debug(errGetPC, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		r.forget(pc.GetName())
+		return reconcile.Result{Requeue: false}, nil
+	}
+
+	id, version, err := r.validate(ctx, pc)
+	if err != nil {
+		log.Debug("Credentials validation failed", "error", err)
+		c := CredentialsError(err)
+		if !pc.Status.GetCondition(xpv1.TypeReady).Equal(c) {
+			r.record.Event(pc, event.Warning(reasonCredentialsError, err))
+		}
+		pc.Status.SetConditions(c)
+		return reconcile.Result{RequeueAfter: healthRetryInterval}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateProviderStatus)
+	}
+
+	if r.rotated(pc.GetName(), version) {
+		r.record.Event(pc, event.Normal(reasonCredentialsRotated, "Credentials of the ProviderConfig have changed", "arn", aws.StringValue(id.Arn)))
+	}
+	pc.Status.AccountID = id.Account
+	pc.Status.ARN = id.Arn
+	pc.Status.SetConditions(xpv1.Available())
+	return reconcile.Result{RequeueAfter: healthPollInterval}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateProviderStatus)
+}
+
+func (r *HealthReconciler) validate(ctx context.Context, pc *v1beta1.ProviderConfig) (*awssts.GetCallerIdentityOutput, string, error) {
+	region := partitionRegions["aws"]
+	if pc.Spec.Endpoint != nil && pc.Spec.Endpoint.Partition != nil {
+		region = partitionRegions[aws.StringValue(pc.Spec.Endpoint.Partition)]
+	}
+	cfg, version, err := r.configFn(ctx, r.client, pc, region)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errGetConfig)
+	}
+	resp, err := r.newClientFn(*cfg).GetCallerIdentityRequest(&awssts.GetCallerIdentityInput{}).Send(ctx)
+	if err != nil {
+		return nil, "", errors.Wrap(err, errGetCallerIdentity)
+	}
+	return resp.GetCallerIdentityOutput, version, nil
+}
+
+// rotated records the given credentials version of the named ProviderConfig
+// and returns whether it differs from the previously recorded one.
+func (r *HealthReconciler) rotated(name, version string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	prev, ok := r.versions[name]
+	r.versions[name] = version
+	return ok && prev != version
+}
+
+// forget drops everything known about the named ProviderConfig, including
+// its cached configs.
+func (r *HealthReconciler) forget(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.versions, name)
+	r.cache.Evict(name)
+}
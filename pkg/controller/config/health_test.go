@@ -0,0 +1,379 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sts"
+	"github.com/crossplane/provider-aws/pkg/clients/sts/fake"
+)
+
+var (
+	accountID = "123456789012"
+	arn       = "arn:aws:iam::123456789012:user/crossplane"
+	errBoom   = errors.New("boom")
+)
+
+func configFn(version string, err error) func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (*aws.Config, string, error) {
+	return func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (*aws.Config, string, error) {
+		if err != nil {
+			return nil, "", err
+		}
+		return &aws.Config{}, version, nil
+	}
+}
+
+func stsClient(err error) func(aws.Config) sts.Client {
+	return func(aws.Config) sts.Client {
+		return &fake.MockSTSClient{
+			MockGetCallerIdentityRequest: func(*awssts.GetCallerIdentityInput) awssts.GetCallerIdentityRequest {
+				return awssts.GetCallerIdentityRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awssts.GetCallerIdentityOutput{
+						Account: aws.String(accountID),
+						Arn:     aws.String(arn),
+					}},
+				}
+			},
+		}
+	}
+}
+
+func TestHealthReconcile(t *testing.T) {
+	type want struct {
+		result reconcile.Result
+		err    error
+		status v1beta1.ProviderConfigStatus
+	}
+
+	cases := map[string]struct {
+		opts []HealthReconcilerOption
+		kube *test.MockClient
+		want want
+	}{
+		"NotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+			},
+			want: want{
+				result: reconcile.Result{},
+			},
+		},
+		"GetError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPC),
+			},
+		},
+		"Healthy": {
+			opts: []HealthReconcilerOption{
+				WithConfigFn(configFn("1", nil)),
+				WithNewClientFn(stsClient(nil)),
+			},
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: healthPollInterval},
+				status: v1beta1.ProviderConfigStatus{
+					AccountID: aws.String(accountID),
+					ARN:       aws.String(arn),
+					ProviderConfigStatus: xpv1.ProviderConfigStatus{
+						ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{xpv1.Available()}},
+					},
+				},
+			},
+		},
+		"ConfigError": {
+			opts: []HealthReconcilerOption{
+				WithConfigFn(configFn("", errBoom)),
+				WithNewClientFn(stsClient(nil)),
+			},
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: healthRetryInterval},
+				status: v1beta1.ProviderConfigStatus{
+					ProviderConfigStatus: xpv1.ProviderConfigStatus{
+						ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{
+							CredentialsError(errors.Wrap(errBoom, errGetConfig)),
+						}},
+					},
+				},
+			},
+		},
+		"CallerIdentityError": {
+			opts: []HealthReconcilerOption{
+				WithConfigFn(configFn("1", nil)),
+				WithNewClientFn(stsClient(errBoom)),
+			},
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: healthRetryInterval},
+				status: v1beta1.ProviderConfigStatus{
+					ProviderConfigStatus: xpv1.ProviderConfigStatus{
+						ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{
+							CredentialsError(errors.Wrap(errBoom, errGetCallerIdentity)),
+						}},
+					},
+				},
+			},
+		},
+		"StatusUpdateError": {
+			opts: []HealthReconcilerOption{
+				WithConfigFn(configFn("1", nil)),
+				WithNewClientFn(stsClient(nil)),
+			},
+			kube: &test.MockClient{
+				MockGet:          test.NewMockGetFn(nil),
+				MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: healthPollInterval},
+				err:    errors.Wrap(errBoom, errUpdateProviderStatus),
+				status: v1beta1.ProviderConfigStatus{
+					AccountID: aws.String(accountID),
+					ARN:       aws.String(arn),
+					ProviderConfigStatus: xpv1.ProviderConfigStatus{
+						ConditionedStatus: xpv1.ConditionedStatus{Conditions: []xpv1.Condition{xpv1.Available()}},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var status v1beta1.ProviderConfigStatus
+			if tc.kube.MockStatusUpdate != nil {
+				update := tc.kube.MockStatusUpdate
+				tc.kube.MockStatusUpdate = func(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+					status = obj.(*v1beta1.ProviderConfig).Status
+					return update(ctx, obj, opts...)
+				}
+			}
+			r := NewHealthReconciler(tc.kube, tc.opts...)
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHealthReconcileEvictsConfigs(t *testing.T) {
+	cases := map[string]struct {
+		kube  *test.MockClient
+		evict bool
+	}{
+		"NotFound": {
+			kube:  &test.MockClient{MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, ""))},
+			evict: true,
+		},
+		"Deleted": {
+			kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+				now := metav1.Now()
+				obj.(*v1beta1.ProviderConfig).SetName("example")
+				obj.(*v1beta1.ProviderConfig).SetDeletionTimestamp(&now)
+				return nil
+			}},
+			evict: true,
+		},
+		"GetError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cc := awsclients.NewConfigCache()
+			builds := 0
+			build := func() (*aws.Config, error) { builds++; return &aws.Config{}, nil }
+			_, _ = cc.GetConfig("example/us-east-1", "1", build)
+
+			r := NewHealthReconciler(tc.kube, WithConfigCache(cc))
+			_, _ = r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}})
+
+			_, _ = cc.GetConfig("example/us-east-1", "1", build)
+			if diff := cmp.Diff(tc.evict, builds == 2); diff != "" {
+				t.Errorf("evicted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotated(t *testing.T) {
+	r := NewHealthReconciler(nil)
+	for _, tc := range []struct {
+		version string
+		want    bool
+	}{
+		{version: "1", want: false},
+		{version: "1", want: false},
+		{version: "2", want: true},
+		{version: "2", want: false},
+	} {
+		if diff := cmp.Diff(tc.want, r.rotated("example", tc.version)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	}
+}
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestHealthReconcileEvents(t *testing.T) {
+	var status v1beta1.ProviderConfigStatus
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			obj.(*v1beta1.ProviderConfig).Status = status
+			return nil
+		},
+		MockStatusUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+			status = obj.(*v1beta1.ProviderConfig).Status
+			return nil
+		},
+	}
+	rec := &recorder{}
+	fn := configFn("1", errBoom)
+	r := NewHealthReconciler(kube,
+		WithRecorder(rec),
+		WithConfigFn(func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, string, error) {
+			return fn(ctx, c, pc, region)
+		}),
+		WithNewClientFn(stsClient(nil)))
+
+	validate := func(err error) {
+		fn = configFn("1", err)
+		if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}}); err != nil {
+			t.Fatalf("r.Reconcile(...): %s", err)
+		}
+	}
+
+	// A failure that persists across retries is only reported once.
+	validate(errBoom)
+	validate(errBoom)
+	if diff := cmp.Diff(1, len(rec.events)); diff != "" {
+		t.Errorf("events: -want, +got:\n%s", diff)
+	}
+
+	// A different failure is reported again, and so is the same failure
+	// once the credentials have been healthy in between.
+	validate(errors.New("other"))
+	validate(nil)
+	validate(errBoom)
+	if diff := cmp.Diff(3, len(rec.events)); diff != "" {
+		t.Errorf("events: -want, +got:\n%s", diff)
+	}
+}
+
+func TestProviderConfigsForSecret(t *testing.T) {
+	pc := func(name string, source xpv1.CredentialsSource, secret string) v1beta1.ProviderConfig {
+		p := v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		p.Spec.Credentials.Source = source
+		if secret != "" {
+			p.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: secret, Namespace: "crossplane-system"},
+				Key:             "creds",
+			}
+		}
+		return p
+	}
+
+	cases := map[string]struct {
+		kube *test.MockClient
+		want []reconcile.Request
+	}{
+		"Referenced": {
+			kube: &test.MockClient{
+				MockList: func(_ context.Context, obj runtime.Object, _ ...client.ListOption) error {
+					obj.(*v1beta1.ProviderConfigList).Items = []v1beta1.ProviderConfig{
+						pc("a", xpv1.CredentialsSourceSecret, "aws-creds"),
+						pc("b", xpv1.CredentialsSourceSecret, "other-creds"),
+						pc("c", xpv1.CredentialsSourceInjectedIdentity, ""),
+						pc("d", xpv1.CredentialsSourceSecret, "aws-creds"),
+					}
+					return nil
+				},
+			},
+			want: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "a"}},
+				{NamespacedName: types.NamespacedName{Name: "d"}},
+			},
+		},
+		"ListError": {
+			kube: &test.MockClient{
+				MockList: test.NewMockListFn(errBoom),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "aws-creds", Namespace: "crossplane-system"}}
+			got := providerConfigsForSecret(tc.kube)(handler.MapObject{Meta: s, Object: s})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+)
+
+const (
+	errNotDBCluster        = "managed resource is not a DBCluster custom resource"
+	errKubeUpdateFailed    = "cannot update DBCluster custom resource"
+	errCreateFailed        = "cannot create DBCluster"
+	errModifyFailed        = "cannot modify DBCluster"
+	errAddTagsFailed       = "cannot add tags to DBCluster"
+	errDeleteFailed        = "cannot delete DBCluster"
+	errDescribeFailed      = "cannot describe DBCluster"
+	errPatchCreationFailed = "cannot create a patch object"
+	errUpToDateFailed      = "cannot check whether object is up-to-date"
+)
+
+// SetupDBCluster adds a controller that reconciles DBClusters.
+func SetupDBCluster(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBClusterGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBCluster{}).
+		Complete(newReconciler(mgr, l))
+}
+
+// newReconciler returns a reconciler of DBClusters. The supplied options
+// override the default ones.
+func newReconciler(mgr ctrl.Manager, l logging.Logger, o ...managed.ReconcilerOption) *managed.Reconciler {
+	name := managed.ControllerName(v1beta1.DBClusterGroupKind)
+	opts := []managed.ReconcilerOption{
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewDBClusterClient}),
+		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+	return managed.NewReconciler(mgr, resource.ManagedKind(v1beta1.DBClusterGroupVersionKind), append(opts, o...)...)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) rds.DBClusterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return nil, errors.New(errNotDBCluster)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{c.newClientFn(*cfg), c.kube}, nil
+}
+
+type external struct {
+	client rds.DBClusterClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDBCluster)
+	}
+
+	rsp, err := e.client.DescribeDBClustersRequest(&awsrds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(rds.IsDBClusterNotFound, err), errDescribeFailed)
+	}
+
+	// We use an explicit identifier, so, if there is no error, there should
+	// be only 1 element in the list.
+	cluster := rsp.DBClusters[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	rds.LateInitializeDBCluster(&cr.Spec.ForProvider, &cluster)
+	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+	cr.Status.AtProvider = rds.GenerateDBClusterObservation(cluster)
+
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.DBClusterStateAvailable, v1beta1.DBClusterStateModifying, v1beta1.DBClusterStateBackingUp:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1beta1.DBClusterStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1beta1.DBClusterStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+	upToDate, err := rds.IsDBClusterUpToDate(ctx, e.kube, cr, cluster)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: rds.GetDBClusterConnectionDetails(*cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDBCluster)
+	}
+	cr.SetConditions(xpv1.Creating())
+	if cr.Status.AtProvider.Status == v1beta1.DBClusterStateCreating {
+		return managed.ExternalCreation{}, nil
+	}
+	pw, _, err := rds.GetDBClusterPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if pw == "" {
+		pw, err = password.Generate()
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	_, err = e.client.CreateDBClusterRequest(rds.GenerateCreateDBClusterInput(meta.GetExternalName(cr), pw, &cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}
+	if cr.Spec.ForProvider.MasterUsername != nil {
+		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
+	}
+	return managed.ExternalCreation{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDBCluster)
+	}
+	switch cr.Status.AtProvider.Status {
+	case v1beta1.DBClusterStateModifying, v1beta1.DBClusterStateCreating:
+		return managed.ExternalUpdate{}, nil
+	}
+	// Similar to RDS instances, AWS rejects modification requests that
+	// contain fields whose values are same as the current ones, so we send
+	// only a patch of the desired state against the current state.
+	rsp, err := e.client.DescribeDBClustersRequest(&awsrds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(meta.GetExternalName(cr))}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeFailed)
+	}
+	patch, err := rds.CreateDBClusterPatch(&rsp.DBClusters[0], &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPatchCreationFailed)
+	}
+	modify := rds.GenerateModifyDBClusterInput(meta.GetExternalName(cr), patch, &rsp.DBClusters[0])
+	var conn managed.ConnectionDetails
+
+	pwd, changed, err := rds.GetDBClusterPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if changed {
+		conn = managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+		}
+		modify.MasterUserPassword = aws.String(pwd)
+	}
+
+	if _, err = e.client.ModifyDBClusterRequest(modify).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModifyFailed)
+	}
+	if len(patch.Tags) > 0 {
+		_, err = e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{
+			ResourceName: aws.String(cr.Status.AtProvider.DBClusterARN),
+			Tags:         rds.GenerateTags(patch.Tags),
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{ConnectionDetails: conn}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return errors.New(errNotDBCluster)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == v1beta1.DBClusterStateDeleting {
+		return nil
+	}
+	// NOTE: Same as for RDS instances, we update the cluster before deleting
+	// it so that changes like disabling deletion protection take effect. The
+	// update is best effort.
+	_, err := e.Update(ctx, cr)
+	if rds.IsDBClusterNotFound(err) {
+		return nil
+	}
+	_, err = e.client.DeleteDBClusterRequest(&awsrds.DeleteDBClusterInput{
+		DBClusterIdentifier:       aws.String(meta.GetExternalName(cr)),
+		SkipFinalSnapshot:         cr.Spec.ForProvider.SkipFinalSnapshotBeforeDeletion,
+		FinalDBSnapshotIdentifier: cr.Spec.ForProvider.FinalDBSnapshotIdentifier,
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(rds.IsDBClusterNotFound, err), errDeleteFailed)
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.DBCluster)
+	if !ok {
+		return errors.New(errNotDBCluster)
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[t.Key] = t.Value
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		tagMap[k] = v
+	}
+	cr.Spec.ForProvider.Tags = make([]v1beta1.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return cr.Spec.ForProvider.Tags[i].Key < cr.Spec.ForProvider.Tags[j].Key
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
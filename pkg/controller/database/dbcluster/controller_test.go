@@ -0,0 +1,529 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	xpfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+var (
+	masterUsername = "root"
+	engine         = "aurora-mysql"
+	endpoint       = "example.cluster-123456789012.us-east-1.rds.amazonaws.com"
+	readerEndpoint = "example.cluster-ro-123456789012.us-east-1.rds.amazonaws.com"
+	port           = 3306
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds  rds.DBClusterClient
+	kube client.Client
+	cr   *v1beta1.DBCluster
+}
+
+type clusterModifier func(*v1beta1.DBCluster)
+
+func withMasterUsername(s *string) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Spec.ForProvider.MasterUsername = s }
+}
+
+func withConditions(c ...xpv1.Condition) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withEngine(s string) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Spec.ForProvider.Engine = s }
+}
+
+func withPort(p int) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Spec.ForProvider.Port = &p }
+}
+
+func withStatus(s string) clusterModifier {
+	return func(r *v1beta1.DBCluster) { r.Status.AtProvider.Status = s }
+}
+
+func withEndpoints() clusterModifier {
+	return func(r *v1beta1.DBCluster) {
+		r.Status.AtProvider.Endpoint = endpoint
+		r.Status.AtProvider.ReaderEndpoint = readerEndpoint
+		r.Status.AtProvider.Port = port
+	}
+}
+
+func cluster(m ...clusterModifier) *v1beta1.DBCluster {
+	cr := &v1beta1.DBCluster{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(db awsrds.DBCluster, err error) func(*awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+	return func(*awsrds.DescribeDBClustersInput) awsrds.DescribeDBClustersRequest {
+		return awsrds.DescribeDBClustersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBClustersOutput{
+				DBClusters: []awsrds.DBCluster{db},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBCluster
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{
+						Engine:         aws.String(engine),
+						Status:         aws.String(v1beta1.DBClusterStateAvailable),
+						Endpoint:       aws.String(endpoint),
+						ReaderEndpoint: aws.String(readerEndpoint),
+						Port:           aws.Int64(int64(port)),
+					}, nil),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: cluster(withEngine(engine)),
+			},
+			want: want{
+				cr: cluster(withEngine(engine), withPort(port), withStatus(v1beta1.DBClusterStateAvailable), withEndpoints(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+						rds.ConnectionDetailsReaderEndpointKey:    []byte(readerEndpoint),
+					},
+				},
+			},
+		},
+		"CreatingState": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{
+						Engine: aws.String(engine),
+						Status: aws.String(v1beta1.DBClusterStateCreating),
+					}, nil),
+				},
+				cr: cluster(withEngine(engine)),
+			},
+			want: want{
+				cr: cluster(withEngine(engine), withStatus(v1beta1.DBClusterStateCreating), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{
+						Engine:         aws.String(engine),
+						MasterUsername: aws.String(masterUsername),
+					}, nil),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: cluster(withEngine(engine)),
+			},
+			want: want{
+				cr:  cluster(withEngine(engine), withMasterUsername(&masterUsername)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{}, errors.New(awsrds.ErrCodeDBClusterNotFoundFault)),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{}, errBoom),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBCluster
+		result managed.ExternalCreation
+		err    error
+	}
+
+	create := func(err error) func(*awsrds.CreateDBClusterInput) awsrds.CreateDBClusterRequest {
+		return func(*awsrds.CreateDBClusterInput) awsrds.CreateDBClusterRequest {
+			return awsrds.CreateDBClusterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBClusterOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulNoNeedForCreate": {
+			args: args{
+				cr: cluster(withStatus(v1beta1.DBClusterStateCreating)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1beta1.DBClusterStateCreating), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				rds: &fake.MockDBClusterClient{MockCreate: create(errBoom)},
+				cr:  cluster(withMasterUsername(&masterUsername)),
+			},
+			want: want{
+				cr:  cluster(withMasterUsername(&masterUsername), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("Successful", func(t *testing.T) {
+		e := &external{client: &fake.MockDBClusterClient{MockCreate: create(nil)}}
+		o, err := e.Create(context.Background(), cluster(withMasterUsername(&masterUsername)))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) == 0 {
+			t.Errorf("expected a generated password in the connection details")
+		}
+		if diff := cmp.Diff([]byte(masterUsername), o.ConnectionDetails[xpv1.ResourceCredentialsSecretUserKey]); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	modify := func(err error) func(*awsrds.ModifyDBClusterInput) awsrds.ModifyDBClusterRequest {
+		return func(*awsrds.ModifyDBClusterInput) awsrds.ModifyDBClusterRequest {
+			return awsrds.ModifyDBClusterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBClusterOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{Engine: aws.String(engine)}, nil),
+					MockModify:   modify(nil),
+				},
+				cr: cluster(withEngine(engine)),
+			},
+		},
+		"AlreadyModifying": {
+			args: args{
+				cr: cluster(withStatus(v1beta1.DBClusterStateModifying)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{}, errBoom),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{Engine: aws.String(engine)}, nil),
+					MockModify:   modify(errBoom),
+				},
+				cr: cluster(withEngine(engine)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModifyFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			u, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, u); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBCluster
+		err error
+	}
+
+	deleteCluster := func(err error) func(*awsrds.DeleteDBClusterInput) awsrds.DeleteDBClusterRequest {
+		return func(*awsrds.DeleteDBClusterInput) awsrds.DeleteDBClusterRequest {
+			return awsrds.DeleteDBClusterRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DeleteDBClusterOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{Engine: aws.String(engine)}, nil),
+					MockModify: func(*awsrds.ModifyDBClusterInput) awsrds.ModifyDBClusterRequest {
+						return awsrds.ModifyDBClusterRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBClusterOutput{}},
+						}
+					},
+					MockDelete: deleteCluster(nil),
+				},
+				cr: cluster(withEngine(engine)),
+			},
+			want: want{
+				cr: cluster(withEngine(engine), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: cluster(withStatus(v1beta1.DBClusterStateDeleting)),
+			},
+			want: want{
+				cr: cluster(withStatus(v1beta1.DBClusterStateDeleting), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{}, errors.New(awsrds.ErrCodeDBClusterNotFoundFault)),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				rds: &fake.MockDBClusterClient{
+					MockDescribe: describe(awsrds.DBCluster{}, errBoom),
+					MockDelete:   deleteCluster(errBoom),
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.rds}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type manager struct {
+	xpfake.Manager
+}
+
+func (m *manager) GetEventRecorderFor(string) record.EventRecorder { return record.NewFakeRecorder(10) }
+
+func TestReconcilePublishesConnectionDetails(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	var published *corev1.Secret
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.DBCluster:
+				o.SetName("example")
+				o.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "example", Namespace: "default"})
+				meta.SetExternalName(o, "example")
+				o.Spec.ForProvider.Engine = engine
+				return nil
+			case *corev1.Secret:
+				return kerrors.NewNotFound(schema.GroupResource{}, "")
+			}
+			return errBoom
+		},
+		MockCreate: func(_ context.Context, obj runtime.Object, _ ...client.CreateOption) error {
+			if sc, ok := obj.(*corev1.Secret); ok {
+				published = sc
+			}
+			return nil
+		},
+		MockUpdate:       test.NewMockUpdateFn(nil),
+		MockStatusUpdate: test.NewMockStatusUpdateFn(nil),
+	}
+	cl := &fake.MockDBClusterClient{
+		MockDescribe: describe(awsrds.DBCluster{
+			Engine:         aws.String(engine),
+			Status:         aws.String(v1beta1.DBClusterStateAvailable),
+			Endpoint:       aws.String(endpoint),
+			ReaderEndpoint: aws.String(readerEndpoint),
+			Port:           aws.Int64(int64(port)),
+		}, nil),
+	}
+
+	r := newReconciler(&manager{Manager: xpfake.Manager{Client: kube, Scheme: s}}, logging.NewNopLogger(),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.ReferenceResolverFn(func(context.Context, resource.Managed) error { return nil })),
+		managed.WithExternalConnecter(managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+			return &external{client: cl, kube: kube}, nil
+		})),
+	)
+	if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}}); err != nil {
+		t.Fatalf("r.Reconcile(...): %s", err)
+	}
+	if published == nil {
+		t.Fatal("r.Reconcile(...): connection secret was not published")
+	}
+
+	want := map[string][]byte{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+		rds.ConnectionDetailsReaderEndpointKey:    []byte(readerEndpoint),
+	}
+	if diff := cmp.Diff(want, published.Data); diff != "" {
+		t.Errorf("r.Reconcile(...): -want secret data, +got secret data:\n%s", diff)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parametergroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+)
+
+const (
+	errUnexpectedObject   = "the managed resource is not a DBParameterGroup or DBClusterParameterGroup"
+	errDescribe           = "cannot describe the parameter group"
+	errDescribeParameters = "cannot describe the parameters of the parameter group"
+	errListTagsFailed     = "cannot list tags for the parameter group"
+	errCreate             = "cannot create the parameter group"
+	errModifyParameters   = "cannot modify the parameters of the parameter group"
+	errResetParameters    = "cannot reset the parameters of the parameter group"
+	errAddTagsFailed      = "cannot add tags to the parameter group"
+	errRemoveTagsFailed   = "cannot remove tags from the parameter group"
+	errDelete             = "cannot delete the parameter group"
+)
+
+// SetupDBParameterGroup adds a controller that reconciles DBParameterGroups.
+func SetupDBParameterGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: func(cfg aws.Config) rds.ParameterGroupClient {
+				return rds.DBParameterGroups(rds.NewDBParameterGroupClient(cfg))
+			}}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// SetupDBClusterParameterGroup adds a controller that reconciles
+// DBClusterParameterGroups.
+func SetupDBClusterParameterGroup(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1beta1.DBClusterParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1beta1.DBClusterParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1beta1.DBClusterParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: func(cfg aws.Config) rds.ParameterGroupClient {
+				return rds.DBClusterParameterGroups(rds.NewDBClusterParameterGroupClient(cfg))
+			}}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// parameterGroup returns the desired parameters of the supplied DB parameter
+// group or DB cluster parameter group, and where its ARN is observed.
+func parameterGroup(mg resource.Managed) (v1beta1.DBParameterGroupParameters, *string, error) {
+	switch cr := mg.(type) {
+	case *v1beta1.DBParameterGroup:
+		return cr.Spec.ForProvider, &cr.Status.AtProvider.ARN, nil
+	case *v1beta1.DBClusterParameterGroup:
+		return v1beta1.DBParameterGroupParameters(cr.Spec.ForProvider), &cr.Status.AtProvider.ARN, nil
+	}
+	return v1beta1.DBParameterGroupParameters{}, nil, errors.New(errUnexpectedObject)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) rds.ParameterGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	p, _, err := parameterGroup(mg)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, p.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client rds.ParameterGroupClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	p, arn, err := parameterGroup(mgd)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	observed, err := e.client.DescribeParameterGroup(ctx, meta.GetExternalName(mgd))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(rds.IsParameterGroupNotFound, err), errDescribe)
+	}
+	*arn = observed
+	mgd.SetConditions(xpv1.Available())
+
+	params, err := rds.GetParameters(ctx, e.client, meta.GetExternalName(mgd))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errDescribeParameters)
+	}
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{
+		ResourceName: arn,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTagsFailed)
+	}
+
+	modify, reset := rds.DiffParameters(p.Parameters, params)
+	add, remove := rds.DiffTags(p.Tags, tags.TagList)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(modify) == 0 && len(reset) == 0 && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	p, _, err := parameterGroup(mgd)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	mgd.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, errors.Wrap(e.client.CreateParameterGroup(ctx, meta.GetExternalName(mgd), p), errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	p, arn, err := parameterGroup(mgd)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	name := meta.GetExternalName(mgd)
+
+	params, err := rds.GetParameters(ctx, e.client, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeParameters)
+	}
+	modify, reset := rds.DiffParameters(p.Parameters, params)
+	for _, batch := range rds.SplitParameters(reset) {
+		if err := e.client.ResetParameters(ctx, name, batch); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errResetParameters)
+		}
+	}
+	for _, batch := range rds.SplitParameters(modify) {
+		if err := e.client.ModifyParameters(ctx, name, batch); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyParameters)
+		}
+	}
+
+	tags, err := e.client.ListTagsForResourceRequest(&awsrds.ListTagsForResourceInput{
+		ResourceName: arn,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListTagsFailed)
+	}
+	add, remove := rds.DiffTags(p.Tags, tags.TagList)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsFromResourceRequest(&awsrds.RemoveTagsFromResourceInput{
+			ResourceName: arn,
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTagsFailed)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsToResourceRequest(&awsrds.AddTagsToResourceInput{
+			ResourceName: arn,
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTagsFailed)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	if _, _, err := parameterGroup(mgd); err != nil {
+		return err
+	}
+
+	mgd.SetConditions(xpv1.Deleting())
+	err := e.client.DeleteParameterGroup(ctx, meta.GetExternalName(mgd))
+	return errors.Wrap(resource.Ignore(rds.IsParameterGroupNotFound, err), errDelete)
+}
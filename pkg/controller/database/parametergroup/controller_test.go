@@ -0,0 +1,437 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parametergroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+var (
+	groupName  = "example-group"
+	groupARN   = "arn:aws:rds:us-east-1:123456789012:pg:example-group"
+	paramName  = "max_connections"
+	paramValue = "100"
+	tagKey     = "team"
+	tagValue   = "platform"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	rds rds.DBParameterGroupClient
+	cr  *v1beta1.DBParameterGroup
+}
+
+type groupModifier func(*v1beta1.DBParameterGroup)
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withARN(s string) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Status.AtProvider.ARN = s }
+}
+
+func withParameters(p ...v1beta1.Parameter) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func withTags(t ...v1beta1.Tag) groupModifier {
+	return func(r *v1beta1.DBParameterGroup) { r.Spec.ForProvider.Tags = t }
+}
+
+func group(m ...groupModifier) *v1beta1.DBParameterGroup {
+	cr := &v1beta1.DBParameterGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(err error) func(*awsrds.DescribeDBParameterGroupsInput) awsrds.DescribeDBParameterGroupsRequest {
+	return func(*awsrds.DescribeDBParameterGroupsInput) awsrds.DescribeDBParameterGroupsRequest {
+		return awsrds.DescribeDBParameterGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBParameterGroupsOutput{
+				DBParameterGroups: []awsrds.DBParameterGroup{{
+					DBParameterGroupName: aws.String(groupName),
+					DBParameterGroupArn:  aws.String(groupARN),
+				}},
+			}},
+		}
+	}
+}
+
+func describeParameters(err error, p ...awsrds.Parameter) func(*awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+	return func(*awsrds.DescribeDBParametersInput) awsrds.DescribeDBParametersRequest {
+		return awsrds.DescribeDBParametersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DescribeDBParametersOutput{
+				Parameters: p,
+			}},
+		}
+	}
+}
+
+func listTags(err error, t ...awsrds.Tag) func(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+	return func(*awsrds.ListTagsForResourceInput) awsrds.ListTagsForResourceRequest {
+		return awsrds.ListTagsForResourceRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.ListTagsForResourceOutput{
+				TagList: t,
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1beta1.DBParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribe: describe(nil),
+					MockDescribeParameters: describeParameters(nil, awsrds.Parameter{
+						ParameterName:  aws.String(paramName),
+						ParameterValue: aws.String(paramValue),
+					}),
+					MockListTags: listTags(nil, awsrds.Tag{Key: aws.String(tagKey), Value: aws.String(tagValue)}),
+				},
+				cr: group(withParameters(v1beta1.Parameter{ParameterName: paramName, ParameterValue: paramValue}),
+					withTags(v1beta1.Tag{Key: tagKey, Value: tagValue})),
+			},
+			want: want{
+				cr: group(withParameters(v1beta1.Parameter{ParameterName: paramName, ParameterValue: paramValue}),
+					withTags(v1beta1.Tag{Key: tagKey, Value: tagValue}),
+					withARN(groupARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribe: describe(nil),
+					MockDescribeParameters: describeParameters(nil, awsrds.Parameter{
+						ParameterName:  aws.String(paramName),
+						ParameterValue: aws.String("50"),
+					}),
+					MockListTags: listTags(nil),
+				},
+				cr: group(withParameters(v1beta1.Parameter{ParameterName: paramName, ParameterValue: paramValue})),
+			},
+			want: want{
+				cr: group(withParameters(v1beta1.Parameter{ParameterName: paramName, ParameterValue: paramValue}),
+					withARN(groupARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribe: describe(errors.New(awsrds.ErrCodeDBParameterGroupNotFoundFault)),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr: group(),
+			},
+		},
+		"FailedDescribeParameters": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{
+					MockDescribe:           describe(nil),
+					MockDescribeParameters: describeParameters(errBoom),
+				},
+				cr: group(),
+			},
+			want: want{
+				cr:  group(withARN(groupARN), withConditions(xpv1.Available())),
+				err: errors.Wrap(errBoom, errDescribeParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: rds.DBParameterGroups(tc.rds)}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type calls struct {
+		modify []awsrds.Parameter
+		reset  []awsrds.Parameter
+		add    []awsrds.Tag
+		remove []string
+	}
+	type want struct {
+		calls calls
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr       *v1beta1.DBParameterGroup
+		params   []awsrds.Parameter
+		tags     []awsrds.Tag
+		modifyFn error
+		want
+	}{
+		"Successful": {
+			cr: group(withARN(groupARN),
+				withParameters(v1beta1.Parameter{ParameterName: paramName, ParameterValue: paramValue}),
+				withTags(v1beta1.Tag{Key: tagKey, Value: tagValue})),
+			params: []awsrds.Parameter{
+				{ParameterName: aws.String("wait_timeout"), ParameterValue: aws.String("60"), ApplyType: aws.String("dynamic")},
+			},
+			tags: []awsrds.Tag{{Key: aws.String("stale"), Value: aws.String("true")}},
+			want: want{
+				calls: calls{
+					modify: []awsrds.Parameter{{
+						ParameterName:  aws.String(paramName),
+						ParameterValue: aws.String(paramValue),
+						ApplyMethod:    awsrds.ApplyMethodPendingReboot,
+					}},
+					reset: []awsrds.Parameter{{
+						ParameterName: aws.String("wait_timeout"),
+						ApplyMethod:   awsrds.ApplyMethodImmediate,
+					}},
+					add:    []awsrds.Tag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+					remove: []string{"stale"},
+				},
+			},
+		},
+		"FailedModify": {
+			cr:       group(withParameters(v1beta1.Parameter{ParameterName: paramName, ParameterValue: paramValue})),
+			modifyFn: errBoom,
+			want: want{
+				err: errors.Wrap(errBoom, errModifyParameters),
+				calls: calls{
+					modify: []awsrds.Parameter{{
+						ParameterName:  aws.String(paramName),
+						ParameterValue: aws.String(paramValue),
+						ApplyMethod:    awsrds.ApplyMethodPendingReboot,
+					}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := calls{}
+			c := &fake.MockDBParameterGroupClient{
+				MockDescribeParameters: describeParameters(nil, tc.params...),
+				MockListTags:           listTags(nil, tc.tags...),
+				MockModify: func(i *awsrds.ModifyDBParameterGroupInput) awsrds.ModifyDBParameterGroupRequest {
+					got.modify = append(got.modify, i.Parameters...)
+					return awsrds.ModifyDBParameterGroupRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: tc.modifyFn, Data: &awsrds.ModifyDBParameterGroupOutput{}},
+					}
+				},
+				MockReset: func(i *awsrds.ResetDBParameterGroupInput) awsrds.ResetDBParameterGroupRequest {
+					got.reset = append(got.reset, i.Parameters...)
+					return awsrds.ResetDBParameterGroupRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ResetDBParameterGroupOutput{}},
+					}
+				},
+				MockAddTags: func(i *awsrds.AddTagsToResourceInput) awsrds.AddTagsToResourceRequest {
+					got.add = append(got.add, i.Tags...)
+					return awsrds.AddTagsToResourceRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.AddTagsToResourceOutput{}},
+					}
+				},
+				MockRemoveTags: func(i *awsrds.RemoveTagsFromResourceInput) awsrds.RemoveTagsFromResourceRequest {
+					got.remove = append(got.remove, i.TagKeys...)
+					return awsrds.RemoveTagsFromResourceRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RemoveTagsFromResourceOutput{}},
+					}
+				},
+			}
+			e := &external{client: rds.DBParameterGroups(c)}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1beta1.DBParameterGroup
+		err error
+	}
+
+	deleteGroup := func(err error) func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+		return func(*awsrds.DeleteDBParameterGroupInput) awsrds.DeleteDBParameterGroupRequest {
+			return awsrds.DeleteDBParameterGroupRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awsrds.DeleteDBParameterGroupOutput{}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{MockDelete: deleteGroup(nil)},
+				cr:  group(),
+			},
+			want: want{
+				cr: group(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{MockDelete: deleteGroup(errors.New(awsrds.ErrCodeDBParameterGroupNotFoundFault))},
+				cr:  group(),
+			},
+			want: want{
+				cr: group(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				rds: &fake.MockDBParameterGroupClient{MockDelete: deleteGroup(errBoom)},
+				cr:  group(),
+			},
+			want: want{
+				cr:  group(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: rds.DBParameterGroups(tc.rds)}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParameterGroup(t *testing.T) {
+	type want struct {
+		p   v1beta1.DBParameterGroupParameters
+		arn string
+		err error
+	}
+
+	region := "us-east-1"
+	params := []v1beta1.Parameter{{ParameterName: paramName, ParameterValue: paramValue}}
+
+	cases := map[string]struct {
+		mg resource.Managed
+		want
+	}{
+		"DBParameterGroup": {
+			mg: group(withARN(groupARN), withParameters(params...)),
+			want: want{
+				p:   v1beta1.DBParameterGroupParameters{Parameters: params},
+				arn: groupARN,
+			},
+		},
+		"DBClusterParameterGroup": {
+			mg: &v1beta1.DBClusterParameterGroup{
+				Spec: v1beta1.DBClusterParameterGroupSpec{
+					ForProvider: v1beta1.DBClusterParameterGroupParameters{Region: region, Parameters: params},
+				},
+				Status: v1beta1.DBClusterParameterGroupStatus{
+					AtProvider: v1beta1.DBClusterParameterGroupObservation{ARN: groupARN},
+				},
+			},
+			want: want{
+				p:   v1beta1.DBParameterGroupParameters{Region: region, Parameters: params},
+				arn: groupARN,
+			},
+		},
+		"UnexpectedObject": {
+			mg: &v1beta1.RDSInstance{},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, arn, err := parameterGroup(tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, aws.StringValue(arn)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
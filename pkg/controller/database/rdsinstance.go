@@ -51,6 +51,9 @@ const (
 	errPatchCreationFailed     = "cannot create a patch object"
 	errUpToDateFailed          = "cannot check whether object is up-to-date"
 	errGetPasswordSecretFailed = "cannot get password secret"
+	errRestoreSourceMissing    = "restoreFrom source %s requires its configuration block to be set"
+	errCreateReadReplicaFailed = "cannot create RDS instance read replica"
+	errPromoteFailed           = "cannot promote RDS instance read replica"
 )
 
 // SetupRDSInstance adds a controller that reconciles RDSInstances.
@@ -117,7 +120,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
 		}
 	}
+	pending := cr.Status.AtProvider.MasterUserPasswordPending
 	cr.Status.AtProvider = rds.GenerateObservation(instance)
+	cr.Status.AtProvider.MasterUserPasswordPending = pending
 
 	switch cr.Status.AtProvider.DBInstanceStatus {
 	case v1beta1.RDSInstanceStateAvailable:
@@ -150,6 +155,12 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateCreating {
 		return managed.ExternalCreation{}, nil
 	}
+	// Read Replicas inherit the master credentials of their source, so only
+	// the endpoint is published once the instance is available.
+	if rds.IsReadReplica(&cr.Spec.ForProvider) {
+		_, err := e.client.CreateDBInstanceReadReplicaRequest(rds.GenerateCreateDBInstanceReadReplicaInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateReadReplicaFailed)
+	}
 	pw, _, err := rds.GetPassword(ctx, e.kube, cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -161,14 +172,30 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		}
 	}
 
-	req := e.client.CreateDBInstanceRequest(rds.GenerateCreateDBInstanceInput(meta.GetExternalName(cr), pw, &cr.Spec.ForProvider))
-	_, err = req.Send(ctx)
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
-	}
 	conn := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
 	}
+	name := meta.GetExternalName(cr)
+	switch r := cr.Spec.ForProvider.RestoreFrom; {
+	case r == nil:
+		_, err = e.client.CreateDBInstanceRequest(rds.GenerateCreateDBInstanceInput(name, pw, &cr.Spec.ForProvider)).Send(ctx)
+	case r.Source == v1beta1.RestoreSourceSnapshot && r.Snapshot != nil:
+		// NOTE: An instance restored from a snapshot or to a point in time
+		// keeps the master password of its source. The published password is
+		// set by Update once the instance is available.
+		cr.Status.AtProvider.MasterUserPasswordPending = true
+		_, err = e.client.RestoreDBInstanceFromDBSnapshotRequest(rds.GenerateRestoreDBInstanceFromSnapshotInput(name, &cr.Spec.ForProvider)).Send(ctx)
+	case r.Source == v1beta1.RestoreSourcePointInTime && r.PointInTime != nil:
+		cr.Status.AtProvider.MasterUserPasswordPending = true
+		_, err = e.client.RestoreDBInstanceToPointInTimeRequest(rds.GenerateRestoreDBInstanceToPointInTimeInput(name, &cr.Spec.ForProvider)).Send(ctx)
+	case r.Source == v1beta1.RestoreSourceS3 && r.S3 != nil:
+		_, err = e.client.RestoreDBInstanceFromS3Request(rds.GenerateRestoreDBInstanceFromS3Input(name, pw, &cr.Spec.ForProvider)).Send(ctx)
+	default:
+		return managed.ExternalCreation{}, errors.Errorf(errRestoreSourceMissing, r.Source)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
 	if cr.Spec.ForProvider.MasterUsername != nil {
 		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
 	}
@@ -194,6 +221,16 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeFailed)
 	}
+	// The Read Replica is promoted first, the remaining changes are applied
+	// once it is a standalone instance.
+	if rds.NeedsPromotion(&cr.Spec.ForProvider, rsp.DBInstances[0]) {
+		_, err = e.client.PromoteReadReplicaRequest(&awsrds.PromoteReadReplicaInput{
+			DBInstanceIdentifier:  aws.String(meta.GetExternalName(cr)),
+			BackupRetentionPeriod: awsclients.Int64Address(cr.Spec.ForProvider.BackupRetentionPeriod),
+			PreferredBackupWindow: cr.Spec.ForProvider.PreferredBackupWindow,
+		}).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPromoteFailed)
+	}
 	patch, err := rds.CreatePatch(&rsp.DBInstances[0], &cr.Spec.ForProvider)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errPatchCreationFailed)
@@ -205,7 +242,20 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
-	if changed {
+	// A restored DB instance gets the password that was published when it
+	// was created, unless the referenced password has changed since then.
+	pending := cr.Status.AtProvider.MasterUserPasswordPending
+	if pending && !changed {
+		if pwd, err = rds.GetConnectionPassword(ctx, e.kube, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if pwd == "" {
+			if pwd, err = password.Generate(); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+	}
+	if (changed || pending) && !rds.IsReadReplicaInstance(rsp.DBInstances[0]) {
 		conn = managed.ConnectionDetails{
 			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
 		}
@@ -215,6 +265,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if _, err = e.client.ModifyDBInstanceRequest(modify).Send(ctx); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errModifyFailed)
 	}
+	cr.Status.AtProvider.MasterUserPasswordPending = false
 	if len(patch.Tags) > 0 {
 		tags := make([]awsrds.Tag, len(patch.Tags))
 		for i, t := range patch.Tags {
@@ -49,6 +49,7 @@ const (
 var (
 	masterUsername = "root"
 	engineVersion  = "5.6"
+	sourceInstance = "source-instance"
 
 	replaceMe = "replace-me!"
 	errBoom   = errors.New("boom")
@@ -92,6 +93,22 @@ func withPasswordSecretRef(s xpv1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withRestoreFrom(r v1beta1.RestoreBackupConfiguration) rdsModifier {
+	return func(i *v1beta1.RDSInstance) { i.Spec.ForProvider.RestoreFrom = &r }
+}
+
+func withMasterUserPasswordPending(b bool) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Status.AtProvider.MasterUserPasswordPending = b }
+}
+
+func withConnectionSecretRef(s xpv1.SecretReference) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.WriteConnectionSecretToReference = &s }
+}
+
+func withSourceDBInstanceIdentifier(s string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.SourceDBInstanceIdentifier = &s }
+}
+
 func instance(m ...rdsModifier) *v1beta1.RDSInstance {
 	cr := &v1beta1.RDSInstance{}
 	for _, f := range m {
@@ -426,6 +443,125 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(errBoom, errGetPasswordSecretFailed),
 			},
 		},
+		"SuccessfulRestoreFromSnapshot": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreFromSnapshot: func(input *awsrds.RestoreDBInstanceFromDBSnapshotInput) awsrds.RestoreDBInstanceFromDBSnapshotRequest {
+						return awsrds.RestoreDBInstanceFromDBSnapshotRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceFromDBSnapshotOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourceSnapshot, Snapshot: &v1beta1.SnapshotRestoreBackupConfiguration{SnapshotIdentifier: "snap"}})),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourceSnapshot, Snapshot: &v1beta1.SnapshotRestoreBackupConfiguration{SnapshotIdentifier: "snap"}}),
+					withMasterUserPasswordPending(true),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+					},
+				},
+			},
+		},
+		"SuccessfulRestoreToPointInTime": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreToPointInTime: func(input *awsrds.RestoreDBInstanceToPointInTimeInput) awsrds.RestoreDBInstanceToPointInTimeRequest {
+						return awsrds.RestoreDBInstanceToPointInTimeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceToPointInTimeOutput{}},
+						}
+					},
+				},
+				cr: instance(withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourcePointInTime, PointInTime: &v1beta1.PointInTimeRestoreBackupConfiguration{SourceDBInstanceIdentifier: aws.String("source"), UseLatestRestorableTime: aws.Bool(true)}})),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourcePointInTime, PointInTime: &v1beta1.PointInTimeRestoreBackupConfiguration{SourceDBInstanceIdentifier: aws.String("source"), UseLatestRestorableTime: aws.Bool(true)}}),
+					withMasterUserPasswordPending(true),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+					},
+				},
+			},
+		},
+		"SuccessfulRestoreFromS3": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockRestoreFromS3: func(input *awsrds.RestoreDBInstanceFromS3Input) awsrds.RestoreDBInstanceFromS3Request {
+						return awsrds.RestoreDBInstanceFromS3Request{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.RestoreDBInstanceFromS3Output{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourceS3, S3: &v1beta1.S3RestoreBackupConfiguration{BucketName: "bucket", IngestionRoleARN: "arn", SourceEngine: "mysql", SourceEngineVersion: "5.6.40"}})),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourceS3, S3: &v1beta1.S3RestoreBackupConfiguration{BucketName: "bucket", IngestionRoleARN: "arn", SourceEngine: "mysql", SourceEngineVersion: "5.6.40"}}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(replaceMe),
+					},
+				},
+			},
+		},
+		"SuccessfulReadReplica": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockCreateReadReplica: func(input *awsrds.CreateDBInstanceReadReplicaInput) awsrds.CreateDBInstanceReadReplicaRequest {
+						return awsrds.CreateDBInstanceReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.CreateDBInstanceReadReplicaOutput{}},
+						}
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withSourceDBInstanceIdentifier(sourceInstance)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withSourceDBInstanceIdentifier(sourceInstance),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedReadReplica": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockCreateReadReplica: func(input *awsrds.CreateDBInstanceReadReplicaInput) awsrds.CreateDBInstanceReadReplicaRequest {
+						return awsrds.CreateDBInstanceReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withSourceDBInstanceIdentifier(sourceInstance)),
+			},
+			want: want{
+				cr: instance(
+					withSourceDBInstanceIdentifier(sourceInstance),
+					withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateReadReplicaFailed),
+			},
+		},
+		"FailedRestoreConfigurationMissing": {
+			args: args{
+				cr: instance(withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourceSnapshot})),
+			},
+			want: want{
+				cr: instance(
+					withRestoreFrom(v1beta1.RestoreBackupConfiguration{Source: v1beta1.RestoreSourceSnapshot}),
+					withConditions(xpv1.Creating())),
+				err: errors.Errorf(errRestoreSourceMissing, v1beta1.RestoreSourceSnapshot),
+			},
+		},
 		"FailedRequest": {
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -504,6 +640,116 @@ func TestUpdate(t *testing.T) {
 				cr: instance(withTags(map[string]string{"foo": "bar"})),
 			},
 		},
+		"SuccessfulPendingPassword": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						if diff := cmp.Diff(credData, aws.StringValue(input.MasterUserPassword)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.ModifyDBInstanceOutput{}},
+						}
+					},
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{}},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+						secret := corev1.Secret{
+							Data: map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(credData)},
+						}
+						secret.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+				},
+				cr: instance(withConnectionSecretRef(xpv1.SecretReference{Name: "conn"}), withMasterUserPasswordPending(true)),
+			},
+			want: want{
+				cr: instance(withConnectionSecretRef(xpv1.SecretReference{Name: "conn"})),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(credData),
+					},
+				},
+			},
+		},
+		"FailedPendingPassword": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{}},
+							}},
+						}
+					},
+					MockModify: func(input *awsrds.ModifyDBInstanceInput) awsrds.ModifyDBInstanceRequest {
+						return awsrds.ModifyDBInstanceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: instance(withConnectionSecretRef(xpv1.SecretReference{Name: "conn"}), withMasterUserPasswordPending(true)),
+			},
+			want: want{
+				cr:  instance(withConnectionSecretRef(xpv1.SecretReference{Name: "conn"}), withMasterUserPasswordPending(true)),
+				err: errors.Wrap(errBoom, errModifyFailed),
+			},
+		},
+		"SuccessfulPromotion": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{ReadReplicaSourceDBInstanceIdentifier: aws.String(sourceInstance)}},
+							}},
+						}
+					},
+					MockPromoteReadReplica: func(input *awsrds.PromoteReadReplicaInput) awsrds.PromoteReadReplicaRequest {
+						return awsrds.PromoteReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.PromoteReadReplicaOutput{}},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"FailedPromotion": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(input *awsrds.DescribeDBInstancesInput) awsrds.DescribeDBInstancesRequest {
+						return awsrds.DescribeDBInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsrds.DescribeDBInstancesOutput{
+								DBInstances: []awsrds.DBInstance{{ReadReplicaSourceDBInstanceIdentifier: aws.String(sourceInstance)}},
+							}},
+						}
+					},
+					MockPromoteReadReplica: func(input *awsrds.PromoteReadReplicaInput) awsrds.PromoteReadReplicaRequest {
+						return awsrds.PromoteReadReplicaRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: errors.Wrap(errBoom, errPromoteFailed),
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: instance(withDBInstanceStatus(v1beta1.RDSInstanceStateModifying)),
@@ -22,6 +22,7 @@ import (
 	"sort"
 
 	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
@@ -41,18 +42,27 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errDescribeTimeToLive        = "cannot describe time to live of Table"
+	errUpdateTimeToLive          = "cannot update time to live of Table"
+	errDescribeContinuousBackups = "cannot describe continuous backups of Table"
+	errUpdateContinuousBackups   = "cannot update continuous backups of Table"
+)
+
 // SetupTable adds a controller that reconciles Table.
 func SetupTable(mgr ctrl.Manager, l logging.Logger) error {
 	name := managed.ControllerName(svcapitypes.TableGroupKind)
 	opts := []option{
 		func(e *external) {
+			u := &updateClient{DynamoDBAPI: e.client}
+			e.client = u
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			o := &observer{client: e.client}
+			e.postObserve = o.postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.lateInitialize = lateInitialize
 			e.isUpToDate = isUpToDate
-			u := &updateClient{client: e.client}
 			e.preUpdate = u.preUpdate
 		},
 	}
@@ -83,7 +93,11 @@ func preDelete(_ context.Context, cr *svcapitypes.Table, obj *svcsdk.DeleteTable
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+type observer struct {
+	client svcsdkapi.DynamoDBAPI
+}
+
+func (o *observer) postObserve(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -97,6 +111,25 @@ func postObserve(_ context.Context, cr *svcapitypes.Table, resp *svcsdk.Describe
 	case string(svcapitypes.TableStatus_SDK_ARCHIVED), string(svcapitypes.TableStatus_SDK_INACCESSIBLE_ENCRYPTION_CREDENTIALS), string(svcapitypes.TableStatus_SDK_ARCHIVING):
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	if !obs.ResourceUpToDate {
+		return obs, nil
+	}
+	// Time to live and point in time recovery settings are not part of
+	// the table description so they need separate calls.
+	if ttl := cr.Spec.ForProvider.TimeToLive; ttl != nil {
+		out, err := o.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: aws.String(meta.GetExternalName(cr))})
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDescribeTimeToLive)
+		}
+		obs.ResourceUpToDate = isTimeToLiveUpToDate(*ttl, out.TimeToLiveDescription)
+	}
+	if pitr := cr.Spec.ForProvider.PointInTimeRecoveryEnabled; pitr != nil && obs.ResourceUpToDate {
+		out, err := o.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: aws.String(meta.GetExternalName(cr))})
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDescribeContinuousBackups)
+		}
+		obs.ResourceUpToDate = isPointInTimeRecoveryUpToDate(*pitr, out.ContinuousBackupsDescription)
+	}
 	return obs, nil
 }
 
@@ -141,10 +174,14 @@ func lateInitialize(in *svcapitypes.TableParameters, t *svcsdk.DescribeTableOutp
 		in.AttributeDefinitions = buildAttributeDefinitions(t.Table.AttributeDefinitions)
 	}
 
-	if len(in.GlobalSecondaryIndexes) == 0 && len(t.Table.GlobalSecondaryIndexes) != 0 {
+	if in.GlobalSecondaryIndexes == nil && len(t.Table.GlobalSecondaryIndexes) != 0 {
 		in.GlobalSecondaryIndexes = buildGlobalIndexes(t.Table.GlobalSecondaryIndexes)
 	}
 
+	if in.Replicas == nil && len(t.Table.Replicas) != 0 {
+		in.Replicas = buildReplicas(t.Table.Replicas)
+	}
+
 	if len(in.LocalSecondaryIndexes) == 0 && len(t.Table.LocalSecondaryIndexes) != 0 {
 		in.LocalSecondaryIndexes = buildLocalIndexes(t.Table.LocalSecondaryIndexes)
 	}
@@ -221,6 +258,20 @@ func buildGlobalIndexes(indexes []*svcsdk.GlobalSecondaryIndexDescription) []*sv
 	return globalSecondaryIndexes
 }
 
+func buildReplicas(replicas []*svcsdk.ReplicaDescription) []*svcapitypes.TableReplica {
+	if len(replicas) == 0 {
+		return nil
+	}
+	res := make([]*svcapitypes.TableReplica, len(replicas))
+	for i, r := range replicas {
+		res[i] = &svcapitypes.TableReplica{
+			RegionName:     aws.StringValue(r.RegionName),
+			KMSMasterKeyID: r.KMSMasterKeyId,
+		}
+	}
+	return res
+}
+
 func buildLocalIndexes(indexes []*svcsdk.LocalSecondaryIndexDescription) []*svcapitypes.LocalSecondaryIndex {
 	if len(indexes) == 0 {
 		return nil
@@ -266,46 +317,367 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) bool {
 	if err != nil {
 		return false
 	}
-	return cmp.Equal(&svcapitypes.TableParameters{}, patch,
+	// The fields that can be updated are compared separately since their
+	// observed values are either not late-initialized or defaulted by AWS.
+	if !cmp.Equal(&svcapitypes.TableParameters{}, patch,
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(svcapitypes.TableParameters{}, "Region", "Tags", "GlobalSecondaryIndexes", "KeySchema", "LocalSecondaryIndexes", "CustomTableParameters"))
+		cmpopts.IgnoreFields(svcapitypes.TableParameters{}, "Region", "Tags", "GlobalSecondaryIndexes", "KeySchema", "LocalSecondaryIndexes", "CustomTableParameters",
+			"BillingMode", "ProvisionedThroughput", "SSESpecification", "StreamSpecification")) {
+		return false
+	}
+	return isTableUpToDate(cr.Spec.ForProvider, resp.Table)
 }
 
-type updateClient struct {
-	client svcsdkapi.DynamoDBAPI
+// isTableUpToDate returns whether the settings that are updated through the
+// UpdateTable call are up to date.
+func isTableUpToDate(p svcapitypes.TableParameters, t *svcsdk.TableDescription) bool {
+	return isBillingModeUpToDate(p, t) &&
+		isProvisionedThroughputUpToDate(p, t) &&
+		isStreamSpecificationUpToDate(p, t) &&
+		isSSESpecificationUpToDate(p, t) &&
+		len(diffGlobalSecondaryIndexes(p, t)) == 0 &&
+		len(diffReplicas(p, t)) == 0
+}
+
+// billingMode returns the billing mode of the given table. Tables that were
+// created before on-demand billing was available have no billing mode
+// summary and are provisioned.
+func billingMode(t *svcsdk.TableDescription) string {
+	if t.BillingModeSummary == nil || t.BillingModeSummary.BillingMode == nil {
+		return svcsdk.BillingModeProvisioned
+	}
+	return aws.StringValue(t.BillingModeSummary.BillingMode)
 }
 
-func (e *updateClient) preUpdate(_ context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
-	switch aws.StringValue(cr.Status.AtProvider.TableStatus) {
-	case string(svcapitypes.TableStatus_SDK_UPDATING), string(svcapitypes.TableStatus_SDK_CREATING):
+func isBillingModeUpToDate(p svcapitypes.TableParameters, t *svcsdk.TableDescription) bool {
+	return p.BillingMode == nil || aws.StringValue(p.BillingMode) == billingMode(t)
+}
+
+// isProvisionedThroughputUpToDate returns true if the provisioned throughput
+// of the table matches the desired one. It is ignored for tables with
+// on-demand billing.
+func isProvisionedThroughputUpToDate(p svcapitypes.TableParameters, t *svcsdk.TableDescription) bool {
+	if p.ProvisionedThroughput == nil || billingMode(t) != svcsdk.BillingModeProvisioned {
+		return true
+	}
+	if t.ProvisionedThroughput == nil {
+		return false
+	}
+	return aws.Int64Value(t.ProvisionedThroughput.ReadCapacityUnits) == aws.Int64Value(p.ProvisionedThroughput.ReadCapacityUnits) &&
+		aws.Int64Value(t.ProvisionedThroughput.WriteCapacityUnits) == aws.Int64Value(p.ProvisionedThroughput.WriteCapacityUnits)
+}
+
+// isStreamSpecificationUpToDate returns true if the stream of the table is
+// enabled or disabled as desired. The view type of an enabled stream cannot
+// be changed without disabling it first, so it is not compared.
+func isStreamSpecificationUpToDate(p svcapitypes.TableParameters, t *svcsdk.TableDescription) bool {
+	if p.StreamSpecification == nil {
+		return true
+	}
+	enabled := t.StreamSpecification != nil && awsgo.BoolValue(t.StreamSpecification.StreamEnabled)
+	return awsgo.BoolValue(p.StreamSpecification.StreamEnabled) == enabled
+}
+
+func isSSESpecificationUpToDate(p svcapitypes.TableParameters, t *svcsdk.TableDescription) bool {
+	if p.SSESpecification == nil || p.SSESpecification.Enabled == nil {
+		return true
+	}
+	enabled := false
+	if t.SSEDescription != nil {
+		switch aws.StringValue(t.SSEDescription.Status) {
+		case svcsdk.SSEStatusEnabled, svcsdk.SSEStatusEnabling, svcsdk.SSEStatusUpdating:
+			enabled = true
+		}
+	}
+	return awsgo.BoolValue(p.SSESpecification.Enabled) == enabled
+}
+
+// diffGlobalSecondaryIndexes returns the updates that are needed to bring the
+// global secondary indexes of the table to the desired state. Deletions come
+// first, then creations and then throughput changes. The key schema and
+// projection of an existing index cannot be changed.
+func diffGlobalSecondaryIndexes(p svcapitypes.TableParameters, t *svcsdk.TableDescription) []*svcsdk.GlobalSecondaryIndexUpdate { // nolint:gocyclo
+	provisioned := billingMode(t) == svcsdk.BillingModeProvisioned
+	observed := map[string]*svcsdk.GlobalSecondaryIndexDescription{}
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		observed[aws.StringValue(gsi.IndexName)] = gsi
+	}
+	desired := map[string]bool{}
+	var del, create, update []*svcsdk.GlobalSecondaryIndexUpdate
+	for _, gsi := range p.GlobalSecondaryIndexes {
+		name := aws.StringValue(gsi.IndexName)
+		desired[name] = true
+		o, ok := observed[name]
+		switch {
+		case !ok:
+			action := &svcsdk.CreateGlobalSecondaryIndexAction{
+				IndexName: gsi.IndexName,
+				KeySchema: buildKeySchema(gsi.KeySchema),
+			}
+			if gsi.Projection != nil {
+				action.Projection = &svcsdk.Projection{
+					NonKeyAttributes: gsi.Projection.NonKeyAttributes,
+					ProjectionType:   gsi.Projection.ProjectionType,
+				}
+			}
+			if provisioned && gsi.ProvisionedThroughput != nil {
+				action.ProvisionedThroughput = buildProvisionedThroughput(gsi.ProvisionedThroughput)
+			}
+			create = append(create, &svcsdk.GlobalSecondaryIndexUpdate{Create: action})
+		case provisioned && gsi.ProvisionedThroughput != nil &&
+			(o.ProvisionedThroughput == nil ||
+				aws.Int64Value(o.ProvisionedThroughput.ReadCapacityUnits) != aws.Int64Value(gsi.ProvisionedThroughput.ReadCapacityUnits) ||
+				aws.Int64Value(o.ProvisionedThroughput.WriteCapacityUnits) != aws.Int64Value(gsi.ProvisionedThroughput.WriteCapacityUnits)):
+			update = append(update, &svcsdk.GlobalSecondaryIndexUpdate{Update: &svcsdk.UpdateGlobalSecondaryIndexAction{
+				IndexName:             gsi.IndexName,
+				ProvisionedThroughput: buildProvisionedThroughput(gsi.ProvisionedThroughput),
+			}})
+		}
+	}
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		if !desired[aws.StringValue(gsi.IndexName)] {
+			del = append(del, &svcsdk.GlobalSecondaryIndexUpdate{Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{IndexName: gsi.IndexName}})
+		}
+	}
+	return append(append(del, create...), update...)
+}
+
+// diffReplicas returns the updates that are needed to bring the replicas of
+// the table to the desired state. Deletions come first. Replicas are left
+// alone if none are specified.
+func diffReplicas(p svcapitypes.TableParameters, t *svcsdk.TableDescription) []*svcsdk.ReplicationGroupUpdate {
+	if p.Replicas == nil {
+		return nil
+	}
+	observed := map[string]bool{}
+	for _, r := range t.Replicas {
+		observed[aws.StringValue(r.RegionName)] = true
+	}
+	desired := map[string]bool{}
+	var del, create []*svcsdk.ReplicationGroupUpdate
+	for _, r := range p.Replicas {
+		desired[r.RegionName] = true
+		if !observed[r.RegionName] {
+			create = append(create, &svcsdk.ReplicationGroupUpdate{Create: &svcsdk.CreateReplicationGroupMemberAction{
+				RegionName:     aws.String(r.RegionName),
+				KMSMasterKeyId: r.KMSMasterKeyID,
+			}})
+		}
+	}
+	for _, r := range t.Replicas {
+		if !desired[aws.StringValue(r.RegionName)] {
+			del = append(del, &svcsdk.ReplicationGroupUpdate{Delete: &svcsdk.DeleteReplicationGroupMemberAction{RegionName: r.RegionName}})
+		}
+	}
+	return append(del, create...)
+}
+
+func buildKeySchema(keys []*svcapitypes.KeySchemaElement) []*svcsdk.KeySchemaElement {
+	if len(keys) == 0 {
 		return nil
 	}
-	t, err := e.client.DescribeTable(&svcsdk.DescribeTableInput{TableName: aws.String(meta.GetExternalName(cr))})
+	res := make([]*svcsdk.KeySchemaElement, len(keys))
+	for i, k := range keys {
+		res[i] = &svcsdk.KeySchemaElement{
+			AttributeName: k.AttributeName,
+			KeyType:       k.KeyType,
+		}
+	}
+	return res
+}
+
+func buildProvisionedThroughput(pt *svcapitypes.ProvisionedThroughput) *svcsdk.ProvisionedThroughput {
+	return &svcsdk.ProvisionedThroughput{
+		ReadCapacityUnits:  pt.ReadCapacityUnits,
+		WriteCapacityUnits: pt.WriteCapacityUnits,
+	}
+}
+
+// isTableActive returns true if neither the table nor any of its indexes and
+// replicas are being changed, which is required to start another change.
+func isTableActive(t *svcsdk.TableDescription) bool {
+	if aws.StringValue(t.TableStatus) != svcsdk.TableStatusActive {
+		return false
+	}
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		if aws.StringValue(gsi.IndexStatus) != svcsdk.IndexStatusActive {
+			return false
+		}
+	}
+	for _, r := range t.Replicas {
+		if aws.StringValue(r.ReplicaStatus) != svcsdk.ReplicaStatusActive {
+			return false
+		}
+	}
+	return true
+}
+
+func timeToLiveStatus(o *svcsdk.TimeToLiveDescription) (enabled bool, attributeName string) {
+	if o == nil {
+		return false, ""
+	}
+	switch aws.StringValue(o.TimeToLiveStatus) {
+	case svcsdk.TimeToLiveStatusEnabled, svcsdk.TimeToLiveStatusEnabling:
+		return true, aws.StringValue(o.AttributeName)
+	}
+	return false, aws.StringValue(o.AttributeName)
+}
+
+func isTimeToLiveUpToDate(desired svcapitypes.TimeToLive, observed *svcsdk.TimeToLiveDescription) bool {
+	enabled, name := timeToLiveStatus(observed)
+	return enabled == desired.Enabled && (!enabled || name == desired.AttributeName)
+}
+
+// nextTimeToLive returns the time to live specification that should be
+// applied next, or nil if there is nothing to apply. The attribute of an
+// enabled time to live can only be changed by disabling it first.
+func nextTimeToLive(desired svcapitypes.TimeToLive, observed *svcsdk.TimeToLiveDescription) *svcsdk.TimeToLiveSpecification {
+	if observed != nil {
+		switch aws.StringValue(observed.TimeToLiveStatus) {
+		case svcsdk.TimeToLiveStatusEnabling, svcsdk.TimeToLiveStatusDisabling:
+			return nil
+		}
+	}
+	enabled, name := timeToLiveStatus(observed)
+	switch {
+	case enabled && (!desired.Enabled || name != desired.AttributeName):
+		return &svcsdk.TimeToLiveSpecification{AttributeName: aws.String(name), Enabled: awsgo.Bool(false)}
+	case !enabled && desired.Enabled:
+		return &svcsdk.TimeToLiveSpecification{AttributeName: aws.String(desired.AttributeName), Enabled: awsgo.Bool(true)}
+	}
+	return nil
+}
+
+func isPointInTimeRecoveryUpToDate(desired bool, observed *svcsdk.ContinuousBackupsDescription) bool {
+	enabled := observed != nil && observed.PointInTimeRecoveryDescription != nil &&
+		aws.StringValue(observed.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return desired == enabled
+}
+
+// updateClient makes the UpdateTable call only if preUpdate found a change
+// that has to be made through it. The settings that are updated through
+// their own APIs may be the only ones that are out of date, or the next
+// change may have to wait for the table to become active again.
+type updateClient struct {
+	svcsdkapi.DynamoDBAPI
+
+	skipUpdateTable bool
+}
+
+func (e *updateClient) UpdateTableWithContext(ctx awsgo.Context, in *svcsdk.UpdateTableInput, opts ...request.Option) (*svcsdk.UpdateTableOutput, error) {
+	if e.skipUpdateTable {
+		return &svcsdk.UpdateTableOutput{}, nil
+	}
+	return e.DynamoDBAPI.UpdateTableWithContext(ctx, in, opts...)
+}
+
+func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
+	if err := e.updateTimeToLive(ctx, cr); err != nil {
+		return err
+	}
+	if err := e.updatePointInTimeRecovery(ctx, cr); err != nil {
+		return err
+	}
+	t, err := e.DescribeTableWithContext(ctx, &svcsdk.DescribeTableInput{TableName: aws.String(meta.GetExternalName(cr))})
 	if err != nil {
 		return errors.Wrap(err, errDescribe)
 	}
-
-	newUpdateObj := &svcsdk.UpdateTableInput{
+	filtered := &svcsdk.UpdateTableInput{
 		TableName: aws.String(meta.GetExternalName(cr)),
 	}
+	p := cr.Spec.ForProvider
+	e.skipUpdateTable = !isTableActive(t.Table) || isTableUpToDate(p, t.Table)
+	if e.skipUpdateTable {
+		*u = *filtered
+		return nil
+	}
 	// NOTE(muvaf): AWS API prohibits doing those calls in the same call.
 	// See https://github.com/aws/aws-sdk-go/blob/v1.34.32/service/dynamodb/api.go#L5605
+	// So we make one change per reconcile and the next one is picked up once
+	// the table is active again.
+	gsiUpdates := diffGlobalSecondaryIndexes(p, t.Table)
+	replicaUpdates := diffReplicas(p, t.Table)
 	switch {
-	case cr.Spec.ForProvider.ProvisionedThroughput != nil &&
-		(aws.Int64Value(t.Table.ProvisionedThroughput.ReadCapacityUnits) != aws.Int64Value(cr.Spec.ForProvider.ProvisionedThroughput.ReadCapacityUnits) ||
-			aws.Int64Value(t.Table.ProvisionedThroughput.WriteCapacityUnits) != aws.Int64Value(cr.Spec.ForProvider.ProvisionedThroughput.WriteCapacityUnits)):
-		newUpdateObj.ProvisionedThroughput = u.ProvisionedThroughput
-	// NOTE(muvaf): Unless StreamEnabled is changed, updating stream specification
-	// won't work.
-	case cr.Spec.ForProvider.StreamSpecification != nil &&
-		(awsgo.BoolValue(t.Table.StreamSpecification.StreamEnabled) != awsgo.BoolValue(cr.Spec.ForProvider.StreamSpecification.StreamEnabled)):
-		newUpdateObj.StreamSpecification = u.StreamSpecification
-	default:
-		return errors.New("only provisionedThroughput and streamSpecification updates are supported")
-	}
-	// TODO(muvaf): ReplicationGroupUpdate and GlobalSecondaryIndexUpdate features
-	// are not implemented yet.
-
-	*u = *newUpdateObj
+	case !isBillingModeUpToDate(p, t.Table):
+		filtered.BillingMode = u.BillingMode
+		// Switching to provisioned billing requires the throughput of the
+		// table and all of its indexes.
+		if aws.StringValue(p.BillingMode) == svcsdk.BillingModeProvisioned {
+			filtered.ProvisionedThroughput = u.ProvisionedThroughput
+			for _, gsi := range p.GlobalSecondaryIndexes {
+				if gsi.ProvisionedThroughput == nil {
+					continue
+				}
+				filtered.GlobalSecondaryIndexUpdates = append(filtered.GlobalSecondaryIndexUpdates, &svcsdk.GlobalSecondaryIndexUpdate{
+					Update: &svcsdk.UpdateGlobalSecondaryIndexAction{
+						IndexName:             gsi.IndexName,
+						ProvisionedThroughput: buildProvisionedThroughput(gsi.ProvisionedThroughput),
+					},
+				})
+			}
+		}
+	case !isProvisionedThroughputUpToDate(p, t.Table):
+		filtered.ProvisionedThroughput = u.ProvisionedThroughput
+	case !isStreamSpecificationUpToDate(p, t.Table):
+		filtered.StreamSpecification = &svcsdk.StreamSpecification{StreamEnabled: p.StreamSpecification.StreamEnabled}
+		if awsgo.BoolValue(p.StreamSpecification.StreamEnabled) {
+			filtered.StreamSpecification = u.StreamSpecification
+		}
+	case !isSSESpecificationUpToDate(p, t.Table):
+		filtered.SSESpecification = &svcsdk.SSESpecification{Enabled: p.SSESpecification.Enabled}
+		if awsgo.BoolValue(p.SSESpecification.Enabled) {
+			filtered.SSESpecification = u.SSESpecification
+		}
+	case len(gsiUpdates) != 0:
+		// Only one index can be created or deleted per call.
+		filtered.GlobalSecondaryIndexUpdates = gsiUpdates[:1]
+		if gsiUpdates[0].Create != nil {
+			filtered.AttributeDefinitions = u.AttributeDefinitions
+		}
+	case len(replicaUpdates) != 0:
+		filtered.ReplicaUpdates = replicaUpdates[:1]
+	}
+	*u = *filtered
 	return nil
 }
+
+func (e *updateClient) updateTimeToLive(ctx context.Context, cr *svcapitypes.Table) error {
+	ttl := cr.Spec.ForProvider.TimeToLive
+	if ttl == nil {
+		return nil
+	}
+	out, err := e.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(err, errDescribeTimeToLive)
+	}
+	spec := nextTimeToLive(*ttl, out.TimeToLiveDescription)
+	if spec == nil {
+		return nil
+	}
+	_, err = e.UpdateTimeToLiveWithContext(ctx, &svcsdk.UpdateTimeToLiveInput{
+		TableName:               aws.String(meta.GetExternalName(cr)),
+		TimeToLiveSpecification: spec,
+	})
+	return errors.Wrap(err, errUpdateTimeToLive)
+}
+
+func (e *updateClient) updatePointInTimeRecovery(ctx context.Context, cr *svcapitypes.Table) error {
+	pitr := cr.Spec.ForProvider.PointInTimeRecoveryEnabled
+	if pitr == nil {
+		return nil
+	}
+	out, err := e.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return errors.Wrap(err, errDescribeContinuousBackups)
+	}
+	if isPointInTimeRecoveryUpToDate(*pitr, out.ContinuousBackupsDescription) {
+		return nil
+	}
+	_, err = e.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+		TableName: aws.String(meta.GetExternalName(cr)),
+		PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: pitr,
+		},
+	})
+	return errors.Wrap(err, errUpdateContinuousBackups)
+}
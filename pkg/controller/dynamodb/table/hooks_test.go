@@ -17,11 +17,19 @@ limitations under the License.
 package table
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 )
@@ -31,8 +39,44 @@ var (
 	writeCapacityUnits = 1
 
 	arn = "some arn"
+
+	tableName = "some-table"
+	gsiName   = "some-index"
+	region    = "us-west-2"
+
+	errBoom = errors.New("boom")
 )
 
+type mockClient struct {
+	svcsdkapi.DynamoDBAPI
+
+	describeTable            func(*svcsdk.DescribeTableInput) (*svcsdk.DescribeTableOutput, error)
+	describeTimeToLive       func(*svcsdk.DescribeTimeToLiveInput) (*svcsdk.DescribeTimeToLiveOutput, error)
+	updateTimeToLive         func(*svcsdk.UpdateTimeToLiveInput) (*svcsdk.UpdateTimeToLiveOutput, error)
+	describeContinuousBackup func(*svcsdk.DescribeContinuousBackupsInput) (*svcsdk.DescribeContinuousBackupsOutput, error)
+	updateContinuousBackups  func(*svcsdk.UpdateContinuousBackupsInput) (*svcsdk.UpdateContinuousBackupsOutput, error)
+}
+
+func (m *mockClient) DescribeTableWithContext(_ aws.Context, in *svcsdk.DescribeTableInput, _ ...request.Option) (*svcsdk.DescribeTableOutput, error) {
+	return m.describeTable(in)
+}
+
+func (m *mockClient) DescribeTimeToLiveWithContext(_ aws.Context, in *svcsdk.DescribeTimeToLiveInput, _ ...request.Option) (*svcsdk.DescribeTimeToLiveOutput, error) {
+	return m.describeTimeToLive(in)
+}
+
+func (m *mockClient) UpdateTimeToLiveWithContext(_ aws.Context, in *svcsdk.UpdateTimeToLiveInput, _ ...request.Option) (*svcsdk.UpdateTimeToLiveOutput, error) {
+	return m.updateTimeToLive(in)
+}
+
+func (m *mockClient) DescribeContinuousBackupsWithContext(_ aws.Context, in *svcsdk.DescribeContinuousBackupsInput, _ ...request.Option) (*svcsdk.DescribeContinuousBackupsOutput, error) {
+	return m.describeContinuousBackup(in)
+}
+
+func (m *mockClient) UpdateContinuousBackupsWithContext(_ aws.Context, in *svcsdk.UpdateContinuousBackupsInput, _ ...request.Option) (*svcsdk.UpdateContinuousBackupsOutput, error) {
+	return m.updateContinuousBackups(in)
+}
+
 func tableParams(m ...func(*v1alpha1.TableParameters)) *v1alpha1.TableParameters {
 	o := &v1alpha1.TableParameters{
 		ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
@@ -250,6 +294,32 @@ func TestLateInitialize(t *testing.T) {
 			},
 			want: want{spec: tableParams()},
 		},
+		"Replicas": {
+			args: args{
+				spec: tableParams(),
+				in: &svcsdk.DescribeTableOutput{
+					Table: table(func(t *svcsdk.TableDescription) {
+						t.Replicas = []*svcsdk.ReplicaDescription{{RegionName: aws.String(region), KMSMasterKeyId: aws.String("key")}}
+					}),
+				},
+			},
+			want: want{spec: tableParams(func(p *v1alpha1.TableParameters) {
+				p.Replicas = []*v1alpha1.TableReplica{{RegionName: region, KMSMasterKeyID: aws.String("key")}}
+			})},
+		},
+		"EmptyIndexesAreKept": {
+			args: args{
+				spec: tableParams(func(p *v1alpha1.TableParameters) {
+					p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{}
+				}),
+				in: &svcsdk.DescribeTableOutput{
+					Table: table(withGSI),
+				},
+			},
+			want: want{spec: tableParams(func(p *v1alpha1.TableParameters) {
+				p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{}
+			})},
+		},
 	}
 
 	for name, tc := range cases {
@@ -264,3 +334,382 @@ func TestLateInitialize(t *testing.T) {
 		})
 	}
 }
+
+func activeTable(m ...func(*svcsdk.TableDescription)) *svcsdk.TableDescription {
+	o := &svcsdk.TableDescription{
+		TableStatus: aws.String(svcsdk.TableStatusActive),
+		ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+			WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func withGSI(t *svcsdk.TableDescription) {
+	t.GlobalSecondaryIndexes = []*svcsdk.GlobalSecondaryIndexDescription{{
+		IndexName:   aws.String(gsiName),
+		IndexStatus: aws.String(svcsdk.IndexStatusActive),
+		ProvisionedThroughput: &svcsdk.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+			WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+		},
+	}}
+}
+
+func gsi(read int64) *v1alpha1.GlobalSecondaryIndex {
+	return &v1alpha1.GlobalSecondaryIndex{
+		IndexName: aws.String(gsiName),
+		KeySchema: []*v1alpha1.KeySchemaElement{{
+			AttributeName: aws.String("attribute2"),
+			KeyType:       aws.String(svcsdk.KeyTypeHash),
+		}},
+		Projection: &v1alpha1.Projection{ProjectionType: aws.String(svcsdk.ProjectionTypeAll)},
+		ProvisionedThroughput: &v1alpha1.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(read),
+			WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+		},
+	}
+}
+
+func TestDiffGlobalSecondaryIndexes(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.TableParameters
+		t    *svcsdk.TableDescription
+		want []*svcsdk.GlobalSecondaryIndexUpdate
+	}{
+		"UpToDate": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{gsi(int64(readCapacityUnits))}
+			}),
+			t: activeTable(withGSI),
+		},
+		"Create": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{gsi(int64(readCapacityUnits))}
+			}),
+			t: activeTable(),
+			want: []*svcsdk.GlobalSecondaryIndexUpdate{{
+				Create: &svcsdk.CreateGlobalSecondaryIndexAction{
+					IndexName: aws.String(gsiName),
+					KeySchema: []*svcsdk.KeySchemaElement{{
+						AttributeName: aws.String("attribute2"),
+						KeyType:       aws.String(svcsdk.KeyTypeHash),
+					}},
+					Projection: &svcsdk.Projection{ProjectionType: aws.String(svcsdk.ProjectionTypeAll)},
+					ProvisionedThroughput: &svcsdk.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits)),
+						WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+					},
+				},
+			}},
+		},
+		"CreateOnDemand": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{gsi(int64(readCapacityUnits))}
+			}),
+			t: activeTable(func(t *svcsdk.TableDescription) {
+				t.BillingModeSummary = &svcsdk.BillingModeSummary{BillingMode: aws.String(svcsdk.BillingModePayPerRequest)}
+			}),
+			want: []*svcsdk.GlobalSecondaryIndexUpdate{{
+				Create: &svcsdk.CreateGlobalSecondaryIndexAction{
+					IndexName: aws.String(gsiName),
+					KeySchema: []*svcsdk.KeySchemaElement{{
+						AttributeName: aws.String("attribute2"),
+						KeyType:       aws.String(svcsdk.KeyTypeHash),
+					}},
+					Projection: &svcsdk.Projection{ProjectionType: aws.String(svcsdk.ProjectionTypeAll)},
+				},
+			}},
+		},
+		"Resize": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{gsi(int64(readCapacityUnits + 1))}
+			}),
+			t: activeTable(withGSI),
+			want: []*svcsdk.GlobalSecondaryIndexUpdate{{
+				Update: &svcsdk.UpdateGlobalSecondaryIndexAction{
+					IndexName: aws.String(gsiName),
+					ProvisionedThroughput: &svcsdk.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits + 1)),
+						WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+					},
+				},
+			}},
+		},
+		"Delete": {
+			p: tableParams(),
+			t: activeTable(withGSI),
+			want: []*svcsdk.GlobalSecondaryIndexUpdate{{
+				Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{IndexName: aws.String(gsiName)},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := diffGlobalSecondaryIndexes(*tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(svcsdk.GlobalSecondaryIndexUpdate{},
+				svcsdk.CreateGlobalSecondaryIndexAction{}, svcsdk.UpdateGlobalSecondaryIndexAction{}, svcsdk.DeleteGlobalSecondaryIndexAction{},
+				svcsdk.KeySchemaElement{}, svcsdk.Projection{}, svcsdk.ProvisionedThroughput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffReplicas(t *testing.T) {
+	cases := map[string]struct {
+		p    *v1alpha1.TableParameters
+		t    *svcsdk.TableDescription
+		want []*svcsdk.ReplicationGroupUpdate
+	}{
+		"UpToDate": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.Replicas = []*v1alpha1.TableReplica{{RegionName: region}}
+			}),
+			t: activeTable(func(t *svcsdk.TableDescription) {
+				t.Replicas = []*svcsdk.ReplicaDescription{{RegionName: aws.String(region)}}
+			}),
+		},
+		"Create": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.Replicas = []*v1alpha1.TableReplica{{RegionName: region}}
+			}),
+			t: activeTable(),
+			want: []*svcsdk.ReplicationGroupUpdate{{
+				Create: &svcsdk.CreateReplicationGroupMemberAction{RegionName: aws.String(region)},
+			}},
+		},
+		"NotSpecified": {
+			p: tableParams(),
+			t: activeTable(func(t *svcsdk.TableDescription) {
+				t.Replicas = []*svcsdk.ReplicaDescription{{RegionName: aws.String(region)}}
+			}),
+		},
+		"DeleteAll": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.Replicas = []*v1alpha1.TableReplica{}
+			}),
+			t: activeTable(func(t *svcsdk.TableDescription) {
+				t.Replicas = []*svcsdk.ReplicaDescription{{RegionName: aws.String(region)}}
+			}),
+			want: []*svcsdk.ReplicationGroupUpdate{
+				{Delete: &svcsdk.DeleteReplicationGroupMemberAction{RegionName: aws.String(region)}},
+			},
+		},
+		"DeleteFirst": {
+			p: tableParams(func(p *v1alpha1.TableParameters) {
+				p.Replicas = []*v1alpha1.TableReplica{{RegionName: "eu-west-1"}}
+			}),
+			t: activeTable(func(t *svcsdk.TableDescription) {
+				t.Replicas = []*svcsdk.ReplicaDescription{{RegionName: aws.String(region)}}
+			}),
+			want: []*svcsdk.ReplicationGroupUpdate{
+				{Delete: &svcsdk.DeleteReplicationGroupMemberAction{RegionName: aws.String(region)}},
+				{Create: &svcsdk.CreateReplicationGroupMemberAction{RegionName: aws.String("eu-west-1")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := diffReplicas(*tc.p, tc.t)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(svcsdk.ReplicationGroupUpdate{},
+				svcsdk.CreateReplicationGroupMemberAction{}, svcsdk.DeleteReplicationGroupMemberAction{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNextTimeToLive(t *testing.T) {
+	cases := map[string]struct {
+		desired  v1alpha1.TimeToLive
+		observed *svcsdk.TimeToLiveDescription
+		want     *svcsdk.TimeToLiveSpecification
+	}{
+		"Enable": {
+			desired:  v1alpha1.TimeToLive{Enabled: true, AttributeName: "expires"},
+			observed: &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			want:     &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expires"), Enabled: aws.Bool(true)},
+		},
+		"UpToDate": {
+			desired: v1alpha1.TimeToLive{Enabled: true, AttributeName: "expires"},
+			observed: &svcsdk.TimeToLiveDescription{
+				TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				AttributeName:    aws.String("expires"),
+			},
+		},
+		"DisableToChangeAttribute": {
+			desired: v1alpha1.TimeToLive{Enabled: true, AttributeName: "ttl"},
+			observed: &svcsdk.TimeToLiveDescription{
+				TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled),
+				AttributeName:    aws.String("expires"),
+			},
+			want: &svcsdk.TimeToLiveSpecification{AttributeName: aws.String("expires"), Enabled: aws.Bool(false)},
+		},
+		"Changing": {
+			desired: v1alpha1.TimeToLive{Enabled: false},
+			observed: &svcsdk.TimeToLiveDescription{
+				TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabling),
+				AttributeName:    aws.String("expires"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := nextTimeToLive(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(svcsdk.TimeToLiveSpecification{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type args struct {
+		client svcsdkapi.DynamoDBAPI
+		cr     *v1alpha1.Table
+	}
+	type want struct {
+		u    *svcsdk.UpdateTableInput
+		skip bool
+		err  error
+	}
+
+	describe := func(td *svcsdk.TableDescription) func(*svcsdk.DescribeTableInput) (*svcsdk.DescribeTableOutput, error) {
+		return func(*svcsdk.DescribeTableInput) (*svcsdk.DescribeTableOutput, error) {
+			return &svcsdk.DescribeTableOutput{Table: td}, nil
+		}
+	}
+	table := func(p *v1alpha1.TableParameters) *v1alpha1.Table {
+		cr := &v1alpha1.Table{Spec: v1alpha1.TableSpec{ForProvider: *p}}
+		meta.SetExternalName(cr, tableName)
+		return cr
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotActive": {
+			args: args{
+				client: &mockClient{describeTable: describe(activeTable(func(t *svcsdk.TableDescription) {
+					t.TableStatus = aws.String(svcsdk.TableStatusUpdating)
+				}))},
+				cr: table(tableParams(func(p *v1alpha1.TableParameters) {
+					p.ProvisionedThroughput.ReadCapacityUnits = aws.Int64(int64(readCapacityUnits + 1))
+				})),
+			},
+			want: want{
+				u:    &svcsdk.UpdateTableInput{TableName: aws.String(tableName)},
+				skip: true,
+			},
+		},
+		"ThroughputBeforeIndexes": {
+			args: args{
+				client: &mockClient{describeTable: describe(activeTable())},
+				cr: table(tableParams(func(p *v1alpha1.TableParameters) {
+					p.ProvisionedThroughput.ReadCapacityUnits = aws.Int64(int64(readCapacityUnits + 1))
+					p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{gsi(int64(readCapacityUnits))}
+				})),
+			},
+			want: want{
+				u: &svcsdk.UpdateTableInput{
+					TableName: aws.String(tableName),
+					ProvisionedThroughput: &svcsdk.ProvisionedThroughput{
+						ReadCapacityUnits:  aws.Int64(int64(readCapacityUnits + 1)),
+						WriteCapacityUnits: aws.Int64(int64(writeCapacityUnits)),
+					},
+				},
+			},
+		},
+		"OneIndexAtATime": {
+			args: args{
+				client: &mockClient{describeTable: describe(activeTable(withGSI))},
+				cr: table(tableParams(func(p *v1alpha1.TableParameters) {
+					other := gsi(int64(readCapacityUnits))
+					other.IndexName = aws.String("other-index")
+					p.GlobalSecondaryIndexes = []*v1alpha1.GlobalSecondaryIndex{other}
+				})),
+			},
+			want: want{
+				u: &svcsdk.UpdateTableInput{
+					TableName: aws.String(tableName),
+					GlobalSecondaryIndexUpdates: []*svcsdk.GlobalSecondaryIndexUpdate{{
+						Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{IndexName: aws.String(gsiName)},
+					}},
+				},
+			},
+		},
+		"TimeToLive": {
+			args: args{
+				client: &mockClient{
+					describeTable: describe(activeTable()),
+					describeTimeToLive: func(*svcsdk.DescribeTimeToLiveInput) (*svcsdk.DescribeTimeToLiveOutput, error) {
+						return &svcsdk.DescribeTimeToLiveOutput{TimeToLiveDescription: &svcsdk.TimeToLiveDescription{
+							TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled),
+						}}, nil
+					},
+					updateTimeToLive: func(in *svcsdk.UpdateTimeToLiveInput) (*svcsdk.UpdateTimeToLiveOutput, error) {
+						if diff := cmp.Diff("expires", aws.StringValue(in.TimeToLiveSpecification.AttributeName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.UpdateTimeToLiveOutput{}, nil
+					},
+				},
+				cr: table(tableParams(func(p *v1alpha1.TableParameters) {
+					p.TimeToLive = &v1alpha1.TimeToLive{Enabled: true, AttributeName: "expires"}
+				})),
+			},
+			want: want{
+				u:    &svcsdk.UpdateTableInput{TableName: aws.String(tableName)},
+				skip: true,
+			},
+		},
+		"PointInTimeRecoveryFailed": {
+			args: args{
+				client: &mockClient{
+					describeContinuousBackup: func(*svcsdk.DescribeContinuousBackupsInput) (*svcsdk.DescribeContinuousBackupsOutput, error) {
+						return &svcsdk.DescribeContinuousBackupsOutput{}, nil
+					},
+					updateContinuousBackups: func(*svcsdk.UpdateContinuousBackupsInput) (*svcsdk.UpdateContinuousBackupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: table(tableParams(func(p *v1alpha1.TableParameters) {
+					p.PointInTimeRecoveryEnabled = aws.Bool(true)
+				})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateContinuousBackups),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &updateClient{DynamoDBAPI: tc.args.client}
+			u := GenerateUpdateTableInput(tc.args.cr)
+			err := e.preUpdate(context.Background(), tc.args.cr, u)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.u == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.skip, e.skipUpdateTable); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.u, u, cmpopts.IgnoreUnexported(svcsdk.UpdateTableInput{}, svcsdk.ProvisionedThroughput{},
+				svcsdk.GlobalSecondaryIndexUpdate{}, svcsdk.DeleteGlobalSecondaryIndexAction{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject    = "The managed resource is not an Instance resource"
+	errDescribe            = "failed to describe Instance"
+	errDescribeAttribute   = "failed to describe an attribute of the Instance"
+	errNotSingleItem       = "either no or multiple Instances retrieved for the given instanceId"
+	errGetUserData         = "cannot get the user data of the Instance"
+	errUserDataKeyNotFound = "the user data key cannot be found"
+	errCreate              = "failed to create the Instance resource"
+	errModifyAttribute     = "failed to modify an attribute of the Instance resource"
+	errDelete              = "failed to delete the Instance resource"
+	errUpdateTags          = "failed to update tags for the Instance resource"
+	errDeleteTags          = "failed to delete tags for Instance resource"
+)
+
+// SetupInstance adds a controller that reconciles Instances.
+func SetupInstance(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.InstanceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Instance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.InstanceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.InstanceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Instance)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.InstanceClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (awsec2.Instance, error) {
+	response, err := e.client.DescribeInstancesRequest(&awsec2.DescribeInstancesInput{
+		InstanceIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return awsec2.Instance{}, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Reservations) != 1 || len(response.Reservations[0].Instances) != 1 {
+		return awsec2.Instance{}, errors.New(errNotSingleItem)
+	}
+	return response.Reservations[0].Instances[0], nil
+}
+
+// attributesToUpdate returns the inputs to modify the attributes of the
+// instance that are set in the spec but differ from the observed ones. These
+// attributes are not part of the DescribeInstances output.
+func (e *external) attributesToUpdate(ctx context.Context, id string, p v1alpha1.InstanceParameters) ([]*awsec2.ModifyInstanceAttributeInput, error) {
+	var res []*awsec2.ModifyInstanceAttributeInput
+	if p.DisableAPITermination != nil {
+		rsp, err := e.client.DescribeInstanceAttributeRequest(&awsec2.DescribeInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Attribute:  awsec2.InstanceAttributeNameDisableApiTermination,
+		}).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errDescribeAttribute)
+		}
+		if rsp.DisableApiTermination == nil || aws.BoolValue(rsp.DisableApiTermination.Value) != aws.BoolValue(p.DisableAPITermination) {
+			res = append(res, &awsec2.ModifyInstanceAttributeInput{
+				InstanceId:            aws.String(id),
+				DisableApiTermination: &awsec2.AttributeBooleanValue{Value: p.DisableAPITermination},
+			})
+		}
+	}
+	if p.InstanceInitiatedShutdownBehavior != nil {
+		rsp, err := e.client.DescribeInstanceAttributeRequest(&awsec2.DescribeInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Attribute:  awsec2.InstanceAttributeNameInstanceInitiatedShutdownBehavior,
+		}).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errDescribeAttribute)
+		}
+		if rsp.InstanceInitiatedShutdownBehavior == nil || aws.StringValue(rsp.InstanceInitiatedShutdownBehavior.Value) != aws.StringValue(p.InstanceInitiatedShutdownBehavior) {
+			res = append(res, &awsec2.ModifyInstanceAttributeInput{
+				InstanceId:                        aws.String(id),
+				InstanceInitiatedShutdownBehavior: &awsec2.AttributeValue{Value: p.InstanceInitiatedShutdownBehavior},
+			})
+		}
+	}
+	return res, nil
+}
+
+// userData returns the user data of the instance, reading it from the
+// referenced ConfigMap or Secret if one is given.
+func (e *external) userData(ctx context.Context, p v1alpha1.InstanceParameters) (*string, error) {
+	if p.UserDataFrom == nil {
+		return p.UserData, nil
+	}
+	if ref := p.UserDataFrom.ConfigMapKeyRef; ref != nil {
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return nil, err
+		}
+		d, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, errors.New(errUserDataKeyNotFound)
+		}
+		return aws.String(d), nil
+	}
+	if ref := p.UserDataFrom.SecretKeyRef; ref != nil {
+		s := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return nil, err
+		}
+		d, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.New(errUserDataKeyNotFound)
+		}
+		return aws.String(string(d)), nil
+	}
+	return p.UserData, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = ec2.GenerateInstanceObservation(observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.InstanceStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStateRunning:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.InstanceStateShuttingDown:
+		cr.SetConditions(xpv1.Deleting())
+	case v1alpha1.InstanceStateTerminated:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed)
+	if upToDate {
+		attrs, err := e.attributesToUpdate(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = len(attrs) == 0
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	userData, err := e.userData(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetUserData)
+	}
+
+	// The UID is used as the client token so that a retried creation does
+	// not launch another instance. When a terminated instance is replaced,
+	// its ID is appended so that RunInstances does not return it again.
+	token := string(cr.GetUID())
+	if id := meta.GetExternalName(cr); id != "" {
+		token += "-" + id
+	}
+	rsp, err := e.client.RunInstancesRequest(ec2.GenerateRunInstancesInput(token, cr.Spec.ForProvider, userData)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if len(rsp.Instances) != 1 {
+		return managed.ExternalCreation{}, errors.Wrap(errors.New(errNotSingleItem), errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.Instances[0].InstanceId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	attrs, err := e.attributesToUpdate(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !ec2.IsInstanceSecurityGroupsUpToDate(cr.Spec.ForProvider, observed) {
+		attrs = append(attrs, &awsec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(meta.GetExternalName(cr)),
+			Groups:     cr.Spec.ForProvider.SecurityGroupIDs,
+		})
+	}
+	for _, in := range attrs {
+		if _, err := e.client.ModifyInstanceAttributeRequest(in).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errModifyAttribute)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Instance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.InstanceStateShuttingDown ||
+		cr.Status.AtProvider.State == v1alpha1.InstanceStateTerminated {
+		return nil
+	}
+
+	_, err := e.client.TerminateInstancesRequest(&awsec2.TerminateInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsInstanceNotFoundErr, err), errDelete)
+}
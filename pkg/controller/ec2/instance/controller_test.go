@@ -0,0 +1,499 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	instanceID   = "i-123"
+	imageID      = "ami-123"
+	instanceType = "t3.micro"
+	subnetID     = "subnet-123"
+	sgA          = "sg-a"
+	sgB          = "sg-b"
+	privateIP    = "10.0.0.10"
+	ebsOptimized = false
+	monitoring   = false
+	disableTerm  = true
+	userData     = "#!/bin/bash\necho hello"
+	errBoom      = errors.New("boom")
+)
+
+type instanceModifier func(*v1alpha1.Instance)
+
+func withExternalName(name string) instanceModifier {
+	return func(r *v1alpha1.Instance) { meta.SetExternalName(r, name) }
+}
+
+func withUID(uid string) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.SetUID(types.UID(uid)) }
+}
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.InstanceParameters) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.InstanceObservation) instanceModifier {
+	return func(r *v1alpha1.Instance) { r.Status.AtProvider = s }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.Instance {
+	cr := &v1alpha1.Instance{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(groups ...string) v1alpha1.InstanceParameters {
+	return v1alpha1.InstanceParameters{
+		ImageID:          &imageID,
+		InstanceType:     &instanceType,
+		SubnetID:         &subnetID,
+		SecurityGroupIDs: groups,
+		PrivateIPAddress: &privateIP,
+		EBSOptimized:     &ebsOptimized,
+		Monitoring:       &monitoring,
+	}
+}
+
+func observation(state string) v1alpha1.InstanceObservation {
+	return v1alpha1.InstanceObservation{
+		InstanceID:       instanceID,
+		PrivateIPAddress: privateIP,
+		State:            state,
+	}
+}
+
+func describe(state awsec2.InstanceStateName, groups ...string) func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+	return func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+		i := awsec2.Instance{
+			EbsOptimized:     aws.Bool(ebsOptimized),
+			ImageId:          aws.String(imageID),
+			InstanceId:       aws.String(instanceID),
+			InstanceType:     awsec2.InstanceType(instanceType),
+			Monitoring:       &awsec2.Monitoring{State: awsec2.MonitoringStateDisabled},
+			PrivateIpAddress: aws.String(privateIP),
+			State:            &awsec2.InstanceState{Name: state},
+			SubnetId:         aws.String(subnetID),
+		}
+		for _, g := range groups {
+			i.SecurityGroups = append(i.SecurityGroups, awsec2.GroupIdentifier{GroupId: aws.String(g)})
+		}
+		return awsec2.DescribeInstancesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeInstancesOutput{
+				Reservations: []awsec2.Reservation{{Instances: []awsec2.Instance{i}}},
+			}},
+		}
+	}
+}
+
+func describeAttribute(disable bool) func(*awsec2.DescribeInstanceAttributeInput) awsec2.DescribeInstanceAttributeRequest {
+	return func(*awsec2.DescribeInstanceAttributeInput) awsec2.DescribeInstanceAttributeRequest {
+		return awsec2.DescribeInstanceAttributeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeInstanceAttributeOutput{
+				DisableApiTermination: &awsec2.AttributeBooleanValue{Value: aws.Bool(disable)},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	kube   client.Client
+	client ec2.InstanceClient
+	cr     *v1alpha1.Instance
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RunningAndLateInitialized": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockDescribe: describe(awsec2.InstanceStateNameRunning, sgA),
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(spec(sgA)),
+					withStatus(observation(v1alpha1.InstanceStateRunning)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"AttributeChanged": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockDescribe:          describe(awsec2.InstanceStateNameRunning, sgA),
+					MockDescribeAttribute: describeAttribute(false),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(func() v1alpha1.InstanceParameters {
+					p := spec(sgA)
+					p.DisableAPITermination = &disableTerm
+					return p
+				}())),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(func() v1alpha1.InstanceParameters {
+					p := spec(sgA)
+					p.DisableAPITermination = &disableTerm
+					return p
+				}()),
+					withStatus(observation(v1alpha1.InstanceStateRunning)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Terminated": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockDescribe: describe(awsec2.InstanceStateNameTerminated, sgA),
+				},
+				cr: instance(withExternalName(instanceID), withSpec(spec(sgA))),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withSpec(spec(sgA)),
+					withStatus(observation(v1alpha1.InstanceStateTerminated))),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockDescribe: func(*awsec2.DescribeInstancesInput) awsec2.DescribeInstancesRequest {
+						return awsec2.DescribeInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.InstanceNotFound, "", nil)},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Instance
+		result managed.ExternalCreation
+		err    error
+	}
+
+	fromConfigMap := func(key string) v1alpha1.InstanceParameters {
+		p := spec(sgA)
+		p.UserDataFrom = &v1alpha1.UserDataSource{
+			ConfigMapKeyRef: &v1alpha1.ConfigMapKeySelector{Name: "cm", Namespace: "ns", Key: key},
+		}
+		return p
+	}
+	kube := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj runtime.Object) error {
+			obj.(*corev1.ConfigMap).Data = map[string]string{"userdata": userData}
+			return nil
+		}),
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UserDataFromConfigMap": {
+			args: args{
+				kube: kube,
+				client: &fake.MockInstanceClient{
+					MockRun: func(input *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						if diff := cmp.Diff(aws.String("IyEvYmluL2Jhc2gKZWNobyBoZWxsbw=="), input.UserData); diff != "" {
+							t.Errorf("UserData: -want, +got:\n%s", diff)
+						}
+						return awsec2.RunInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RunInstancesOutput{
+								Instances: []awsec2.Instance{{InstanceId: aws.String(instanceID)}},
+							}},
+						}
+					},
+				},
+				cr: instance(withSpec(fromConfigMap("userdata"))),
+			},
+			want: want{
+				cr:     instance(withExternalName(instanceID), withSpec(fromConfigMap("userdata"))),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"ReplaceTerminated": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockRun: func(input *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						if diff := cmp.Diff(aws.String("uid-"+instanceID), input.ClientToken); diff != "" {
+							t.Errorf("ClientToken: -want, +got:\n%s", diff)
+						}
+						return awsec2.RunInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RunInstancesOutput{
+								Instances: []awsec2.Instance{{InstanceId: aws.String("i-new")}},
+							}},
+						}
+					},
+				},
+				cr: instance(withUID("uid"), withExternalName(instanceID), withSpec(spec(sgA))),
+			},
+			want: want{
+				cr:     instance(withUID("uid"), withExternalName("i-new"), withSpec(spec(sgA))),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"UserDataKeyNotFound": {
+			args: args{
+				kube:   kube,
+				client: &fake.MockInstanceClient{},
+				cr:     instance(withSpec(fromConfigMap("missing"))),
+			},
+			want: want{
+				cr:  instance(withSpec(fromConfigMap("missing"))),
+				err: errors.Wrap(errors.New(errUserDataKeyNotFound), errGetUserData),
+			},
+		},
+		"RunFail": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockRun: func(input *awsec2.RunInstancesInput) awsec2.RunInstancesRequest {
+						return awsec2.RunInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withSpec(spec(sgA))),
+			},
+			want: want{
+				cr:  instance(withSpec(spec(sgA))),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SecurityGroupsAndAttribute": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockDescribe:          describe(awsec2.InstanceStateNameRunning, sgA),
+					MockDescribeAttribute: describeAttribute(false),
+					MockModifyAttribute: func(input *awsec2.ModifyInstanceAttributeInput) awsec2.ModifyInstanceAttributeRequest {
+						if input.Groups != nil {
+							if diff := cmp.Diff([]string{sgB}, input.Groups); diff != "" {
+								t.Errorf("Groups: -want, +got:\n%s", diff)
+							}
+						} else if diff := cmp.Diff(&awsec2.AttributeBooleanValue{Value: &disableTerm}, input.DisableApiTermination); diff != "" {
+							t.Errorf("DisableApiTermination: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyInstanceAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyInstanceAttributeOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(func() v1alpha1.InstanceParameters {
+					p := spec(sgB)
+					p.DisableAPITermination = &disableTerm
+					return p
+				}())),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockDescribe: describe(awsec2.InstanceStateNameRunning, sgA),
+					MockModifyAttribute: func(input *awsec2.ModifyInstanceAttributeInput) awsec2.ModifyInstanceAttributeRequest {
+						return awsec2.ModifyInstanceAttributeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID), withSpec(spec(sgB))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModifyAttribute),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Instance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockTerminate: func(input *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.TerminateInstancesOutput{}},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyShuttingDown": {
+			args: args{
+				client: &fake.MockInstanceClient{},
+				cr: instance(withExternalName(instanceID),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateShuttingDown})),
+			},
+			want: want{
+				cr: instance(withExternalName(instanceID),
+					withStatus(v1alpha1.InstanceObservation{State: v1alpha1.InstanceStateShuttingDown}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"TerminateFail": {
+			args: args{
+				client: &fake.MockInstanceClient{
+					MockTerminate: func(input *awsec2.TerminateInstancesInput) awsec2.TerminateInstancesRequest {
+						return awsec2.TerminateInstancesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: instance(withExternalName(instanceID)),
+			},
+			want: want{
+				cr:  instance(withExternalName(instanceID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
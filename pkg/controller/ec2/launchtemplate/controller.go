@@ -0,0 +1,236 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LaunchTemplate resource"
+	errDescribe         = "failed to describe LaunchTemplate"
+	errDescribeVersion  = "failed to describe the latest version of the LaunchTemplate"
+	errNotSingleItem    = "either no or multiple LaunchTemplates retrieved for the given launchTemplateName"
+	errUpToDate         = "cannot check whether the LaunchTemplate is up to date"
+	errCreate           = "failed to create the LaunchTemplate resource"
+	errCreateVersion    = "failed to create a new version of the LaunchTemplate resource"
+	errSetDefault       = "failed to set the default version of the LaunchTemplate resource"
+	errDelete           = "failed to delete the LaunchTemplate resource"
+	errUpdateTags       = "failed to update tags for the LaunchTemplate resource"
+	errDeleteTags       = "failed to delete tags for LaunchTemplate resource"
+)
+
+// SetupLaunchTemplate adds a controller that reconciles LaunchTemplates.
+func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LaunchTemplateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LaunchTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LaunchTemplateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewLaunchTemplateClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.LaunchTemplateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.LaunchTemplateClient
+}
+
+func (e *external) describe(ctx context.Context, name string) (awsec2.LaunchTemplate, error) {
+	response, err := e.client.DescribeLaunchTemplatesRequest(&awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateNames: []string{name},
+	}).Send(ctx)
+	if err != nil {
+		return awsec2.LaunchTemplate{}, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.LaunchTemplates) != 1 {
+		return awsec2.LaunchTemplate{}, errors.New(errNotSingleItem)
+	}
+	return response.LaunchTemplates[0], nil
+}
+
+// isDataUpToDate returns whether the latest version of the template has the
+// desired data.
+func (e *external) isDataUpToDate(ctx context.Context, cr *v1alpha1.LaunchTemplate) (bool, error) {
+	response, err := e.client.DescribeLaunchTemplateVersionsRequest(&awsec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+		Versions:           []string{ec2.LaunchTemplateVersionLatest},
+	}).Send(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, errDescribeVersion)
+	}
+	if len(response.LaunchTemplateVersions) != 1 {
+		return false, errors.New(errDescribeVersion)
+	}
+	ok, err := ec2.IsLaunchTemplateDataUpToDate(cr.Spec.ForProvider.LaunchTemplateData, response.LaunchTemplateVersions[0].LaunchTemplateData)
+	return ok, errors.Wrap(err, errUpToDate)
+}
+
+func isDefaultVersionUpToDate(p v1alpha1.LaunchTemplateParameters, t awsec2.LaunchTemplate) bool {
+	return !aws.BoolValue(p.SetDefaultVersion) || aws.Int64Value(t.DefaultVersionNumber) == aws.Int64Value(t.LatestVersionNumber)
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateLaunchTemplateObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := e.isDataUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: upToDate &&
+			isDefaultVersionUpToDate(cr.Spec.ForProvider, observed) &&
+			v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.CreateLaunchTemplateRequest(ec2.GenerateCreateLaunchTemplateInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	upToDate, err := e.isDataUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !upToDate {
+		rsp, err := e.client.CreateLaunchTemplateVersionRequest(&awsec2.CreateLaunchTemplateVersionInput{
+			LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+			LaunchTemplateData: ec2.GenerateRequestLaunchTemplateData(cr.Spec.ForProvider.LaunchTemplateData),
+			VersionDescription: cr.Spec.ForProvider.VersionDescription,
+		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreateVersion)
+		}
+		observed.LatestVersionNumber = rsp.LaunchTemplateVersion.VersionNumber
+	}
+
+	if !isDefaultVersionUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.ModifyLaunchTemplateRequest(&awsec2.ModifyLaunchTemplateInput{
+			LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+			DefaultVersion:     aws.String(strconv.FormatInt(aws.Int64Value(observed.LatestVersionNumber), 10)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetDefault)
+		}
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{aws.StringValue(observed.LaunchTemplateId)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{aws.StringValue(observed.LaunchTemplateId)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LaunchTemplate)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteLaunchTemplateRequest(&awsec2.DeleteLaunchTemplateInput{
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsLaunchTemplateNotFoundErr, err), errDelete)
+}
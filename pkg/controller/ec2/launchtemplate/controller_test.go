@@ -0,0 +1,415 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	templateName = "nodes"
+	templateID   = "lt-123"
+	imageID      = "ami-123"
+	smallType    = "t3.micro"
+	largeType    = "m5.large"
+	setDefault   = true
+	errBoom      = errors.New("boom")
+)
+
+type templateModifier func(*v1alpha1.LaunchTemplate)
+
+func withConditions(c ...xpv1.Condition) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.LaunchTemplateParameters) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.LaunchTemplateObservation) templateModifier {
+	return func(r *v1alpha1.LaunchTemplate) { r.Status.AtProvider = s }
+}
+
+func template(m ...templateModifier) *v1alpha1.LaunchTemplate {
+	cr := &v1alpha1.LaunchTemplate{}
+	meta.SetExternalName(cr, templateName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(instanceType string, def *bool) v1alpha1.LaunchTemplateParameters {
+	return v1alpha1.LaunchTemplateParameters{
+		LaunchTemplateData: v1alpha1.LaunchTemplateData{
+			ImageID:      &imageID,
+			InstanceType: &instanceType,
+		},
+		SetDefaultVersion: def,
+	}
+}
+
+func describe(defaultVersion, latestVersion int64) func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+	return func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+		return awsec2.DescribeLaunchTemplatesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeLaunchTemplatesOutput{
+				LaunchTemplates: []awsec2.LaunchTemplate{{
+					DefaultVersionNumber: aws.Int64(defaultVersion),
+					LatestVersionNumber:  aws.Int64(latestVersion),
+					LaunchTemplateId:     aws.String(templateID),
+					LaunchTemplateName:   aws.String(templateName),
+				}},
+			}},
+		}
+	}
+}
+
+func describeVersions(instanceType string) func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+	return func(*awsec2.DescribeLaunchTemplateVersionsInput) awsec2.DescribeLaunchTemplateVersionsRequest {
+		return awsec2.DescribeLaunchTemplateVersionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []awsec2.LaunchTemplateVersion{{
+					LaunchTemplateData: &awsec2.ResponseLaunchTemplateData{
+						ImageId:      aws.String(imageID),
+						InstanceType: awsec2.InstanceType(instanceType),
+					},
+				}},
+			}},
+		}
+	}
+}
+
+func observation(defaultVersion, latestVersion int64) v1alpha1.LaunchTemplateObservation {
+	return v1alpha1.LaunchTemplateObservation{
+		DefaultVersionNumber: defaultVersion,
+		LatestVersionNumber:  latestVersion,
+		LaunchTemplateID:     templateID,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.LaunchTemplateClient
+	cr     *v1alpha1.LaunchTemplate
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LaunchTemplate
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(1, 1),
+					MockDescribeVersions: describeVersions(smallType),
+				},
+				cr: template(withSpec(spec(smallType, &setDefault))),
+			},
+			want: want{
+				cr: template(withSpec(spec(smallType, &setDefault)),
+					withStatus(observation(1, 1)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DataChanged": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(1, 1),
+					MockDescribeVersions: describeVersions(smallType),
+				},
+				cr: template(withSpec(spec(largeType, nil))),
+			},
+			want: want{
+				cr: template(withSpec(spec(largeType, nil)),
+					withStatus(observation(1, 1)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"DefaultVersionBehind": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(1, 2),
+					MockDescribeVersions: describeVersions(smallType),
+				},
+				cr: template(withSpec(spec(smallType, &setDefault))),
+			},
+			want: want{
+				cr: template(withSpec(spec(smallType, &setDefault)),
+					withStatus(observation(1, 2)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe: func(*awsec2.DescribeLaunchTemplatesInput) awsec2.DescribeLaunchTemplatesRequest {
+						return awsec2.DescribeLaunchTemplatesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.LaunchTemplateNotFound, "", nil)},
+						}
+					},
+				},
+				cr: template(),
+			},
+			want: want{
+				cr: template(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockCreate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						if diff := cmp.Diff(aws.String(templateName), input.LaunchTemplateName); diff != "" {
+							t.Errorf("LaunchTemplateName: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: template(withSpec(spec(smallType, nil))),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockCreate: func(input *awsec2.CreateLaunchTemplateInput) awsec2.CreateLaunchTemplateRequest {
+						return awsec2.CreateLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: template(withSpec(spec(smallType, nil))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NewDefaultVersion": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(1, 1),
+					MockDescribeVersions: describeVersions(smallType),
+					MockCreateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						if diff := cmp.Diff(awsec2.InstanceType(largeType), input.LaunchTemplateData.InstanceType); diff != "" {
+							t.Errorf("InstanceType: -want, +got:\n%s", diff)
+						}
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateVersionOutput{
+								LaunchTemplateVersion: &awsec2.LaunchTemplateVersion{VersionNumber: aws.Int64(2)},
+							}},
+						}
+					},
+					MockModify: func(input *awsec2.ModifyLaunchTemplateInput) awsec2.ModifyLaunchTemplateRequest {
+						if diff := cmp.Diff(aws.String("2"), input.DefaultVersion); diff != "" {
+							t.Errorf("DefaultVersion: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: template(withSpec(spec(largeType, &setDefault))),
+			},
+		},
+		"NewVersionOnly": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(1, 1),
+					MockDescribeVersions: describeVersions(smallType),
+					MockCreateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateLaunchTemplateVersionOutput{
+								LaunchTemplateVersion: &awsec2.LaunchTemplateVersion{VersionNumber: aws.Int64(2)},
+							}},
+						}
+					},
+				},
+				cr: template(withSpec(spec(largeType, nil))),
+			},
+		},
+		"CreateVersionFail": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDescribe:         describe(1, 1),
+					MockDescribeVersions: describeVersions(smallType),
+					MockCreateVersion: func(input *awsec2.CreateLaunchTemplateVersionInput) awsec2.CreateLaunchTemplateVersionRequest {
+						return awsec2.CreateLaunchTemplateVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: template(withSpec(spec(largeType, nil))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateVersion),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LaunchTemplate
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDelete: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteLaunchTemplateOutput{}},
+						}
+					},
+				},
+				cr: template(),
+			},
+			want: want{
+				cr: template(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockLaunchTemplateClient{
+					MockDelete: func(input *awsec2.DeleteLaunchTemplateInput) awsec2.DeleteLaunchTemplateRequest {
+						return awsec2.DeleteLaunchTemplateRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.LaunchTemplateNotFound, "", nil)},
+						}
+					},
+				},
+				cr: template(),
+			},
+			want: want{
+				cr: template(withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -49,6 +49,8 @@ const (
 	errUpdateNotFound     = "cannot update the RouteTable, since the RouteTableID is not present"
 	errDelete             = "failed to delete the RouteTable resource"
 	errCreateRoute        = "failed to create a route in the RouteTable resource"
+	errReplaceRoute       = "failed to replace a route in the RouteTable resource"
+	errDeleteRoute        = "failed to delete a route from the RouteTable resource"
 	errAssociateSubnet    = "failed to associate subnet %v to the RouteTable resource"
 	errDisassociateSubnet = "failed to disassociate subnet %v from the RouteTable resource"
 	errCreateTags         = "failed to create tags for the RouteTable resource"
@@ -200,11 +202,8 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if patch.Routes != nil {
-		// Attach the routes in Spec
-		if err := e.createRoutes(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Routes, cr.Status.AtProvider.Routes); err != nil {
-			return managed.ExternalUpdate{}, err
-		}
+	if err := e.syncRoutes(ctx, cr, table.Routes); err != nil {
+		return managed.ExternalUpdate{}, err
 	}
 
 	if patch.Associations != nil {
@@ -237,29 +236,34 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return errors.Wrap(resource.Ignore(ec2.IsRouteTableNotFoundErr, err), errDelete)
 }
 
-func (e *external) createRoutes(ctx context.Context, tableID string, desired []v1alpha4.Route, observed []v1alpha4.RouteState) error {
-	for _, rt := range desired {
-		isObserved := false
-		for _, ob := range observed {
-			if ob.GatewayID == aws.StringValue(rt.GatewayID) && ob.DestinationCIDRBlock == aws.StringValue(rt.DestinationCIDRBlock) {
-				isObserved = true
-				break
-			}
+func (e *external) syncRoutes(ctx context.Context, cr *v1alpha4.RouteTable, observed []awsec2.Route) error {
+	tableID := meta.GetExternalName(cr)
+	create, replace, remove := ec2.DiffRoutes(cr.Spec.ForProvider.Routes, observed, aws.BoolValue(cr.Spec.ForProvider.PruneRoutes))
+	for _, rt := range remove {
+		req := e.client.DeleteRouteRequest(&awsec2.DeleteRouteInput{
+			RouteTableId:             aws.String(tableID),
+			DestinationCidrBlock:     rt.DestinationCidrBlock,
+			DestinationIpv6CidrBlock: rt.DestinationIpv6CidrBlock,
+		})
+		ec2.WithRouteParameters(req.Request, rt.DestinationPrefixListId, nil)
+		if _, err := req.Send(ctx); resource.Ignore(ec2.IsRouteNotFoundErr, err) != nil {
+			return errors.Wrap(err, errDeleteRoute)
 		}
-		// if the route is already created, skip it
-		if !isObserved {
-			_, err := e.client.CreateRouteRequest(&awsec2.CreateRouteInput{
-				RouteTableId:         aws.String(tableID),
-				DestinationCidrBlock: rt.DestinationCIDRBlock,
-				GatewayId:            rt.GatewayID,
-			}).Send(ctx)
-
-			if err != nil {
-				return errors.Wrap(err, errCreateRoute)
-			}
+	}
+	for _, rt := range replace {
+		req := e.client.ReplaceRouteRequest(ec2.GenerateReplaceRouteInput(tableID, rt))
+		ec2.WithRouteParameters(req.Request, rt.DestinationPrefixListID, rt.VPCEndpointID)
+		if _, err := req.Send(ctx); err != nil {
+			return errors.Wrap(err, errReplaceRoute)
+		}
+	}
+	for _, rt := range create {
+		req := e.client.CreateRouteRequest(ec2.GenerateCreateRouteInput(tableID, rt))
+		ec2.WithRouteParameters(req.Request, rt.DestinationPrefixListID, rt.VPCEndpointID)
+		if _, err := req.Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateRoute)
 		}
 	}
-
 	return nil
 }
 
@@ -41,8 +41,12 @@ var (
 	rtID     = "some rt"
 	vpcID    = "some vpc"
 	igID     = "some ig"
+	natID    = "some nat"
 	subnetID = "some subnet"
 
+	defaultCIDR = "0.0.0.0/0"
+	staleCIDR   = "10.1.0.0/16"
+
 	errBoom = errors.New("boom")
 )
 
@@ -312,6 +316,81 @@ func TestUpdate(t *testing.T) {
 					})),
 			},
 		},
+		"ReplaceAndDeleteRoutes": {
+			args: args{
+				rt: &fake.MockRouteTableClient{
+					MockDescribe: func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+						return awsec2.DescribeRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeRouteTablesOutput{
+								RouteTables: []awsec2.RouteTable{{
+									Routes: []awsec2.Route{
+										{DestinationCidrBlock: aws.String(defaultCIDR), GatewayId: aws.String(igID), Origin: awsec2.RouteOriginCreateRoute},
+										{DestinationCidrBlock: aws.String(staleCIDR), GatewayId: aws.String(igID), Origin: awsec2.RouteOriginCreateRoute},
+									},
+								}},
+							}},
+						}
+					},
+					MockReplaceRoute: func(input *awsec2.ReplaceRouteInput) awsec2.ReplaceRouteRequest {
+						if aws.StringValue(input.NatGatewayId) != natID {
+							t.Errorf("unexpected route target: %s", aws.StringValue(input.NatGatewayId))
+						}
+						return awsec2.ReplaceRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ReplaceRouteOutput{}},
+						}
+					},
+					MockDeleteRoute: func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+						if aws.StringValue(input.DestinationCidrBlock) != staleCIDR {
+							t.Errorf("unexpected route deletion: %s", aws.StringValue(input.DestinationCidrBlock))
+						}
+						return awsec2.DeleteRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteRouteOutput{}},
+						}
+					},
+				},
+				cr: rt(withSpec(v1alpha4.RouteTableParameters{
+					Routes: []v1alpha4.Route{{
+						DestinationCIDRBlock: aws.String(defaultCIDR),
+						NatGatewayID:         aws.String(natID),
+					}},
+				})),
+			},
+			want: want{
+				cr: rt(withSpec(v1alpha4.RouteTableParameters{
+					Routes: []v1alpha4.Route{{
+						DestinationCIDRBlock: aws.String(defaultCIDR),
+						NatGatewayID:         aws.String(natID),
+					}},
+				})),
+			},
+		},
+		"DeleteRouteFail": {
+			args: args{
+				rt: &fake.MockRouteTableClient{
+					MockDescribe: func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+						return awsec2.DescribeRouteTablesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeRouteTablesOutput{
+								RouteTables: []awsec2.RouteTable{{
+									Routes: []awsec2.Route{
+										{DestinationCidrBlock: aws.String(staleCIDR), GatewayId: aws.String(igID), Origin: awsec2.RouteOriginCreateRoute},
+									},
+								}},
+							}},
+						}
+					},
+					MockDeleteRoute: func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+						return awsec2.DeleteRouteRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rt(withSpec(v1alpha4.RouteTableParameters{PruneRoutes: aws.Bool(true)})),
+			},
+			want: want{
+				cr:  rt(withSpec(v1alpha4.RouteTableParameters{PruneRoutes: aws.Bool(true)})),
+				err: errors.Wrap(errBoom, errDeleteRoute),
+			},
+		},
 		"CreateRouteFail": {
 			args: args{
 				rt: &fake.MockRouteTableClient{
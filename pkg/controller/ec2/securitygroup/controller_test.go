@@ -144,6 +144,36 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"SharedGroup": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDescribe: func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+						return awsec2.DescribeSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSecurityGroupsOutput{
+								SecurityGroups: []awsec2.SecurityGroup{{
+									IpPermissions: append(ec2.GenerateEC2Permissions(specPermissions()), sgPersmissions()...),
+								}},
+							}},
+						}
+					},
+				},
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: specPermissions(),
+				}),
+					withExternalName(sgID)),
+			},
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: specPermissions(),
+				}),
+					withExternalName(sgID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"MultipleSGs": {
 			args: args{
 				sg: &fake.MockSecurityGroupClient{
@@ -0,0 +1,201 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a SecurityGroupRule resource"
+
+	errDescribe      = "failed to describe the SecurityGroup of the SecurityGroupRule"
+	errMultipleItems = "retrieved multiple SecurityGroups for the given securityGroupId"
+	errPermission    = "cannot generate the permission of the SecurityGroupRule"
+	errAuthorize     = "failed to authorize the SecurityGroupRule"
+	errUpdate        = "failed to update the description of the SecurityGroupRule"
+	errRevoke        = "failed to revoke the SecurityGroupRule"
+)
+
+// SetupSecurityGroupRule adds a controller that reconciles SecurityGroupRules.
+func SetupSecurityGroupRule(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SecurityGroupRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.SecurityGroupRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SecurityGroupRuleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupRuleClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.SecurityGroupRuleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SecurityGroupRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.SecurityGroupRuleClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// Security group rules have no identifier of their own, so
+	// they are looked up by their content in the security group they belong to.
+	response, err := e.client.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
+		GroupIds: []string{aws.StringValue(cr.Spec.ForProvider.SecurityGroupID)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsSecurityGroupNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.SecurityGroups) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	description, found := ec2.FindSecurityGroupRule(cr.Spec.ForProvider, response.SecurityGroups[0])
+	if !found {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsSecurityGroupRuleUpToDate(cr.Spec.ForProvider, description),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	perm, err := ec2.GenerateSecurityGroupRulePermission(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errPermission)
+	}
+
+	if cr.Spec.ForProvider.Type == v1alpha1.SecurityGroupRuleTypeEgress {
+		_, err = e.client.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: []awsec2.IpPermission{perm},
+		}).Send(ctx)
+	} else {
+		_, err = e.client.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: []awsec2.IpPermission{perm},
+		}).Send(ctx)
+	}
+	return managed.ExternalCreation{}, errors.Wrap(resource.Ignore(ec2.IsRuleAlreadyExistsErr, err), errAuthorize)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.SecurityGroupRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	perm, err := ec2.GenerateSecurityGroupRulePermission(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPermission)
+	}
+
+	if cr.Spec.ForProvider.Type == v1alpha1.SecurityGroupRuleTypeEgress {
+		_, err = e.client.UpdateSecurityGroupRuleDescriptionsEgressRequest(&awsec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: []awsec2.IpPermission{perm},
+		}).Send(ctx)
+	} else {
+		_, err = e.client.UpdateSecurityGroupRuleDescriptionsIngressRequest(&awsec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: []awsec2.IpPermission{perm},
+		}).Send(ctx)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.SecurityGroupRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	perm, err := ec2.GenerateSecurityGroupRulePermission(cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errPermission)
+	}
+
+	if cr.Spec.ForProvider.Type == v1alpha1.SecurityGroupRuleTypeEgress {
+		_, err = e.client.RevokeSecurityGroupEgressRequest(&awsec2.RevokeSecurityGroupEgressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: []awsec2.IpPermission{perm},
+		}).Send(ctx)
+	} else {
+		_, err = e.client.RevokeSecurityGroupIngressRequest(&awsec2.RevokeSecurityGroupIngressInput{
+			GroupId:       cr.Spec.ForProvider.SecurityGroupID,
+			IpPermissions: []awsec2.IpPermission{perm},
+		}).Send(ctx)
+	}
+	if ec2.IsSecurityGroupNotFoundErr(err) {
+		return nil
+	}
+	return errors.Wrap(resource.Ignore(ec2.IsRuleNotFoundErr, err), errRevoke)
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	sgID              = "sg-123"
+	port443     int64 = 443
+	cidr              = "10.0.0.0/16"
+	tcpProtocol       = "tcp"
+	description       = "https"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.SecurityGroupRuleClient
+	cr     *v1alpha1.SecurityGroupRule
+}
+
+type ruleModifier func(*v1alpha1.SecurityGroupRule)
+
+func withType(t string) ruleModifier {
+	return func(r *v1alpha1.SecurityGroupRule) { r.Spec.ForProvider.Type = t }
+}
+
+func withDescription(d string) ruleModifier {
+	return func(r *v1alpha1.SecurityGroupRule) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withSourceSecurityGroupID(id string) ruleModifier {
+	return func(r *v1alpha1.SecurityGroupRule) { r.Spec.ForProvider.SourceSecurityGroupID = aws.String(id) }
+}
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *v1alpha1.SecurityGroupRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.SecurityGroupRule {
+	cr := &v1alpha1.SecurityGroupRule{
+		Spec: v1alpha1.SecurityGroupRuleSpec{
+			ForProvider: v1alpha1.SecurityGroupRuleParameters{
+				Type:            v1alpha1.SecurityGroupRuleTypeIngress,
+				SecurityGroupID: aws.String(sgID),
+				IPProtocol:      tcpProtocol,
+				FromPort:        aws.Int64(port443),
+				ToPort:          aws.Int64(port443),
+				CIDRBlock:       aws.String(cidr),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func permissions(desc *string) []awsec2.IpPermission {
+	return []awsec2.IpPermission{{
+		FromPort:   aws.Int64(port443),
+		ToPort:     aws.Int64(port443),
+		IpProtocol: aws.String(tcpProtocol),
+		IpRanges:   []awsec2.IpRange{{CidrIp: aws.String(cidr), Description: desc}},
+	}}
+}
+
+func describe(sg awsec2.SecurityGroup, err error) func(*awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+	return func(*awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []awsec2.SecurityGroup{sg},
+			}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SecurityGroupRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: describe(awsec2.SecurityGroup{IpPermissions: permissions(nil)}, nil),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: describe(awsec2.SecurityGroup{IpPermissions: permissions(nil)}, nil),
+				},
+				cr: rule(withDescription(description)),
+			},
+			want: want{
+				cr: rule(withDescription(description), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFoundInDirection": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: describe(awsec2.SecurityGroup{IpPermissions: permissions(nil)}, nil),
+				},
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress)),
+			},
+			want: want{
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress)),
+			},
+		},
+		"GroupNotFound": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: describe(awsec2.SecurityGroup{}, awserr.New(ec2.InvalidGroupNotFound, "", nil)),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: describe(awsec2.SecurityGroup{}, errBoom),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SecurityGroupRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulIngress": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
+						return awsec2.AuthorizeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AuthorizeSecurityGroupIngressOutput{}},
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Creating())),
+			},
+		},
+		"AlreadyExistsEgress": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeEgress: func(input *awsec2.AuthorizeSecurityGroupEgressInput) awsec2.AuthorizeSecurityGroupEgressRequest {
+						return awsec2.AuthorizeSecurityGroupEgressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.InvalidPermissionDuplicate, "", nil)},
+						}
+					},
+				},
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress)),
+			},
+			want: want{
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress), withConditions(xpv1.Creating())),
+			},
+		},
+		"MultipleSources": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{},
+				cr:     rule(withSourceSecurityGroupID(sgID)),
+			},
+			want: want{
+				cr:  rule(withSourceSecurityGroupID(sgID), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New("exactly one of cidrBlock, ipv6CidrBlock, prefixListId or sourceSecurityGroupId must be set"), errPermission),
+			},
+		},
+		"AuthorizeFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
+						return awsec2.AuthorizeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errAuthorize),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SecurityGroupRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockUpdateIngressDescription: func(input *awsec2.UpdateSecurityGroupRuleDescriptionsIngressInput) awsec2.UpdateSecurityGroupRuleDescriptionsIngressRequest {
+						if diff := cmp.Diff(permissions(aws.String(description)), input.IpPermissions); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsec2.UpdateSecurityGroupRuleDescriptionsIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.UpdateSecurityGroupRuleDescriptionsIngressOutput{}},
+						}
+					},
+				},
+				cr: rule(withDescription(description)),
+			},
+			want: want{
+				cr: rule(withDescription(description)),
+			},
+		},
+		"UpdateFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockUpdateEgressDescription: func(input *awsec2.UpdateSecurityGroupRuleDescriptionsEgressInput) awsec2.UpdateSecurityGroupRuleDescriptionsEgressRequest {
+						return awsec2.UpdateSecurityGroupRuleDescriptionsEgressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress)),
+			},
+			want: want{
+				cr:  rule(withType(v1alpha1.SecurityGroupRuleTypeEgress)),
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SecurityGroupRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+						return awsec2.RevokeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.RevokeSecurityGroupIngressOutput{}},
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyRevoked": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockRevokeEgress: func(input *awsec2.RevokeSecurityGroupEgressInput) awsec2.RevokeSecurityGroupEgressRequest {
+						return awsec2.RevokeSecurityGroupEgressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.InvalidPermissionNotFound, "", nil)},
+						}
+					},
+				},
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress)),
+			},
+			want: want{
+				cr: rule(withType(v1alpha1.SecurityGroupRuleTypeEgress), withConditions(xpv1.Deleting())),
+			},
+		},
+		"RevokeFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+						return awsec2.RevokeSecurityGroupIngressRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errRevoke),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
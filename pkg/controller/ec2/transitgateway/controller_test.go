@@ -0,0 +1,374 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgateway
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	tgID    = "tgw-123"
+	tgARN   = "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-123"
+	asn     = int64(64512)
+	enable  = "enable"
+	errBoom = errors.New("boom")
+)
+
+type tgModifier func(*v1alpha1.TransitGateway)
+
+func withExternalName(name string) tgModifier {
+	return func(r *v1alpha1.TransitGateway) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) tgModifier {
+	return func(r *v1alpha1.TransitGateway) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TransitGatewayParameters) tgModifier {
+	return func(r *v1alpha1.TransitGateway) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TransitGatewayObservation) tgModifier {
+	return func(r *v1alpha1.TransitGateway) { r.Status.AtProvider = s }
+}
+
+func tg(m ...tgModifier) *v1alpha1.TransitGateway {
+	cr := &v1alpha1.TransitGateway{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func lateInitSpec(tags []v1beta1.Tag) v1alpha1.TransitGatewayParameters {
+	return v1alpha1.TransitGatewayParameters{
+		AmazonSideASN: &asn,
+		DNSSupport:    &enable,
+		Tags:          tags,
+	}
+}
+
+func describe(state awsec2.TransitGatewayState, tags []awsec2.Tag) func(*awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+	return func(*awsec2.DescribeTransitGatewaysInput) awsec2.DescribeTransitGatewaysRequest {
+		return awsec2.DescribeTransitGatewaysRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeTransitGatewaysOutput{
+				TransitGateways: []awsec2.TransitGateway{{
+					Options: &awsec2.TransitGatewayOptions{
+						AmazonSideAsn: &asn,
+						DnsSupport:    awsec2.DnsSupportValueEnable,
+					},
+					State:             state,
+					Tags:              tags,
+					TransitGatewayArn: aws.String(tgARN),
+					TransitGatewayId:  aws.String(tgID),
+				}},
+			}},
+		}
+	}
+}
+
+func observation(state string) v1alpha1.TransitGatewayObservation {
+	return v1alpha1.TransitGatewayObservation{
+		State:             state,
+		TransitGatewayARN: tgARN,
+		TransitGatewayID:  tgID,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayClient
+	cr     *v1alpha1.TransitGateway
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGateway
+		result managed.ExternalObservation
+		err    error
+	}
+
+	tags := []v1beta1.Tag{{Key: "k", Value: "v"}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGatewayStateAvailable, nil),
+				},
+				cr: tg(withExternalName(tgID)),
+			},
+			want: want{
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec(nil)),
+					withStatus(observation(v1alpha1.TransitGatewayStateAvailable)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGatewayStatePending, nil),
+				},
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec(tags))),
+			},
+			want: want{
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec(tags)),
+					withStatus(observation(v1alpha1.TransitGatewayStatePending)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGatewayStateDeleted, nil),
+				},
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec(nil))),
+			},
+			want: want{
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec(nil)),
+					withStatus(observation(v1alpha1.TransitGatewayStateDeleted))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGateway
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockCreate: func(input *awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayOutput{
+								TransitGateway: &awsec2.TransitGateway{TransitGatewayId: aws.String(tgID)},
+							}},
+						}
+					},
+				},
+				cr: tg(),
+			},
+			want: want{
+				cr:     tg(withExternalName(tgID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockCreate: func(input *awsec2.CreateTransitGatewayInput) awsec2.CreateTransitGatewayRequest {
+						return awsec2.CreateTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(),
+			},
+			want: want{
+				cr:  tg(),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceTags": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGatewayStateAvailable, []awsec2.Tag{{Key: aws.String("old"), Value: aws.String("v")}}),
+					MockDeleteTags: func(input *awsec2.DeleteTagsInput) awsec2.DeleteTagsRequest {
+						return awsec2.DeleteTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTagsOutput{}},
+						}
+					},
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec([]v1beta1.Tag{{Key: "new", Value: "v"}}))),
+			},
+		},
+		"CreateTagsFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribe: describe(awsec2.TransitGatewayStateAvailable, nil),
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgID), withSpec(lateInitSpec([]v1beta1.Tag{{Key: "new", Value: "v"}}))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TransitGateway
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDelete: func(input *awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTransitGatewayOutput{}},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgID)),
+			},
+			want: want{
+				cr: tg(withExternalName(tgID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDelete: func(input *awsec2.DeleteTransitGatewayInput) awsec2.DeleteTransitGatewayRequest {
+						return awsec2.DeleteTransitGatewayRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgID)),
+			},
+			want: want{
+				cr:  tg(withExternalName(tgID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,421 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	attachmentID = "tgw-attach-123"
+	tgID         = "tgw-123"
+	vpcID        = "vpc-123"
+	ownerID      = "123456789012"
+	subnetA      = "subnet-a"
+	subnetB      = "subnet-b"
+	enable       = "enable"
+	disable      = "disable"
+	errBoom      = errors.New("boom")
+)
+
+type attachmentModifier func(*v1alpha1.TransitGatewayVPCAttachment)
+
+func withExternalName(name string) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TransitGatewayVPCAttachmentParameters) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TransitGatewayVPCAttachmentObservation) attachmentModifier {
+	return func(r *v1alpha1.TransitGatewayVPCAttachment) { r.Status.AtProvider = s }
+}
+
+func attachment(m ...attachmentModifier) *v1alpha1.TransitGatewayVPCAttachment {
+	cr := &v1alpha1.TransitGatewayVPCAttachment{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(subnets ...string) v1alpha1.TransitGatewayVPCAttachmentParameters {
+	return v1alpha1.TransitGatewayVPCAttachmentParameters{
+		TransitGatewayID: &tgID,
+		VPCID:            &vpcID,
+		SubnetIDs:        subnets,
+		DNSSupport:       &enable,
+		IPv6Support:      &disable,
+	}
+}
+
+func observation(state string) v1alpha1.TransitGatewayVPCAttachmentObservation {
+	return v1alpha1.TransitGatewayVPCAttachmentObservation{
+		State:                      state,
+		TransitGatewayAttachmentID: attachmentID,
+		VPCOwnerID:                 ownerID,
+	}
+}
+
+func describe(state awsec2.TransitGatewayAttachmentState, subnets ...string) func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+	return func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+		return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeTransitGatewayVpcAttachmentsOutput{
+				TransitGatewayVpcAttachments: []awsec2.TransitGatewayVpcAttachment{{
+					Options: &awsec2.TransitGatewayVpcAttachmentOptions{
+						DnsSupport:  awsec2.DnsSupportValueEnable,
+						Ipv6Support: awsec2.Ipv6SupportValueDisable,
+					},
+					State:                      state,
+					SubnetIds:                  subnets,
+					TransitGatewayAttachmentId: aws.String(attachmentID),
+					TransitGatewayId:           aws.String(tgID),
+					VpcId:                      aws.String(vpcID),
+					VpcOwnerId:                 aws.String(ownerID),
+				}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.TransitGatewayVPCAttachmentClient
+	cr     *v1alpha1.TransitGatewayVPCAttachment
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayVPCAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(awsec2.TransitGatewayAttachmentStateAvailable, subnetA),
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(v1alpha1.TransitGatewayVPCAttachmentParameters{
+					TransitGatewayID: &tgID,
+					VPCID:            &vpcID,
+					SubnetIDs:        []string{subnetA},
+				})),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(spec(subnetA)),
+					withStatus(observation(v1alpha1.TransitGatewayAttachmentStateAvailable)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"SubnetsChanged": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(awsec2.TransitGatewayAttachmentStatePending, subnetA),
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(spec(subnetA, subnetB))),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withSpec(spec(subnetA, subnetB)),
+					withStatus(observation(v1alpha1.TransitGatewayAttachmentStatePending)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.TransitGatewayAttachmentNotFound, "", nil)},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: func(*awsec2.DescribeTransitGatewayVpcAttachmentsInput) awsec2.DescribeTransitGatewayVpcAttachmentsRequest {
+						return awsec2.DescribeTransitGatewayVpcAttachmentsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TransitGatewayVPCAttachment
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockCreate: func(input *awsec2.CreateTransitGatewayVpcAttachmentInput) awsec2.CreateTransitGatewayVpcAttachmentRequest {
+						return awsec2.CreateTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTransitGatewayVpcAttachmentOutput{
+								TransitGatewayVpcAttachment: &awsec2.TransitGatewayVpcAttachment{TransitGatewayAttachmentId: aws.String(attachmentID)},
+							}},
+						}
+					},
+				},
+				cr: attachment(withSpec(spec(subnetA))),
+			},
+			want: want{
+				cr:     attachment(withExternalName(attachmentID), withSpec(spec(subnetA))),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockCreate: func(input *awsec2.CreateTransitGatewayVpcAttachmentInput) awsec2.CreateTransitGatewayVpcAttachmentRequest {
+						return awsec2.CreateTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withSpec(spec(subnetA))),
+			},
+			want: want{
+				cr:  attachment(withSpec(spec(subnetA))),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AddSubnet": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(awsec2.TransitGatewayAttachmentStateAvailable, subnetA),
+					MockModify: func(input *awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+						if diff := cmp.Diff([]string{subnetB}, input.AddSubnetIds); diff != "" {
+							t.Errorf("AddSubnetIds: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyTransitGatewayVpcAttachmentOutput{}},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(spec(subnetA, subnetB))),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(awsec2.TransitGatewayAttachmentStateAvailable, subnetA),
+					MockModify: func(input *awsec2.ModifyTransitGatewayVpcAttachmentInput) awsec2.ModifyTransitGatewayVpcAttachmentRequest {
+						return awsec2.ModifyTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(spec(subnetB))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDescribe: describe(awsec2.TransitGatewayAttachmentStateAvailable, subnetA),
+				},
+				cr: attachment(withExternalName(attachmentID), withSpec(spec(subnetA))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TransitGatewayVPCAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDelete: func(input *awsec2.DeleteTransitGatewayVpcAttachmentInput) awsec2.DeleteTransitGatewayVpcAttachmentRequest {
+						return awsec2.DeleteTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteTransitGatewayVpcAttachmentOutput{}},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{},
+				cr: attachment(withExternalName(attachmentID),
+					withStatus(v1alpha1.TransitGatewayVPCAttachmentObservation{State: v1alpha1.TransitGatewayAttachmentStateDeleting})),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID),
+					withStatus(v1alpha1.TransitGatewayVPCAttachmentObservation{State: v1alpha1.TransitGatewayAttachmentStateDeleting}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockTransitGatewayVPCAttachmentClient{
+					MockDelete: func(input *awsec2.DeleteTransitGatewayVpcAttachmentInput) awsec2.DeleteTransitGatewayVpcAttachmentRequest {
+						return awsec2.DeleteTransitGatewayVpcAttachmentRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCEndpoint resource"
+	errDescribe         = "failed to describe VPCEndpoint"
+	errNotSingleItem    = "either no or multiple VPCEndpoints retrieved for the given vpcEndpointId"
+	errCreate           = "failed to create the VPCEndpoint resource"
+	errModify           = "failed to modify the VPCEndpoint resource"
+	errDelete           = "failed to delete the VPCEndpoint resource"
+	errUpToDate         = "cannot check whether the VPCEndpoint is up to date"
+	errUpdateTags       = "failed to update tags for the VPCEndpoint resource"
+	errDeleteTags       = "failed to delete tags for VPCEndpoint resource"
+)
+
+// SetupVPCEndpoint adds a controller that reconciles VPCEndpoints.
+func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha4.VPCEndpointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha4.VPCEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha4.VPCEndpointGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCEndpointClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPCEndpointClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.VPCEndpointClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (awsec2.VpcEndpoint, error) {
+	response, err := e.client.DescribeVpcEndpointsRequest(&awsec2.DescribeVpcEndpointsInput{
+		VpcEndpointIds: []string{id},
+	}).Send(ctx)
+	if err != nil {
+		return awsec2.VpcEndpoint{}, err
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcEndpoints) != 1 {
+		return awsec2.VpcEndpoint{}, errors.New(errNotSingleItem)
+	}
+	return response.VpcEndpoints[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPCEndpointNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPCEndpoint(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = ec2.GenerateVPCEndpointObservation(observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha4.VPCEndpointStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha4.VPCEndpointStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case v1alpha4.VPCEndpointStateDeleted:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	case v1alpha4.VPCEndpointStateRejected,
+		v1alpha4.VPCEndpointStateFailed,
+		v1alpha4.VPCEndpointStateExpired:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.LastErrorMessage))
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, err := ec2.IsVPCEndpointUpToDate(cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	ve, err := e.client.CreateVpcEndpointRequest(ec2.GenerateCreateVPCEndpointInput(cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(ve.VpcEndpoint.VpcEndpointId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if _, err := e.client.ModifyVpcEndpointRequest(ec2.GenerateModifyVPCEndpointInput(meta.GetExternalName(cr), cr.Spec.ForProvider, observed)).Send(ctx); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errModify)
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha4.VPCEndpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha4.VPCEndpointStateDeleted ||
+		cr.Status.AtProvider.State == v1alpha4.VPCEndpointStateDeleting {
+		return nil
+	}
+
+	rsp, err := e.client.DeleteVpcEndpointsRequest(&awsec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(ec2.IsVPCEndpointNotFoundErr, err), errDelete)
+	}
+	// DeleteVpcEndpoints reports failures per endpoint instead of returning
+	// an error.
+	for _, u := range rsp.Unsuccessful {
+		if u.Error == nil || aws.StringValue(u.Error.Code) == ec2.VPCEndpointNotFound {
+			continue
+		}
+		return errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errDelete)
+	}
+	return nil
+}
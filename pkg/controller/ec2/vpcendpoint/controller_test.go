@@ -0,0 +1,438 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha4"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	endpointID   = "vpce-123"
+	vpcID        = "vpc-123"
+	ownerID      = "123456789012"
+	serviceName  = "com.amazonaws.us-east-1.s3"
+	routeTableA  = "rtb-a"
+	routeTableB  = "rtb-b"
+	gateway      = v1alpha4.VPCEndpointTypeGateway
+	privateDNS   = false
+	errorMessage = "access denied"
+	errBoom      = errors.New("boom")
+)
+
+type endpointModifier func(*v1alpha4.VPCEndpoint)
+
+func withExternalName(name string) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha4.VPCEndpointParameters) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha4.VPCEndpointObservation) endpointModifier {
+	return func(r *v1alpha4.VPCEndpoint) { r.Status.AtProvider = s }
+}
+
+func endpoint(m ...endpointModifier) *v1alpha4.VPCEndpoint {
+	cr := &v1alpha4.VPCEndpoint{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(routeTables ...string) v1alpha4.VPCEndpointParameters {
+	return v1alpha4.VPCEndpointParameters{
+		VPCID:             &vpcID,
+		ServiceName:       serviceName,
+		VPCEndpointType:   &gateway,
+		PrivateDNSEnabled: &privateDNS,
+		RouteTableIDs:     routeTables,
+	}
+}
+
+func observation(state string) v1alpha4.VPCEndpointObservation {
+	return v1alpha4.VPCEndpointObservation{
+		OwnerID:       ownerID,
+		State:         state,
+		VPCEndpointID: endpointID,
+	}
+}
+
+func describe(state awsec2.State, routeTables ...string) func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+	return func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcEndpointsOutput{
+				VpcEndpoints: []awsec2.VpcEndpoint{{
+					OwnerId:           aws.String(ownerID),
+					PrivateDnsEnabled: aws.Bool(privateDNS),
+					RouteTableIds:     routeTables,
+					ServiceName:       aws.String(serviceName),
+					State:             state,
+					VpcEndpointId:     aws.String(endpointID),
+					VpcEndpointType:   awsec2.VpcEndpointTypeGateway,
+					VpcId:             aws.String(vpcID),
+				}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.VPCEndpointClient
+	cr     *v1alpha4.VPCEndpoint
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.StateAvailable, routeTableA),
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(v1alpha4.VPCEndpointParameters{
+					VPCID:         &vpcID,
+					ServiceName:   serviceName,
+					RouteTableIDs: []string{routeTableA},
+				})),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableA)),
+					withStatus(observation(v1alpha4.VPCEndpointStateAvailable)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"RouteTablesChanged": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.StatePending, routeTableA),
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableB))),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableB)),
+					withStatus(observation(v1alpha4.VPCEndpointStatePending)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: func(in *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						req := describe(awsec2.StateFailed, routeTableA)(in)
+						req.Data.(*awsec2.DescribeVpcEndpointsOutput).VpcEndpoints[0].LastError = &awsec2.LastError{Message: aws.String(errorMessage)}
+						return req
+					},
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableA))),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableA)),
+					withStatus(v1alpha4.VPCEndpointObservation{
+						LastErrorMessage: errorMessage,
+						OwnerID:          ownerID,
+						State:            v1alpha4.VPCEndpointStateFailed,
+						VPCEndpointID:    endpointID,
+					}),
+					withConditions(xpv1.Unavailable().WithMessage(errorMessage))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: func(*awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+						return awsec2.DescribeVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(ec2.VPCEndpointNotFound, "", nil)},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha4.VPCEndpoint
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockCreate: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						return awsec2.CreateVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcEndpointOutput{
+								VpcEndpoint: &awsec2.VpcEndpoint{VpcEndpointId: aws.String(endpointID)},
+							}},
+						}
+					},
+				},
+				cr: endpoint(withSpec(spec(routeTableA))),
+			},
+			want: want{
+				cr:     endpoint(withExternalName(endpointID), withSpec(spec(routeTableA))),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockCreate: func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+						return awsec2.CreateVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withSpec(spec(routeTableA))),
+			},
+			want: want{
+				cr:  endpoint(withSpec(spec(routeTableA))),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplaceRouteTable": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.StateAvailable, routeTableA),
+					MockModify: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						if diff := cmp.Diff([]string{routeTableB}, input.AddRouteTableIds); diff != "" {
+							t.Errorf("AddRouteTableIds: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff([]string{routeTableA}, input.RemoveRouteTableIds); diff != "" {
+							t.Errorf("RemoveRouteTableIds: -want, +got:\n%s", diff)
+						}
+						return awsec2.ModifyVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.ModifyVpcEndpointOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableB))),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDescribe: describe(awsec2.StateAvailable, routeTableA),
+					MockModify: func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+						return awsec2.ModifyVpcEndpointRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID), withSpec(spec(routeTableB))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha4.VPCEndpoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDelete: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointsOutput{}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDelete: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointsOutput{
+								Unsuccessful: []awsec2.UnsuccessfulItem{{
+									Error:      &awsec2.UnsuccessfulItemError{Code: aws.String(ec2.VPCEndpointNotFound)},
+									ResourceId: aws.String(endpointID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr: endpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"UnsuccessfulItem": {
+			args: args{
+				client: &fake.MockVPCEndpointClient{
+					MockDelete: func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+						return awsec2.DeleteVpcEndpointsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcEndpointsOutput{
+								Unsuccessful: []awsec2.UnsuccessfulItem{{
+									Error:      &awsec2.UnsuccessfulItemError{Code: aws.String("InternalError"), Message: aws.String(errBoom.Error())},
+									ResourceId: aws.String(endpointID),
+								}},
+							}},
+						}
+					},
+				},
+				cr: endpoint(withExternalName(endpointID)),
+			},
+			want: want{
+				cr:  endpoint(withExternalName(endpointID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
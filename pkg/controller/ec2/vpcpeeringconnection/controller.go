@@ -0,0 +1,266 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCPeeringConnection resource"
+	errDescribe         = "failed to describe VPCPeeringConnection"
+	errNotSingleItem    = "either no or multiple VPCPeeringConnections retrieved for the given vpcPeeringConnectionId"
+	errCreate           = "failed to create the VPCPeeringConnection resource"
+	errAccept           = "failed to accept the VPCPeeringConnection"
+	errAccepterConfig   = "cannot get the config of the accepter side"
+	errGetAccepterPC    = "cannot get the accepter ProviderConfig"
+	errDelete           = "failed to delete the VPCPeeringConnection resource"
+	errUpdateTags       = "failed to update tags for the VPCPeeringConnection resource"
+	errDeleteTags       = "failed to delete tags for VPCPeeringConnection resource"
+)
+
+// SetupVPCPeeringConnection adds a controller that reconciles
+// VPCPeeringConnections.
+func SetupVPCPeeringConnection(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.VPCPeeringConnectionGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.VPCPeeringConnection{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.VPCPeeringConnectionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCPeeringConnectionClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPCPeeringConnectionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, newClientFn: c.newClientFn}, nil
+}
+
+type external struct {
+	kube        client.Client
+	client      ec2.VPCPeeringConnectionClient
+	newClientFn func(config aws.Config) ec2.VPCPeeringConnectionClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	response, err := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcPeeringConnections) != 1 {
+		return managed.ExternalObservation{}, errors.New(errNotSingleItem)
+	}
+
+	observed := response.VpcPeeringConnections[0]
+
+	cr.Status.AtProvider = ec2.GenerateVPCPeeringConnectionObservation(observed)
+
+	switch cr.Status.AtProvider.StatusCode {
+	case v1alpha1.VPCPeeringConnectionStatusInitiatingRequest,
+		v1alpha1.VPCPeeringConnectionStatusPendingAcceptance,
+		v1alpha1.VPCPeeringConnectionStatusProvisioning:
+		cr.SetConditions(xpv1.Unavailable())
+	case v1alpha1.VPCPeeringConnectionStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.VPCPeeringConnectionStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case v1alpha1.VPCPeeringConnectionStatusRejected,
+		v1alpha1.VPCPeeringConnectionStatusFailed,
+		v1alpha1.VPCPeeringConnectionStatusExpired:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.StatusMessage))
+	case v1alpha1.VPCPeeringConnectionStatusDeleted:
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: v1beta1.CompareTags(cr.Spec.ForProvider.Tags, observed.Tags) &&
+			!ec2.NeedsAcceptance(cr.Spec.ForProvider, cr.Status.AtProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	pc, err := e.client.CreateVpcPeeringConnectionRequest(&awsec2.CreateVpcPeeringConnectionInput{
+		VpcId:       cr.Spec.ForProvider.VPCID,
+		PeerVpcId:   cr.Spec.ForProvider.PeerVPCID,
+		PeerOwnerId: cr.Spec.ForProvider.PeerOwnerID,
+		PeerRegion:  cr.Spec.ForProvider.PeerRegion,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(pc.VpcPeeringConnection.VpcPeeringConnectionId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if ec2.NeedsAcceptance(cr.Spec.ForProvider, cr.Status.AtProvider) {
+		accepter, err := e.accepterClient(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAccepterConfig)
+		}
+		if _, err := accepter.AcceptVpcPeeringConnectionRequest(&awsec2.AcceptVpcPeeringConnectionInput{
+			VpcPeeringConnectionId: aws.String(meta.GetExternalName(cr)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAccept)
+		}
+	}
+
+	response, err := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcPeeringConnections) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errNotSingleItem)
+	}
+
+	addTags, removeTags := awscommon.DiffEC2Tags(v1beta1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), response.VpcPeeringConnections[0].Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTagsRequest(&awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.StatusCode == v1alpha1.VPCPeeringConnectionStatusDeleted ||
+		cr.Status.AtProvider.StatusCode == v1alpha1.VPCPeeringConnectionStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteVpcPeeringConnectionRequest(&awsec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDelete)
+}
+
+// accepterClient returns a client for the account and region of the accepter
+// VPC. The requester client is reused when both sides are the same.
+func (e *external) accepterClient(ctx context.Context, cr *v1alpha1.VPCPeeringConnection) (ec2.VPCPeeringConnectionClient, error) {
+	region := cr.Spec.ForProvider.Region
+	if cr.Spec.ForProvider.PeerRegion != nil {
+		region = aws.StringValue(cr.Spec.ForProvider.PeerRegion)
+	}
+	ref := cr.Spec.ForProvider.AccepterProviderConfigRef
+	switch {
+	case ref != nil:
+		pc := &awsv1beta1.ProviderConfig{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+			return nil, errors.Wrap(err, errGetAccepterPC)
+		}
+		cfg, _, err := awscommon.GetProviderConfigConfig(ctx, e.kube, pc, region)
+		if err != nil {
+			return nil, err
+		}
+		return e.newClientFn(*cfg), nil
+	case region != cr.Spec.ForProvider.Region:
+		cfg, err := awscommon.GetConfig(ctx, e.kube, cr, region)
+		if err != nil {
+			return nil, err
+		}
+		return e.newClientFn(*cfg), nil
+	}
+	return e.client, nil
+}
@@ -0,0 +1,450 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	peeringID = "pcx-123"
+	vpcID     = "vpc-123"
+	peerVPCID = "vpc-456"
+	errBoom   = errors.New("boom")
+)
+
+type peeringModifier func(*v1alpha1.VPCPeeringConnection)
+
+func withExternalName(name string) peeringModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) peeringModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.VPCPeeringConnectionParameters) peeringModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.VPCPeeringConnectionObservation) peeringModifier {
+	return func(r *v1alpha1.VPCPeeringConnection) { r.Status.AtProvider = s }
+}
+
+func peering(m ...peeringModifier) *v1alpha1.VPCPeeringConnection {
+	cr := &v1alpha1.VPCPeeringConnection{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(accept bool) v1alpha1.VPCPeeringConnectionParameters {
+	return v1alpha1.VPCPeeringConnectionParameters{
+		VPCID:         aws.String(vpcID),
+		PeerVPCID:     aws.String(peerVPCID),
+		AcceptRequest: aws.Bool(accept),
+	}
+}
+
+func observation(code string) v1alpha1.VPCPeeringConnectionObservation {
+	return v1alpha1.VPCPeeringConnectionObservation{
+		AccepterVPCInfo:        v1alpha1.VPCPeeringConnectionVPCInfo{VPCID: peerVPCID},
+		RequesterVPCInfo:       v1alpha1.VPCPeeringConnectionVPCInfo{VPCID: vpcID},
+		StatusCode:             code,
+		VPCPeeringConnectionID: peeringID,
+	}
+}
+
+func describe(code awsec2.VpcPeeringConnectionStateReasonCode, tags []awsec2.Tag, err error) func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+	return func(*awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+				VpcPeeringConnections: []awsec2.VpcPeeringConnection{{
+					AccepterVpcInfo:        &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(peerVPCID)},
+					RequesterVpcInfo:       &awsec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String(vpcID)},
+					Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: code},
+					Tags:                   tags,
+					VpcPeeringConnectionId: aws.String(peeringID),
+				}},
+			}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client ec2.VPCPeeringConnectionClient
+	cr     *v1alpha1.VPCPeeringConnection
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCPeeringConnection
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ExternalNameEmpty": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     peering(),
+			},
+			want: want{
+				cr: peering(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe("", nil, awserr.New(ec2.VPCPeeringConnectionNotFound, "", nil)),
+				},
+				cr: peering(withExternalName(peeringID)),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID)),
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil),
+				},
+				cr: peering(withExternalName(peeringID), withSpec(spec(true))),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID), withSpec(spec(true)),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusPendingAcceptance)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"PendingAcceptanceByOthers": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil),
+				},
+				cr: peering(withExternalName(peeringID), withSpec(spec(false))),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID), withSpec(spec(false)),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusPendingAcceptance)),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Active": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodeActive, nil, nil),
+				},
+				cr: peering(withExternalName(peeringID), withSpec(spec(true))),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID), withSpec(spec(true)),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusActive)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodeDeleted, nil, nil),
+				},
+				cr: peering(withExternalName(peeringID)),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusDeleted))),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe("", nil, errBoom),
+				},
+				cr: peering(withExternalName(peeringID)),
+			},
+			want: want{
+				cr:  peering(withExternalName(peeringID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.VPCPeeringConnection
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockCreate: func(input *awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateVpcPeeringConnectionOutput{
+								VpcPeeringConnection: &awsec2.VpcPeeringConnection{VpcPeeringConnectionId: aws.String(peeringID)},
+							}},
+						}
+					},
+				},
+				cr: peering(withSpec(spec(true))),
+			},
+			want: want{
+				cr:     peering(withSpec(spec(true)), withExternalName(peeringID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockCreate: func(input *awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+						return awsec2.CreateVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: peering(withSpec(spec(true))),
+			},
+			want: want{
+				cr:  peering(withSpec(spec(true))),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		accepted bool
+		err      error
+	}
+
+	tags := []v1beta1.Tag{{Key: "k", Value: "v"}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AcceptAndTag": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil),
+					MockCreateTags: func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+						return awsec2.CreateTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.CreateTagsOutput{}},
+						}
+					},
+				},
+				cr: peering(withExternalName(peeringID),
+					withSpec(func() v1alpha1.VPCPeeringConnectionParameters { p := spec(true); p.Tags = tags; return p }()),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusPendingAcceptance))),
+			},
+			want: want{
+				accepted: true,
+			},
+		},
+		"NoAcceptNeeded": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDescribe: describe(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil),
+				},
+				cr: peering(withExternalName(peeringID), withSpec(spec(false)),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusPendingAcceptance))),
+			},
+		},
+		"AcceptFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr: peering(withExternalName(peeringID), withSpec(spec(true)),
+					withStatus(observation(v1alpha1.VPCPeeringConnectionStatusPendingAcceptance))),
+			},
+			want: want{
+				accepted: true,
+				err:      errors.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			accepted := false
+			c := tc.client.(*fake.MockVPCPeeringConnectionClient)
+			c.MockAccept = func(input *awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+				accepted = true
+				var err error
+				if tc.want.err != nil {
+					err = errBoom
+				}
+				return awsec2.AcceptVpcPeeringConnectionRequest{
+					Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.AcceptVpcPeeringConnectionOutput{}, Error: err},
+				}
+			}
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.accepted, accepted); diff != "" {
+				t.Errorf("accepted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.VPCPeeringConnection
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(input *awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsec2.DeleteVpcPeeringConnectionOutput{}},
+						}
+					},
+				},
+				cr: peering(withExternalName(peeringID)),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{},
+				cr:     peering(withExternalName(peeringID), withStatus(observation(v1alpha1.VPCPeeringConnectionStatusDeleting))),
+			},
+			want: want{
+				cr: peering(withExternalName(peeringID), withStatus(observation(v1alpha1.VPCPeeringConnectionStatusDeleting)),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionClient{
+					MockDelete: func(input *awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+						return awsec2.DeleteVpcPeeringConnectionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: peering(withExternalName(peeringID)),
+			},
+			want: want{
+				cr:  peering(withExternalName(peeringID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,560 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	version      = "v1.7.5-eksbuild.1"
+	otherVersion = "v1.7.0-eksbuild.1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	eks  eks.AddonClient
+	kube client.Client
+	cr   *v1alpha1.Addon
+}
+
+type addonModifier func(*v1alpha1.Addon)
+
+func withConditions(c ...xpv1.Condition) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tagMaps ...map[string]string) addonModifier {
+	tags := map[string]string{}
+	for _, tagMap := range tagMaps {
+		for k, v := range tagMap {
+			tags[k] = v
+		}
+	}
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withVersion(v *string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Spec.ForProvider.AddonVersion = v }
+}
+
+func withStatus(s v1alpha1.AddonStatusType) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.Status = s }
+}
+
+func withObservedVersion(v string) addonModifier {
+	return func(r *v1alpha1.Addon) { r.Status.AtProvider.AddonVersion = v }
+}
+
+func addon(m ...addonModifier) *v1alpha1.Addon {
+	cr := &v1alpha1.Addon{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.DescribeAddonOutput{
+								Addon: &eks.Addon{
+									AddonVersion: &version,
+									Status:       aws.String(string(v1alpha1.AddonStatusActive)),
+								},
+							}},
+						}
+					},
+				},
+				cr: addon(withVersion(&version)),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Available()),
+					withObservedVersion(version),
+					withStatus(v1alpha1.AddonStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.DescribeAddonOutput{
+								Addon: &eks.Addon{
+									AddonVersion: &otherVersion,
+									Status:       aws.String(string(v1alpha1.AddonStatusActive)),
+								},
+							}},
+						}
+					},
+				},
+				cr: addon(withVersion(&version)),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Available()),
+					withObservedVersion(otherVersion),
+					withStatus(v1alpha1.AddonStatusActive)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"DegradedState": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.DescribeAddonOutput{
+								Addon: &eks.Addon{
+									AddonVersion: &version,
+									Status:       aws.String(string(v1alpha1.AddonStatusDegraded)),
+								},
+							}},
+						}
+					},
+				},
+				cr: addon(withVersion(&version)),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Unavailable()),
+					withObservedVersion(version),
+					withStatus(v1alpha1.AddonStatusDegraded)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(),
+				err: errors.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awseks.ErrCodeResourceNotFoundException)},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(),
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.DescribeAddonOutput{
+								Addon: &eks.Addon{
+									AddonVersion: &version,
+									Status:       aws.String(string(v1alpha1.AddonStatusCreating)),
+								},
+							}},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(
+					withVersion(&version),
+					withConditions(xpv1.Creating()),
+					withObservedVersion(version),
+					withStatus(v1alpha1.AddonStatusCreating)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitFailedKubeUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				eks: &fake.MockAddonClient{
+					MockDescribeAddonRequest: func(_ *eks.DescribeAddonInput) eks.DescribeAddonRequest {
+						return eks.DescribeAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.DescribeAddonOutput{
+								Addon: &eks.Addon{
+									AddonVersion: &version,
+									Status:       aws.String(string(v1alpha1.AddonStatusCreating)),
+								},
+							}},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withVersion(&version)),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddonRequest: func(_ *eks.CreateAddonInput) eks.CreateAddonRequest {
+						return eks.CreateAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.CreateAddonOutput{}},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Creating())),
+			},
+		},
+		"SuccessfulNoNeedForCreate": {
+			args: args{
+				cr: addon(withStatus(v1alpha1.AddonStatusCreating)),
+			},
+			want: want{
+				cr: addon(
+					withStatus(v1alpha1.AddonStatusCreating),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockCreateAddonRequest: func(_ *eks.CreateAddonInput) eks.CreateAddonRequest {
+						return eks.CreateAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Addon
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockUpdateAddonRequest: func(_ *eks.UpdateAddonInput) eks.UpdateAddonRequest {
+						return eks.UpdateAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.UpdateAddonOutput{}},
+						}
+					},
+				},
+				cr: addon(withStatus(v1alpha1.AddonStatusActive)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusActive)),
+			},
+		},
+		"AlreadyUpdating": {
+			args: args{
+				cr: addon(withStatus(v1alpha1.AddonStatusUpdating)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusUpdating)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockUpdateAddonRequest: func(_ *eks.UpdateAddonInput) eks.UpdateAddonRequest {
+						return eks.UpdateAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: addon(withStatus(v1alpha1.AddonStatusDegraded)),
+			},
+			want: want{
+				cr:  addon(withStatus(v1alpha1.AddonStatusDegraded)),
+				err: errors.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddonRequest: func(_ *eks.DeleteAddonInput) eks.DeleteAddonRequest {
+						return eks.DeleteAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &eks.DeleteAddonOutput{}},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: addon(withStatus(v1alpha1.AddonStatusDeleting)),
+			},
+			want: want{
+				cr: addon(withStatus(v1alpha1.AddonStatusDeleting),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddonRequest: func(_ *eks.DeleteAddonInput) eks.DeleteAddonRequest {
+						return eks.DeleteAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errors.New(awseks.ErrCodeResourceNotFoundException)},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr: addon(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eks: &fake.MockAddonClient{
+					MockDeleteAddonRequest: func(_ *eks.DeleteAddonInput) eks.DeleteAddonRequest {
+						return eks.DeleteAddonRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: addon(),
+			},
+			want: want{
+				cr:  addon(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.eks}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Addon
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr:   addon(withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			},
+			want: want{
+				cr: addon(withTags(resource.GetExternalTags(addon()), map[string]string{"foo": "bar"})),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				cr:   addon(),
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &tagger{kube: tc.kube}
+			err := e.Initialize(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentitymapping
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+const (
+	errNotIAMIdentityMapping = "managed resource is not an EKS IAM identity mapping custom resource"
+
+	errDescribeCluster = "cannot describe EKS cluster"
+	errClusterNotReady = "EKS cluster is not ready to accept connections"
+	errClusterNotFound = "EKS cluster does not exist"
+	errNewKubeClient   = "cannot create Kubernetes client for EKS cluster"
+	errGetAWSAuth      = "cannot get aws-auth ConfigMap"
+	errCreateAWSAuth   = "cannot create aws-auth ConfigMap"
+	errUpdateAWSAuth   = "cannot update aws-auth ConfigMap"
+	errNoARN           = "exactly one of roleArn and userArn must be set"
+)
+
+// SetupIAMIdentityMapping adds a controller that reconciles
+// IAMIdentityMappings.
+func SetupIAMIdentityMapping(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.IAMIdentityMappingKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.IAMIdentityMapping{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.IAMIdentityMappingGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube:            mgr.GetClient(),
+				newEKSClientFn:  eks.NewEKSClient,
+				newSTSClientFn:  eks.NewSTSClient,
+				newKubeClientFn: eks.NewKubeClient,
+			}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube            client.Client
+	newEKSClientFn  func(config aws.Config) eks.Client
+	newSTSClientFn  func(config aws.Config) eks.STSClient
+	newKubeClientFn func(kubeconfig []byte) (client.Client, error)
+}
+
+// Connect builds a client for the EKS cluster the mapping belongs to, using
+// the same kubeconfig that is published for the Cluster resource.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.IAMIdentityMapping)
+	if !ok {
+		return nil, errors.New(errNotIAMIdentityMapping)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := c.newEKSClientFn(*cfg).DescribeClusterRequest(&awseks.DescribeClusterInput{Name: &cr.Spec.ForProvider.ClusterName}).Send(ctx)
+	if eks.IsErrorNotFound(err) {
+		// The mapping cannot exist without its cluster.
+		return &external{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errDescribeCluster)
+	}
+	kubeconfig := eks.GetConnectionDetails(rsp.Cluster, c.newSTSClientFn(*cfg))[xpv1.ResourceCredentialsSecretKubeconfigKey]
+	if len(kubeconfig) == 0 {
+		return nil, errors.New(errClusterNotReady)
+	}
+	kc, err := c.newKubeClientFn(kubeconfig)
+	return &external{client: kc}, errors.Wrap(err, errNewKubeClient)
+}
+
+type external struct {
+	// client is a client of the EKS cluster. It is nil if the cluster does
+	// not exist.
+	client client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.IAMIdentityMapping)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIAMIdentityMapping)
+	}
+	if (cr.Spec.ForProvider.RoleARN == "") == (cr.Spec.ForProvider.UserARN == "") {
+		return managed.ExternalObservation{}, errors.New(errNoARN)
+	}
+	if e.client == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := e.client.Get(ctx, types.NamespacedName{Namespace: eks.AWSAuthNamespace, Name: eks.AWSAuthName}, cm); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.IgnoreNotFound(err), errGetAWSAuth)
+	}
+	m, err := eks.GetIdentityMapping(cm, &cr.Spec.ForProvider)
+	if err != nil || m == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: eks.IsIdentityMappingUpToDate(&cr.Spec.ForProvider, m),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.IAMIdentityMapping)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIAMIdentityMapping)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.upsert(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.IAMIdentityMapping)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIAMIdentityMapping)
+	}
+	return managed.ExternalUpdate{}, e.upsert(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IAMIdentityMapping)
+	if !ok {
+		return errors.New(errNotIAMIdentityMapping)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if e.client == nil {
+		return nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := e.client.Get(ctx, types.NamespacedName{Namespace: eks.AWSAuthNamespace, Name: eks.AWSAuthName}, cm); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetAWSAuth)
+	}
+	removed, err := eks.RemoveIdentityMapping(cm, &cr.Spec.ForProvider)
+	if err != nil || !removed {
+		return err
+	}
+	return errors.Wrap(e.client.Update(ctx, cm), errUpdateAWSAuth)
+}
+
+// upsert writes the mapping to the aws-auth ConfigMap, creating the ConfigMap
+// if the cluster does not have one yet.
+func (e *external) upsert(ctx context.Context, cr *v1alpha1.IAMIdentityMapping) error {
+	if e.client == nil {
+		return errors.New(errClusterNotFound)
+	}
+	cm := &corev1.ConfigMap{}
+	err := e.client.Get(ctx, types.NamespacedName{Namespace: eks.AWSAuthNamespace, Name: eks.AWSAuthName}, cm)
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetAWSAuth)
+	}
+	if kerrors.IsNotFound(err) {
+		cm = eks.GenerateAWSAuthConfigMap()
+		if err := eks.UpsertIdentityMapping(cm, &cr.Spec.ForProvider); err != nil {
+			return err
+		}
+		return errors.Wrap(e.client.Create(ctx, cm), errCreateAWSAuth)
+	}
+	if err := eks.UpsertIdentityMapping(cm, &cr.Spec.ForProvider); err != nil {
+		return err
+	}
+	return errors.Wrap(e.client.Update(ctx, cm), errUpdateAWSAuth)
+}
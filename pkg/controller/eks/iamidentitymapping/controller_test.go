@@ -0,0 +1,384 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamidentitymapping
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+)
+
+var (
+	roleARN = "arn:aws:iam::123456789012:role/admin"
+	userARN = "arn:aws:iam::123456789012:user/alice"
+
+	adminMapping = "- groups:\n  - system:masters\n  rolearn: " + roleARN + "\n  username: admin\n"
+	otherMapping = "- rolearn: arn:aws:iam::123456789012:role/node\n  username: node\n"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	kube client.Client
+	cr   *v1alpha1.IAMIdentityMapping
+}
+
+type mappingModifier func(*v1alpha1.IAMIdentityMapping)
+
+func withConditions(c ...xpv1.Condition) mappingModifier {
+	return func(r *v1alpha1.IAMIdentityMapping) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRoleARN(arn string) mappingModifier {
+	return func(r *v1alpha1.IAMIdentityMapping) { r.Spec.ForProvider.RoleARN = arn }
+}
+
+func withUserARN(arn string) mappingModifier {
+	return func(r *v1alpha1.IAMIdentityMapping) { r.Spec.ForProvider.UserARN = arn }
+}
+
+func withUsername(u string) mappingModifier {
+	return func(r *v1alpha1.IAMIdentityMapping) { r.Spec.ForProvider.Username = u }
+}
+
+func withGroups(g ...string) mappingModifier {
+	return func(r *v1alpha1.IAMIdentityMapping) { r.Spec.ForProvider.Groups = g }
+}
+
+func mapping(m ...mappingModifier) *v1alpha1.IAMIdentityMapping {
+	cr := &v1alpha1.IAMIdentityMapping{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func admin(m ...mappingModifier) *v1alpha1.IAMIdentityMapping {
+	return mapping(append([]mappingModifier{withRoleARN(roleARN), withUsername("admin"), withGroups("system:masters")}, m...)...)
+}
+
+func mockGetAWSAuth(data map[string]string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+		cm := obj.(*corev1.ConfigMap)
+		cm.Namespace = eks.AWSAuthNamespace
+		cm.Name = eks.AWSAuthName
+		cm.Data = data
+		return nil
+	}
+}
+
+func mockGetNotFound() test.MockGetFn {
+	return test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, eks.AWSAuthName))
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.IAMIdentityMapping
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetAWSAuth(map[string]string{"mapRoles": otherMapping + adminMapping})},
+				cr:   admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetAWSAuth(map[string]string{"mapRoles": "- rolearn: " + roleARN + "\n  username: old\n"})},
+				cr:   admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"MappingNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetAWSAuth(map[string]string{"mapRoles": otherMapping})},
+				cr:   admin(),
+			},
+			want: want{
+				cr: admin(),
+			},
+		},
+		"ConfigMapNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetNotFound()},
+				cr:   admin(),
+			},
+			want: want{
+				cr: admin(),
+			},
+		},
+		"ClusterNotFound": {
+			args: args{
+				cr: admin(),
+			},
+			want: want{
+				cr: admin(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   admin(),
+			},
+			want: want{
+				cr:  admin(),
+				err: errors.Wrap(errBoom, errGetAWSAuth),
+			},
+		},
+		"BothARNs": {
+			args: args{
+				cr: admin(withUserARN(userARN)),
+			},
+			want: want{
+				cr:  admin(withUserARN(userARN)),
+				err: errors.New(errNoARN),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IAMIdentityMapping
+		cm  *corev1.ConfigMap
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AppendToExisting": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    mockGetAWSAuth(map[string]string{"mapRoles": otherMapping}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Creating())),
+				cm: func() *corev1.ConfigMap {
+					cm := eks.GenerateAWSAuthConfigMap()
+					cm.Data = map[string]string{"mapRoles": "- rolearn: arn:aws:iam::123456789012:role/node\n  username: node\n" + adminMapping}
+					return cm
+				}(),
+			},
+		},
+		"CreateConfigMap": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    mockGetNotFound(),
+					MockCreate: test.NewMockCreateFn(nil),
+				},
+				cr: admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Creating())),
+				cm: func() *corev1.ConfigMap {
+					cm := eks.GenerateAWSAuthConfigMap()
+					cm.Data = map[string]string{"mapRoles": adminMapping}
+					return cm
+				}(),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    mockGetAWSAuth(nil),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: admin(),
+			},
+			want: want{
+				cr:  admin(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errUpdateAWSAuth),
+			},
+		},
+		"ClusterNotFound": {
+			args: args{
+				cr: admin(),
+			},
+			want: want{
+				cr:  admin(withConditions(xpv1.Creating())),
+				err: errors.New(errClusterNotFound),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var written *corev1.ConfigMap
+			if mc, ok := tc.kube.(*test.MockClient); ok {
+				if mc.MockUpdate != nil {
+					update := mc.MockUpdate
+					mc.MockUpdate = func(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+						written = obj.(*corev1.ConfigMap)
+						return update(ctx, obj, opts...)
+					}
+				}
+				if mc.MockCreate != nil {
+					create := mc.MockCreate
+					mc.MockCreate = func(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error {
+						written = obj.(*corev1.ConfigMap)
+						return create(ctx, obj, opts...)
+					}
+				}
+			}
+			e := &external{client: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cm, written); tc.want.err == nil && diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.IAMIdentityMapping
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: mockGetAWSAuth(map[string]string{"mapRoles": otherMapping + adminMapping}),
+					MockUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+						if diff := cmp.Diff(otherMapping, obj.(*corev1.ConfigMap).Data["mapRoles"]); diff != "" {
+							return errors.New(diff)
+						}
+						return nil
+					},
+				},
+				cr: admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyRemoved": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetAWSAuth(map[string]string{"mapRoles": otherMapping})},
+				cr:   admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ConfigMapNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetNotFound()},
+				cr:   admin(),
+			},
+			want: want{
+				cr: admin(withConditions(xpv1.Deleting())),
+			},
+		},
+		"UpdateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    mockGetAWSAuth(map[string]string{"mapRoles": adminMapping}),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: admin(),
+			},
+			want: want{
+				cr:  admin(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errUpdateAWSAuth),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
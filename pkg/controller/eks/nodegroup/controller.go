@@ -94,13 +94,15 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotEKSNodeGroup)
 	}
 
-	rsp, err := e.client.DescribeNodegroupRequest(&awseks.DescribeNodegroupInput{NodegroupName: aws.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName}).Send(ctx)
+	req := e.client.DescribeNodegroupRequest(&awseks.DescribeNodegroupInput{NodegroupName: aws.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
+	lt := eks.LaunchTemplateFrom(req.Request)
+	rsp, err := req.Send(ctx)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDescribeFailed)
 	}
 
 	current := cr.Spec.ForProvider.DeepCopy()
-	eks.LateInitializeNodeGroup(&cr.Spec.ForProvider, rsp.Nodegroup)
+	eks.LateInitializeNodeGroup(&cr.Spec.ForProvider, rsp.Nodegroup, lt)
 	if !reflect.DeepEqual(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
 			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
@@ -123,7 +125,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: eks.IsNodeGroupUpToDate(&cr.Spec.ForProvider, rsp.Nodegroup),
+		ResourceUpToDate: eks.IsNodeGroupUpToDate(&cr.Spec.ForProvider, rsp.Nodegroup, lt),
 	}, nil
 }
 
@@ -136,7 +138,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status == v1alpha1.NodeGroupStatusCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	_, err := e.client.CreateNodegroupRequest(eks.GenerateCreateNodeGroupInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+	req := e.client.CreateNodegroupRequest(eks.GenerateCreateNodeGroupInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	eks.WithLaunchTemplate(req.Request, cr.Spec.ForProvider.LaunchTemplate)
+	_, err := req.Send(ctx)
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
 }
 
@@ -152,7 +156,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	// NOTE(hasheddan): we have to describe the node group again because
 	// different fields require different update methods.
-	rsp, err := e.client.DescribeNodegroupRequest(&awseks.DescribeNodegroupInput{NodegroupName: aws.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName}).Send(ctx)
+	req := e.client.DescribeNodegroupRequest(&awseks.DescribeNodegroupInput{NodegroupName: aws.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
+	lt := eks.LaunchTemplateFrom(req.Request)
+	rsp, err := req.Send(ctx)
 	if err != nil || rsp.Nodegroup == nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribeFailed)
 	}
@@ -174,6 +180,14 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			Version:       cr.Spec.ForProvider.Version}).Send(ctx)
 		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
 	}
+	if !eks.IsLaunchTemplateUpToDate(&cr.Spec.ForProvider, lt) {
+		req := e.client.UpdateNodegroupVersionRequest(&awseks.UpdateNodegroupVersionInput{
+			ClusterName:   &cr.Spec.ForProvider.ClusterName,
+			NodegroupName: awsclients.String(meta.GetExternalName(cr))})
+		eks.WithLaunchTemplate(req.Request, cr.Spec.ForProvider.LaunchTemplate)
+		_, err := req.Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
+	}
 	_, err = e.client.UpdateNodegroupConfigRequest(eks.GenerateUpdateNodeGroupConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup)).Send(ctx)
 	return managed.ExternalUpdate{}, errors.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
 }
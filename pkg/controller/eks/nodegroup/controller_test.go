@@ -78,6 +78,10 @@ func withScalingConfig(c *v1alpha1.NodeGroupScalingConfig) nodeGroupModifier {
 	return func(r *v1alpha1.NodeGroup) { r.Spec.ForProvider.ScalingConfig = c }
 }
 
+func withLaunchTemplate(lt *v1alpha1.LaunchTemplateSpecification) nodeGroupModifier {
+	return func(r *v1alpha1.NodeGroup) { r.Spec.ForProvider.LaunchTemplate = lt }
+}
+
 func nodeGroup(m ...nodeGroupModifier) *v1alpha1.NodeGroup {
 	cr := &v1alpha1.NodeGroup{}
 	for _, f := range m {
@@ -443,6 +447,28 @@ func TestUpdate(t *testing.T) {
 				cr: nodeGroup(withVersion(&version)),
 			},
 		},
+		"SuccessfulUpdateLaunchTemplateVersion": {
+			args: args{
+				eks: &fake.MockClient{
+					MockUpdateNodegroupVersionRequest: func(input *awseks.UpdateNodegroupVersionInput) awseks.UpdateNodegroupVersionRequest {
+						return awseks.UpdateNodegroupVersionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.UpdateNodegroupVersionOutput{}},
+						}
+					},
+					MockDescribeNodegroupRequest: func(input *awseks.DescribeNodegroupInput) awseks.DescribeNodegroupRequest {
+						return awseks.DescribeNodegroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awseks.DescribeNodegroupOutput{
+								Nodegroup: &awseks.Nodegroup{},
+							}},
+						}
+					},
+				},
+				cr: nodeGroup(withLaunchTemplate(&v1alpha1.LaunchTemplateSpecification{Version: aws.String("2")})),
+			},
+			want: want{
+				cr: nodeGroup(withLaunchTemplate(&v1alpha1.LaunchTemplateSpecification{Version: aws.String("2")})),
+			},
+		},
 		"SuccessfulUpdateNodeGroup": {
 			args: args{
 				eks: &fake.MockClient{
@@ -0,0 +1,420 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listener
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	certARN     = "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012"
+	sslPolicy   = "ELBSecurityPolicy-2016-08"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awselbv2.ErrCodeListenerNotFoundException, "", nil)
+)
+
+type listenerModifier func(*v1alpha1.Listener)
+
+func withExternalName(name string) listenerModifier {
+	return func(r *v1alpha1.Listener) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ListenerParameters) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ListenerObservation) listenerModifier {
+	return func(r *v1alpha1.Listener) { r.Status.AtProvider = s }
+}
+
+func listener(m ...listenerModifier) *v1alpha1.Listener {
+	cr := &v1alpha1.Listener{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(port int64) v1alpha1.ListenerParameters {
+	return v1alpha1.ListenerParameters{
+		LoadBalancerARN: &lbARN,
+		Port:            port,
+		Protocol:        "HTTPS",
+		CertificateARN:  &certARN,
+		DefaultActions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: &tgARN,
+		}},
+	}
+}
+
+func lateInitSpec() v1alpha1.ListenerParameters {
+	s := spec(443)
+	s.SSLPolicy = &sslPolicy
+	return s
+}
+
+func describe(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+	return awselbv2.DescribeListenersRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeListenersOutput{
+			Listeners: []awselbv2.Listener{{
+				Certificates: []awselbv2.Certificate{{CertificateArn: aws.String(certARN)}},
+				DefaultActions: []awselbv2.Action{{
+					Type:           awselbv2.ActionTypeEnumForward,
+					Order:          aws.Int64(1),
+					TargetGroupArn: aws.String(tgARN),
+					ForwardConfig: &awselbv2.ForwardActionConfig{
+						TargetGroups: []awselbv2.TargetGroupTuple{{
+							TargetGroupArn: aws.String(tgARN),
+							Weight:         aws.Int64(1),
+						}},
+						TargetGroupStickinessConfig: &awselbv2.TargetGroupStickinessConfig{Enabled: aws.Bool(false)},
+					},
+				}},
+				ListenerArn:     aws.String(listenerARN),
+				LoadBalancerArn: aws.String(lbARN),
+				Port:            aws.Int64(443),
+				Protocol:        awselbv2.ProtocolEnumHttps,
+				SslPolicy:       aws.String(sslPolicy),
+			}},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client elbv2.Client
+	cr     *v1alpha1.Listener
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: listener(withSpec(spec(443))),
+			},
+			want: want{
+				cr: listener(withSpec(spec(443))),
+			},
+		},
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeListenersRequest: describe,
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(spec(443))),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withSpec(lateInitSpec()),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PortChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeListenersRequest: describe,
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(spec(8443))),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withSpec(func() v1alpha1.ListenerParameters {
+					s := spec(8443)
+					s.SSLPolicy = &sslPolicy
+					return s
+				}()),
+					withStatus(v1alpha1.ListenerObservation{ListenerARN: listenerARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeListenersRequest: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeListenersRequest: func(*awselbv2.DescribeListenersInput) awselbv2.DescribeListenersRequest {
+						return awselbv2.DescribeListenersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr:  listener(withExternalName(listenerARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Listener
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateListenerRequest: func(in *awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						if diff := cmp.Diff([]awselbv2.Certificate{{CertificateArn: &certARN}}, in.Certificates); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateListenerOutput{
+								Listeners: []awselbv2.Listener{{ListenerArn: aws.String(listenerARN)}},
+							}},
+						}
+					},
+				},
+				cr: listener(withSpec(spec(443))),
+			},
+			want: want{
+				cr: listener(withSpec(spec(443)), withExternalName(listenerARN),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateListenerRequest: func(*awselbv2.CreateListenerInput) awselbv2.CreateListenerRequest {
+						return awselbv2.CreateListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withSpec(spec(443))),
+			},
+			want: want{
+				cr:  listener(withSpec(spec(443)), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockModifyListenerRequest: func(in *awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+						if aws.Int64Value(in.Port) != 8443 {
+							t.Errorf("unexpected port %d", aws.Int64Value(in.Port))
+						}
+						return awselbv2.ModifyListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(spec(8443))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockModifyListenerRequest: func(*awselbv2.ModifyListenerInput) awselbv2.ModifyListenerRequest {
+						return awselbv2.ModifyListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN), withSpec(spec(8443))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Listener
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteListenerRequest: func(*awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteListenerOutput{}},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteListenerRequest: func(*awselbv2.DeleteListenerInput) awselbv2.DeleteListenerRequest {
+						return awselbv2.DeleteListenerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: listener(withExternalName(listenerARN)),
+			},
+			want: want{
+				cr: listener(withExternalName(listenerARN), withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,467 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listenerrule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	ruleARN     = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener-rule/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee"
+	listenerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awselbv2.ErrCodeRuleNotFoundException, "", nil)
+)
+
+type ruleModifier func(*v1alpha1.ListenerRule)
+
+func withExternalName(name string) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.ListenerRuleParameters) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.ListenerRuleObservation) ruleModifier {
+	return func(r *v1alpha1.ListenerRule) { r.Status.AtProvider = s }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.ListenerRule {
+	cr := &v1alpha1.ListenerRule{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func spec(priority int64, paths ...string) v1alpha1.ListenerRuleParameters {
+	return v1alpha1.ListenerRuleParameters{
+		ListenerARN: &listenerARN,
+		Priority:    priority,
+		Conditions: []v1alpha1.RuleCondition{{
+			Field:             elbv2.ConditionFieldPathPattern,
+			PathPatternValues: paths,
+		}},
+		Actions: []v1alpha1.Action{{
+			Type:           "forward",
+			TargetGroupARN: &tgARN,
+		}},
+	}
+}
+
+func describe(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+	return awselbv2.DescribeRulesRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeRulesOutput{
+			Rules: []awselbv2.Rule{{
+				RuleArn:  aws.String(ruleARN),
+				Priority: aws.String("10"),
+				Conditions: []awselbv2.RuleCondition{{
+					Field:             aws.String(elbv2.ConditionFieldPathPattern),
+					PathPatternConfig: &awselbv2.PathPatternConditionConfig{Values: []string{"/api/*"}},
+				}},
+				Actions: []awselbv2.Action{{
+					Type:           awselbv2.ActionTypeEnumForward,
+					Order:          aws.Int64(1),
+					TargetGroupArn: aws.String(tgARN),
+					ForwardConfig: &awselbv2.ForwardActionConfig{
+						TargetGroups: []awselbv2.TargetGroupTuple{{
+							TargetGroupArn: aws.String(tgARN),
+							Weight:         aws.Int64(1),
+						}},
+					},
+				}},
+			}},
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client elbv2.Client
+	cr     *v1alpha1.ListenerRule
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ListenerRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: rule(withSpec(spec(10, "/api/*"))),
+			},
+			want: want{
+				cr: rule(withSpec(spec(10, "/api/*"))),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(10, "/api/*"))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN), withSpec(spec(10, "/api/*")),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PriorityChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(20, "/api/*"))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN), withSpec(spec(20, "/api/*")),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ConditionsChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(10, "/v2/*"))),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN), withSpec(spec(10, "/v2/*")),
+					withStatus(v1alpha1.ListenerRuleObservation{RuleARN: ruleARN}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+						return awselbv2.DescribeRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: func(*awselbv2.DescribeRulesInput) awselbv2.DescribeRulesRequest {
+						return awselbv2.DescribeRulesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleARN)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ListenerRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRuleRequest: func(*awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+						return awselbv2.CreateRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateRuleOutput{
+								Rules: []awselbv2.Rule{{RuleArn: aws.String(ruleARN)}},
+							}},
+						}
+					},
+				},
+				cr: rule(withSpec(spec(10, "/api/*"))),
+			},
+			want: want{
+				cr: rule(withSpec(spec(10, "/api/*")), withExternalName(ruleARN),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRuleRequest: func(*awselbv2.CreateRuleInput) awselbv2.CreateRuleRequest {
+						return awselbv2.CreateRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withSpec(spec(10, "/api/*"))),
+			},
+			want: want{
+				cr:  rule(withSpec(spec(10, "/api/*")), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PriorityOnly": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+					MockModifyRuleRequest: func(*awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+						t.Error("unexpected call to ModifyRule")
+						return awselbv2.ModifyRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyRuleOutput{}},
+						}
+					},
+					MockSetRulePrioritiesRequest: func(in *awselbv2.SetRulePrioritiesInput) awselbv2.SetRulePrioritiesRequest {
+						if diff := cmp.Diff([]awselbv2.RulePriorityPair{{RuleArn: &ruleARN, Priority: aws.Int64(20)}}, in.RulePriorities); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetRulePrioritiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetRulePrioritiesOutput{}},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(20, "/api/*"))),
+			},
+		},
+		"ConditionsOnly": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+					MockModifyRuleRequest: func(in *awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+						if diff := cmp.Diff([]string{"/v2/*"}, in.Conditions[0].PathPatternConfig.Values); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.ModifyRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyRuleOutput{}},
+						}
+					},
+					MockSetRulePrioritiesRequest: func(*awselbv2.SetRulePrioritiesInput) awselbv2.SetRulePrioritiesRequest {
+						t.Error("unexpected call to SetRulePriorities")
+						return awselbv2.SetRulePrioritiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetRulePrioritiesOutput{}},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(10, "/v2/*"))),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+					MockModifyRuleRequest: func(*awselbv2.ModifyRuleInput) awselbv2.ModifyRuleRequest {
+						return awselbv2.ModifyRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(10, "/v2/*"))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+		"SetPriorityFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeRulesRequest: describe,
+					MockSetRulePrioritiesRequest: func(*awselbv2.SetRulePrioritiesInput) awselbv2.SetRulePrioritiesRequest {
+						return awselbv2.SetRulePrioritiesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN), withSpec(spec(20, "/api/*"))),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errSetPriority),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.ListenerRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRuleRequest: func(*awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+						return awselbv2.DeleteRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteRuleOutput{}},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr: rule(withExternalName(ruleARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteRuleRequest: func(*awselbv2.DeleteRuleInput) awselbv2.DeleteRuleRequest {
+						return awselbv2.DeleteRuleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: rule(withExternalName(ruleARN)),
+			},
+			want: want{
+				cr:  rule(withExternalName(ruleARN), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a LoadBalancer resource"
+
+	errDescribe         = "cannot describe LoadBalancer with given name"
+	errDescribeTags     = "cannot describe tags for LoadBalancer"
+	errNotSingleItem    = "either no or multiple LoadBalancers retrieved for the given name"
+	errCreate           = "cannot create the LoadBalancer resource"
+	errDelete           = "cannot delete the LoadBalancer resource"
+	errSetIPAddressType = "cannot set IP address type of LoadBalancer"
+	errSetSecurityGroup = "cannot set security groups of LoadBalancer"
+	errSetSubnets       = "cannot set subnets of LoadBalancer"
+	errAddTags          = "cannot add tags to LoadBalancer"
+	errRemoveTags       = "cannot remove tags from LoadBalancer"
+)
+
+// SetupLoadBalancer adds a controller that reconciles LoadBalancers.
+func SetupLoadBalancer(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.LoadBalancerGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.LoadBalancer{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.LoadBalancerGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client elbv2.Client
+}
+
+func (e *external) describe(ctx context.Context, cr *v1alpha1.LoadBalancer) (awselbv2.LoadBalancer, []awselbv2.Tag, error) {
+	response, err := e.client.DescribeLoadBalancersRequest(&awselbv2.DescribeLoadBalancersInput{
+		Names: []string{meta.GetExternalName(cr)},
+	}).Send(ctx)
+	if err != nil {
+		return awselbv2.LoadBalancer{}, nil, errors.Wrap(err, errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.LoadBalancers) != 1 {
+		return awselbv2.LoadBalancer{}, nil, errors.New(errNotSingleItem)
+	}
+	observed := response.LoadBalancers[0]
+
+	tagsResponse, err := e.client.DescribeTagsRequest(&awselbv2.DescribeTagsInput{
+		ResourceArns: []string{aws.StringValue(observed.LoadBalancerArn)},
+	}).Send(ctx)
+	if err != nil {
+		return awselbv2.LoadBalancer{}, nil, errors.Wrap(err, errDescribeTags)
+	}
+	var tags []awselbv2.Tag
+	if len(tagsResponse.TagDescriptions) != 0 {
+		tags = tagsResponse.TagDescriptions[0].Tags
+	}
+	return observed, tags, nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, tags, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(elbv2.IsLoadBalancerNotFound, err)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	elbv2.LateInitializeLoadBalancer(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = elbv2.GenerateLoadBalancerObservation(observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.LoadBalancerStateActive, v1alpha1.LoadBalancerStateActiveImpaired:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.LoadBalancerStateProvisioning:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.LoadBalancerStateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        elbv2.IsLoadBalancerUpToDate(cr.Spec.ForProvider, observed, tags),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateLoadBalancerRequest(elbv2.GenerateCreateLoadBalancerInput(meta.GetExternalName(cr), cr.Spec.ForProvider)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, tags, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	arn := observed.LoadBalancerArn
+
+	if !elbv2.IsIPAddressTypeUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.SetIpAddressTypeRequest(&awselbv2.SetIpAddressTypeInput{
+			LoadBalancerArn: arn,
+			IpAddressType:   awselbv2.IpAddressType(aws.StringValue(cr.Spec.ForProvider.IPAddressType)),
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetIPAddressType)
+		}
+	}
+
+	if !elbv2.AreSecurityGroupsUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.SetSecurityGroupsRequest(&awselbv2.SetSecurityGroupsInput{
+			LoadBalancerArn: arn,
+			SecurityGroups:  cr.Spec.ForProvider.SecurityGroupIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSecurityGroup)
+		}
+	}
+
+	if !elbv2.AreSubnetsUpToDate(cr.Spec.ForProvider, observed) {
+		if _, err := e.client.SetSubnetsRequest(&awselbv2.SetSubnetsInput{
+			LoadBalancerArn: arn,
+			Subnets:         cr.Spec.ForProvider.SubnetIDs,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSubnets)
+		}
+	}
+
+	add, remove := elbv2.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsRequest(&awselbv2.RemoveTagsInput{
+			ResourceArns: []string{aws.StringValue(arn)},
+			TagKeys:      remove,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsRequest(&awselbv2.AddTagsInput{
+			ResourceArns: []string{aws.StringValue(arn)},
+			Tags:         add,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.LoadBalancer)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteLoadBalancerRequest(&awselbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: aws.String(cr.Status.AtProvider.LoadBalancerARN),
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsLoadBalancerNotFound, err), errDelete)
+}
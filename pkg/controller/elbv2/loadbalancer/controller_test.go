@@ -0,0 +1,469 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	lbName      = "my-lb"
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	dnsName     = "my-lb-1234567890.us-east-1.elb.amazonaws.com"
+	zoneID      = "Z35SXDOTRQ7X7K"
+	vpcID       = "vpc-123"
+	lbType      = "application"
+	scheme      = "internet-facing"
+	ipv4        = "ipv4"
+	sg1         = "sg-1"
+	sg2         = "sg-2"
+	subnet1     = "subnet-1"
+	subnet2     = "subnet-2"
+	tagKey      = "k"
+	tagValue    = "v"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awselbv2.ErrCodeLoadBalancerNotFoundException, "", nil)
+)
+
+type lbModifier func(*v1alpha1.LoadBalancer)
+
+func withExternalName(name string) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.LoadBalancerParameters) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.LoadBalancerObservation) lbModifier {
+	return func(r *v1alpha1.LoadBalancer) { r.Status.AtProvider = s }
+}
+
+func lb(m ...lbModifier) *v1alpha1.LoadBalancer {
+	cr := &v1alpha1.LoadBalancer{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func lateInitSpec() v1alpha1.LoadBalancerParameters {
+	return v1alpha1.LoadBalancerParameters{
+		Type:             &lbType,
+		Scheme:           &scheme,
+		IPAddressType:    &ipv4,
+		SecurityGroupIDs: []string{sg1},
+		SubnetIDs:        []string{subnet1, subnet2},
+	}
+}
+
+func observation(state string) v1alpha1.LoadBalancerObservation {
+	return v1alpha1.LoadBalancerObservation{
+		LoadBalancerARN:       lbARN,
+		CanonicalHostedZoneID: zoneID,
+		DNSName:               dnsName,
+		State:                 state,
+		VPCID:                 vpcID,
+	}
+}
+
+func describe(state awselbv2.LoadBalancerStateEnum) func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+	return func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+		return awselbv2.DescribeLoadBalancersRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeLoadBalancersOutput{
+				LoadBalancers: []awselbv2.LoadBalancer{{
+					AvailabilityZones: []awselbv2.AvailabilityZone{
+						{SubnetId: aws.String(subnet1)},
+						{SubnetId: aws.String(subnet2)},
+					},
+					CanonicalHostedZoneId: aws.String(zoneID),
+					DNSName:               aws.String(dnsName),
+					IpAddressType:         awselbv2.IpAddressTypeIpv4,
+					LoadBalancerArn:       aws.String(lbARN),
+					LoadBalancerName:      aws.String(lbName),
+					Scheme:                awselbv2.LoadBalancerSchemeEnumInternetFacing,
+					SecurityGroups:        []string{sg1},
+					State:                 &awselbv2.LoadBalancerState{Code: state},
+					Type:                  awselbv2.LoadBalancerTypeEnumApplication,
+					VpcId:                 aws.String(vpcID),
+				}},
+			}},
+		}
+	}
+}
+
+func describeTags(tags ...awselbv2.Tag) func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+				TagDescriptions: []awselbv2.TagDescription{{ResourceArn: aws.String(lbARN), Tags: tags}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client elbv2.Client
+	cr     *v1alpha1.LoadBalancer
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalObservation
+		err    error
+	}
+
+	changedSGs := lateInitSpec()
+	changedSGs.SecurityGroupIDs = []string{sg1, sg2}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTagsRequest:          describeTags(),
+				},
+				cr: lb(withExternalName(lbName)),
+			},
+			want: want{
+				cr: lb(withExternalName(lbName), withSpec(lateInitSpec()),
+					withStatus(observation(v1alpha1.LoadBalancerStateActive)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ProvisioningAndNotUpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(awselbv2.LoadBalancerStateEnumProvisioning),
+					MockDescribeTagsRequest:          describeTags(),
+				},
+				cr: lb(withExternalName(lbName), withSpec(changedSGs)),
+			},
+			want: want{
+				cr: lb(withExternalName(lbName), withSpec(changedSGs),
+					withStatus(observation(v1alpha1.LoadBalancerStateProvisioning)),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbName)),
+			},
+			want: want{
+				cr: lb(withExternalName(lbName)),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: func(*awselbv2.DescribeLoadBalancersInput) awselbv2.DescribeLoadBalancersRequest {
+						return awselbv2.DescribeLoadBalancersRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbName)),
+			},
+			want: want{
+				cr:  lb(withExternalName(lbName)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.LoadBalancer
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(in *awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						if aws.StringValue(in.Name) != lbName {
+							t.Errorf("unexpected load balancer name %q", aws.StringValue(in.Name))
+						}
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbName)),
+			},
+			want: want{
+				cr: lb(withExternalName(lbName), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateLoadBalancerRequest: func(*awselbv2.CreateLoadBalancerInput) awselbv2.CreateLoadBalancerRequest {
+						return awselbv2.CreateLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbName)),
+			},
+			want: want{
+				cr:  lb(withExternalName(lbName), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	changedSGsAndTags := lateInitSpec()
+	changedSGsAndTags.SecurityGroupIDs = []string{sg1, sg2}
+	changedSGsAndTags.Tags = []v1alpha1.Tag{{Key: tagKey, Value: &tagValue}}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SecurityGroupsAndTags": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTagsRequest:          describeTags(awselbv2.Tag{Key: aws.String("old"), Value: aws.String(tagValue)}),
+					MockSetSecurityGroupsRequest: func(in *awselbv2.SetSecurityGroupsInput) awselbv2.SetSecurityGroupsRequest {
+						if diff := cmp.Diff([]string{sg1, sg2}, in.SecurityGroups); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.SetSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.SetSecurityGroupsOutput{}},
+						}
+					},
+					MockRemoveTagsRequest: func(in *awselbv2.RemoveTagsInput) awselbv2.RemoveTagsRequest {
+						if diff := cmp.Diff([]string{"old"}, in.TagKeys); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.RemoveTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.RemoveTagsOutput{}},
+						}
+					},
+					MockAddTagsRequest: func(in *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						if diff := cmp.Diff([]awselbv2.Tag{{Key: &tagKey, Value: &tagValue}}, in.Tags); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbName), withSpec(changedSGsAndTags)),
+			},
+		},
+		"SetSecurityGroupsFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeLoadBalancersRequest: describe(awselbv2.LoadBalancerStateEnumActive),
+					MockDescribeTagsRequest:          describeTags(),
+					MockSetSecurityGroupsRequest: func(*awselbv2.SetSecurityGroupsInput) awselbv2.SetSecurityGroupsRequest {
+						return awselbv2.SetSecurityGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(withExternalName(lbName), withSpec(changedSGsAndTags)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errSetSecurityGroup),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.LoadBalancer
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(in *awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						if aws.StringValue(in.LoadBalancerArn) != lbARN {
+							t.Errorf("unexpected load balancer ARN %q", aws.StringValue(in.LoadBalancerArn))
+						}
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteLoadBalancerOutput{}},
+						}
+					},
+				},
+				cr: lb(withStatus(observation(v1alpha1.LoadBalancerStateActive))),
+			},
+			want: want{
+				cr: lb(withStatus(observation(v1alpha1.LoadBalancerStateActive)), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: lb(),
+			},
+			want: want{
+				cr: lb(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteLoadBalancerRequest: func(*awselbv2.DeleteLoadBalancerInput) awselbv2.DeleteLoadBalancerRequest {
+						return awselbv2.DeleteLoadBalancerRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: lb(),
+			},
+			want: want{
+				cr:  lb(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
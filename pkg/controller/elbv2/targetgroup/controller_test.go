@@ -0,0 +1,474 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	tgName      = "my-tg"
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	lbARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+	vpcID       = "vpc-123"
+	protocol    = "HTTP"
+	port        = int64(80)
+	targetType  = "instance"
+	path        = "/"
+	newPath     = "/healthz"
+	trafficPort = "traffic-port"
+	matcher     = "200"
+	enabled     = true
+	interval    = int64(30)
+	timeout     = int64(5)
+	threshold   = int64(5)
+	unhealthy   = int64(2)
+	tagKey      = "k"
+	tagValue    = "v"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awselbv2.ErrCodeTargetGroupNotFoundException, "", nil)
+)
+
+type tgModifier func(*v1alpha1.TargetGroup)
+
+func withExternalName(name string) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.TargetGroupParameters) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.TargetGroupObservation) tgModifier {
+	return func(r *v1alpha1.TargetGroup) { r.Status.AtProvider = s }
+}
+
+func tg(m ...tgModifier) *v1alpha1.TargetGroup {
+	cr := &v1alpha1.TargetGroup{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func lateInitSpec() v1alpha1.TargetGroupParameters {
+	return v1alpha1.TargetGroupParameters{
+		Protocol:                   &protocol,
+		Port:                       &port,
+		TargetType:                 &targetType,
+		VPCID:                      &vpcID,
+		HealthCheckEnabled:         &enabled,
+		HealthCheckIntervalSeconds: &interval,
+		HealthCheckPath:            &path,
+		HealthCheckPort:            &trafficPort,
+		HealthCheckProtocol:        &protocol,
+		HealthCheckTimeoutSeconds:  &timeout,
+		HealthyThresholdCount:      &threshold,
+		UnhealthyThresholdCount:    &unhealthy,
+		Matcher:                    &matcher,
+	}
+}
+
+func observation() v1alpha1.TargetGroupObservation {
+	return v1alpha1.TargetGroupObservation{
+		TargetGroupARN:   tgARN,
+		LoadBalancerARNs: []string{lbARN},
+	}
+}
+
+func describe(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+	return awselbv2.DescribeTargetGroupsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTargetGroupsOutput{
+			TargetGroups: []awselbv2.TargetGroup{{
+				HealthCheckEnabled:         aws.Bool(enabled),
+				HealthCheckIntervalSeconds: aws.Int64(interval),
+				HealthCheckPath:            aws.String(path),
+				HealthCheckPort:            aws.String(trafficPort),
+				HealthCheckProtocol:        awselbv2.ProtocolEnumHttp,
+				HealthCheckTimeoutSeconds:  aws.Int64(timeout),
+				HealthyThresholdCount:      aws.Int64(threshold),
+				LoadBalancerArns:           []string{lbARN},
+				Matcher:                    &awselbv2.Matcher{HttpCode: aws.String(matcher)},
+				Port:                       aws.Int64(port),
+				Protocol:                   awselbv2.ProtocolEnumHttp,
+				TargetGroupArn:             aws.String(tgARN),
+				TargetGroupName:            aws.String(tgName),
+				TargetType:                 awselbv2.TargetTypeEnumInstance,
+				UnhealthyThresholdCount:    aws.Int64(unhealthy),
+				VpcId:                      aws.String(vpcID),
+			}},
+		}},
+	}
+}
+
+func describeTags(tags ...awselbv2.Tag) func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+	return func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+		return awselbv2.DescribeTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTagsOutput{
+				TagDescriptions: []awselbv2.TagDescription{{ResourceArn: aws.String(tgARN), Tags: tags}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client elbv2.Client
+	cr     *v1alpha1.TargetGroup
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	changedPath := lateInitSpec()
+	changedPath.HealthCheckPath = &newPath
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AvailableAndLateInitialized": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe,
+					MockDescribeTagsRequest:         describeTags(),
+				},
+				cr: tg(withExternalName(tgName)),
+			},
+			want: want{
+				cr: tg(withExternalName(tgName), withSpec(lateInitSpec()),
+					withStatus(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"HealthCheckChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe,
+					MockDescribeTagsRequest:         describeTags(),
+				},
+				cr: tg(withExternalName(tgName), withSpec(changedPath)),
+			},
+			want: want{
+				cr: tg(withExternalName(tgName), withSpec(changedPath),
+					withStatus(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: func(*awselbv2.DescribeTargetGroupsInput) awselbv2.DescribeTargetGroupsRequest {
+						return awselbv2.DescribeTargetGroupsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName)),
+			},
+			want: want{
+				cr: tg(withExternalName(tgName)),
+			},
+		},
+		"DescribeTagsFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe,
+					MockDescribeTagsRequest: func(*awselbv2.DescribeTagsInput) awselbv2.DescribeTagsRequest {
+						return awselbv2.DescribeTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName)),
+			},
+			want: want{
+				cr:  tg(withExternalName(tgName)),
+				err: errors.Wrap(errBoom, errDescribeTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroup
+		result managed.ExternalCreation
+		err    error
+	}
+
+	tags := []v1alpha1.Tag{{Key: tagKey, Value: &tagValue}}
+	create := func(*awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+		return awselbv2.CreateTargetGroupRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.CreateTargetGroupOutput{
+				TargetGroups: []awselbv2.TargetGroup{{TargetGroupArn: aws.String(tgARN)}},
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulWithTags": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateTargetGroupRequest: create,
+					MockAddTagsRequest: func(in *awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						if diff := cmp.Diff([]string{tgARN}, in.ResourceArns); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.AddTagsOutput{}},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName), withSpec(v1alpha1.TargetGroupParameters{Tags: tags})),
+			},
+			want: want{
+				cr: tg(withExternalName(tgName), withSpec(v1alpha1.TargetGroupParameters{Tags: tags}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"AddTagsFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateTargetGroupRequest: create,
+					MockAddTagsRequest: func(*awselbv2.AddTagsInput) awselbv2.AddTagsRequest {
+						return awselbv2.AddTagsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName), withSpec(v1alpha1.TargetGroupParameters{Tags: tags})),
+			},
+			want: want{
+				cr: tg(withExternalName(tgName), withSpec(v1alpha1.TargetGroupParameters{Tags: tags}),
+					withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errAddTags),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateTargetGroupRequest: func(*awselbv2.CreateTargetGroupInput) awselbv2.CreateTargetGroupRequest {
+						return awselbv2.CreateTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName)),
+			},
+			want: want{
+				cr:  tg(withExternalName(tgName), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	changedPath := lateInitSpec()
+	changedPath.HealthCheckPath = &newPath
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"HealthCheckChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe,
+					MockDescribeTagsRequest:         describeTags(),
+					MockModifyTargetGroupRequest: func(in *awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+						if aws.StringValue(in.HealthCheckPath) != newPath {
+							t.Errorf("unexpected health check path %q", aws.StringValue(in.HealthCheckPath))
+						}
+						return awselbv2.ModifyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.ModifyTargetGroupOutput{}},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName), withSpec(changedPath)),
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetGroupsRequest: describe,
+					MockDescribeTagsRequest:         describeTags(),
+					MockModifyTargetGroupRequest: func(*awselbv2.ModifyTargetGroupInput) awselbv2.ModifyTargetGroupRequest {
+						return awselbv2.ModifyTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(withExternalName(tgName), withSpec(changedPath)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TargetGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteTargetGroupRequest: func(in *awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+						if aws.StringValue(in.TargetGroupArn) != tgARN {
+							t.Errorf("unexpected target group ARN %q", aws.StringValue(in.TargetGroupArn))
+						}
+						return awselbv2.DeleteTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeleteTargetGroupOutput{}},
+						}
+					},
+				},
+				cr: tg(withStatus(observation())),
+			},
+			want: want{
+				cr: tg(withStatus(observation()), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteTargetGroupRequest: func(*awselbv2.DeleteTargetGroupInput) awselbv2.DeleteTargetGroupRequest {
+						return awselbv2.DeleteTargetGroupRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: tg(),
+			},
+			want: want{
+				cr:  tg(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
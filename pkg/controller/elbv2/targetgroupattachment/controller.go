@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroupattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TargetGroupAttachment resource"
+
+	errDescribe = "cannot describe the health of the target"
+	errCreate   = "cannot register the target with the TargetGroup"
+	errDelete   = "cannot deregister the target from the TargetGroup"
+)
+
+// SetupTargetGroupAttachment adds a controller that reconciles
+// TargetGroupAttachments.
+func SetupTargetGroupAttachment(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.TargetGroupAttachmentGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.TargetGroupAttachment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TargetGroupAttachmentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elbv2.NewClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elbv2.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.TargetGroupAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client elbv2.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroupAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeTargetHealthRequest(&awselbv2.DescribeTargetHealthInput{
+		TargetGroupArn: cr.Spec.ForProvider.TargetGroupARN,
+		Targets:        []awselbv2.TargetDescription{elbv2.GenerateTargetDescription(cr.Spec.ForProvider)},
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(elbv2.IsTargetGroupNotFound, err), errDescribe)
+	}
+
+	if len(response.TargetHealthDescriptions) == 0 || response.TargetHealthDescriptions[0].TargetHealth == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	health := response.TargetHealthDescriptions[0].TargetHealth
+
+	// Targets that are not registered are reported as unused.
+	if health.Reason == awselbv2.TargetHealthReasonEnumTargetNotRegistered {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = v1alpha1.TargetGroupAttachmentObservation{
+		State:  string(health.State),
+		Reason: string(health.Reason),
+	}
+
+	switch health.State {
+	case awselbv2.TargetHealthStateEnumInitial:
+		cr.SetConditions(xpv1.Creating())
+	case awselbv2.TargetHealthStateEnumDraining:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.TargetGroupAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.RegisterTargetsRequest(&awselbv2.RegisterTargetsInput{
+		TargetGroupArn: cr.Spec.ForProvider.TargetGroupARN,
+		Targets:        []awselbv2.TargetDescription{elbv2.GenerateTargetDescription(cr.Spec.ForProvider)},
+	}).Send(ctx)
+
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.TargetGroupAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeregisterTargetsRequest(&awselbv2.DeregisterTargetsInput{
+		TargetGroupArn: cr.Spec.ForProvider.TargetGroupARN,
+		Targets:        []awselbv2.TargetDescription{elbv2.GenerateTargetDescription(cr.Spec.ForProvider)},
+	}).Send(ctx)
+
+	return errors.Wrap(resource.Ignore(elbv2.IsTargetGroupNotFound, err), errDelete)
+}
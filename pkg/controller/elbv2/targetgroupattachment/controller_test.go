@@ -0,0 +1,339 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetgroupattachment
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2"
+	"github.com/crossplane/provider-aws/pkg/clients/elbv2/fake"
+)
+
+var (
+	tgARN       = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067"
+	instanceID  = "i-0123456789abcdef0"
+	errBoom     = errors.New("boom")
+	errNotFound = awserr.New(awselbv2.ErrCodeTargetGroupNotFoundException, "", nil)
+)
+
+type attachmentModifier func(*v1alpha1.TargetGroupAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *v1alpha1.TargetGroupAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s v1alpha1.TargetGroupAttachmentObservation) attachmentModifier {
+	return func(r *v1alpha1.TargetGroupAttachment) { r.Status.AtProvider = s }
+}
+
+func attachment(m ...attachmentModifier) *v1alpha1.TargetGroupAttachment {
+	cr := &v1alpha1.TargetGroupAttachment{
+		Spec: v1alpha1.TargetGroupAttachmentSpec{
+			ForProvider: v1alpha1.TargetGroupAttachmentParameters{
+				TargetGroupARN: &tgARN,
+				TargetID:       &instanceID,
+				Port:           aws.Int64(80),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(h *awselbv2.TargetHealth) func(*awselbv2.DescribeTargetHealthInput) awselbv2.DescribeTargetHealthRequest {
+	return func(*awselbv2.DescribeTargetHealthInput) awselbv2.DescribeTargetHealthRequest {
+		return awselbv2.DescribeTargetHealthRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DescribeTargetHealthOutput{
+				TargetHealthDescriptions: []awselbv2.TargetHealthDescription{{
+					Target:       &awselbv2.TargetDescription{Id: &instanceID, Port: aws.Int64(80)},
+					TargetHealth: h,
+				}},
+			}},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type args struct {
+	client elbv2.Client
+	cr     *v1alpha1.TargetGroupAttachment
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.TargetGroupAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Healthy": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetHealthRequest: describe(&awselbv2.TargetHealth{State: awselbv2.TargetHealthStateEnumHealthy}),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withStatus(v1alpha1.TargetGroupAttachmentObservation{State: "healthy"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Initial": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetHealthRequest: describe(&awselbv2.TargetHealth{
+						State:  awselbv2.TargetHealthStateEnumInitial,
+						Reason: awselbv2.TargetHealthReasonEnumElbRegistrationInProgress,
+					}),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withStatus(v1alpha1.TargetGroupAttachmentObservation{
+					State:  "initial",
+					Reason: "Elb.RegistrationInProgress",
+				}), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotRegistered": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetHealthRequest: describe(&awselbv2.TargetHealth{
+						State:  awselbv2.TargetHealthStateEnumUnused,
+						Reason: awselbv2.TargetHealthReasonEnumTargetNotRegistered,
+					}),
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"TargetGroupNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetHealthRequest: func(*awselbv2.DescribeTargetHealthInput) awselbv2.DescribeTargetHealthRequest {
+						return awselbv2.DescribeTargetHealthRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"DescribeFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeTargetHealthRequest: func(*awselbv2.DescribeTargetHealthInput) awselbv2.DescribeTargetHealthRequest {
+						return awselbv2.DescribeTargetHealthRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TargetGroupAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockRegisterTargetsRequest: func(in *awselbv2.RegisterTargetsInput) awselbv2.RegisterTargetsRequest {
+						want := []awselbv2.TargetDescription{{Id: &instanceID, Port: aws.Int64(80)}}
+						if diff := cmp.Diff(want, in.Targets); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awselbv2.RegisterTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.RegisterTargetsOutput{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Creating())),
+			},
+		},
+		"RegisterFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockRegisterTargetsRequest: func(*awselbv2.RegisterTargetsInput) awselbv2.RegisterTargetsRequest {
+						return awselbv2.RegisterTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.TargetGroupAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeregisterTargetsRequest: func(*awselbv2.DeregisterTargetsInput) awselbv2.DeregisterTargetsRequest {
+						return awselbv2.DeregisterTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awselbv2.DeregisterTargetsOutput{}},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Deleting())),
+			},
+		},
+		"TargetGroupNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeregisterTargetsRequest: func(*awselbv2.DeregisterTargetsInput) awselbv2.DeregisterTargetsRequest {
+						return awselbv2.DeregisterTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errNotFound},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeregisterFailed": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeregisterTargetsRequest: func(*awselbv2.DeregisterTargetsInput) awselbv2.DeregisterTargetsRequest {
+						return awselbv2.DeregisterTargetsRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,10 +18,12 @@ package iamaccesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -43,6 +45,16 @@ const (
 	errCreate           = "failed to create the IAMAccessKey resource"
 	errDelete           = "failed to delete the IAMAccessKey resource"
 	errUpdate           = "failed to update the IAMAccessKey resource"
+	errRotate           = "failed to rotate the IAMAccessKey resource"
+	errDeactivate       = "failed to deactivate the previous IAMAccessKey"
+	errDeletePrevious   = "failed to delete the previous IAMAccessKey"
+	errKubeUpdate       = "failed to update the IAMAccessKey custom resource"
+	errRotateBefore     = "cannot parse the rotate-before annotation"
+	errTooManyKeys      = "cannot rotate the IAMAccessKey because the user already has two access keys"
+
+	// defaultGracePeriod is how long the previous access key is kept after
+	// rotation if the rotation policy does not say otherwise.
+	defaultGracePeriod = 24 * time.Hour
 )
 
 // SetupIAMAccessKey adds a controller that reconciles IAMAccessKeys.
@@ -109,11 +121,19 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	case awsiam.StatusTypeInactive:
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	if accessKey.CreateDate != nil {
+		cr.Status.AtProvider.CreateDate = &metav1.Time{Time: *accessKey.CreateDate}
+	}
+	cr.Status.AtProvider.PreviousAccessKeyID = previousAccessKeyID(keys.AccessKeyMetadata, accessKey)
+	rotate, err := isRotationDue(cr, time.Now())
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	current := cr.Spec.ForProvider.Status
 	cr.Spec.ForProvider.Status = awscommon.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status && !rotate && !isGracePeriodOver(cr, time.Now()),
 		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
 	}, nil
 }
@@ -151,8 +171,78 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Status:      awsiam.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
 	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+
+	if isGracePeriodOver(cr, time.Now()) {
+		return managed.ExternalUpdate{}, e.deletePrevious(ctx, cr)
+	}
+	rotate, err := isRotationDue(cr, time.Now())
+	if err != nil || !rotate {
+		return managed.ExternalUpdate{}, err
+	}
+	return e.rotate(ctx, cr)
+}
+
+// rotate creates a new access key and makes it the current one. The previous
+// access key is kept until the grace period has passed so that its consumers
+// have time to switch to the new one.
+func (e *external) rotate(ctx context.Context, cr *v1alpha1.IAMAccessKey) (managed.ExternalUpdate, error) {
+	// IAM users can have at most two access keys.
+	keys, err := e.client.ListAccessKeysRequest(&awsiam.ListAccessKeysInput{UserName: aws.String(cr.Spec.ForProvider.IAMUsername)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errList)
+	}
+	if len(keys.AccessKeyMetadata) > 1 {
+		return managed.ExternalUpdate{}, errors.New(errTooManyKeys)
+	}
+
+	response, err := e.client.CreateAccessKeyRequest(&awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.IAMUsername)}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRotate)
+	}
+
+	previous := meta.GetExternalName(cr)
+	meta.SetExternalName(cr, aws.StringValue(response.AccessKey.AccessKeyId))
+	// NOTE: The status has to be set after the update since the response of
+	// the API server overrides it.
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdate)
+	}
+	cr.Status.AtProvider.PreviousAccessKeyID = previous
+	cr.Status.AtProvider.CreateDate = nil
+	if response.AccessKey.CreateDate != nil {
+		cr.Status.AtProvider.CreateDate = &metav1.Time{Time: *response.AccessKey.CreateDate}
+	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(response.AccessKey.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.StringValue(response.AccessKey.SecretAccessKey)),
+	}}, nil
+}
+
+// deletePrevious deactivates and deletes the access key that was replaced
+// during the last rotation.
+func (e *external) deletePrevious(ctx context.Context, cr *v1alpha1.IAMAccessKey) error {
+	previous := aws.String(cr.Status.AtProvider.PreviousAccessKeyID)
+	_, err := e.client.UpdateAccessKeyRequest(&awsiam.UpdateAccessKeyInput{
+		AccessKeyId: previous,
+		Status:      awsiam.StatusTypeInactive,
+		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+	}).Send(ctx)
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errDeactivate)
+	}
+	_, err = e.client.DeleteAccessKeyRequest(&awsiam.DeleteAccessKeyInput{
+		AccessKeyId: previous,
+		UserName:    aws.String(cr.Spec.ForProvider.IAMUsername),
+	}).Send(ctx)
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errDeletePrevious)
+	}
+	cr.Status.AtProvider.PreviousAccessKeyID = ""
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -170,3 +260,56 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+// previousAccessKeyID returns the ID of the access key that the current one
+// replaced during rotation. IAM users can have at most two access keys and
+// rotation only happens when the user has one, so the previous access key is
+// the other key of the user that was not created after the current one.
+func previousAccessKeyID(keys []awsiam.AccessKeyMetadata, current awsiam.AccessKeyMetadata) string {
+	for _, k := range keys {
+		if aws.StringValue(k.AccessKeyId) == aws.StringValue(current.AccessKeyId) {
+			continue
+		}
+		if k.CreateDate != nil && current.CreateDate != nil && !k.CreateDate.After(*current.CreateDate) {
+			return aws.StringValue(k.AccessKeyId)
+		}
+	}
+	return ""
+}
+
+// isRotationDue returns true if the current access key should be replaced
+// either because it is older than the maximum age of the rotation policy or
+// because it was created before the time in the rotate-before annotation.
+// Rotation waits until the previous access key is deleted since IAM users can
+// have at most two access keys.
+func isRotationDue(cr *v1alpha1.IAMAccessKey, now time.Time) (bool, error) {
+	created := cr.Status.AtProvider.CreateDate
+	if created == nil || cr.Status.AtProvider.PreviousAccessKeyID != "" {
+		return false, nil
+	}
+	if v, ok := cr.GetAnnotations()[v1alpha1.AnnotationKeyRotateBefore]; ok {
+		before, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return false, errors.Wrap(err, errRotateBefore)
+		}
+		if created.Time.Before(before) {
+			return true, nil
+		}
+	}
+	p := cr.Spec.ForProvider.RotationPolicy
+	return p != nil && p.MaxAge != nil && !created.Add(p.MaxAge.Duration).After(now), nil
+}
+
+// isGracePeriodOver returns true if there is a previous access key and the
+// current one was created longer than the grace period ago.
+func isGracePeriodOver(cr *v1alpha1.IAMAccessKey, now time.Time) bool {
+	created := cr.Status.AtProvider.CreateDate
+	if cr.Status.AtProvider.PreviousAccessKeyID == "" || created == nil {
+		return false
+	}
+	grace := defaultGracePeriod
+	if p := cr.Spec.ForProvider.RotationPolicy; p != nil && p.GracePeriod != nil {
+		grace = p.GracePeriod.Duration
+	}
+	return !created.Add(grace).After(now)
+}
@@ -20,12 +20,14 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -47,6 +49,9 @@ var (
 	inactiveStatus = awsiam.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	oldDate        = time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	newDate        = time.Now().Truncate(time.Second)
 
 	errBoom = errors.New("boom")
 )
@@ -81,6 +86,30 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withCreateDate(t time.Time) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		r.Status.AtProvider.CreateDate = &metav1.Time{Time: t}
+	}
+}
+
+func withPreviousAccessKey(keyid string) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		r.Status.AtProvider.PreviousAccessKeyID = keyid
+	}
+}
+
+func withMaxAge(d time.Duration) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		r.Spec.ForProvider.RotationPolicy = &v1alpha1.AccessKeyRotationPolicy{MaxAge: &metav1.Duration{Duration: d}}
+	}
+}
+
+func withRotateBefore(v string) accessModifier {
+	return func(r *v1alpha1.IAMAccessKey) {
+		meta.AddAnnotations(r, map[string]string{v1alpha1.AnnotationKeyRotateBefore: v})
+	}
+}
+
 func accesskey(m ...accessModifier) *v1alpha1.IAMAccessKey {
 	cr := &v1alpha1.IAMAccessKey{}
 	for _, f := range m {
@@ -156,6 +185,242 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDueByMaxAge": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									CreateDate:  &oldDate,
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withMaxAge(24*time.Hour)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withMaxAge(24*time.Hour),
+					withCreateDate(oldDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RotationDueByAnnotation": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									CreateDate:  &oldDate,
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotateBefore(newDate.Format(time.RFC3339))),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotateBefore(newDate.Format(time.RFC3339)),
+					withCreateDate(oldDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InvalidRotateBefore": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(accessKeyID),
+									CreateDate:  &oldDate,
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotateBefore("yesterday")),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotateBefore("yesterday"),
+					withCreateDate(oldDate),
+					withConditions(xpv1.Available())),
+				err: errors.Wrap(&time.ParseError{Layout: time.RFC3339, Value: "yesterday", LayoutElem: "2006", ValueElem: "yesterday"}, errRotateBefore),
+			},
+		},
+		"GracePeriodOver": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{
+									{
+										AccessKeyId: aws.String(accessKeyID),
+										CreateDate:  &oldDate,
+										Status:      activeStatus,
+										UserName:    aws.String(userName),
+									},
+									{
+										AccessKeyId: aws.String(newAccessKeyID),
+										CreateDate:  &oldDate,
+										Status:      activeStatus,
+										UserName:    aws.String(userName),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(newAccessKeyID), withStatus(string(activeStatus)), withPreviousAccessKey(accessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(newAccessKeyID),
+					withStatus(string(activeStatus)),
+					withPreviousAccessKey(accessKeyID),
+					withCreateDate(oldDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousKeyGone": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{
+									AccessKeyId: aws.String(newAccessKeyID),
+									CreateDate:  &newDate,
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(newAccessKeyID), withStatus(string(activeStatus)), withPreviousAccessKey(accessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(newAccessKeyID),
+					withStatus(string(activeStatus)),
+					withCreateDate(newDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PreviousKeyNotInStatus": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{
+									{
+										AccessKeyId: aws.String(accessKeyID),
+										CreateDate:  &oldDate,
+										Status:      activeStatus,
+										UserName:    aws.String(userName),
+									},
+									{
+										AccessKeyId: aws.String(newAccessKeyID),
+										CreateDate:  &newDate,
+										Status:      activeStatus,
+										UserName:    aws.String(userName),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(newAccessKeyID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(newAccessKeyID),
+					withStatus(string(activeStatus)),
+					withPreviousAccessKey(accessKeyID),
+					withCreateDate(newDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NewerKeyIsNotPrevious": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{
+									{
+										AccessKeyId: aws.String(accessKeyID),
+										CreateDate:  &oldDate,
+										Status:      activeStatus,
+										UserName:    aws.String(userName),
+									},
+									{
+										AccessKeyId: aws.String(newAccessKeyID),
+										CreateDate:  &newDate,
+										Status:      activeStatus,
+										UserName:    aws.String(userName),
+									},
+								},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus))),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withCreateDate(oldDate),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"ValidInputNotExists": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -424,6 +689,111 @@ func TestUpdate(t *testing.T) {
 				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus))),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{AccessKeyId: aws.String(accessKeyID)}},
+							}},
+						}
+					},
+					MockCreateAccessKeyRequest: func(input *awsiam.CreateAccessKeyInput) awsiam.CreateAccessKeyRequest {
+						return awsiam.CreateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateAccessKeyOutput{
+								AccessKey: &awsiam.AccessKey{
+									AccessKeyId:     aws.String(newAccessKeyID),
+									CreateDate:      &newDate,
+									SecretAccessKey: aws.String(secretKeyID),
+								},
+							}},
+						}
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withMaxAge(24*time.Hour), withCreateDate(oldDate)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withMaxAge(24*time.Hour),
+					withCreateDate(newDate), withPreviousAccessKey(accessKeyID)),
+				update: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+				}},
+			},
+		},
+		"RotateTooManyKeys": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+					MockListAccessKeysRequest: func(input *awsiam.ListAccessKeysInput) awsiam.ListAccessKeysRequest {
+						return awsiam.ListAccessKeysRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAccessKeysOutput{
+								AccessKeyMetadata: []awsiam.AccessKeyMetadata{{AccessKeyId: aws.String(accessKeyID)}, {AccessKeyId: aws.String(newAccessKeyID)}},
+							}},
+						}
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withMaxAge(24*time.Hour), withCreateDate(oldDate)),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withMaxAge(24*time.Hour), withCreateDate(oldDate)),
+				err: errors.New(errTooManyKeys),
+			},
+		},
+		"DeletePrevious": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteAccessKeyOutput{}},
+						}
+					},
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withCreateDate(oldDate), withPreviousAccessKey(accessKeyID)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withCreateDate(oldDate)),
+			},
+		},
+		"DeletePreviousError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKeyRequest: func(input *awsiam.UpdateAccessKeyInput) awsiam.UpdateAccessKeyRequest {
+						return awsiam.UpdateAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAccessKeyOutput{}},
+						}
+					},
+					MockDeleteAccessKeyRequest: func(input *awsiam.DeleteAccessKeyInput) awsiam.DeleteAccessKeyRequest {
+						return awsiam.DeleteAccessKeyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withCreateDate(oldDate), withPreviousAccessKey(accessKeyID)),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withCreateDate(oldDate), withPreviousAccessKey(accessKeyID)),
+				err: errors.Wrap(errBoom, errDeletePrevious),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -453,7 +823,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -46,6 +46,19 @@ const (
 	errUpdate           = "failed to update the IAMRole resource"
 	errSDK              = "empty IAMRole received from IAM API"
 
+	errListInlinePolicies        = "failed to list the inline policies of the IAMRole"
+	errGetInlinePolicy           = "failed to get an inline policy of the IAMRole"
+	errPutInlinePolicy           = "failed to put an inline policy of the IAMRole"
+	errDeleteInlinePolicy        = "failed to delete an inline policy of the IAMRole"
+	errListManagedPolicies       = "failed to list the managed policies attached to the IAMRole"
+	errAttachManagedPolicy       = "failed to attach a managed policy to the IAMRole"
+	errDetachManagedPolicy       = "failed to detach a managed policy from the IAMRole"
+	errGetInstanceProfile        = "failed to get the instance profile of the IAMRole"
+	errCreateInstanceProfile     = "failed to create the instance profile of the IAMRole"
+	errAddToInstanceProfile      = "failed to add the IAMRole to its instance profile"
+	errRemoveFromInstanceProfile = "failed to remove the IAMRole from its instance profile"
+	errDeleteInstanceProfile     = "failed to delete the instance profile of the IAMRole"
+
 	errKubeUpdateFailed = "cannot late initialize IAMRole"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
 )
@@ -115,11 +128,33 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = iam.GenerateRoleObservation(*observed.Role)
 
-	upToDate, err := iam.IsRoleUpToDate(cr.Spec.ForProvider, role)
+	desired, err := desiredParameters(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+	}
+	upToDate, err := iam.IsRoleUpToDate(*desired, role)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	if aws.BoolValue(cr.Spec.ForProvider.CreateInstanceProfile) {
+		ip, err := e.getInstanceProfile(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if ip != nil {
+			cr.Status.AtProvider.InstanceProfileARN = aws.StringValue(ip.Arn)
+		}
+		upToDate = upToDate && hasRole(ip, meta.GetExternalName(cr))
+	}
+
+	if upToDate {
+		upToDate, err = e.arePoliciesUpToDate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
 		ResourceUpToDate: upToDate,
@@ -134,7 +169,11 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	_, err := e.client.CreateRoleRequest(iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+	desired, err := desiredParameters(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	_, err = e.client.CreateRoleRequest(iam.GenerateCreateRoleInput(meta.GetExternalName(cr), desired)).Send(ctx)
 	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
 }
 
@@ -156,7 +195,14 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errSDK)
 	}
 
-	patch, err := iam.CreatePatch(observed.Role, &cr.Spec.ForProvider)
+	desired, err := desiredParameters(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	patch, err := iam.CreatePatch(observed.Role, desired)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
 
 	if patch.Description != nil || patch.MaxSessionDuration != nil {
 		_, err = e.client.UpdateRoleRequest(&awsiam.UpdateRoleInput{
@@ -172,12 +218,24 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	if patch.AssumeRolePolicyDocument != "" {
 		_, err = e.client.UpdateAssumeRolePolicyRequest(&awsiam.UpdateAssumeRolePolicyInput{
-			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
+			PolicyDocument: &desired.AssumeRolePolicyDocument,
 			RoleName:       aws.String(meta.GetExternalName(cr)),
 		}).Send(ctx)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
 	}
 
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	if err := e.updateInlinePolicies(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateManagedPolicies(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if aws.BoolValue(cr.Spec.ForProvider.CreateInstanceProfile) {
+		return managed.ExternalUpdate{}, e.updateInstanceProfile(ctx, cr)
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -188,9 +246,265 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	// A role cannot be deleted while it has inline policies, attached
+	// managed policies or is part of an instance profile.
+	if err := e.deletePolicies(ctx, cr); err != nil {
+		return err
+	}
+	if aws.BoolValue(cr.Spec.ForProvider.CreateInstanceProfile) {
+		if err := e.deleteInstanceProfile(ctx, meta.GetExternalName(cr)); err != nil {
+			return err
+		}
+	}
+
 	_, err := e.client.DeleteRoleRequest(&awsiam.DeleteRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	}).Send(ctx)
 
 	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
+
+// desiredParameters returns a copy of the parameters of the IAMRole with the
+// trust policy that is generated from its ServiceAccountTrust, if any. The
+// spec of the IAMRole is left as is.
+func desiredParameters(cr *v1beta1.IAMRole) (*v1beta1.IAMRoleParameters, error) {
+	p := cr.Spec.ForProvider.DeepCopy()
+	doc, err := iam.AssumeRolePolicyDocument(*p)
+	if err != nil {
+		return nil, err
+	}
+	p.AssumeRolePolicyDocument = doc
+	return p, nil
+}
+
+// arePoliciesUpToDate returns true if the inline and managed policies of the
+// role match the desired ones. Policies are only compared if they are managed
+// by the IAMRole, i.e. if the corresponding field is set.
+func (e *external) arePoliciesUpToDate(ctx context.Context, cr *v1beta1.IAMRole) (bool, error) {
+	name := meta.GetExternalName(cr)
+	if cr.Spec.ForProvider.InlinePolicies != nil {
+		observed, err := e.getInlinePolicies(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		put, remove, err := iam.DiffInlinePolicies(cr.Spec.ForProvider.InlinePolicies, observed)
+		if err != nil {
+			return false, errors.Wrap(err, errUpToDateFailed)
+		}
+		if len(put) != 0 || len(remove) != 0 {
+			return false, nil
+		}
+	}
+	if cr.Spec.ForProvider.ManagedPolicyARNs != nil {
+		observed, err := e.getManagedPolicies(ctx, name)
+		if err != nil {
+			return false, err
+		}
+		attach, detach := iam.DiffManagedPolicies(cr.Spec.ForProvider.ManagedPolicyARNs, observed)
+		if len(attach) != 0 || len(detach) != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getInlinePolicies returns the URL-encoded documents of the inline policies
+// of the role keyed by their names.
+func (e *external) getInlinePolicies(ctx context.Context, name string) (map[string]string, error) {
+	policies := map[string]string{}
+	input := &awsiam.ListRolePoliciesInput{RoleName: aws.String(name)}
+	for {
+		res, err := e.client.ListRolePoliciesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errListInlinePolicies)
+		}
+		for _, p := range res.PolicyNames {
+			doc, err := e.client.GetRolePolicyRequest(&awsiam.GetRolePolicyInput{
+				PolicyName: aws.String(p),
+				RoleName:   aws.String(name),
+			}).Send(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, errGetInlinePolicy)
+			}
+			policies[p] = aws.StringValue(doc.PolicyDocument)
+		}
+		if !aws.BoolValue(res.IsTruncated) {
+			return policies, nil
+		}
+		input.Marker = res.Marker
+	}
+}
+
+// getManagedPolicies returns the ARNs of the managed policies attached to the
+// role.
+func (e *external) getManagedPolicies(ctx context.Context, name string) ([]string, error) {
+	arns := []string{}
+	input := &awsiam.ListAttachedRolePoliciesInput{RoleName: aws.String(name)}
+	for {
+		res, err := e.client.ListAttachedRolePoliciesRequest(input).Send(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, errListManagedPolicies)
+		}
+		for _, p := range res.AttachedPolicies {
+			arns = append(arns, aws.StringValue(p.PolicyArn))
+		}
+		if !aws.BoolValue(res.IsTruncated) {
+			return arns, nil
+		}
+		input.Marker = res.Marker
+	}
+}
+
+// getInstanceProfile returns the instance profile with the given name or nil
+// if it does not exist.
+func (e *external) getInstanceProfile(ctx context.Context, name string) (*awsiam.InstanceProfile, error) {
+	res, err := e.client.GetInstanceProfileRequest(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	}).Send(ctx)
+	if iam.IsErrorNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetInstanceProfile)
+	}
+	return res.InstanceProfile, nil
+}
+
+func (e *external) updateInlinePolicies(ctx context.Context, cr *v1beta1.IAMRole) error {
+	if cr.Spec.ForProvider.InlinePolicies == nil {
+		return nil
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.getInlinePolicies(ctx, name)
+	if err != nil {
+		return err
+	}
+	put, remove, err := iam.DiffInlinePolicies(cr.Spec.ForProvider.InlinePolicies, observed)
+	if err != nil {
+		return errors.Wrap(err, errUpdate)
+	}
+	for p, doc := range put {
+		if _, err := e.client.PutRolePolicyRequest(&awsiam.PutRolePolicyInput{
+			PolicyDocument: aws.String(doc),
+			PolicyName:     aws.String(p),
+			RoleName:       aws.String(name),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errPutInlinePolicy)
+		}
+	}
+	for _, p := range remove {
+		if _, err := e.client.DeleteRolePolicyRequest(&awsiam.DeleteRolePolicyInput{
+			PolicyName: aws.String(p),
+			RoleName:   aws.String(name),
+		}).Send(ctx); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteInlinePolicy)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateManagedPolicies(ctx context.Context, cr *v1beta1.IAMRole) error {
+	if cr.Spec.ForProvider.ManagedPolicyARNs == nil {
+		return nil
+	}
+	name := meta.GetExternalName(cr)
+	observed, err := e.getManagedPolicies(ctx, name)
+	if err != nil {
+		return err
+	}
+	attach, detach := iam.DiffManagedPolicies(cr.Spec.ForProvider.ManagedPolicyARNs, observed)
+	for _, arn := range attach {
+		if _, err := e.client.AttachRolePolicyRequest(&awsiam.AttachRolePolicyInput{
+			PolicyArn: aws.String(arn),
+			RoleName:  aws.String(name),
+		}).Send(ctx); err != nil {
+			return errors.Wrap(err, errAttachManagedPolicy)
+		}
+	}
+	for _, arn := range detach {
+		if _, err := e.client.DetachRolePolicyRequest(&awsiam.DetachRolePolicyInput{
+			PolicyArn: aws.String(arn),
+			RoleName:  aws.String(name),
+		}).Send(ctx); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDetachManagedPolicy)
+		}
+	}
+	return nil
+}
+
+// updateInstanceProfile creates an instance profile with the same name and
+// path as the role and adds the role to it.
+func (e *external) updateInstanceProfile(ctx context.Context, cr *v1beta1.IAMRole) error {
+	name := meta.GetExternalName(cr)
+	ip, err := e.getInstanceProfile(ctx, name)
+	if err != nil {
+		return err
+	}
+	if ip == nil {
+		res, err := e.client.CreateInstanceProfileRequest(&awsiam.CreateInstanceProfileInput{
+			InstanceProfileName: aws.String(name),
+			Path:                cr.Spec.ForProvider.Path,
+		}).Send(ctx)
+		if err != nil {
+			return errors.Wrap(err, errCreateInstanceProfile)
+		}
+		ip = res.InstanceProfile
+	}
+	if hasRole(ip, name) {
+		return nil
+	}
+	_, err = e.client.AddRoleToInstanceProfileRequest(&awsiam.AddRoleToInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		RoleName:            aws.String(name),
+	}).Send(ctx)
+	return errors.Wrap(err, errAddToInstanceProfile)
+}
+
+// deletePolicies deletes the inline policies and detaches the managed
+// policies that are managed by the IAMRole.
+func (e *external) deletePolicies(ctx context.Context, cr *v1beta1.IAMRole) error {
+	name := meta.GetExternalName(cr)
+	for p := range cr.Spec.ForProvider.InlinePolicies {
+		if _, err := e.client.DeleteRolePolicyRequest(&awsiam.DeleteRolePolicyInput{
+			PolicyName: aws.String(p),
+			RoleName:   aws.String(name),
+		}).Send(ctx); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDeleteInlinePolicy)
+		}
+	}
+	for _, arn := range cr.Spec.ForProvider.ManagedPolicyARNs {
+		if _, err := e.client.DetachRolePolicyRequest(&awsiam.DetachRolePolicyInput{
+			PolicyArn: aws.String(arn),
+			RoleName:  aws.String(name),
+		}).Send(ctx); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errors.Wrap(err, errDetachManagedPolicy)
+		}
+	}
+	return nil
+}
+
+func (e *external) deleteInstanceProfile(ctx context.Context, name string) error {
+	_, err := e.client.RemoveRoleFromInstanceProfileRequest(&awsiam.RemoveRoleFromInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+		RoleName:            aws.String(name),
+	}).Send(ctx)
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return errors.Wrap(err, errRemoveFromInstanceProfile)
+	}
+	_, err = e.client.DeleteInstanceProfileRequest(&awsiam.DeleteInstanceProfileInput{
+		InstanceProfileName: aws.String(name),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDeleteInstanceProfile)
+}
+
+func hasRole(ip *awsiam.InstanceProfile, name string) bool {
+	if ip == nil {
+		return false
+	}
+	for _, r := range ip.Roles {
+		if aws.StringValue(r.RoleName) == name {
+			return true
+		}
+	}
+	return false
+}
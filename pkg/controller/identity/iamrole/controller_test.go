@@ -19,6 +19,7 @@ package iamrole
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -57,6 +58,16 @@ var (
 		]
 	   }`
 
+	inlinePolicy       = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	inlinePolicyName   = "inline"
+	managedPolicyARN   = "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"
+	instanceProfileARN = "arn:aws:iam::123456789012:instance-profile/some-arbitrary-name"
+	trust              = v1beta1.ServiceAccountTrust{
+		OIDCProviderARN:    "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE",
+		Namespace:          "default",
+		ServiceAccountName: "app",
+	}
+
 	errBoom = errors.New("boom")
 )
 
@@ -91,6 +102,36 @@ func withDescription() roleModifier {
 	}
 }
 
+func withInlinePolicies(p map[string]string) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.InlinePolicies = p
+	}
+}
+
+func withManagedPolicyARNs(arns ...string) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.ManagedPolicyARNs = arns
+	}
+}
+
+func withInstanceProfile() roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.CreateInstanceProfile = aws.Bool(true)
+	}
+}
+
+func withInstanceProfileARN(arn string) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Status.AtProvider.InstanceProfileARN = arn
+	}
+}
+
+func withServiceAccountTrust(t v1beta1.ServiceAccountTrust) roleModifier {
+	return func(r *v1beta1.IAMRole) {
+		r.Spec.ForProvider.ServiceAccountTrust = &t
+	}
+}
+
 func role(m ...roleModifier) *v1beta1.IAMRole {
 	cr := &v1beta1.IAMRole{}
 	for _, f := range m {
@@ -134,6 +175,132 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InlinePolicyNotUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockListRolePoliciesRequest: func(input *awsiam.ListRolePoliciesInput) awsiam.ListRolePoliciesRequest {
+						return awsiam.ListRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListRolePoliciesOutput{
+								PolicyNames: []string{"other"},
+							}},
+						}
+					},
+					MockGetRolePolicyRequest: func(input *awsiam.GetRolePolicyInput) awsiam.GetRolePolicyRequest {
+						return awsiam.GetRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRolePolicyOutput{
+								PolicyDocument: aws.String(inlinePolicy),
+							}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy})),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PoliciesAndInstanceProfileUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockListRolePoliciesRequest: func(input *awsiam.ListRolePoliciesInput) awsiam.ListRolePoliciesRequest {
+						return awsiam.ListRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListRolePoliciesOutput{
+								PolicyNames: []string{inlinePolicyName},
+							}},
+						}
+					},
+					MockGetRolePolicyRequest: func(input *awsiam.GetRolePolicyInput) awsiam.GetRolePolicyRequest {
+						return awsiam.GetRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRolePolicyOutput{
+								PolicyDocument: aws.String(url.QueryEscape(inlinePolicy)),
+							}},
+						}
+					},
+					MockListAttachedRolePoliciesRequest: func(input *awsiam.ListAttachedRolePoliciesInput) awsiam.ListAttachedRolePoliciesRequest {
+						return awsiam.ListAttachedRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{{PolicyArn: aws.String(managedPolicyARN)}},
+							}},
+						}
+					},
+					MockGetInstanceProfileRequest: func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetInstanceProfileOutput{
+								InstanceProfile: &awsiam.InstanceProfile{
+									Arn:   aws.String(instanceProfileARN),
+									Roles: []awsiam.Role{{RoleName: aws.String(roleName)}},
+								},
+							}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withManagedPolicyARNs(managedPolicyARN), withInstanceProfile()),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withManagedPolicyARNs(managedPolicyARN),
+					withInstanceProfile(),
+					withInstanceProfileARN(instanceProfileARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InstanceProfileMissing": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockGetInstanceProfileRequest: func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInstanceProfile()),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withInstanceProfile(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -223,6 +390,28 @@ func TestCreate(t *testing.T) {
 					withConditions(xpv1.Creating())),
 			},
 		},
+		"ServiceAccountTrust": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockCreateRoleRequest: func(input *awsiam.CreateRoleInput) awsiam.CreateRoleRequest {
+						var err error
+						if doc, _ := iam.ServiceAccountAssumeRolePolicy(trust); aws.StringValue(input.AssumeRolePolicyDocument) != doc {
+							err = errBoom
+						}
+						return awsiam.CreateRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateRoleOutput{}, Error: err},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withServiceAccountTrust(trust)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withServiceAccountTrust(trust),
+					withConditions(xpv1.Creating())),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -302,6 +491,174 @@ func TestUpdate(t *testing.T) {
 				cr: role(withRoleName(&roleName)),
 			},
 		},
+		"ServiceAccountTrust": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{AssumeRolePolicyDocument: aws.String(policy)},
+							}},
+						}
+					},
+					MockUpdateAssumeRolePolicyRequest: func(input *awsiam.UpdateAssumeRolePolicyInput) awsiam.UpdateAssumeRolePolicyRequest {
+						var err error
+						if doc, _ := iam.ServiceAccountAssumeRolePolicy(trust); aws.StringValue(input.PolicyDocument) != doc {
+							err = errBoom
+						}
+						return awsiam.UpdateAssumeRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateAssumeRolePolicyOutput{}, Error: err},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withServiceAccountTrust(trust)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withServiceAccountTrust(trust)),
+			},
+		},
+		"UpdatePoliciesAndInstanceProfile": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockListRolePoliciesRequest: func(input *awsiam.ListRolePoliciesInput) awsiam.ListRolePoliciesRequest {
+						return awsiam.ListRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListRolePoliciesOutput{
+								PolicyNames: []string{"other"},
+							}},
+						}
+					},
+					MockGetRolePolicyRequest: func(input *awsiam.GetRolePolicyInput) awsiam.GetRolePolicyRequest {
+						return awsiam.GetRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRolePolicyOutput{
+								PolicyDocument: aws.String(inlinePolicy),
+							}},
+						}
+					},
+					MockPutRolePolicyRequest: func(input *awsiam.PutRolePolicyInput) awsiam.PutRolePolicyRequest {
+						return awsiam.PutRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.PutRolePolicyOutput{}},
+						}
+					},
+					MockDeleteRolePolicyRequest: func(input *awsiam.DeleteRolePolicyInput) awsiam.DeleteRolePolicyRequest {
+						return awsiam.DeleteRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteRolePolicyOutput{}},
+						}
+					},
+					MockListAttachedRolePoliciesRequest: func(input *awsiam.ListAttachedRolePoliciesInput) awsiam.ListAttachedRolePoliciesRequest {
+						return awsiam.ListAttachedRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{{PolicyArn: aws.String("other")}},
+							}},
+						}
+					},
+					MockAttachRolePolicyRequest: func(input *awsiam.AttachRolePolicyInput) awsiam.AttachRolePolicyRequest {
+						return awsiam.AttachRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AttachRolePolicyOutput{}},
+						}
+					},
+					MockDetachRolePolicyRequest: func(input *awsiam.DetachRolePolicyInput) awsiam.DetachRolePolicyRequest {
+						return awsiam.DetachRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DetachRolePolicyOutput{}},
+						}
+					},
+					MockGetInstanceProfileRequest: func(input *awsiam.GetInstanceProfileInput) awsiam.GetInstanceProfileRequest {
+						return awsiam.GetInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+					MockCreateInstanceProfileRequest: func(input *awsiam.CreateInstanceProfileInput) awsiam.CreateInstanceProfileRequest {
+						return awsiam.CreateInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateInstanceProfileOutput{InstanceProfile: &awsiam.InstanceProfile{}}},
+						}
+					},
+					MockAddRoleToInstanceProfileRequest: func(input *awsiam.AddRoleToInstanceProfileInput) awsiam.AddRoleToInstanceProfileRequest {
+						return awsiam.AddRoleToInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AddRoleToInstanceProfileOutput{}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withManagedPolicyARNs(managedPolicyARN), withInstanceProfile()),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withManagedPolicyARNs(managedPolicyARN), withInstanceProfile()),
+			},
+		},
+		"PutInlinePolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockListRolePoliciesRequest: func(input *awsiam.ListRolePoliciesInput) awsiam.ListRolePoliciesRequest {
+						return awsiam.ListRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListRolePoliciesOutput{
+								PolicyNames: []string{},
+							}},
+						}
+					},
+					MockGetRolePolicyRequest: func(input *awsiam.GetRolePolicyInput) awsiam.GetRolePolicyRequest {
+						return awsiam.GetRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRolePolicyOutput{
+								PolicyDocument: aws.String(inlinePolicy),
+							}},
+						}
+					},
+					MockPutRolePolicyRequest: func(input *awsiam.PutRolePolicyInput) awsiam.PutRolePolicyRequest {
+						return awsiam.PutRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy})),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy})),
+				err: errors.Wrap(errBoom, errPutInlinePolicy),
+			},
+		},
+		"AttachManagedPolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRoleRequest: func(input *awsiam.GetRoleInput) awsiam.GetRoleRequest {
+						return awsiam.GetRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetRoleOutput{
+								Role: &awsiam.Role{},
+							}},
+						}
+					},
+					MockListAttachedRolePoliciesRequest: func(input *awsiam.ListAttachedRolePoliciesInput) awsiam.ListAttachedRolePoliciesRequest {
+						return awsiam.ListAttachedRolePoliciesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiam.AttachedPolicy{},
+							}},
+						}
+					},
+					MockAttachRolePolicyRequest: func(input *awsiam.AttachRolePolicyInput) awsiam.AttachRolePolicyRequest {
+						return awsiam.AttachRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withManagedPolicyARNs(managedPolicyARN)),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withManagedPolicyARNs(managedPolicyARN)),
+				err: errors.Wrap(errBoom, errAttachManagedPolicy),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
@@ -409,6 +766,64 @@ func TestDelete(t *testing.T) {
 					withConditions(xpv1.Deleting())),
 			},
 		},
+		"DeletePoliciesAndInstanceProfile": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockDeleteRolePolicyRequest: func(input *awsiam.DeleteRolePolicyInput) awsiam.DeleteRolePolicyRequest {
+						return awsiam.DeleteRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteRolePolicyOutput{}},
+						}
+					},
+					MockDetachRolePolicyRequest: func(input *awsiam.DetachRolePolicyInput) awsiam.DetachRolePolicyRequest {
+						return awsiam.DetachRolePolicyRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DetachRolePolicyOutput{}},
+						}
+					},
+					MockRemoveRoleFromInstanceProfileRequest: func(input *awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+						return awsiam.RemoveRoleFromInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveRoleFromInstanceProfileOutput{}},
+						}
+					},
+					MockDeleteInstanceProfileRequest: func(input *awsiam.DeleteInstanceProfileInput) awsiam.DeleteInstanceProfileRequest {
+						return awsiam.DeleteInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteInstanceProfileOutput{}},
+						}
+					},
+					MockDeleteRoleRequest: func(input *awsiam.DeleteRoleInput) awsiam.DeleteRoleRequest {
+						return awsiam.DeleteRoleRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteRoleOutput{}},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withManagedPolicyARNs(managedPolicyARN), withInstanceProfile()),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withInlinePolicies(map[string]string{inlinePolicyName: inlinePolicy}),
+					withManagedPolicyARNs(managedPolicyARN), withInstanceProfile(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteInstanceProfileError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockRemoveRoleFromInstanceProfileRequest: func(input *awsiam.RemoveRoleFromInstanceProfileInput) awsiam.RemoveRoleFromInstanceProfileRequest {
+						return awsiam.RemoveRoleFromInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveRoleFromInstanceProfileOutput{}},
+						}
+					},
+					MockDeleteInstanceProfileRequest: func(input *awsiam.DeleteInstanceProfileInput) awsiam.DeleteInstanceProfileRequest {
+						return awsiam.DeleteInstanceProfileRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: role(withRoleName(&roleName), withInstanceProfile()),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withInstanceProfile(), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDeleteInstanceProfile),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpecedItem,
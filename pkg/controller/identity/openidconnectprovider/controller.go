@@ -0,0 +1,251 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openidconnectprovider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an OpenIDConnectProvider resource"
+
+	errGet              = "failed to get the OpenIDConnectProvider"
+	errCreate           = "failed to create the OpenIDConnectProvider"
+	errDelete           = "failed to delete the OpenIDConnectProvider"
+	errUpdateThumbprint = "failed to update the thumbprints of the OpenIDConnectProvider"
+	errAddClientID      = "failed to add a client ID to the OpenIDConnectProvider"
+	errRemoveClientID   = "failed to remove a client ID from the OpenIDConnectProvider"
+	errThumbprint       = "cannot compute the thumbprint of the OpenIDConnectProvider"
+	errKubeUpdateFailed = "cannot update OpenIDConnectProvider custom resource"
+)
+
+// SetupOpenIDConnectProvider adds a controller that reconciles
+// OpenIDConnectProviders.
+func SetupOpenIDConnectProvider(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.OpenIDConnectProviderGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.OpenIDConnectProvider{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.OpenIDConnectProviderGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewOpenIDConnectProviderClient}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(&clusterResolver{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// clusterResolver resolves the URL of an OpenIDConnectProvider from the
+// issuer of the referenced EKS Cluster. It lives here rather than in the API
+// package because the eks API package already depends on the identity one.
+type clusterResolver struct {
+	kube client.Client
+}
+
+func (r *clusterResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	existing := cr.DeepCopy()
+
+	rsp, err := reference.NewAPIResolver(r.kube, cr).Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: cr.Spec.ForProvider.URL,
+		Reference:    cr.Spec.ForProvider.ClusterRef,
+		Selector:     cr.Spec.ForProvider.ClusterSelector,
+		To:           reference.To{Managed: &eksv1beta1.Cluster{}, List: &eksv1beta1.ClusterList{}},
+		Extract:      eksv1beta1.ClusterOIDCIssuer(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.url")
+	}
+	cr.Spec.ForProvider.URL = rsp.ResolvedValue
+	cr.Spec.ForProvider.ClusterRef = rsp.ResolvedReference
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.OpenIDConnectProviderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, awscommon.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, thumbprint: iam.GetRootCAThumbprint}, nil
+}
+
+type external struct {
+	client     iam.OpenIDConnectProviderClient
+	kube       client.Client
+	thumbprint func(ctx context.Context, url string) (string, error)
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.client.GetOpenIDConnectProviderRequest(&awsiam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	iam.LateInitializeOpenIDConnectProvider(&cr.Spec.ForProvider, resp.GetOpenIDConnectProviderOutput)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = v1alpha1.OpenIDConnectProviderObservation{ARN: meta.GetExternalName(cr)}
+	if resp.CreateDate != nil {
+		cr.Status.AtProvider.CreateDate = &metav1.Time{Time: *resp.CreateDate}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsOpenIDConnectProviderUpToDate(cr.Spec.ForProvider, *resp.GetOpenIDConnectProviderOutput),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	// The thumbprint is late initialized from the provider once it exists.
+	thumbprints := cr.Spec.ForProvider.ThumbprintList
+	if len(thumbprints) == 0 {
+		t, err := e.thumbprint(ctx, cr.Spec.ForProvider.URL)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errThumbprint)
+		}
+		thumbprints = []string{t}
+	}
+
+	resp, err := e.client.CreateOpenIDConnectProviderRequest(&awsiam.CreateOpenIDConnectProviderInput{
+		ClientIDList:   cr.Spec.ForProvider.ClientIDList,
+		ThumbprintList: thumbprints,
+		Url:            aws.String(cr.Spec.ForProvider.URL),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.StringValue(resp.OpenIDConnectProviderArn))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(meta.GetExternalName(cr))
+	resp, err := e.client.GetOpenIDConnectProviderRequest(&awsiam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: arn,
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+
+	observed := resp.GetOpenIDConnectProviderOutput
+	if !iam.IsThumbprintListUpToDate(cr.Spec.ForProvider.ThumbprintList, observed.ThumbprintList) {
+		if _, err := e.client.UpdateOpenIDConnectProviderThumbprintRequest(&awsiam.UpdateOpenIDConnectProviderThumbprintInput{
+			OpenIDConnectProviderArn: arn,
+			ThumbprintList:           cr.Spec.ForProvider.ThumbprintList,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateThumbprint)
+		}
+	}
+
+	add, remove := iam.DiffClientIDs(cr.Spec.ForProvider.ClientIDList, observed.ClientIDList)
+	for _, id := range add {
+		if _, err := e.client.AddClientIDToOpenIDConnectProviderRequest(&awsiam.AddClientIDToOpenIDConnectProviderInput{
+			ClientID:                 aws.String(id),
+			OpenIDConnectProviderArn: arn,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddClientID)
+		}
+	}
+	for _, id := range remove {
+		if _, err := e.client.RemoveClientIDFromOpenIDConnectProviderRequest(&awsiam.RemoveClientIDFromOpenIDConnectProviderInput{
+			ClientID:                 aws.String(id),
+			OpenIDConnectProviderArn: arn,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveClientID)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.OpenIDConnectProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteOpenIDConnectProviderRequest(&awsiam.DeleteOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
@@ -0,0 +1,552 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openidconnectprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane/provider-aws/apis/identity/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+	providerArn   = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	url           = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	clientID      = "sts.amazonaws.com"
+	thumbprint    = "9e99a48a9960b14926bb7f3b02e22da2b0ab7280"
+	createDate    = time.Now().Truncate(time.Second)
+
+	errBoom = errors.New("boom")
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+var _ managed.ReferenceResolver = &clusterResolver{}
+
+type args struct {
+	iam        iam.OpenIDConnectProviderClient
+	kube       client.Client
+	thumbprint func(ctx context.Context, url string) (string, error)
+	cr         resource.Managed
+}
+
+type providerModifier func(*v1alpha1.OpenIDConnectProvider)
+
+func withConditions(c ...xpv1.Condition) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(s string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { meta.SetExternalName(r, s) }
+}
+
+func withURL(s string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Spec.ForProvider.URL = s }
+}
+
+func withClusterRef(name string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) {
+		r.Spec.ForProvider.ClusterRef = &xpv1.Reference{Name: name}
+	}
+}
+
+func withClientIDs(ids ...string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Spec.ForProvider.ClientIDList = ids }
+}
+
+func withThumbprints(t ...string) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Spec.ForProvider.ThumbprintList = t }
+}
+
+func withObservation(o v1alpha1.OpenIDConnectProviderObservation) providerModifier {
+	return func(r *v1alpha1.OpenIDConnectProvider) { r.Status.AtProvider = o }
+}
+
+func provider(m ...providerModifier) *v1alpha1.OpenIDConnectProvider {
+	cr := &v1alpha1.OpenIDConnectProvider{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getProvider(ids, thumbprints []string) func(*awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+	return func(input *awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+		return awsiam.GetOpenIDConnectProviderRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.GetOpenIDConnectProviderOutput{
+				ClientIDList:   ids,
+				CreateDate:     &createDate,
+				ThumbprintList: thumbprints,
+				Url:            aws.String("oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"),
+			}},
+		}
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ResolveFromCluster": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+						c, ok := obj.(*eksv1beta1.Cluster)
+						if !ok {
+							return errBoom
+						}
+						c.Status.AtProvider.Identity.OIDC.Issuer = url
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: provider(withClusterRef("cluster")),
+			},
+			want: want{
+				cr: provider(withClusterRef("cluster"), withURL(url)),
+			},
+		},
+		"IssuerNotReady": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: provider(withClusterRef("cluster")),
+			},
+			want: want{
+				cr:  provider(withClusterRef("cluster")),
+				err: errors.Wrap(errors.New("referenced field was empty (referenced resource may not yet be ready)"), "spec.forProvider.url"),
+			},
+		},
+		"URLAlreadySet": {
+			args: args{
+				kube: &test.MockClient{},
+				cr:   provider(withClusterRef("cluster"), withURL(url)),
+			},
+			want: want{
+				cr: provider(withClusterRef("cluster"), withURL(url)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &clusterResolver{kube: tc.kube}
+			err := r.ResolveReferences(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider([]string{clientID}, []string{thumbprint}),
+				},
+				cr: provider(withExternalName(providerArn), withURL(url), withClientIDs(clientID), withThumbprints(thumbprint)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn), withURL(url), withClientIDs(clientID), withThumbprints(thumbprint),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.OpenIDConnectProviderObservation{ARN: providerArn, CreateDate: &metav1.Time{Time: createDate}})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider([]string{clientID}, []string{thumbprint}),
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: provider(withExternalName(providerArn), withURL(url), withClientIDs(clientID)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn), withURL(url), withClientIDs(clientID), withThumbprints(thumbprint),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.OpenIDConnectProviderObservation{ARN: providerArn, CreateDate: &metav1.Time{Time: createDate}})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider([]string{"other"}, []string{thumbprint}),
+				},
+				cr: provider(withExternalName(providerArn), withURL(url), withClientIDs(clientID), withThumbprints(thumbprint)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn), withURL(url), withClientIDs(clientID), withThumbprints(thumbprint),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.OpenIDConnectProviderObservation{ARN: providerArn, CreateDate: &metav1.Time{Time: createDate}})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: provider(withURL(url)),
+			},
+			want: want{
+				cr: provider(withURL(url)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: func(input *awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+						return awsiam.GetOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: func(input *awsiam.GetOpenIDConnectProviderInput) awsiam.GetOpenIDConnectProviderRequest {
+						return awsiam.GetOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  provider(withExternalName(providerArn)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ComputedThumbprint": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockCreateOpenIDConnectProviderRequest: func(input *awsiam.CreateOpenIDConnectProviderInput) awsiam.CreateOpenIDConnectProviderRequest {
+						if diff := cmp.Diff([]string{thumbprint}, input.ThumbprintList); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awsiam.CreateOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.CreateOpenIDConnectProviderOutput{
+								OpenIDConnectProviderArn: aws.String(providerArn),
+							}},
+						}
+					},
+				},
+				thumbprint: func(_ context.Context, _ string) (string, error) { return thumbprint, nil },
+				cr:         provider(withURL(url), withClientIDs(clientID)),
+			},
+			want: want{
+				cr: provider(withURL(url), withClientIDs(clientID), withExternalName(providerArn),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"ThumbprintError": {
+			args: args{
+				thumbprint: func(_ context.Context, _ string) (string, error) { return "", errBoom },
+				cr:         provider(withURL(url)),
+			},
+			want: want{
+				cr:  provider(withURL(url), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errThumbprint),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockCreateOpenIDConnectProviderRequest: func(input *awsiam.CreateOpenIDConnectProviderInput) awsiam.CreateOpenIDConnectProviderRequest {
+						return awsiam.CreateOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: provider(withURL(url), withThumbprints(thumbprint)),
+			},
+			want: want{
+				cr:  provider(withURL(url), withThumbprints(thumbprint), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, thumbprint: tc.args.thumbprint}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateThumbprintsAndClientIDs": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider([]string{"other"}, []string{"other"}),
+					MockUpdateOpenIDConnectProviderThumbprintRequest: func(input *awsiam.UpdateOpenIDConnectProviderThumbprintInput) awsiam.UpdateOpenIDConnectProviderThumbprintRequest {
+						return awsiam.UpdateOpenIDConnectProviderThumbprintRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.UpdateOpenIDConnectProviderThumbprintOutput{}},
+						}
+					},
+					MockAddClientIDToOpenIDConnectProviderRequest: func(input *awsiam.AddClientIDToOpenIDConnectProviderInput) awsiam.AddClientIDToOpenIDConnectProviderRequest {
+						return awsiam.AddClientIDToOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.AddClientIDToOpenIDConnectProviderOutput{}},
+						}
+					},
+					MockRemoveClientIDFromOpenIDConnectProviderRequest: func(input *awsiam.RemoveClientIDFromOpenIDConnectProviderInput) awsiam.RemoveClientIDFromOpenIDConnectProviderRequest {
+						return awsiam.RemoveClientIDFromOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.RemoveClientIDFromOpenIDConnectProviderOutput{}},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn), withClientIDs(clientID), withThumbprints(thumbprint)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn), withClientIDs(clientID), withThumbprints(thumbprint)),
+			},
+		},
+		"AddClientIDError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockGetOpenIDConnectProviderRequest: getProvider(nil, []string{thumbprint}),
+					MockAddClientIDToOpenIDConnectProviderRequest: func(input *awsiam.AddClientIDToOpenIDConnectProviderInput) awsiam.AddClientIDToOpenIDConnectProviderRequest {
+						return awsiam.AddClientIDToOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn), withClientIDs(clientID), withThumbprints(thumbprint)),
+			},
+			want: want{
+				cr:  provider(withExternalName(providerArn), withClientIDs(clientID), withThumbprints(thumbprint)),
+				err: errors.Wrap(errBoom, errAddClientID),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpecedItem,
+			},
+			want: want{
+				cr:  unexpecedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockDeleteOpenIDConnectProviderRequest: func(input *awsiam.DeleteOpenIDConnectProviderInput) awsiam.DeleteOpenIDConnectProviderRequest {
+						return awsiam.DeleteOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awsiam.DeleteOpenIDConnectProviderOutput{}},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockDeleteOpenIDConnectProviderRequest: func(input *awsiam.DeleteOpenIDConnectProviderInput) awsiam.DeleteOpenIDConnectProviderRequest {
+						return awsiam.DeleteOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: awserr.New(awsiam.ErrCodeNoSuchEntityException, "", nil)},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: provider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockOpenIDConnectProviderClient{
+					MockDeleteOpenIDConnectProviderRequest: func(input *awsiam.DeleteOpenIDConnectProviderInput) awsiam.DeleteOpenIDConnectProviderRequest {
+						return awsiam.DeleteOpenIDConnectProviderRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom},
+						}
+					},
+				},
+				cr: provider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  provider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
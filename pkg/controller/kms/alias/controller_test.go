@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/kms/fake"
+)
+
+var (
+	aliasName = "my-key"
+	aliasARN  = "arn:aws:kms:us-east-1:111122223333:alias/my-key"
+	keyID     = "1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	errBoom = errors.New("boom")
+)
+
+type aliasModifier func(*v1alpha1.Alias)
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTargetKeyID(id string) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Spec.ForProvider.TargetKeyID = aws.String(id) }
+}
+
+func withStatus(s v1alpha1.AliasObservation) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.AtProvider = s }
+}
+
+func alias(m ...aliasModifier) *v1alpha1.Alias {
+	cr := &v1alpha1.Alias{}
+	meta.SetExternalName(cr, aliasName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Alias
+		result managed.ExternalObservation
+		err    error
+	}
+
+	list := func(err error) func(*awskms.ListAliasesInput) awskms.ListAliasesRequest {
+		return func(*awskms.ListAliasesInput) awskms.ListAliasesRequest {
+			return awskms.ListAliasesRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awskms.ListAliasesOutput{
+					Aliases: []awskms.AliasListEntry{{
+						AliasArn:    aws.String(aliasARN),
+						AliasName:   aws.String("alias/" + aliasName),
+						TargetKeyId: aws.String(keyID),
+					}},
+				}},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		cr *v1alpha1.Alias
+		want
+		err error
+	}{
+		"UpToDate": {
+			cr: alias(withTargetKeyID(keyID)),
+			want: want{
+				cr:     alias(withTargetKeyID(keyID), withStatus(v1alpha1.AliasObservation{AliasARN: aliasARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TargetChanged": {
+			cr: alias(withTargetKeyID("another-key")),
+			want: want{
+				cr:     alias(withTargetKeyID("another-key"), withStatus(v1alpha1.AliasObservation{AliasARN: aliasARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			cr: func() *v1alpha1.Alias {
+				cr := alias()
+				meta.SetExternalName(cr, "another-alias")
+				return cr
+			}(),
+			want: want{
+				cr: func() *v1alpha1.Alias {
+					cr := alias()
+					meta.SetExternalName(cr, "another-alias")
+					return cr
+				}(),
+			},
+		},
+		"ListFailed": {
+			cr:  alias(),
+			err: errBoom,
+			want: want{
+				cr:  alias(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockAliasClient{MockList: list(tc.err)}}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
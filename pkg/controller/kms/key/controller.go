@@ -0,0 +1,340 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/kms"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Key resource"
+	errKubeUpdateFailed = "cannot update Key custom resource"
+
+	errDescribe          = "failed to describe the Key resource"
+	errGetPolicy         = "failed to get the policy of the Key resource"
+	errGetRotation       = "failed to get the rotation status of the Key resource"
+	errListTags          = "failed to list tags for the Key resource"
+	errCreate            = "failed to create the Key resource"
+	errCancelDeletion    = "failed to cancel the deletion of the Key resource"
+	errUpdateDescription = "failed to update the description of the Key resource"
+	errEnable            = "failed to enable the Key resource"
+	errDisable           = "failed to disable the Key resource"
+	errUpdateRotation    = "failed to update the rotation status of the Key resource"
+	errComparePolicy     = "failed to compare the policy of the Key resource"
+	errPutPolicy         = "failed to put the policy of the Key resource"
+	errCreateTags        = "failed to create tags for the Key resource"
+	errRemoveTags        = "failed to remove tags for the Key resource"
+	errDelete            = "failed to schedule the deletion of the Key resource"
+)
+
+// SetupKey adds a controller that reconciles Keys.
+func SetupKey(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.KeyGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Key{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.KeyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: kms.NewKeyClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) kms.KeyClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Key)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client kms.KeyClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	rsp, err := e.client.DescribeKeyRequest(&awskms.DescribeKeyInput{
+		KeyId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(kms.IsNotFound, err), errDescribe)
+	}
+	if rsp.KeyMetadata == nil {
+		return managed.ExternalObservation{}, errors.New(errDescribe)
+	}
+	cr.Status.AtProvider = kms.GenerateKeyObservation(*rsp.KeyMetadata)
+
+	// A key is never deleted right away but scheduled for deletion, so we
+	// consider it gone once it is pending deletion. If the deletion was not
+	// requested by us, it is cancelled during the next update.
+	if rsp.KeyMetadata.KeyState == awskms.KeyStatePendingDeletion {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	o, err := e.observe(ctx, *rsp.KeyMetadata)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	kms.LateInitializeKey(&cr.Spec.ForProvider, o)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	switch rsp.KeyMetadata.KeyState {
+	case awskms.KeyStateEnabled, awskms.KeyStateDisabled:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, err := kms.IsKeyUpToDate(cr.Spec.ForProvider, o)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errComparePolicy)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := e.client.CreateKeyRequest(kms.GenerateCreateKeyInput(&cr.Spec.ForProvider)).Send(ctx)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	if rsp.KeyMetadata == nil {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+	meta.SetExternalName(cr, aws.StringValue(rsp.KeyMetadata.KeyId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Key)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+
+	rsp, err := e.client.DescribeKeyRequest(&awskms.DescribeKeyInput{KeyId: id}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+	if rsp.KeyMetadata == nil {
+		return managed.ExternalUpdate{}, errors.New(errDescribe)
+	}
+	m := *rsp.KeyMetadata
+
+	// A key whose deletion is cancelled is disabled.
+	if m.KeyState == awskms.KeyStatePendingDeletion {
+		if _, err := e.client.CancelKeyDeletionRequest(&awskms.CancelKeyDeletionInput{KeyId: id}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCancelDeletion)
+		}
+		m.KeyState = awskms.KeyStateDisabled
+		m.Enabled = aws.Bool(false)
+	}
+
+	o, err := e.observe(ctx, m)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if aws.StringValue(p.Description) != aws.StringValue(m.Description) {
+		if _, err := e.client.UpdateKeyDescriptionRequest(&awskms.UpdateKeyDescriptionInput{KeyId: id, Description: aws.String(aws.StringValue(p.Description))}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDescription)
+		}
+	}
+
+	enabled := p.Enabled == nil || aws.BoolValue(p.Enabled)
+	switch {
+	case enabled && m.KeyState == awskms.KeyStateDisabled:
+		if _, err := e.client.EnableKeyRequest(&awskms.EnableKeyInput{KeyId: id}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errEnable)
+		}
+		m.KeyState = awskms.KeyStateEnabled
+	case !enabled && m.KeyState == awskms.KeyStateEnabled:
+		if _, err := e.client.DisableKeyRequest(&awskms.DisableKeyInput{KeyId: id}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errDisable)
+		}
+		m.KeyState = awskms.KeyStateDisabled
+	}
+
+	// The rotation status can only be changed while the key is enabled.
+	if p.EnableKeyRotation != nil && kms.IsRotationSupported(m) && aws.BoolValue(p.EnableKeyRotation) != aws.BoolValue(o.RotationEnabled) {
+		var err error
+		if aws.BoolValue(p.EnableKeyRotation) {
+			_, err = e.client.EnableKeyRotationRequest(&awskms.EnableKeyRotationInput{KeyId: id}).Send(ctx)
+		} else {
+			_, err = e.client.DisableKeyRotationRequest(&awskms.DisableKeyRotationInput{KeyId: id}).Send(ctx)
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRotation)
+		}
+	}
+
+	upToDate, err := kms.IsPolicyUpToDate(p.Policy, o.Policy)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errComparePolicy)
+	}
+	if !upToDate {
+		if _, err := e.client.PutKeyPolicyRequest(&awskms.PutKeyPolicyInput{
+			KeyId:                          id,
+			PolicyName:                     aws.String(kms.DefaultPolicyName),
+			Policy:                         p.Policy,
+			BypassPolicyLockoutSafetyCheck: p.BypassPolicyLockoutSafetyCheck,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutPolicy)
+		}
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, id, p.Tags, o.Tags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Key)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.KeyState == string(awskms.KeyStatePendingDeletion) {
+		return nil
+	}
+	_, err := e.client.ScheduleKeyDeletionRequest(&awskms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(meta.GetExternalName(cr)),
+		PendingWindowInDays: cr.Spec.ForProvider.PendingWindowInDays,
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(kms.IsNotFound, err), errDelete)
+}
+
+// observe collects the parts of the state of the given key that are not
+// reported by DescribeKey.
+func (e *external) observe(ctx context.Context, m awskms.KeyMetadata) (kms.ObservedKey, error) {
+	o := kms.ObservedKey{Metadata: m}
+
+	policy, err := e.client.GetKeyPolicyRequest(&awskms.GetKeyPolicyInput{KeyId: m.KeyId, PolicyName: aws.String(kms.DefaultPolicyName)}).Send(ctx)
+	if err != nil {
+		return o, errors.Wrap(err, errGetPolicy)
+	}
+	o.Policy = policy.Policy
+
+	if kms.IsRotationSupported(m) {
+		rotation, err := e.client.GetKeyRotationStatusRequest(&awskms.GetKeyRotationStatusInput{KeyId: m.KeyId}).Send(ctx)
+		if err != nil {
+			return o, errors.Wrap(err, errGetRotation)
+		}
+		o.RotationEnabled = rotation.KeyRotationEnabled
+	}
+
+	tags, err := e.client.ListResourceTagsRequest(&awskms.ListResourceTagsInput{KeyId: m.KeyId}).Send(ctx)
+	if err != nil {
+		return o, errors.Wrap(err, errListTags)
+	}
+	o.Tags = kms.GenerateTags(tags.Tags)
+	return o, nil
+}
+
+func (e *external) updateTags(ctx context.Context, id *string, desired, current map[string]string) error {
+	add, remove := awsclients.DiffTags(desired, current)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceRequest(&awskms.UntagResourceInput{KeyId: id, TagKeys: remove}).Send(ctx); err != nil {
+			return errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		tags := make([]awskms.Tag, 0, len(add))
+		for k, v := range add {
+			tags = append(tags, awskms.Tag{TagKey: aws.String(k), TagValue: aws.String(v)})
+		}
+		if _, err := e.client.TagResourceRequest(&awskms.TagResourceInput{KeyId: id, Tags: tags}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Key)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	for k, v := range resource.GetExternalTags(mgd) {
+		cr.Spec.ForProvider.Tags[k] = v
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
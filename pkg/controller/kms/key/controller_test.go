@@ -0,0 +1,414 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/kms/fake"
+)
+
+var (
+	keyID  = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	keyARN = "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	policy = `{"Version":"2012-10-17","Statement":[]}`
+
+	deletionTime = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type keyModifier func(*v1alpha1.Key)
+
+func withExternalName(name string) keyModifier {
+	return func(r *v1alpha1.Key) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) keyModifier {
+	return func(r *v1alpha1.Key) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.KeyParameters) keyModifier {
+	return func(r *v1alpha1.Key) { r.Spec.ForProvider = p }
+}
+
+func withState(s awskms.KeyState) keyModifier {
+	return func(r *v1alpha1.Key) {
+		r.Status.AtProvider = v1alpha1.KeyObservation{ARN: keyARN, KeyID: keyID, KeyState: string(s)}
+	}
+}
+
+func withDeletionTimestamp() keyModifier {
+	return func(r *v1alpha1.Key) {
+		r.SetDeletionTimestamp(&deletionTime)
+	}
+}
+
+func key(m ...keyModifier) *v1alpha1.Key {
+	cr := &v1alpha1.Key{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.KeyParameters {
+	return v1alpha1.KeyParameters{
+		Enabled:           aws.Bool(true),
+		EnableKeyRotation: aws.Bool(true),
+		Policy:            aws.String(policy),
+	}
+}
+
+func describe(s awskms.KeyState, err error) func(*awskms.DescribeKeyInput) awskms.DescribeKeyRequest {
+	return func(*awskms.DescribeKeyInput) awskms.DescribeKeyRequest {
+		return awskms.DescribeKeyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: err, Data: &awskms.DescribeKeyOutput{
+				KeyMetadata: &awskms.KeyMetadata{
+					Arn:      aws.String(keyARN),
+					KeyId:    aws.String(keyID),
+					KeyState: s,
+					Enabled:  aws.Bool(s == awskms.KeyStateEnabled),
+				},
+			}},
+		}
+	}
+}
+
+func getPolicy(*awskms.GetKeyPolicyInput) awskms.GetKeyPolicyRequest {
+	return awskms.GetKeyPolicyRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.GetKeyPolicyOutput{Policy: aws.String(policy)}},
+	}
+}
+
+func getRotationStatus(*awskms.GetKeyRotationStatusInput) awskms.GetKeyRotationStatusRequest {
+	return awskms.GetKeyRotationStatusRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.GetKeyRotationStatusOutput{KeyRotationEnabled: aws.Bool(true)}},
+	}
+}
+
+func listTags(*awskms.ListResourceTagsInput) awskms.ListResourceTagsRequest {
+	return awskms.ListResourceTagsRequest{
+		Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.ListResourceTagsOutput{}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Key
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockKeyClient
+		cr     *v1alpha1.Key
+		want
+	}{
+		"NoExternalName": {
+			client: &fake.MockKeyClient{},
+			cr:     key(),
+			want: want{
+				cr: key(),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockKeyClient{
+				MockDescribe:          describe(awskms.KeyStateEnabled, nil),
+				MockGetPolicy:         getPolicy,
+				MockGetRotationStatus: getRotationStatus,
+				MockListTags:          listTags,
+			},
+			cr: key(withExternalName(keyID), withSpec(params())),
+			want: want{
+				cr:     key(withExternalName(keyID), withSpec(params()), withState(awskms.KeyStateEnabled), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Disabled": {
+			client: &fake.MockKeyClient{
+				MockDescribe:  describe(awskms.KeyStateDisabled, nil),
+				MockGetPolicy: getPolicy,
+				MockListTags:  listTags,
+			},
+			cr: key(withExternalName(keyID), withSpec(params())),
+			want: want{
+				cr:     key(withExternalName(keyID), withSpec(params()), withState(awskms.KeyStateDisabled), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"PendingDeletion": {
+			client: &fake.MockKeyClient{
+				MockDescribe: describe(awskms.KeyStatePendingDeletion, nil),
+			},
+			cr: key(withExternalName(keyID), withSpec(params())),
+			want: want{
+				cr:     key(withExternalName(keyID), withSpec(params()), withState(awskms.KeyStatePendingDeletion), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			client: &fake.MockKeyClient{
+				MockDescribe: describe(awskms.KeyStatePendingDeletion, nil),
+			},
+			cr: key(withExternalName(keyID), withDeletionTimestamp()),
+			want: want{
+				cr:     key(withExternalName(keyID), withDeletionTimestamp(), withState(awskms.KeyStatePendingDeletion)),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockKeyClient{
+				MockDescribe: describe("", awserr.New(awskms.ErrCodeNotFoundException, "", nil)),
+			},
+			cr: key(withExternalName(keyID)),
+			want: want{
+				cr: key(withExternalName(keyID)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockKeyClient{
+				MockDescribe: describe("", errBoom),
+			},
+			cr: key(withExternalName(keyID)),
+			want: want{
+				cr:  key(withExternalName(keyID)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: &test.MockClient{MockUpdate: test.NewMockClient().Update}}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Key
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockKeyClient
+		cr     *v1alpha1.Key
+		want
+	}{
+		"Successful": {
+			client: &fake.MockKeyClient{
+				MockCreate: func(*awskms.CreateKeyInput) awskms.CreateKeyRequest {
+					return awskms.CreateKeyRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.CreateKeyOutput{
+							KeyMetadata: &awskms.KeyMetadata{KeyId: aws.String(keyID)},
+						}},
+					}
+				},
+			},
+			cr: key(),
+			want: want{
+				cr:     key(withExternalName(keyID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockKeyClient{
+				MockCreate: func(*awskms.CreateKeyInput) awskms.CreateKeyRequest {
+					return awskms.CreateKeyRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+					}
+				},
+			},
+			cr: key(),
+			want: want{
+				cr:  key(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type calls struct {
+		cancel, enable, rotation bool
+	}
+
+	cases := map[string]struct {
+		state awskms.KeyState
+		want  calls
+	}{
+		"Enable": {
+			state: awskms.KeyStateDisabled,
+			want:  calls{enable: true, rotation: true},
+		},
+		"CancelDeletion": {
+			state: awskms.KeyStatePendingDeletion,
+			want:  calls{cancel: true, enable: true, rotation: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := calls{}
+			client := &fake.MockKeyClient{
+				MockDescribe:  describe(tc.state, nil),
+				MockGetPolicy: getPolicy,
+				MockListTags:  listTags,
+				MockCancelDeletion: func(*awskms.CancelKeyDeletionInput) awskms.CancelKeyDeletionRequest {
+					got.cancel = true
+					return awskms.CancelKeyDeletionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.CancelKeyDeletionOutput{}},
+					}
+				},
+				MockEnable: func(*awskms.EnableKeyInput) awskms.EnableKeyRequest {
+					got.enable = true
+					return awskms.EnableKeyRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.EnableKeyOutput{}},
+					}
+				},
+				MockEnableRotation: func(*awskms.EnableKeyRotationInput) awskms.EnableKeyRotationRequest {
+					got.rotation = true
+					return awskms.EnableKeyRotationRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.EnableKeyRotationOutput{}},
+					}
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), key(withExternalName(keyID), withSpec(params())))
+
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Key
+		err error
+	}
+
+	scheduleDeletion := func(err error) func(*awskms.ScheduleKeyDeletionInput) awskms.ScheduleKeyDeletionRequest {
+		return func(*awskms.ScheduleKeyDeletionInput) awskms.ScheduleKeyDeletionRequest {
+			return awskms.ScheduleKeyDeletionRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awskms.ScheduleKeyDeletionOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockKeyClient
+		cr     *v1alpha1.Key
+		want
+	}{
+		"Successful": {
+			client: &fake.MockKeyClient{MockScheduleDeletion: scheduleDeletion(nil)},
+			cr:     key(withExternalName(keyID), withState(awskms.KeyStateEnabled)),
+			want: want{
+				cr: key(withExternalName(keyID), withState(awskms.KeyStateEnabled), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyPendingDeletion": {
+			client: &fake.MockKeyClient{},
+			cr:     key(withExternalName(keyID), withState(awskms.KeyStatePendingDeletion)),
+			want: want{
+				cr: key(withExternalName(keyID), withState(awskms.KeyStatePendingDeletion), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockKeyClient{MockScheduleDeletion: scheduleDeletion(awserr.New(awskms.ErrCodeNotFoundException, "", nil))},
+			cr:     key(withExternalName(keyID)),
+			want: want{
+				cr: key(withExternalName(keyID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockKeyClient{MockScheduleDeletion: scheduleDeletion(errBoom)},
+			cr:     key(withExternalName(keyID)),
+			want: want{
+				cr:  key(withExternalName(keyID), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
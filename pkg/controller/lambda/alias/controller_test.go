@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	aliasName = "live"
+	aliasARN  = "arn:aws:lambda:us-east-1:123456789012:function:fn:live"
+
+	errBoom = errors.New("boom")
+)
+
+type aliasModifier func(*v1alpha1.Alias)
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withVersion(v string) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Spec.ForProvider.FunctionVersion = v }
+}
+
+func withStatus(s v1alpha1.AliasObservation) aliasModifier {
+	return func(r *v1alpha1.Alias) { r.Status.AtProvider = s }
+}
+
+func alias(m ...aliasModifier) *v1alpha1.Alias {
+	cr := &v1alpha1.Alias{Spec: v1alpha1.AliasSpec{ForProvider: v1alpha1.AliasParameters{
+		FunctionName:    aws.String("fn"),
+		FunctionVersion: "1",
+		Description:     aws.String(""),
+	}}}
+	meta.SetExternalName(cr, aliasName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Alias
+		result managed.ExternalObservation
+		err    error
+	}
+
+	getAlias := func(o *awslambda.GetAliasOutput, err error) func(*awslambda.GetAliasInput) awslambda.GetAliasRequest {
+		return func(*awslambda.GetAliasInput) awslambda.GetAliasRequest {
+			return awslambda.GetAliasRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: o, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client lambda.AliasClient
+		cr     *v1alpha1.Alias
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockAliasClient{MockGet: getAlias(&awslambda.GetAliasOutput{
+				AliasArn:        aws.String(aliasARN),
+				FunctionVersion: aws.String("1"),
+			}, nil)},
+			cr: alias(),
+			want: want{
+				cr:     alias(withStatus(v1alpha1.AliasObservation{AliasARN: aliasARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"VersionChanged": {
+			client: &fake.MockAliasClient{MockGet: getAlias(&awslambda.GetAliasOutput{
+				AliasArn:        aws.String(aliasARN),
+				FunctionVersion: aws.String("1"),
+			}, nil)},
+			cr: alias(withVersion("2")),
+			want: want{
+				cr:     alias(withVersion("2"), withStatus(v1alpha1.AliasObservation{AliasARN: aliasARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockAliasClient{MockGet: getAlias(nil, awserr.New(lambda.ResourceNotFound, "", nil))},
+			cr:     alias(),
+			want: want{
+				cr: alias(),
+			},
+		},
+		"GetFailed": {
+			client: &fake.MockAliasClient{MockGet: getAlias(nil, errBoom)},
+			cr:     alias(),
+			want: want{
+				cr:  alias(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: &test.MockClient{MockUpdate: test.NewMockClient().Update}}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsourcemapping
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	uuid        = "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:fn"
+	queueARN    = "arn:aws:sqs:us-east-1:123456789012:queue"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	lambda lambda.EventSourceMappingClient
+	kube   client.Client
+	cr     *v1alpha1.EventSourceMapping
+}
+
+type mappingModifier func(*v1alpha1.EventSourceMapping)
+
+func withExternalName(name string) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.EventSourceMappingParameters) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.EventSourceMappingObservation) mappingModifier {
+	return func(r *v1alpha1.EventSourceMapping) { r.Status.AtProvider = s }
+}
+
+func mapping(m ...mappingModifier) *v1alpha1.EventSourceMapping {
+	cr := &v1alpha1.EventSourceMapping{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EventSourceMapping
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: mapping(),
+			},
+			want: want{
+				cr: mapping(),
+			},
+		},
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockClient().Update},
+				lambda: &fake.MockEventSourceMappingClient{
+					MockGet: func(input *awslambda.GetEventSourceMappingInput) awslambda.GetEventSourceMappingRequest {
+						return awslambda.GetEventSourceMappingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.GetEventSourceMappingOutput{
+								UUID:           aws.String(uuid),
+								FunctionArn:    aws.String(functionARN),
+								EventSourceArn: aws.String(queueARN),
+								BatchSize:      aws.Int64(10),
+								State:          aws.String(lambda.EventSourceMappingStateEnabled),
+							}},
+						}
+					},
+				},
+				cr: mapping(withExternalName(uuid), withSpec(v1alpha1.EventSourceMappingParameters{
+					FunctionName:   aws.String("fn"),
+					EventSourceARN: aws.String(queueARN),
+				})),
+			},
+			want: want{
+				cr: mapping(withExternalName(uuid), withSpec(v1alpha1.EventSourceMappingParameters{
+					FunctionName:   aws.String("fn"),
+					EventSourceARN: aws.String(queueARN),
+					BatchSize:      aws.Int64(10),
+					Enabled:        aws.Bool(true),
+				}), withStatus(v1alpha1.EventSourceMappingObservation{
+					FunctionARN: functionARN,
+					State:       lambda.EventSourceMappingStateEnabled,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"GetFailed": {
+			args: args{
+				lambda: &fake.MockEventSourceMappingClient{
+					MockGet: func(input *awslambda.GetEventSourceMappingInput) awslambda.GetEventSourceMappingRequest {
+						return awslambda.GetEventSourceMappingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: mapping(withExternalName(uuid)),
+			},
+			want: want{
+				cr:  mapping(withExternalName(uuid)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.EventSourceMapping
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockEventSourceMappingClient{
+					MockCreate: func(input *awslambda.CreateEventSourceMappingInput) awslambda.CreateEventSourceMappingRequest {
+						return awslambda.CreateEventSourceMappingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.CreateEventSourceMappingOutput{
+								UUID: aws.String(uuid),
+							}},
+						}
+					},
+				},
+				cr: mapping(),
+			},
+			want: want{
+				cr:     mapping(withExternalName(uuid), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				lambda: &fake.MockEventSourceMappingClient{
+					MockCreate: func(input *awslambda.CreateEventSourceMappingInput) awslambda.CreateEventSourceMappingRequest {
+						return awslambda.CreateEventSourceMappingRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: mapping(),
+			},
+			want: want{
+				cr:  mapping(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Function resource"
+	errKubeUpdateFailed = "cannot update Function custom resource"
+
+	errGet                 = "failed to get the Function resource"
+	errCreate              = "failed to create the Function resource"
+	errUpdateCode          = "failed to update the code of the Function resource"
+	errUpdateConfiguration = "failed to update the configuration of the Function resource"
+	errDelete              = "failed to delete the Function resource"
+	errCreateTags          = "failed to create tags for the Function resource"
+	errRemoveTags          = "failed to remove tags for the Function resource"
+	errNoCode              = "either an S3 object or a container image must be given as code"
+)
+
+// SetupFunction adds a controller that reconciles Functions.
+func SetupFunction(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.FunctionGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Function{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FunctionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: lambda.NewFunctionClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) lambda.FunctionClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Function)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client lambda.FunctionClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.GetFunctionRequest(&awslambda.GetFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	})
+	ic := lambda.ImageCodeFrom(req.Request)
+	rsp, err := req.Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(lambda.IsNotFound, err), errGet)
+	}
+	if rsp.Configuration == nil {
+		return managed.ExternalObservation{}, errors.New(errGet)
+	}
+	observed := *rsp.Configuration
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lambda.LateInitializeFunction(&cr.Spec.ForProvider, &observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	applied := cr.Status.AtProvider.LastAppliedCode
+	cr.Status.AtProvider = lambda.GenerateFunctionObservation(observed, *ic)
+	cr.Status.AtProvider.LastAppliedCode = applied
+
+	switch observed.State {
+	case awslambda.StatePending:
+		cr.SetConditions(xpv1.Creating())
+	case awslambda.StateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// Lambda rejects any update of a function that is still being created or
+	// updated, so we consider it up to date until it settles.
+	if observed.State == awslambda.StatePending || observed.LastUpdateStatus == awslambda.LastUpdateStatusInProgress {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, rsp.Tags)
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: len(add) == 0 && len(remove) == 0 &&
+			lambda.IsFunctionCodeUpToDate(cr.Spec.ForProvider, observed, *ic, applied) &&
+			lambda.IsFunctionConfigurationUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	uri := lambda.GenerateImageURI(cr.Spec.ForProvider.Code)
+	if uri == "" && cr.Spec.ForProvider.Code.S3Bucket == nil {
+		return managed.ExternalCreation{}, errors.New(errNoCode)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	req := e.client.CreateFunctionRequest(lambda.GenerateCreateFunctionInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	if uri != "" {
+		lambda.WithImageURI(req.Request, uri)
+	}
+	if _, err := req.Send(ctx); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.LastAppliedCode = lambda.GenerateFunctionCodeLocation(cr.Spec.ForProvider.Code)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.GetFunctionRequest(&awslambda.GetFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	})
+	ic := lambda.ImageCodeFrom(req.Request)
+	rsp, err := req.Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	if rsp.Configuration == nil {
+		return managed.ExternalUpdate{}, errors.New(errGet)
+	}
+
+	if err := e.updateTags(ctx, cr, rsp.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Lambda rejects an update while another one is in progress, so we update
+	// the code and the configuration in separate reconciles.
+	if !lambda.IsFunctionCodeUpToDate(cr.Spec.ForProvider, *rsp.Configuration, *ic, cr.Status.AtProvider.LastAppliedCode) {
+		req := e.client.UpdateFunctionCodeRequest(lambda.GenerateUpdateFunctionCodeInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+		if uri := lambda.GenerateImageURI(cr.Spec.ForProvider.Code); uri != "" {
+			lambda.WithImageURI(req.Request, uri)
+		}
+		if _, err := req.Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCode)
+		}
+		cr.Status.AtProvider.LastAppliedCode = lambda.GenerateFunctionCodeLocation(cr.Spec.ForProvider.Code)
+		return managed.ExternalUpdate{}, nil
+	}
+
+	if !lambda.IsFunctionConfigurationUpToDate(cr.Spec.ForProvider, *rsp.Configuration) {
+		_, err := e.client.UpdateFunctionConfigurationRequest(lambda.GenerateUpdateFunctionConfigurationInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)).Send(ctx)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateConfiguration)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteFunctionRequest(&awslambda.DeleteFunctionInput{
+		FunctionName: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(lambda.IsNotFound, err), errDelete)
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha1.Function, current map[string]string) error {
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, current)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceRequest(&awslambda.UntagResourceInput{Resource: aws.String(cr.Status.AtProvider.FunctionARN), TagKeys: remove}).Send(ctx); err != nil {
+			return errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.TagResourceRequest(&awslambda.TagResourceInput{Resource: aws.String(cr.Status.AtProvider.FunctionARN), Tags: add}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Function)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	for k, v := range resource.GetExternalTags(mgd) {
+		cr.Spec.ForProvider.Tags[k] = v
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
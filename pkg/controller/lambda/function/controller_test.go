@@ -0,0 +1,493 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package function
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	functionName = "fn"
+	functionARN  = "arn:aws:lambda:us-east-1:123456789012:function:fn"
+	bucket       = "bucket"
+	key          = "code.zip"
+	handler      = "main.handler"
+	oldSHA256    = "b2xk"
+	newSHA256    = "bmV3"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	lambda lambda.FunctionClient
+	kube   client.Client
+	cr     *v1alpha1.Function
+}
+
+type functionModifier func(*v1alpha1.Function)
+
+func withExternalName(name string) functionModifier {
+	return func(r *v1alpha1.Function) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.FunctionParameters) functionModifier {
+	return func(r *v1alpha1.Function) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s v1alpha1.FunctionObservation) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.AtProvider = s }
+}
+
+func function(m ...functionModifier) *v1alpha1.Function {
+	cr := &v1alpha1.Function{}
+	meta.SetExternalName(cr, functionName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(sha *string) v1alpha1.FunctionParameters {
+	return v1alpha1.FunctionParameters{
+		Code:    v1alpha1.FunctionCode{S3Bucket: aws.String(bucket), S3Key: aws.String(key), SHA256: sha},
+		Handler: aws.String(handler),
+	}
+}
+
+func applied() *v1alpha1.FunctionCodeLocation {
+	return &v1alpha1.FunctionCodeLocation{S3Bucket: bucket, S3Key: key}
+}
+
+func withLastAppliedCode(l *v1alpha1.FunctionCodeLocation) functionModifier {
+	return func(r *v1alpha1.Function) { r.Status.AtProvider.LastAppliedCode = l }
+}
+
+func getFunction(c *awslambda.FunctionConfiguration, err error) func(*awslambda.GetFunctionInput) awslambda.GetFunctionRequest {
+	return func(*awslambda.GetFunctionInput) awslambda.GetFunctionRequest {
+		return awslambda.GetFunctionRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.GetFunctionOutput{Configuration: c}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Function
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockClient().Update},
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						FunctionArn: aws.String(functionARN),
+						Handler:     aws.String(handler),
+						CodeSha256:  aws.String(oldSHA256),
+						State:       awslambda.StateActive,
+					}, nil),
+				},
+				cr: function(withSpec(params(aws.String(oldSHA256))), withLastAppliedCode(applied())),
+			},
+			want: want{
+				cr: function(withSpec(params(aws.String(oldSHA256))),
+					withStatus(v1alpha1.FunctionObservation{
+						FunctionARN:     functionARN,
+						CodeSHA256:      oldSHA256,
+						PackageType:     v1alpha1.PackageTypeZip,
+						State:           string(awslambda.StateActive),
+						LastAppliedCode: applied(),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CodeLocationChanged": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockClient().Update},
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						FunctionArn: aws.String(functionARN),
+						Handler:     aws.String(handler),
+						CodeSha256:  aws.String(oldSHA256),
+						State:       awslambda.StateActive,
+					}, nil),
+				},
+				cr: function(withSpec(params(nil)), withLastAppliedCode(&v1alpha1.FunctionCodeLocation{S3Bucket: bucket, S3Key: "old.zip"})),
+			},
+			want: want{
+				cr: function(withSpec(params(nil)),
+					withStatus(v1alpha1.FunctionObservation{
+						FunctionARN:     functionARN,
+						CodeSHA256:      oldSHA256,
+						PackageType:     v1alpha1.PackageTypeZip,
+						State:           string(awslambda.StateActive),
+						LastAppliedCode: &v1alpha1.FunctionCodeLocation{S3Bucket: bucket, S3Key: "old.zip"},
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"CodeChanged": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockClient().Update},
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						FunctionArn: aws.String(functionARN),
+						Handler:     aws.String(handler),
+						CodeSha256:  aws.String(oldSHA256),
+						State:       awslambda.StateActive,
+					}, nil),
+				},
+				cr: function(withSpec(params(aws.String(newSHA256)))),
+			},
+			want: want{
+				cr: function(withSpec(params(aws.String(newSHA256))),
+					withStatus(v1alpha1.FunctionObservation{
+						FunctionARN: functionARN,
+						CodeSHA256:  oldSHA256,
+						PackageType: v1alpha1.PackageTypeZip,
+						State:       string(awslambda.StateActive),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"UpdateInProgress": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockClient().Update},
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						FunctionArn:      aws.String(functionARN),
+						Handler:          aws.String(handler),
+						CodeSha256:       aws.String(oldSHA256),
+						State:            awslambda.StateActive,
+						LastUpdateStatus: awslambda.LastUpdateStatusInProgress,
+					}, nil),
+				},
+				cr: function(withSpec(params(aws.String(newSHA256)))),
+			},
+			want: want{
+				cr: function(withSpec(params(aws.String(newSHA256))),
+					withStatus(v1alpha1.FunctionObservation{
+						FunctionARN:      functionARN,
+						CodeSHA256:       oldSHA256,
+						PackageType:      v1alpha1.PackageTypeZip,
+						State:            string(awslambda.StateActive),
+						LastUpdateStatus: string(awslambda.LastUpdateStatusInProgress),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(nil, awserr.New(lambda.ResourceNotFound, "", nil)),
+				},
+				cr: function(withSpec(params(nil))),
+			},
+			want: want{
+				cr: function(withSpec(params(nil))),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(nil, errBoom),
+				},
+				cr: function(withSpec(params(nil))),
+			},
+			want: want{
+				cr:  function(withSpec(params(nil))),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Function
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockCreate: func(input *awslambda.CreateFunctionInput) awslambda.CreateFunctionRequest {
+						return awslambda.CreateFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.CreateFunctionOutput{}},
+						}
+					},
+				},
+				cr: function(withSpec(params(nil))),
+			},
+			want: want{
+				cr: function(withSpec(params(nil)), withLastAppliedCode(applied()), withConditions(xpv1.Creating())),
+			},
+		},
+		"NoCode": {
+			args: args{
+				cr: function(),
+			},
+			want: want{
+				cr:  function(),
+				err: errors.New(errNoCode),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockCreate: func(input *awslambda.CreateFunctionInput) awslambda.CreateFunctionRequest {
+						return awslambda.CreateFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(withSpec(params(nil))),
+			},
+			want: want{
+				cr:  function(withSpec(params(nil)), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateCode": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						Handler:    aws.String("old.handler"),
+						CodeSha256: aws.String(oldSHA256),
+					}, nil),
+					MockUpdateCode: func(input *awslambda.UpdateFunctionCodeInput) awslambda.UpdateFunctionCodeRequest {
+						return awslambda.UpdateFunctionCodeRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.UpdateFunctionCodeOutput{}},
+						}
+					},
+				},
+				cr: function(withSpec(params(aws.String(newSHA256)))),
+			},
+		},
+		"UpdateConfiguration": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						Handler:    aws.String("old.handler"),
+						CodeSha256: aws.String(oldSHA256),
+					}, nil),
+					MockUpdateConfiguration: func(input *awslambda.UpdateFunctionConfigurationInput) awslambda.UpdateFunctionConfigurationRequest {
+						return awslambda.UpdateFunctionConfigurationRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(withSpec(params(aws.String(oldSHA256))), withLastAppliedCode(applied())),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateConfiguration),
+			},
+		},
+		"UpdateTags": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockGet: getFunction(&awslambda.FunctionConfiguration{
+						Handler:    aws.String(handler),
+						CodeSha256: aws.String(oldSHA256),
+					}, nil),
+					MockTag: func(input *awslambda.TagResourceInput) awslambda.TagResourceRequest {
+						return awslambda.TagResourceRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(withSpec(v1alpha1.FunctionParameters{
+					Handler: aws.String(handler),
+					Tags:    map[string]string{"k": "v"},
+				})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockDelete: func(input *awslambda.DeleteFunctionInput) awslambda.DeleteFunctionRequest {
+						return awslambda.DeleteFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.DeleteFunctionOutput{}},
+						}
+					},
+				},
+				cr: function(),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockDelete: func(input *awslambda.DeleteFunctionInput) awslambda.DeleteFunctionRequest {
+						return awslambda.DeleteFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(lambda.ResourceNotFound, "", nil)},
+						}
+					},
+				},
+				cr: function(),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				lambda: &fake.MockFunctionClient{
+					MockDelete: func(input *awslambda.DeleteFunctionInput) awslambda.DeleteFunctionRequest {
+						return awslambda.DeleteFunctionRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: function(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.lambda}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInitialize(t *testing.T) {
+	cr := function(withSpec(v1alpha1.FunctionParameters{Tags: map[string]string{"k": "v"}}))
+	tg := &tagger{kube: &test.MockClient{MockUpdate: test.NewMockClient().Update}}
+	if err := tg.Initialize(context.Background(), cr); err != nil {
+		t.Fatalf("Initialize(...): %s", err)
+	}
+	want := map[string]string{"k": "v"}
+	for k, v := range resource.GetExternalTags(cr) {
+		want[k] = v
+	}
+	if diff := cmp.Diff(want, cr.Spec.ForProvider.Tags); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
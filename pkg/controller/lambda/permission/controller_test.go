@@ -0,0 +1,207 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	statementID = "s3"
+	policy      = `{"Version":"2012-10-17","Statement":[{"Sid":"s3","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction","Resource":"arn:aws:lambda:us-east-1:123456789012:function:fn"}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type permissionModifier func(*v1alpha1.Permission)
+
+func withConditions(c ...xpv1.Condition) permissionModifier {
+	return func(r *v1alpha1.Permission) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPrincipal(p string) permissionModifier {
+	return func(r *v1alpha1.Permission) { r.Spec.ForProvider.Principal = p }
+}
+
+func permission(m ...permissionModifier) *v1alpha1.Permission {
+	cr := &v1alpha1.Permission{Spec: v1alpha1.PermissionSpec{ForProvider: v1alpha1.PermissionParameters{
+		FunctionName: aws.String("fn"),
+		Action:       "lambda:InvokeFunction",
+		Principal:    "s3.amazonaws.com",
+	}}}
+	meta.SetExternalName(cr, statementID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPolicy(p string, err error) func(*awslambda.GetPolicyInput) awslambda.GetPolicyRequest {
+	return func(*awslambda.GetPolicyInput) awslambda.GetPolicyRequest {
+		return awslambda.GetPolicyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.GetPolicyOutput{Policy: aws.String(p)}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Permission
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client lambda.PermissionClient
+		cr     *v1alpha1.Permission
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockPermissionClient{MockGetPolicy: getPolicy(policy, nil)},
+			cr:     permission(),
+			want: want{
+				cr:     permission(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PrincipalChanged": {
+			client: &fake.MockPermissionClient{MockGetPolicy: getPolicy(policy, nil)},
+			cr:     permission(withPrincipal("sns.amazonaws.com")),
+			want: want{
+				cr:     permission(withPrincipal("sns.amazonaws.com"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NoStatement": {
+			client: &fake.MockPermissionClient{MockGetPolicy: getPolicy(`{"Statement":[]}`, nil)},
+			cr:     permission(),
+			want: want{
+				cr: permission(),
+			},
+		},
+		"NoPolicy": {
+			client: &fake.MockPermissionClient{MockGetPolicy: getPolicy("", awserr.New(lambda.ResourceNotFound, "", nil))},
+			cr:     permission(),
+			want: want{
+				cr: permission(),
+			},
+		},
+		"GetPolicyFailed": {
+			client: &fake.MockPermissionClient{MockGetPolicy: getPolicy("", errBoom)},
+			cr:     permission(),
+			want: want{
+				cr:  permission(),
+				err: errors.Wrap(errBoom, errGetPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client lambda.PermissionClient
+		err    error
+	}{
+		"Successful": {
+			client: &fake.MockPermissionClient{
+				MockRemove: func(input *awslambda.RemovePermissionInput) awslambda.RemovePermissionRequest {
+					return awslambda.RemovePermissionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.RemovePermissionOutput{}},
+					}
+				},
+				MockAdd: func(input *awslambda.AddPermissionInput) awslambda.AddPermissionRequest {
+					return awslambda.AddPermissionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.AddPermissionOutput{}},
+					}
+				},
+			},
+		},
+		"RemoveFailed": {
+			client: &fake.MockPermissionClient{
+				MockRemove: func(input *awslambda.RemovePermissionInput) awslambda.RemovePermissionRequest {
+					return awslambda.RemovePermissionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+					}
+				},
+			},
+			err: errors.Wrap(errBoom, errRemove),
+		},
+		"AddFailed": {
+			client: &fake.MockPermissionClient{
+				MockRemove: func(input *awslambda.RemovePermissionInput) awslambda.RemovePermissionRequest {
+					return awslambda.RemovePermissionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awslambda.RemovePermissionOutput{}},
+					}
+				},
+				MockAdd: func(input *awslambda.AddPermissionInput) awslambda.AddPermissionRequest {
+					return awslambda.AddPermissionRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+					}
+				},
+			},
+			err: errors.Wrap(errBoom, errAdd),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), permission())
+
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
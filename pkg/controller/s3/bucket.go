@@ -69,7 +69,7 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger) error {
 
 type connector struct {
 	kube        client.Client
-	newClientFn func(config aws.Config) s3.BucketClient
+	newClientFn func(config aws.Config, pathStyle bool) s3.BucketClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -81,7 +81,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	s3client := c.newClientFn(*cfg)
+	pathStyle, err := awscommon.UsesS3PathStyle(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s3client := c.newClientFn(*cfg, pathStyle)
 	return &external{s3client: s3client, subresourceClients: bucket.NewSubresourceClients(s3client), kube: c.kube}, nil
 }
 
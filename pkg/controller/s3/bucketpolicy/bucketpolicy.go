@@ -65,7 +65,7 @@ func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger) error {
 
 type connector struct {
 	kube        client.Client
-	newClientFn func(config aws.Config) s3.BucketPolicyClient
+	newClientFn func(config aws.Config, pathStyle bool) s3.BucketPolicyClient
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -77,7 +77,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	pathStyle, err := awscommon.UsesS3PathStyle(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg, pathStyle), kube: c.kube}, nil
 }
 
 type external struct {
@@ -0,0 +1,298 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssecretsmanager "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/secretsmanager"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Secret resource"
+	errKubeUpdateFailed = "cannot update Secret custom resource"
+
+	errDescribe       = "failed to describe the Secret resource"
+	errGetValue       = "failed to get the value of the Secret resource"
+	errCreate         = "failed to create the Secret resource"
+	errRestore        = "failed to restore the Secret resource"
+	errUpdate         = "failed to update the Secret resource"
+	errPutValue       = "failed to put a new value of the Secret resource"
+	errRotate         = "failed to configure the rotation of the Secret resource"
+	errCancelRotation = "failed to cancel the rotation of the Secret resource"
+	errCreateTags     = "failed to create tags for the Secret resource"
+	errRemoveTags     = "failed to remove tags for the Secret resource"
+	errDelete         = "failed to delete the Secret resource"
+)
+
+// SetupSecret adds a controller that reconciles Secrets.
+func SetupSecret(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.SecretGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.Secret{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SecretGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: secretsmanager.NewSecretClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) secretsmanager.SecretClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Secret)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclients.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client secretsmanager.SecretClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.Secret)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	rsp, err := e.client.DescribeSecretRequest(&awssecretsmanager.DescribeSecretInput{
+		SecretId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(secretsmanager.IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = secretsmanager.GenerateSecretObservation(rsp)
+
+	// A secret is scheduled for deletion rather than deleted right away, so we
+	// consider it gone once it is scheduled. If the deletion was not requested
+	// by us, the secret is restored during the next update.
+	if rsp.DeletedDate != nil {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	secretsmanager.LateInitializeSecret(&cr.Spec.ForProvider, rsp)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	add, remove := awsclients.DiffTags(cr.Spec.ForProvider.Tags, secretsmanager.GenerateTags(rsp.Tags))
+	if len(add) != 0 || len(remove) != 0 ||
+		!secretsmanager.IsSecretUpToDate(cr.Spec.ForProvider, rsp) ||
+		!secretsmanager.IsRotationUpToDate(cr.Spec.ForProvider, rsp) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+	}
+
+	upToDate, err := e.isValueUpToDate(ctx, cr)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, err
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.Secret)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+	value, err := secretsmanager.GetDesiredValue(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.CreateSecretRequest(secretsmanager.GenerateCreateSecretInput(meta.GetExternalName(cr), cr.Spec.ForProvider, value)).Send(ctx)
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha1.Secret)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	id := aws.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+
+	rsp, err := e.client.DescribeSecretRequest(&awssecretsmanager.DescribeSecretInput{SecretId: id}).Send(ctx)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDescribe)
+	}
+
+	if rsp.DeletedDate != nil {
+		if _, err := e.client.RestoreSecretRequest(&awssecretsmanager.RestoreSecretInput{SecretId: id}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRestore)
+		}
+	}
+
+	if !secretsmanager.IsSecretUpToDate(p, rsp) {
+		if _, err := e.client.UpdateSecretRequest(&awssecretsmanager.UpdateSecretInput{
+			SecretId:    id,
+			Description: aws.String(aws.StringValue(p.Description)),
+			KmsKeyId:    p.KMSKeyID,
+		}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+		}
+	}
+
+	upToDate, err := e.isValueUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !upToDate {
+		value, err := secretsmanager.GetDesiredValue(ctx, e.kube, p)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if _, err := e.client.PutSecretValueRequest(&awssecretsmanager.PutSecretValueInput{SecretId: id, SecretString: value}).Send(ctx); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPutValue)
+		}
+	}
+
+	if !secretsmanager.IsRotationUpToDate(p, rsp) {
+		if p.RotationLambdaARN == nil {
+			if _, err := e.client.CancelRotateSecretRequest(&awssecretsmanager.CancelRotateSecretInput{SecretId: id}).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errCancelRotation)
+			}
+		} else {
+			if _, err := e.client.RotateSecretRequest(secretsmanager.GenerateRotateSecretInput(meta.GetExternalName(cr), p)).Send(ctx); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRotate)
+			}
+		}
+	}
+
+	return managed.ExternalUpdate{}, e.updateTags(ctx, id, p.Tags, secretsmanager.GenerateTags(rsp.Tags))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Secret)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.DeletedDate != nil {
+		return nil
+	}
+	in := &awssecretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(meta.GetExternalName(cr)),
+		ForceDeleteWithoutRecovery: cr.Spec.ForProvider.ForceDeleteWithoutRecovery,
+	}
+	// Secrets Manager rejects a recovery window for a forced deletion.
+	if !aws.BoolValue(cr.Spec.ForProvider.ForceDeleteWithoutRecovery) {
+		in.RecoveryWindowInDays = cr.Spec.ForProvider.RecoveryWindowInDays
+	}
+	_, err := e.client.DeleteSecretRequest(in).Send(ctx)
+	return errors.Wrap(resource.Ignore(secretsmanager.IsNotFound, err), errDelete)
+}
+
+// isValueUpToDate returns true if the current value of the secret is the one
+// of the referenced Kubernetes Secret, or if the value is not managed.
+func (e *external) isValueUpToDate(ctx context.Context, cr *v1alpha1.Secret) (bool, error) {
+	if !secretsmanager.IsValueManaged(cr.Spec.ForProvider) {
+		return true, nil
+	}
+	desired, err := secretsmanager.GetDesiredValue(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+	rsp, err := e.client.GetSecretValueRequest(&awssecretsmanager.GetSecretValueInput{
+		SecretId: aws.String(meta.GetExternalName(cr)),
+	}).Send(ctx)
+	// A secret that was created without a value has no current version.
+	if secretsmanager.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetValue)
+	}
+	return aws.StringValue(desired) == aws.StringValue(rsp.SecretString), nil
+}
+
+func (e *external) updateTags(ctx context.Context, id *string, desired, current map[string]string) error {
+	add, remove := awsclients.DiffTags(desired, current)
+	if len(remove) != 0 {
+		if _, err := e.client.UntagResourceRequest(&awssecretsmanager.UntagResourceInput{SecretId: id, TagKeys: remove}).Send(ctx); err != nil {
+			return errors.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		tags := make([]awssecretsmanager.Tag, 0, len(add))
+		for k, v := range add {
+			tags = append(tags, awssecretsmanager.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		if _, err := e.client.TagResourceRequest(&awssecretsmanager.TagResourceInput{SecretId: id, Tags: tags}).Send(ctx); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha1.Secret)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.Tags == nil {
+		cr.Spec.ForProvider.Tags = map[string]string{}
+	}
+	for k, v := range resource.GetExternalTags(mgd) {
+		cr.Spec.ForProvider.Tags[k] = v
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
@@ -0,0 +1,459 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssecretsmanager "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/secretsmanager/fake"
+)
+
+var (
+	secretName = "example"
+	secretARN  = "arn:aws:secretsmanager:us-east-1:123456789012:secret:example-AbCdEf"
+	lambdaARN  = "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	value      = "s3cr3t"
+
+	deletedDate  = time.Date(2020, time.October, 1, 0, 0, 0, 0, time.UTC)
+	deletionTime = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type secretModifier func(*v1alpha1.Secret)
+
+func withExternalName(name string) secretModifier {
+	return func(r *v1alpha1.Secret) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) secretModifier {
+	return func(r *v1alpha1.Secret) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.SecretParameters) secretModifier {
+	return func(r *v1alpha1.Secret) { r.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.SecretObservation) secretModifier {
+	return func(r *v1alpha1.Secret) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() secretModifier {
+	return func(r *v1alpha1.Secret) { r.SetDeletionTimestamp(&deletionTime) }
+}
+
+func secret(m ...secretModifier) *v1alpha1.Secret {
+	cr := &v1alpha1.Secret{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params() v1alpha1.SecretParameters {
+	return v1alpha1.SecretParameters{
+		Description:     aws.String("example"),
+		StringSecretRef: &xpv1.SecretKeySelector{Key: "password"},
+	}
+}
+
+func kube() *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(value)}
+			return nil
+		},
+		MockUpdate: test.NewMockClient().Update,
+	}
+}
+
+func describe(o awssecretsmanager.DescribeSecretOutput, err error) func(*awssecretsmanager.DescribeSecretInput) awssecretsmanager.DescribeSecretRequest {
+	return func(*awssecretsmanager.DescribeSecretInput) awssecretsmanager.DescribeSecretRequest {
+		o.ARN = aws.String(secretARN)
+		return awssecretsmanager.DescribeSecretRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &o, Error: err},
+		}
+	}
+}
+
+func getValue(v string, err error) func(*awssecretsmanager.GetSecretValueInput) awssecretsmanager.GetSecretValueRequest {
+	return func(*awssecretsmanager.GetSecretValueInput) awssecretsmanager.GetSecretValueRequest {
+		return awssecretsmanager.GetSecretValueRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.GetSecretValueOutput{SecretString: aws.String(v)}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Secret
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockSecretClient
+		cr     *v1alpha1.Secret
+		want
+	}{
+		"UpToDate": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{Description: aws.String("example")}, nil),
+				MockGetValue: getValue(value, nil),
+			},
+			cr: secret(withExternalName(secretName), withSpec(params())),
+			want: want{
+				cr: secret(withExternalName(secretName), withSpec(params()),
+					withObservation(v1alpha1.SecretObservation{ARN: secretARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ValueChanged": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{Description: aws.String("example")}, nil),
+				MockGetValue: getValue("old", nil),
+			},
+			cr: secret(withExternalName(secretName), withSpec(params())),
+			want: want{
+				cr: secret(withExternalName(secretName), withSpec(params()),
+					withObservation(v1alpha1.SecretObservation{ARN: secretARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RotationNotConfigured": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{Description: aws.String("example")}, nil),
+			},
+			cr: secret(withExternalName(secretName), withSpec(v1alpha1.SecretParameters{
+				Description:       aws.String("example"),
+				RotationLambdaARN: aws.String(lambdaARN),
+			})),
+			want: want{
+				cr: secret(withExternalName(secretName), withSpec(v1alpha1.SecretParameters{
+					Description:       aws.String("example"),
+					RotationLambdaARN: aws.String(lambdaARN),
+				}), withObservation(v1alpha1.SecretObservation{ARN: secretARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"PendingDeletion": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{DeletedDate: &deletedDate}, nil),
+			},
+			cr: secret(withExternalName(secretName), withSpec(params())),
+			want: want{
+				cr: secret(withExternalName(secretName), withSpec(params()),
+					withObservation(v1alpha1.SecretObservation{ARN: secretARN, DeletedDate: &metav1.Time{Time: deletedDate}}),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Deleted": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{DeletedDate: &deletedDate}, nil),
+			},
+			cr: secret(withExternalName(secretName), withDeletionTimestamp()),
+			want: want{
+				cr: secret(withExternalName(secretName), withDeletionTimestamp(),
+					withObservation(v1alpha1.SecretObservation{ARN: secretARN, DeletedDate: &metav1.Time{Time: deletedDate}})),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotFound": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{}, awserr.New(awssecretsmanager.ErrCodeResourceNotFoundException, "", nil)),
+			},
+			cr: secret(withExternalName(secretName)),
+			want: want{
+				cr: secret(withExternalName(secretName)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{}, errBoom),
+			},
+			cr: secret(withExternalName(secretName)),
+			want: want{
+				cr:  secret(withExternalName(secretName)),
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"GetValueFailed": {
+			client: &fake.MockSecretClient{
+				MockDescribe: describe(awssecretsmanager.DescribeSecretOutput{Description: aws.String("example")}, nil),
+				MockGetValue: getValue("", errBoom),
+			},
+			cr: secret(withExternalName(secretName), withSpec(params())),
+			want: want{
+				cr: secret(withExternalName(secretName), withSpec(params()),
+					withObservation(v1alpha1.SecretObservation{ARN: secretARN}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+				err:    errors.Wrap(errBoom, errGetValue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: kube()}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Secret
+		result managed.ExternalCreation
+		err    error
+	}
+
+	create := func(err error) func(*awssecretsmanager.CreateSecretInput) awssecretsmanager.CreateSecretRequest {
+		return func(in *awssecretsmanager.CreateSecretInput) awssecretsmanager.CreateSecretRequest {
+			if aws.StringValue(in.SecretString) != value {
+				err = errors.New("unexpected secret value")
+			}
+			return awssecretsmanager.CreateSecretRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.CreateSecretOutput{}, Error: err},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		client *fake.MockSecretClient
+		cr     *v1alpha1.Secret
+		want
+	}{
+		"Successful": {
+			client: &fake.MockSecretClient{MockCreate: create(nil)},
+			cr:     secret(withExternalName(secretName), withSpec(params())),
+			want: want{
+				cr: secret(withExternalName(secretName), withSpec(params()), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockSecretClient{MockCreate: create(errBoom)},
+			cr:     secret(withExternalName(secretName), withSpec(params())),
+			want: want{
+				cr:  secret(withExternalName(secretName), withSpec(params()), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: kube()}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type calls struct {
+		restore, update, putValue, rotate, cancelRotation bool
+	}
+
+	cases := map[string]struct {
+		p        v1alpha1.SecretParameters
+		observed awssecretsmanager.DescribeSecretOutput
+		want     calls
+	}{
+		"ValueChanged": {
+			p:        params(),
+			observed: awssecretsmanager.DescribeSecretOutput{Description: aws.String("example")},
+			want:     calls{putValue: true},
+		},
+		"Restore": {
+			p:        params(),
+			observed: awssecretsmanager.DescribeSecretOutput{Description: aws.String("example"), DeletedDate: &deletedDate},
+			want:     calls{restore: true, putValue: true},
+		},
+		"DescriptionChanged": {
+			p:        params(),
+			observed: awssecretsmanager.DescribeSecretOutput{Description: aws.String("old")},
+			want:     calls{update: true, putValue: true},
+		},
+		"Rotate": {
+			p:        v1alpha1.SecretParameters{RotationLambdaARN: aws.String(lambdaARN)},
+			observed: awssecretsmanager.DescribeSecretOutput{},
+			want:     calls{rotate: true},
+		},
+		"CancelRotation": {
+			p:        v1alpha1.SecretParameters{},
+			observed: awssecretsmanager.DescribeSecretOutput{RotationEnabled: aws.Bool(true), RotationLambdaARN: aws.String(lambdaARN)},
+			want:     calls{cancelRotation: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := calls{}
+			client := &fake.MockSecretClient{
+				MockDescribe: describe(tc.observed, nil),
+				MockGetValue: getValue("old", nil),
+				MockRestore: func(*awssecretsmanager.RestoreSecretInput) awssecretsmanager.RestoreSecretRequest {
+					got.restore = true
+					return awssecretsmanager.RestoreSecretRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.RestoreSecretOutput{}},
+					}
+				},
+				MockUpdate: func(*awssecretsmanager.UpdateSecretInput) awssecretsmanager.UpdateSecretRequest {
+					got.update = true
+					return awssecretsmanager.UpdateSecretRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.UpdateSecretOutput{}},
+					}
+				},
+				MockPutValue: func(*awssecretsmanager.PutSecretValueInput) awssecretsmanager.PutSecretValueRequest {
+					got.putValue = true
+					return awssecretsmanager.PutSecretValueRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.PutSecretValueOutput{}},
+					}
+				},
+				MockRotate: func(*awssecretsmanager.RotateSecretInput) awssecretsmanager.RotateSecretRequest {
+					got.rotate = true
+					return awssecretsmanager.RotateSecretRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.RotateSecretOutput{}},
+					}
+				},
+				MockCancelRotate: func(*awssecretsmanager.CancelRotateSecretInput) awssecretsmanager.CancelRotateSecretRequest {
+					got.cancelRotation = true
+					return awssecretsmanager.CancelRotateSecretRequest{
+						Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.CancelRotateSecretOutput{}},
+					}
+				},
+			}
+			e := &external{client: client, kube: kube()}
+			_, err := e.Update(context.Background(), secret(withExternalName(secretName), withSpec(tc.p)))
+
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(calls{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Secret
+		err error
+	}
+
+	deleteSecret := func(err error) func(*awssecretsmanager.DeleteSecretInput) awssecretsmanager.DeleteSecretRequest {
+		return func(*awssecretsmanager.DeleteSecretInput) awssecretsmanager.DeleteSecretRequest {
+			return awssecretsmanager.DeleteSecretRequest{
+				Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssecretsmanager.DeleteSecretOutput{}, Error: err},
+			}
+		}
+	}
+	deleted := v1alpha1.SecretObservation{DeletedDate: &metav1.Time{Time: deletedDate}}
+
+	cases := map[string]struct {
+		client *fake.MockSecretClient
+		cr     *v1alpha1.Secret
+		want
+	}{
+		"Successful": {
+			client: &fake.MockSecretClient{MockDelete: deleteSecret(nil)},
+			cr:     secret(withExternalName(secretName)),
+			want: want{
+				cr: secret(withExternalName(secretName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyPendingDeletion": {
+			client: &fake.MockSecretClient{},
+			cr:     secret(withExternalName(secretName), withObservation(deleted)),
+			want: want{
+				cr: secret(withExternalName(secretName), withObservation(deleted), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockSecretClient{MockDelete: deleteSecret(awserr.New(awssecretsmanager.ErrCodeResourceNotFoundException, "", nil))},
+			cr:     secret(withExternalName(secretName)),
+			want: want{
+				cr: secret(withExternalName(secretName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			client: &fake.MockSecretClient{MockDelete: deleteSecret(errBoom)},
+			cr:     secret(withExternalName(secretName)),
+			want: want{
+				cr:  secret(withExternalName(secretName), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuepolicy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awscommon "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a QueuePolicy custom resource"
+	errGet              = "cannot get the policy of the Queue"
+	errSerialize        = "cannot serialize the QueuePolicy"
+	errCompare          = "cannot compare the desired and observed policies of the Queue"
+	errAttach           = "cannot attach the policy to the Queue"
+	errUpdate           = "cannot update the policy of the Queue"
+	errDelete           = "cannot delete the policy of the Queue"
+)
+
+// SetupQueuePolicy adds a controller that reconciles QueuePolicies.
+func SetupQueuePolicy(mgr ctrl.Manager, l logging.Logger) error {
+	name := managed.ControllerName(v1alpha1.QueuePolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.QueuePolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.QueuePolicyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewQueuePolicyClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(aws.Config) sqs.QueuePolicyClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awscommon.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client sqs.QueuePolicyClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	attr, err := e.getAttributes(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(sqs.IsNotFound, err), errGet)
+	}
+	if attr[v1beta1.AttributePolicy] == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	policy, err := sqs.GenerateQueuePolicy(cr.Spec.ForProvider, attr[v1beta1.AttributeQueueArn])
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errSerialize)
+	}
+	upToDate, err := sqs.IsQueuePolicyUpToDate(policy, attr[v1beta1.AttributePolicy])
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCompare)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, errors.Wrap(e.putPolicy(ctx, cr), errAttach)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(e.putPolicy(ctx, cr), errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.QueuePolicy)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	// SQS removes the policy of a queue when it is set to an empty string.
+	_, err := e.client.SetQueueAttributesRequest(&awssqs.SetQueueAttributesInput{
+		QueueUrl:   cr.Spec.ForProvider.QueueURL,
+		Attributes: map[string]string{v1beta1.AttributePolicy: ""},
+	}).Send(ctx)
+	return errors.Wrap(resource.Ignore(sqs.IsNotFound, err), errDelete)
+}
+
+func (e *external) getAttributes(ctx context.Context, cr *v1alpha1.QueuePolicy) (map[string]string, error) {
+	resp, err := e.client.GetQueueAttributesRequest(&awssqs.GetQueueAttributesInput{
+		QueueUrl: cr.Spec.ForProvider.QueueURL,
+		AttributeNames: []awssqs.QueueAttributeName{
+			awssqs.QueueAttributeName(v1beta1.AttributePolicy),
+			awssqs.QueueAttributeName(v1beta1.AttributeQueueArn),
+		},
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Attributes, nil
+}
+
+// putPolicy sets the policy described by the QueuePolicy on its queue. The
+// ARN of the queue is looked up so that it can be used as the default
+// resource of the statements.
+func (e *external) putPolicy(ctx context.Context, cr *v1alpha1.QueuePolicy) error {
+	attr, err := e.getAttributes(ctx, cr)
+	if err != nil {
+		return err
+	}
+	policy, err := sqs.GenerateQueuePolicy(cr.Spec.ForProvider, attr[v1beta1.AttributeQueueArn])
+	if err != nil {
+		return errors.Wrap(err, errSerialize)
+	}
+	_, err = e.client.SetQueueAttributesRequest(&awssqs.SetQueueAttributesInput{
+		QueueUrl:   cr.Spec.ForProvider.QueueURL,
+		Attributes: map[string]string{v1beta1.AttributePolicy: policy},
+	}).Send(ctx)
+	return err
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuepolicy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs/fake"
+)
+
+var (
+	queueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/queue"
+	queueARN = "arn:aws:sqs:us-east-1:123456789012:queue"
+	policy   = `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"sns.amazonaws.com"},"Resource":"arn:aws:sqs:us-east-1:123456789012:queue"}],"Version":"2012-10-17"}`
+
+	params = v1alpha1.QueuePolicyParameters{
+		Version:  "2012-10-17",
+		QueueURL: &queueURL,
+		Statements: []v1alpha1.QueuePolicyStatement{
+			{
+				Effect:    "Allow",
+				Principal: &v1alpha1.QueuePrincipal{Service: []string{"sns.amazonaws.com"}},
+				Action:    []string{"sqs:SendMessage"},
+			},
+		},
+	}
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sqs sqs.QueuePolicyClient
+	cr  *v1alpha1.QueuePolicy
+}
+
+type queuePolicyModifier func(*v1alpha1.QueuePolicy)
+
+func withConditions(c ...xpv1.Condition) queuePolicyModifier {
+	return func(r *v1alpha1.QueuePolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func queuePolicy(m ...queuePolicyModifier) *v1alpha1.QueuePolicy {
+	cr := &v1alpha1.QueuePolicy{
+		Spec: v1alpha1.QueuePolicySpec{
+			ForProvider: params,
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAttributes(attr map[string]string, err error) func(*awssqs.GetQueueAttributesInput) awssqs.GetQueueAttributesRequest {
+	return func(*awssqs.GetQueueAttributesInput) awssqs.GetQueueAttributesRequest {
+		return awssqs.GetQueueAttributesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.GetQueueAttributesOutput{Attributes: attr}, Error: err},
+		}
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.QueuePolicy
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+						v1beta1.AttributePolicy:   policy,
+					}, nil),
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+						v1beta1.AttributePolicy:   `{"Statement":[],"Version":"2012-10-17"}`,
+					}, nil),
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+					}, nil),
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(),
+			},
+		},
+		"QueueNotFound": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(nil, awserr.New(sqs.QueueNotFound, "", nil)),
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(nil, errBoom),
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr:  queuePolicy(),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sqs}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.QueuePolicy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+					}, nil),
+					MockSetQueueAttributesRequest: func(input *awssqs.SetQueueAttributesInput) awssqs.SetQueueAttributesRequest {
+						if diff := cmp.Diff(map[string]string{v1beta1.AttributePolicy: policy}, input.Attributes); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssqs.SetQueueAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.SetQueueAttributesOutput{}},
+						}
+					},
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(withConditions(xpv1.Creating())),
+			},
+		},
+		"SetFailed": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+					}, nil),
+					MockSetQueueAttributesRequest: func(input *awssqs.SetQueueAttributesInput) awssqs.SetQueueAttributesRequest {
+						return awssqs.SetQueueAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr:  queuePolicy(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sqs}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(map[string]string{
+						v1beta1.AttributeQueueArn: queueARN,
+					}, nil),
+					MockSetQueueAttributesRequest: func(input *awssqs.SetQueueAttributesInput) awssqs.SetQueueAttributesRequest {
+						return awssqs.SetQueueAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.SetQueueAttributesOutput{}},
+						}
+					},
+				},
+				cr: queuePolicy(),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributesRequest: getAttributes(nil, errBoom),
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sqs}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.QueuePolicy
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockSetQueueAttributesRequest: func(input *awssqs.SetQueueAttributesInput) awssqs.SetQueueAttributesRequest {
+						if diff := cmp.Diff(map[string]string{v1beta1.AttributePolicy: ""}, input.Attributes); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return awssqs.SetQueueAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Data: &awssqs.SetQueueAttributesOutput{}},
+						}
+					},
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"QueueNotFound": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockSetQueueAttributesRequest: func(input *awssqs.SetQueueAttributesInput) awssqs.SetQueueAttributesRequest {
+						return awssqs.SetQueueAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: awserr.New(sqs.QueueNotFound, "", nil)},
+						}
+					},
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr: queuePolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockSetQueueAttributesRequest: func(input *awssqs.SetQueueAttributesInput) awssqs.SetQueueAttributesRequest {
+						return awssqs.SetQueueAttributesRequest{
+							Request: &aws.Request{HTTPRequest: &http.Request{}, Retryer: aws.NoOpRetryer{}, Error: errBoom},
+						}
+					},
+				},
+				cr: queuePolicy(),
+			},
+			want: want{
+				cr:  queuePolicy(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sqs}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}